@@ -6,9 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"sync/atomic"
 
-	"github.com/alitto/pond/v2"
 	"github.com/defeedco/defeed/pkg/llms"
 	"github.com/defeedco/defeed/pkg/sources/activities"
 
@@ -24,6 +22,7 @@ import (
 type Config struct {
 	SourceUIDs              []string
 	ActivityUIDs            []string
+	SourceTypes             []string
 	DryRun                  bool
 	BatchSize               int
 	MaxActivities           int
@@ -31,6 +30,7 @@ type Config struct {
 	ForceReprocessSummary   bool
 	ForceReprocessEmbedding bool
 	ForceUpsert             bool
+	TargetEmbeddingDim      int          `validate:"oneof=0 1536 3072"`
 	Period                  types.Period `json:"period" validate:"required,oneof=all month week day"`
 	EnvFilePath             string       `validate:"required"`
 }
@@ -40,6 +40,7 @@ func main() {
 
 	flag.Var((*stringSlice)(&config.SourceUIDs), "source", "Source UID to reprocess (can be specified multiple times)")
 	flag.Var((*stringSlice)(&config.ActivityUIDs), "activity", "Activity UID to reprocess (can be specified multiple times)")
+	flag.Var((*stringSlice)(&config.SourceTypes), "source-type", "Source type to reprocess, e.g. githubissues, after changing its embedding input (can be specified multiple times)")
 	flag.BoolVar(&config.DryRun, "dry-run", false, "Show what would be reprocessed without actually doing it")
 	flag.IntVar(&config.BatchSize, "batch-size", 50, "Number of activities to process in each batch")
 	flag.IntVar(&config.MaxActivities, "max-activities", 0, "Maximum number of activities to reprocess (0 = no limit)")
@@ -47,6 +48,7 @@ func main() {
 	flag.BoolVar(&config.ForceReprocessSummary, "force-reprocess-summary", false, "Force reprocess full/short summary even if summary exists")
 	flag.BoolVar(&config.ForceReprocessEmbedding, "force-reprocess-embeddings", false, "Force reprocess embeddings even if activity embeddings exists")
 	flag.BoolVar(&config.ForceUpsert, "force-upsert", false, "Force upsert even if activity already exists")
+	flag.IntVar(&config.TargetEmbeddingDim, "target-embedding-dim", 0, "Recompute embeddings of a different dimension after an embedding model change, e.g. 1536 (0 = disabled)")
 	flag.StringVar((*string)(&config.Period), "period", "all", "Time period to filter activities (all, month, week, day)")
 	flag.StringVar(&config.EnvFilePath, "env-file", ".env", "Path to .env file")
 	flag.Parse()
@@ -85,6 +87,10 @@ func run(ctx context.Context, config Config) error {
 	if err != nil {
 		return fmt.Errorf("connect to database: %w", err)
 	}
+	if !db.VectorSearch() {
+		logger.Warn().Msg("PGVECTOR IS UNAVAILABLE: embeddings are disabled and activities are searched by keywords only")
+		cfg.Activities.KeywordSearchOnly = true
+	}
 
 	completionModel, err := llms.NewCompletionModel(&cfg.LLMs, logger)
 	if err != nil {
@@ -96,21 +102,30 @@ func run(ctx context.Context, config Config) error {
 		return fmt.Errorf("create embedder model: %w", err)
 	}
 
-	summarizer := nlp.NewSummarizer(completionModel, logger)
+	summarizer, err := nlp.NewSummarizer(completionModel, &cfg.LLMs, logger)
+	if err != nil {
+		return fmt.Errorf("create summarizer: %w", err)
+	}
 
-	embedder := nlp.NewActivityEmbedder(embeddingModel)
+	embeddingInputs, err := nlp.ParseEmbeddingInputs(cfg.LLMs.EmbeddingInputSourceTypes)
+	if err != nil {
+		return fmt.Errorf("parse embedding inputs: %w", err)
+	}
+	embedder := nlp.NewActivityEmbedder(embeddingModel, cfg.LLMs.EmbeddingModel).
+		WithSourceTypeInputs(embeddingInputs, cfg.LLMs.EmbeddingInputMaxBodyChars)
 
 	activityRepo := postgres.NewActivityRepository(db, logger)
-	activityRegistry := activities.NewRegistry(logger, activityRepo, summarizer, embedder)
+	activityRegistry := activities.NewRegistry(logger, activityRepo, summarizer, embedder, &cfg.Activities)
 
-	searchReq, err := buildSearchRequest(config)
+	reprocessReq, err := buildReprocessRequest(config)
 	if err != nil {
-		return fmt.Errorf("build search request: %w", err)
+		return fmt.Errorf("build reprocess request: %w", err)
 	}
 
 	logger.Info().
 		Strs("source_uids", config.SourceUIDs).
 		Strs("activity_uids", config.ActivityUIDs).
+		Strs("source_types", config.SourceTypes).
 		Bool("dry_run", config.DryRun).
 		Int("batch_size", config.BatchSize).
 		Int("max_activities", config.MaxActivities).
@@ -118,82 +133,35 @@ func run(ctx context.Context, config Config) error {
 		Bool("force-reprocess-summary", config.ForceReprocessSummary).
 		Bool("force-reprocess-embeddings", config.ForceReprocessEmbedding).
 		Bool("force-upsert", config.ForceUpsert).
+		Int("target-embedding-dim", config.TargetEmbeddingDim).
 		Str("period", string(config.Period)).
 		Msg("Starting reprocessing")
 
-	// Create a pool with limited concurrency
-	pool := pond.NewPool(config.MaxConcurrency)
-	skipped := atomic.Int32{}
-	errored := atomic.Int32{}
-	fetchCount := 0
-
-	for {
-		result, err := activityRegistry.Search(ctx, searchReq)
-		if err != nil {
-			return fmt.Errorf("search activities: %w", err)
-		}
-		searchReq.Cursor = result.NextCursor
-		fetchCount += len(result.Activities)
-
-		logger.Info().
-			Int("activities_count", len(result.Activities)).
-			Str("next_cursor", result.NextCursor).
-			Bool("has_more", result.HasMore).
-			Msg("Processing batch")
-
-		if config.MaxActivities > 0 && fetchCount > config.MaxActivities {
-			break
-		}
-
-		if config.DryRun {
-			continue
-		}
-
-		for _, act := range result.Activities {
-			pool.Submit(func() {
-				isUpserted, err := activityRegistry.Create(ctx, activities.CreateRequest{
-					Activity:                act.Activity,
-					ForceReprocessSummary:   config.ForceReprocessSummary,
-					ForceReprocessEmbedding: config.ForceReprocessEmbedding,
-					Upsert:                  config.ForceUpsert,
-				})
-				if err != nil {
-					logger.Error().
-						Err(err).
-						Str("activity_id", act.Activity.UID().String()).
-						Msg("Error reprocessing activity")
-					errored.Add(1)
-				}
-				if !isUpserted {
-					skipped.Add(1)
-				}
-				logger.Info().
-					Str("activity_id", act.Activity.UID().String()).
-					Bool("is_added", isUpserted).
-					Msg("Processed activity")
-			})
-		}
-
-		if !result.HasMore {
-			break
-		}
+	progress := &activities.ReprocessProgress{}
+	if err := activityRegistry.Reprocess(ctx, reprocessReq, progress); err != nil {
+		return fmt.Errorf("reprocess: %w", err)
 	}
 
-	pool.StopAndWait()
-
 	logger.Info().
-		Int32("skipped", skipped.Load()).
-		Int32("errored", errored.Load()).
+		Int32("skipped", progress.Skipped.Load()).
+		Int32("errored", progress.Errored.Load()).
 		Msg("Reprocessing completed")
 
 	return nil
 }
 
-func buildSearchRequest(config Config) (activities.SearchRequest, error) {
-	req := activities.SearchRequest{
-		Limit:  config.BatchSize,
-		SortBy: types.SortByDate,
-		Period: config.Period,
+func buildReprocessRequest(config Config) (activities.ReprocessRequest, error) {
+	req := activities.ReprocessRequest{
+		SourceTypes:             config.SourceTypes,
+		Period:                  config.Period,
+		BatchSize:               config.BatchSize,
+		MaxActivities:           config.MaxActivities,
+		MaxConcurrency:          config.MaxConcurrency,
+		DryRun:                  config.DryRun,
+		ForceReprocessSummary:   config.ForceReprocessSummary,
+		ForceReprocessEmbedding: config.ForceReprocessEmbedding,
+		ForceUpsert:             config.ForceUpsert,
+		TargetEmbeddingDim:      config.TargetEmbeddingDim,
 	}
 
 	// Convert source UIDs
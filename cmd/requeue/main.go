@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	appconfig "github.com/defeedco/defeed/pkg/config"
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/lib/log"
+	"github.com/defeedco/defeed/pkg/storage/postgres"
+	"github.com/joho/godotenv"
+)
+
+type Config struct {
+	ActivityUIDs []string
+	DryRun       bool
+	EnvFilePath  string `validate:"required"`
+}
+
+func main() {
+	var config Config
+
+	flag.Var((*stringSlice)(&config.ActivityUIDs), "activity", "Failed activity UID to requeue (can be specified multiple times, defaults to all)")
+	flag.BoolVar(&config.DryRun, "dry-run", false, "List failed activities without requeuing them")
+	flag.StringVar(&config.EnvFilePath, "env-file", ".env", "Path to .env file")
+	flag.Parse()
+
+	ctx := context.Background()
+	if err := run(ctx, config); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, config Config) error {
+	if err := lib.ValidateStruct(config); err != nil {
+		return fmt.Errorf("config validation: %w", err)
+	}
+
+	// Load environment
+	err := godotenv.Load(config.EnvFilePath)
+	if err != nil {
+		fmt.Println("Warning: Could not load .env file")
+	}
+
+	cfg, err := appconfig.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	logger, err := log.NewLogger(&cfg.Log)
+	if err != nil {
+		return fmt.Errorf("create logger: %w", err)
+	}
+
+	// Connect to database
+	db := postgres.NewDB(&cfg.DB)
+	err = db.Connect(ctx)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+
+	failedActivityRepo := postgres.NewFailedActivityRepository(db)
+
+	failed, err := failedActivityRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list failed activities: %w", err)
+	}
+
+	for _, f := range failed {
+		logger.Info().
+			Str("activity_uid", f.Activity.UID().String()).
+			Int("attempt_count", f.AttemptCount).
+			Time("next_retry_at", f.NextRetryAt).
+			Str("error", f.Error).
+			Msg("Failed activity")
+	}
+
+	if config.DryRun {
+		return nil
+	}
+
+	// Retries are picked up by the running server scheduler
+	count, err := failedActivityRepo.Requeue(ctx, config.ActivityUIDs)
+	if err != nil {
+		return fmt.Errorf("requeue failed activities: %w", err)
+	}
+
+	logger.Info().
+		Int("requeued", count).
+		Msg("Requeue completed")
+
+	return nil
+}
+
+// stringSlice implements flag.Value for string slices
+type stringSlice []string
+
+func (s *stringSlice) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSlice) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
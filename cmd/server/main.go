@@ -16,6 +16,7 @@ import (
 	"github.com/defeedco/defeed/pkg/api/auth"
 	"github.com/defeedco/defeed/pkg/config"
 	"github.com/defeedco/defeed/pkg/lib/log"
+	"github.com/defeedco/defeed/pkg/lib/tracing"
 	"github.com/defeedco/defeed/pkg/llms"
 	"github.com/defeedco/defeed/pkg/storage/postgres"
 	"github.com/joho/godotenv"
@@ -45,6 +46,16 @@ func run() error {
 	}
 
 	ctx := context.Background()
+	shutdownTracing, err := tracing.Setup(ctx, &cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("setup tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error().Err(err).Msg("failed to shutdown tracing")
+		}
+	}()
+
 	server, err := initServer(ctx, logger, cfg)
 	if err != nil {
 		return fmt.Errorf("initialize server: %w", err)
@@ -63,6 +74,10 @@ func initServer(ctx context.Context, logger *zerolog.Logger, config *config.Conf
 	if err != nil {
 		return nil, fmt.Errorf("connect to database: %w", err)
 	}
+	if !db.VectorSearch() {
+		logger.Warn().Msg("PGVECTOR IS UNAVAILABLE: embeddings are disabled and activities are searched by keywords only")
+		config.Activities.KeywordSearchOnly = true
+	}
 
 	completionModel, err := llms.NewCompletionModel(&config.LLMs, logger)
 	if err != nil {
@@ -79,16 +94,59 @@ func initServer(ctx context.Context, logger *zerolog.Logger, config *config.Conf
 	cachedCompletionModel := llms.NewCachedCompletionModel(completionModel, llmCache)
 
 	// Cache will help mostly with request-time LLM computations like query-rewrites
-	summarizer := nlp.NewSummarizer(cachedCompletionModel, logger)
+	summarizer, err := nlp.NewSummarizer(cachedCompletionModel, &config.LLMs, logger)
+	if err != nil {
+		return nil, fmt.Errorf("create summarizer: %w", err)
+	}
 	queryRewriter := nlp.NewQueryRewriter(cachedCompletionModel, logger)
-	embedder := nlp.NewActivityEmbedder(cachedEmbeddingModel)
+	embeddingInputs, err := nlp.ParseEmbeddingInputs(config.LLMs.EmbeddingInputSourceTypes)
+	if err != nil {
+		return nil, fmt.Errorf("parse embedding inputs: %w", err)
+	}
+	embedder := nlp.NewActivityEmbedder(cachedEmbeddingModel, config.LLMs.EmbeddingModel).
+		WithSourceTypeInputs(embeddingInputs, config.LLMs.EmbeddingInputMaxBodyChars)
+	if config.Activities.QueryEmbeddingCacheTTL > 0 {
+		embedder = embedder.WithQueryCache(lib.NewCache(config.Activities.QueryEmbeddingCacheTTL, logger))
+	}
+	if config.Activities.QueryExpansion {
+		queryExpansionCache := lib.NewCache(config.Activities.QueryExpansionCacheTTL, logger)
+		embedder = embedder.WithQueryExpander(nlp.NewQueryExpander(cachedCompletionModel, queryExpansionCache, logger))
+	}
 
 	activityRepo := postgres.NewActivityRepository(db, logger)
-	sourceRepo := postgres.NewSourceRepository(db)
+	secretCipher, err := lib.NewSecretCipher(config.DB.SecretsKey)
+	if err != nil {
+		return nil, fmt.Errorf("create secret cipher: %w", err)
+	}
+	sourceRepo := postgres.NewSourceRepository(db, secretCipher)
+	failedActivityRepo := postgres.NewFailedActivityRepository(db)
+
+	activityRegistry := activities.NewRegistry(logger, activityRepo, summarizer, embedder, &config.Activities)
+
+	lib.SetTextCache(config.SourceProviders.ArticleTextCache(postgres.NewArticleTextRepository(db)))
+	lib.SetImageValidator(config.SourceProviders.ImageValidator())
+	lib.SetDomainPolicy(config.Sources.DomainPolicy())
+	lib.SetMaxConcurrentFetches(config.SourceProviders.ExternalFetchConcurrency)
+	lib.SetRequestLimiter(sources.NewProviderRateLimiter(&config.SourceProviders))
 
-	activityRegistry := activities.NewRegistry(logger, activityRepo, summarizer, embedder)
+	sourceScheduler := sources.NewScheduler(logger, sourceRepo, failedActivityRepo, activityRegistry, &config.Sources, &config.SourceProviders).
+		WithStateStore(postgres.NewSourceStateRepository(db)).
+		WithStaleSourceNotifier(config.Sources.StaleSourceNotifier())
 
-	sourceScheduler := sources.NewScheduler(logger, sourceRepo, activityRegistry, &config.Sources, &config.SourceProviders)
+	// Cache source results to avoid hitting the 3rd party APIs for every FindByUID call
+	sourceRegistry := sources.NewCachedRegistry(sources.NewRegistry(logger, &config.SourceProviders), logger)
+	if err := sourceRegistry.Initialize(); err != nil {
+		return nil, fmt.Errorf("initialize source registry: %w", err)
+	}
+
+	feedStore := postgres.NewFeedRepository(db)
+	idempotencyKeyStore := postgres.NewIdempotencyKeyRepository(db)
+	readActivityStore := postgres.NewReadActivityRepository(db)
+	feedbackStore := postgres.NewActivityFeedbackRepository(db)
+	feedRegistry := feeds.NewRegistry(feedStore, readActivityStore, feedbackStore, sourceScheduler, sourceRegistry, activityRegistry, summarizer, queryRewriter, &config.Feeds, logger)
+	// The feeds are needed to filter the processed activities, so the sources are polled after the feed registry is created.
+	sourceScheduler.WithActivityFilter(feedRegistry)
+	sourceScheduler.StartFailedActivityRetries()
 	if config.SourceInitialization {
 		// Don't block the server startup
 		go func() {
@@ -96,23 +154,27 @@ func initServer(ctx context.Context, logger *zerolog.Logger, config *config.Conf
 				logger.Error().Err(err).Msg("failed to initialize source scheduler")
 			}
 		}()
+		sourceScheduler.StartReconciler(feedRegistry)
 	}
+	feedRegistry.StartPurge(ctx)
+	collections := feeds.NewCollections(postgres.NewCollectionRepository(db), activityRegistry, logger)
 
-	// Cache source results to avoid hitting the 3rd party APIs for every FindByUID call
-	sourceRegistry := sources.NewCachedRegistry(sources.NewRegistry(logger, &config.SourceProviders), logger)
-	if err := sourceRegistry.Initialize(); err != nil {
-		return nil, fmt.Errorf("initialize source registry: %w", err)
+	starterFeeds, err := config.Feeds.ParseStarterFeeds()
+	if err != nil {
+		return nil, fmt.Errorf("parse starter feeds: %w", err)
 	}
+	provisioner := feeds.NewProvisioner(feedRegistry, postgres.NewUserProvisionRepository(db), starterFeeds, &config.Feeds, logger)
+	reprocessJobs := activities.NewReprocessJobs(activityRegistry, logger)
 
-	feedStore := postgres.NewFeedRepository(db)
-	feedRegistry := feeds.NewRegistry(feedStore, sourceScheduler, sourceRegistry, activityRegistry, summarizer, queryRewriter, &config.Feeds, logger)
+	sourceTrimmer := activities.NewSourceTrimmer(logger, activityRepo, &config.Activities)
+	sourceTrimmer.Start(ctx)
 
 	authMw, err := authMiddleware(config)
 	if err != nil {
 		return nil, fmt.Errorf("create auth middleware: %w", err)
 	}
 
-	server, err := api.NewServer(logger, &config.API, authMw, sourceRegistry, sourceScheduler, feedRegistry)
+	server, err := api.NewServer(logger, &config.API, authMw, sourceRegistry, sourceScheduler, feedRegistry, collections, idempotencyKeyStore, provisioner, reprocessJobs, sourceTrimmer)
 	if err != nil {
 		return nil, fmt.Errorf("create server: %w", err)
 	}
@@ -136,6 +198,13 @@ func authMiddleware(config *config.Config) (*auth.RouteAuthMiddleware, error) {
 
 	// Per-route configuration
 	authMiddleware.
+		// Health checks are public
+		SetRouteAuthProvider("GET /health", nil, false).
+		// Images are loaded by the browser without the auth header
+		SetRouteAuthProvider("GET /img", nil, false).
+		// Meta info is used to render the filters before login
+		SetRouteAuthProvider("GET /meta/topics", nil, false).
+		SetRouteAuthProvider("GET /meta/source-types", nil, false).
 		// MCP is public, so no auth required
 		SetRouteAuthProvider("POST /mcp", apiKeyProvider, false).
 		// User info requires auth
@@ -144,13 +213,39 @@ func authMiddleware(config *config.Config) (*auth.RouteAuthMiddleware, error) {
 		SetRouteAuthProvider("GET /sources/{uid}", apiKeyProvider, false).
 		// Feeds can be public, so no auth required
 		SetRouteAuthProvider("GET /feeds", apiKeyProvider, false).
+		SetRouteAuthProvider("GET /home", apiKeyProvider, true).
 		SetRouteAuthProvider("GET /feeds/{uid}/activities", apiKeyProvider, false).
+		SetRouteAuthProvider("GET /feeds/{uid}/topics", apiKeyProvider, false).
+		SetRouteAuthProvider("GET /feeds/{uid}/digest", apiKeyProvider, false).
+		SetRouteAuthProvider("GET /feeds/{uid}/export", apiKeyProvider, true).
 		// Creating, updating, deleting feeds requires auth
 		SetRouteAuthProvider("POST /feeds", apiKeyProvider, true).
 		SetRouteAuthProvider("PUT /feeds/{uid}", apiKeyProvider, true).
 		SetRouteAuthProvider("DELETE /feeds/{uid}", apiKeyProvider, true).
+		SetRouteAuthProvider("PATCH /feeds/{uid}/pause", apiKeyProvider, true).
+		SetRouteAuthProvider("PUT /feeds/order", apiKeyProvider, true).
+		SetRouteAuthProvider("POST /feeds/{uid}/restore", apiKeyProvider, true).
+		SetRouteAuthProvider("POST /feeds/{uid}/read-all", apiKeyProvider, true).
+		// Relevance feedback is stored per user
+		SetRouteAuthProvider("POST /activities/{uid}/feedback", apiKeyProvider, true).
+		SetRouteAuthProvider("GET /activities/{uid}/related", apiKeyProvider, false).
+		// Collections are private to the user
+		SetRouteAuthProvider("POST /collections", apiKeyProvider, true).
+		SetRouteAuthProvider("POST /collections/{uid}/activities", apiKeyProvider, true).
+		SetRouteAuthProvider("GET /collections/{uid}/activities", apiKeyProvider, true).
 		// Sources are listed on feed details, which requires auth
-		SetRouteAuthProvider("GET /sources", apiKeyProvider, true)
+		SetRouteAuthProvider("GET /sources", apiKeyProvider, true).
+		SetRouteAuthProvider("POST /sources/validate", apiKeyProvider, true).
+		// Pushing activities to custom sources requires auth
+		SetRouteAuthProvider("POST /ingest", apiKeyProvider, true).
+		// Webhooks are authenticated with the signature of the source webhook secret of any source owner
+		SetRouteAuthProvider("POST /webhooks/sources/{sourceUid}", nil, false).
+		// Webhook secrets are scoped to the source owners
+		SetRouteAuthProvider("GET /sources/{uid}/webhook-secret", apiKeyProvider, true).
+		// Admin endpoints additionally require the user to be in AUTH_ADMIN_USER_IDS
+		SetRouteAuthProvider("POST /admin/reprocess", apiKeyProvider, true).
+		SetRouteAuthProvider("GET /admin/reprocess/{jobId}", apiKeyProvider, true).
+		SetRouteAuthProvider("GET /admin/source-counts", apiKeyProvider, true)
 
 	return authMiddleware, nil
 }
@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/defeedco/defeed/pkg/api/auth"
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+)
+
+// reprocessBatchSize is the number of activities searched at once by the reprocess jobs.
+const reprocessBatchSize = 50
+
+type reprocessJobs interface {
+	Start(ctx context.Context, req activities.ReprocessRequest) (*activities.ReprocessJob, error)
+	Get(id string) *activities.ReprocessJob
+}
+
+type sourceTrimmer interface {
+	Counts(ctx context.Context) ([]activities.SourceCount, error)
+}
+
+// requireAdmin responds with 403 Forbidden and returns false if the user isn't an admin.
+func (s *Server) requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return false
+	}
+
+	if !s.config.Auth.IsAdmin(user.UserID) {
+		http.Error(w, "admin access required", http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) StartReprocess(w http.ResponseWriter, r *http.Request) {
+	if !s.requireAdmin(w, r) {
+		return
+	}
+
+	var req ReprocessRequest
+	if err := deserializeReq(r, &req); err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	reprocessReq, err := deserializeReprocessRequest(req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize reprocess request")
+		return
+	}
+
+	job, err := s.reprocessJobs.Start(r.Context(), reprocessReq)
+	if errors.Is(err, activities.ErrReprocessJobRunning) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "start reprocess job")
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(serializeReprocessJob(job))
+}
+
+func (s *Server) GetReprocessJob(w http.ResponseWriter, r *http.Request, jobID string) {
+	if !s.requireAdmin(w, r) {
+		return
+	}
+
+	job := s.reprocessJobs.Get(jobID)
+	if job == nil {
+		http.Error(w, "reprocess job not found", http.StatusNotFound)
+		return
+	}
+
+	s.serializeRes(w, serializeReprocessJob(job))
+}
+
+func (s *Server) ListSourceActivityCounts(w http.ResponseWriter, r *http.Request, params ListSourceActivityCountsParams) {
+	if !s.requireAdmin(w, r) {
+		return
+	}
+
+	limit := 50
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 1000 {
+		s.badRequest(w, fmt.Errorf("limit must be between 1 and 1000"), "validate limit")
+		return
+	}
+
+	counts, err := s.sourceTrimmer.Counts(r.Context())
+	if err != nil {
+		s.internalError(w, err, "count activities by source")
+		return
+	}
+	if len(counts) > limit {
+		counts = counts[:limit]
+	}
+
+	results := make([]SourceActivityCount, len(counts))
+	for i, count := range counts {
+		results[i] = SourceActivityCount{
+			SourceUid:     count.SourceUID,
+			ActivityCount: count.ActivityCount,
+			RetentionCap:  count.RetentionCap,
+		}
+	}
+
+	s.serializeRes(w, SourceActivityCountsResponse{Results: results})
+}
+
+func deserializeReprocessRequest(in ReprocessRequest) (activities.ReprocessRequest, error) {
+	out := activities.ReprocessRequest{
+		Period:         deserializePeriod(in.Period),
+		BatchSize:      reprocessBatchSize,
+		MaxConcurrency: 10,
+	}
+
+	if in.SourceUids != nil {
+		for _, uid := range *in.SourceUids {
+			sourceUID, err := sources.NewTypedUID(uid)
+			if err != nil {
+				return out, fmt.Errorf("deserialize source uid: %w", err)
+			}
+			out.SourceUIDs = append(out.SourceUIDs, sourceUID)
+		}
+	}
+	if in.ActivityUids != nil {
+		for _, uid := range *in.ActivityUids {
+			activityUID, err := lib.NewTypedUIDFromString(uid)
+			if err != nil {
+				return out, fmt.Errorf("deserialize activity uid: %w", err)
+			}
+			out.ActivityUIDs = append(out.ActivityUIDs, activityUID)
+		}
+	}
+	if in.SourceTypes != nil {
+		out.SourceTypes = *in.SourceTypes
+	}
+	if in.MaxActivities != nil {
+		out.MaxActivities = *in.MaxActivities
+	}
+	if in.MaxConcurrency != nil {
+		out.MaxConcurrency = *in.MaxConcurrency
+	}
+	if in.DryRun != nil {
+		out.DryRun = *in.DryRun
+	}
+	if in.ForceReprocessSummary != nil {
+		out.ForceReprocessSummary = *in.ForceReprocessSummary
+	}
+	if in.ForceReprocessEmbeddings != nil {
+		out.ForceReprocessEmbedding = *in.ForceReprocessEmbeddings
+	}
+	if in.ForceUpsert != nil {
+		out.ForceUpsert = *in.ForceUpsert
+	}
+	if in.TargetEmbeddingDim != nil {
+		out.TargetEmbeddingDim = *in.TargetEmbeddingDim
+	}
+
+	return out, nil
+}
+
+func serializeReprocessJob(in *activities.ReprocessJob) ReprocessJob {
+	out := ReprocessJob{
+		Id:        in.ID,
+		Status:    Running,
+		Fetched:   int(in.Progress.Fetched.Load()),
+		Processed: int(in.Progress.Processed.Load()),
+		Skipped:   int(in.Progress.Skipped.Load()),
+		Errored:   int(in.Progress.Errored.Load()),
+		StartedAt: in.StartedAt,
+	}
+
+	finishedAt, err := in.Result()
+	if !finishedAt.IsZero() {
+		out.FinishedAt = &finishedAt
+		out.Status = Completed
+	}
+	if err != nil {
+		errMsg := err.Error()
+		out.Error = &errMsg
+		out.Status = Failed
+	}
+
+	return out
+}
@@ -18,6 +18,13 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ActivityFeedback.
+const (
+	Less ActivityFeedback = "less"
+	More ActivityFeedback = "more"
+	None ActivityFeedback = "none"
+)
+
 // Defines values for ActivityPeriod.
 const (
 	All   ActivityPeriod = "all"
@@ -32,17 +39,34 @@ const (
 	Similarity   ActivitySortBy = "similarity"
 )
 
+// Defines values for ExportFormat.
+const (
+	Csv   ExportFormat = "csv"
+	Jsonl ExportFormat = "jsonl"
+)
+
+// Defines values for ReprocessJobStatus.
+const (
+	Completed ReprocessJobStatus = "completed"
+	Failed    ReprocessJobStatus = "failed"
+	Running   ReprocessJobStatus = "running"
+)
+
 // Defines values for SourceType.
 const (
 	ChangedetectionWebsite SourceType = "changedetectionWebsite"
+	Custom                 SourceType = "custom"
 	GithubIssues           SourceType = "githubIssues"
 	GithubReleases         SourceType = "githubReleases"
 	GithubTopics           SourceType = "githubTopics"
 	HackernewsPosts        SourceType = "hackernewsPosts"
+	JsonApiEndpoint        SourceType = "jsonApiEndpoint"
+	LobstersComments       SourceType = "lobstersComments"
 	LobstersFeed           SourceType = "lobstersFeed"
 	LobstersTag            SourceType = "lobstersTag"
 	MastodonAccount        SourceType = "mastodonAccount"
 	MastodonTag            SourceType = "mastodonTag"
+	MastodonTrending       SourceType = "mastodonTrending"
 	ProductHuntPosts       SourceType = "productHuntPosts"
 	RedditSubreddit        SourceType = "redditSubreddit"
 	RssFeed                SourceType = "rssFeed"
@@ -71,12 +95,19 @@ const (
 	WebPerformance         TopicTag = "web_performance"
 )
 
+// Defines values for WeekStart.
+const (
+	Monday   WeekStart = "monday"
+	Saturday WeekStart = "saturday"
+	Sunday   WeekStart = "sunday"
+)
+
 // ActivitiesListResponse defines model for ActivitiesListResponse.
 type ActivitiesListResponse struct {
 	// HasMore Whether there are more results available
 	HasMore *bool `json:"hasMore,omitempty"`
 
-	// NextCursor Cursor to use for fetching the next page of results
+	// NextCursor Opaque cursor to use for fetching the next page of results. Only set for the paginated requests (see `paginate`).
 	NextCursor *string         `json:"nextCursor,omitempty"`
 	Results    []Activity      `json:"results"`
 	Topics     []ActivityTopic `json:"topics"`
@@ -96,27 +127,77 @@ type Activity struct {
 	FullSummary string `json:"fullSummary"`
 	ImageUrl    string `json:"imageUrl"`
 
+	// IsRead True if the authenticated user read the activity (e.g. marked the feed as read). Only set for the feed activities.
+	IsRead *bool `json:"isRead,omitempty"`
+
+	// QualityScore Content substance heuristic (0-1) based on the body length, link to text ratio and summary. -1 if not available.
+	QualityScore *float64 `json:"qualityScore,omitempty"`
+
 	// ShortSummary One-line short plain text summary.
 	ShortSummary string     `json:"shortSummary"`
 	Similarity   *float32   `json:"similarity,omitempty"`
 	SourceType   SourceType `json:"sourceType"`
 	SourceUids   []string   `json:"sourceUids"`
-	Title        string     `json:"title"`
-	Uid          string     `json:"uid"`
+
+	// Sources All sources the activity was seen in (e.g. cross-posted to multiple subreddits).
+	Sources []ActivitySource `json:"sources"`
+	Title   string           `json:"title"`
+	Uid     string           `json:"uid"`
+
+	// UpdatedAt Time of the last edit, if the source reports that the activity was edited after it was published.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 
 	// UpvotesCount Number of upvotes/likes. -1 if not available.
 	UpvotesCount int    `json:"upvotesCount"`
 	Url          string `json:"url"`
 }
 
+// ActivityExportRecord defines model for ActivityExportRecord.
+type ActivityExportRecord struct {
+	CreatedAt    time.Time `json:"createdAt"`
+	QualityScore float64   `json:"qualityScore"`
+
+	// SocialScore Source specific popularity score, or -1 if not available
+	SocialScore float64  `json:"socialScore"`
+	SourceUids  []string `json:"sourceUids"`
+
+	// Summary Short summary of the activity
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Uid     string `json:"uid"`
+	Url     string `json:"url"`
+}
+
+// ActivityFeedback defines model for ActivityFeedback.
+type ActivityFeedback string
+
+// ActivityFeedbackRequest defines model for ActivityFeedbackRequest.
+type ActivityFeedbackRequest struct {
+	Feedback ActivityFeedback `json:"feedback" validate:"oneof=more less none"`
+}
+
 // ActivityPeriod Time period to filter activities from. 'month' means last month, 'week' means last week, 'day' means last day.
 type ActivityPeriod string
 
 // ActivitySortBy defines model for ActivitySortBy.
 type ActivitySortBy string
 
+// ActivitySource defines model for ActivitySource.
+type ActivitySource struct {
+	// DisplayName Feed-specific name of the source, if overridden in the feed.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// IconUrl Feed-specific icon of the source, if overridden in the feed.
+	IconUrl *string    `json:"iconUrl,omitempty"`
+	Type    SourceType `json:"type"`
+	Uid     string     `json:"uid"`
+}
+
 // ActivityTopic defines model for ActivityTopic.
 type ActivityTopic struct {
+	// ActivityCount Number of activities in this topic.
+	ActivityCount int `json:"activityCount"`
+
 	// ActivityIds List of activity IDs in this topic.
 	ActivityIds []string `json:"activityIds"`
 
@@ -133,45 +214,391 @@ type ActivityTopic struct {
 	Title string `json:"title"`
 }
 
+// AddCollectionActivityRequest defines model for AddCollectionActivityRequest.
+type AddCollectionActivityRequest struct {
+	ActivityUid string `json:"activityUid" validate:"required"`
+}
+
+// Collection defines model for Collection.
+type Collection struct {
+	ActivityCount int       `json:"activityCount"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Name          string    `json:"name"`
+	Uid           string    `json:"uid"`
+}
+
+// CollectionActivitiesResponse defines model for CollectionActivitiesResponse.
+type CollectionActivitiesResponse struct {
+	Results []Activity `json:"results"`
+}
+
+// CreateCollectionRequest defines model for CreateCollectionRequest.
+type CreateCollectionRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
 // CreateFeedRequest defines model for CreateFeedRequest.
 type CreateFeedRequest struct {
-	Icon       string   `json:"icon"`
-	Name       string   `json:"name"`
-	Query      string   `json:"query"`
-	SourceUids []string `json:"sourceUids"`
+	Icon string `json:"icon"`
+
+	// Language BCP 47 tag of the language the feed topics are generated in (e.g. de, pt-BR). Defaults to English.
+	Language *string `json:"language,omitempty" validate:"omitempty,bcp47_language_tag"`
+
+	// MinQualityScore Excludes low-substance activities (e.g. link-only posts) with a lower quality score (0-1). Defaults to 0, which disables the filter.
+	MinQualityScore *float64 `json:"minQualityScore,omitempty" validate:"omitempty,gte=0,lte=1"`
+	Name            string   `json:"name" validate:"required"`
+	Query           string   `json:"query"`
+
+	// Sections Named sections of the feed, each with a subset of the feed sources. Activities are grouped by section instead of source type.
+	Sections *[]FeedSection `json:"sections,omitempty" validate:"omitempty,dive"`
+
+	// SourceFilters Feed-specific keyword filters per source UID, which drop the noise (e.g. daily discussion threads) from the sources.
+	SourceFilters *map[string]SourceFilter `json:"sourceFilters,omitempty" validate:"omitempty,dive"`
+
+	// SourceOverrides Feed-specific display name and icon per source UID. The sources keep their canonical names and icons outside of the feed.
+	SourceOverrides *map[string]SourceOverride `json:"sourceOverrides,omitempty" validate:"omitempty,dive"`
+	SourceUids      []string                   `json:"sourceUids" validate:"dive,required"`
+
+	// SourceWeights Relative weight per source UID that biases how many activities are picked from each source. Sources without a weight default to 1.
+	SourceWeights *map[string]float64 `json:"sourceWeights,omitempty" validate:"omitempty,dive,gt=0"`
 }
 
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
 // Feed defines model for Feed.
 type Feed struct {
 	CreatedAt time.Time `json:"createdAt"`
 
 	// CreatedBy ID of the user who created and owns the feed. Feed can only be modified by him.
-	CreatedBy  string   `json:"createdBy"`
-	Icon       string   `json:"icon"`
-	IsPublic   bool     `json:"isPublic"`
-	Name       string   `json:"name"`
-	Query      string   `json:"query"`
-	SourceUids []string `json:"sourceUids"`
-	Uid        string   `json:"uid"`
+	CreatedBy string `json:"createdBy"`
+	Icon      string `json:"icon"`
+
+	// IsPaused Sources of paused feeds are not polled, unless used by other active feeds.
+	IsPaused bool `json:"isPaused"`
+	IsPublic bool `json:"isPublic"`
+
+	// Language BCP 47 tag of the language the feed topics are generated in. Empty for English.
+	Language *string `json:"language,omitempty"`
+
+	// MinQualityScore Activities with a lower quality score (0-1) are excluded. 0 if the filter is disabled.
+	MinQualityScore *float64       `json:"minQualityScore,omitempty"`
+	Name            string         `json:"name"`
+	Query           string         `json:"query"`
+	Sections        *[]FeedSection `json:"sections,omitempty"`
+
+	// SourceFilters Feed-specific keyword filters per source UID.
+	SourceFilters *map[string]SourceFilter `json:"sourceFilters,omitempty"`
+
+	// SourceOverrides Feed-specific display name and icon per source UID.
+	SourceOverrides *map[string]SourceOverride `json:"sourceOverrides,omitempty"`
+	SourceUids      []string                   `json:"sourceUids"`
+
+	// SourceWeights Relative weight per source UID that biases how many activities are picked from each source. Sources without a weight default to 1.
+	SourceWeights *map[string]float64 `json:"sourceWeights,omitempty"`
+	Uid           string              `json:"uid"`
+}
+
+// FeedDigestResponse defines model for FeedDigestResponse.
+type FeedDigestResponse struct {
+	Highlights []FeedHighlight `json:"highlights"`
+}
+
+// FeedHighlight defines model for FeedHighlight.
+type FeedHighlight struct {
+	// Content A concise highlight summarizing a key point
+	Content string `json:"content"`
+
+	// SourceActivityIds List of activity IDs that contributed to this highlight
+	SourceActivityIds []string `json:"sourceActivityIds"`
+}
+
+// FeedOrderRequest defines model for FeedOrderRequest.
+type FeedOrderRequest struct {
+	// FeedUids Feed UIDs in the preferred order.
+	FeedUids []string `json:"feedUids" validate:"dive,required"`
+}
+
+// FeedSection defines model for FeedSection.
+type FeedSection struct {
+	Icon string `json:"icon"`
+	Name string `json:"name" validate:"required"`
+
+	// SourceUids Subset of the feed source UIDs.
+	SourceUids []string `json:"sourceUids" validate:"dive,required"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	// FailedActivities Number of activities that failed processing and are pending a retry or exhausted all retry attempts.
+	FailedActivities int `json:"failedActivities"`
+
+	// QueryEmbeddingCache Hits of the search query embedding cache since the server started. Omitted if the cache is disabled.
+	QueryEmbeddingCache *QueryEmbeddingCacheStats `json:"queryEmbeddingCache,omitempty"`
+
+	// SeenActivityCache Hits of the recently processed activities cache since the server started. Omitted if the cache is disabled.
+	SeenActivityCache *SeenActivityCacheStats `json:"seenActivityCache,omitempty"`
+
+	// Status Service status
+	Status string `json:"status"`
+}
+
+// HomeTimelineResponse defines model for HomeTimelineResponse.
+type HomeTimelineResponse struct {
+	Results []TimelineActivity `json:"results"`
+}
+
+// IngestActivity defines model for IngestActivity.
+type IngestActivity struct {
+	Body *string `json:"body,omitempty"`
+
+	// CreatedAt Defaults to the ingestion time.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Id Unique ID within the source, used to deduplicate the activities.
+	Id       string  `json:"id" validate:"required"`
+	ImageUrl *string `json:"imageUrl,omitempty" validate:"omitempty,url"`
+	Title    string  `json:"title" validate:"required"`
+	Url      *string `json:"url,omitempty" validate:"omitempty,url"`
+}
+
+// IngestRequest defines model for IngestRequest.
+type IngestRequest struct {
+	Activities []IngestActivity `json:"activities" validate:"required,min=1,max=100,dive"`
+
+	// SourceUid UID of the custom source, scoped to the authenticated user: custom:<user id>:<id>. Example: custom:user_123:deployments
+	SourceUid string `json:"sourceUid" validate:"required"`
+}
+
+// IngestResponse defines model for IngestResponse.
+type IngestResponse struct {
+	// Accepted Number of activities accepted for processing (i.e. not excluded by the content policy or source filters).
+	Accepted int `json:"accepted"`
+}
+
+// MarkFeedReadRequest defines model for MarkFeedReadRequest.
+type MarkFeedReadRequest struct {
+	// Before Only marks the activities created at or before this time. Defaults to now.
+	Before *time.Time `json:"before,omitempty"`
+}
+
+// MarkFeedReadResponse defines model for MarkFeedReadResponse.
+type MarkFeedReadResponse struct {
+	// Marked Number of newly marked activities (already read activities aren't counted).
+	Marked int `json:"marked"`
+}
+
+// PauseFeedRequest defines model for PauseFeedRequest.
+type PauseFeedRequest struct {
+	Paused bool `json:"paused"`
+}
+
+// QueryEmbeddingCacheStats Hits of the search query embedding cache since the server started. Omitted if the cache is disabled.
+type QueryEmbeddingCacheStats struct {
+	// HitRate Fraction of the search queries served from the cached embeddings.
+	HitRate float64 `json:"hitRate"`
+
+	// Hits Number of search queries served from the cached embeddings.
+	Hits int64 `json:"hits"`
+
+	// Misses Number of search queries embedded by the embedding model.
+	Misses int64 `json:"misses"`
+}
+
+// RelatedActivitiesResponse defines model for RelatedActivitiesResponse.
+type RelatedActivitiesResponse struct {
+	Results []Activity `json:"results"`
+}
+
+// ReprocessJob defines model for ReprocessJob.
+type ReprocessJob struct {
+	Error   *string `json:"error,omitempty"`
+	Errored int     `json:"errored"`
+
+	// Fetched Number of matching activities searched so far
+	Fetched    int        `json:"fetched"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Id         string     `json:"id"`
+	Processed  int        `json:"processed"`
+
+	// Skipped Number of activities that weren't updated (e.g. already up to date, or failed)
+	Skipped   int                `json:"skipped"`
+	StartedAt time.Time          `json:"startedAt"`
+	Status    ReprocessJobStatus `json:"status"`
+}
+
+// ReprocessJobStatus defines model for ReprocessJobStatus.
+type ReprocessJobStatus string
+
+// ReprocessRequest defines model for ReprocessRequest.
+type ReprocessRequest struct {
+	ActivityUids *[]string `json:"activityUids,omitempty"`
+
+	// DryRun Only count the matching activities, without reprocessing them
+	DryRun                   *bool `json:"dryRun,omitempty"`
+	ForceReprocessEmbeddings *bool `json:"forceReprocessEmbeddings,omitempty"`
+	ForceReprocessSummary    *bool `json:"forceReprocessSummary,omitempty"`
+	ForceUpsert              *bool `json:"forceUpsert,omitempty"`
+
+	// MaxActivities Max number of activities to reprocess (0 = no limit)
+	MaxActivities *int `json:"maxActivities,omitempty" validate:"omitempty,gte=0"`
+
+	// MaxConcurrency Max number of activities reprocessed at once
+	MaxConcurrency *int `json:"maxConcurrency,omitempty" validate:"omitempty,gte=1,lte=100"`
+
+	// Period Time period to filter activities from. 'month' means last month, 'week' means last week, 'day' means last day.
+	Period      *ActivityPeriod `json:"period,omitempty"`
+	SourceTypes *[]string       `json:"sourceTypes,omitempty"`
+	SourceUids  *[]string       `json:"sourceUids,omitempty"`
+
+	// TargetEmbeddingDim Recompute embeddings of a different dimension (1536 or 3072) after an embedding model change (0 = disabled)
+	TargetEmbeddingDim *int `json:"targetEmbeddingDim,omitempty" validate:"omitempty,oneof=0 1536 3072"`
+}
+
+// RequestValidationError defines model for RequestValidationError.
+type RequestValidationError struct {
+	Errors []SourceValidationError `json:"errors"`
+}
+
+// SeenActivityCacheStats Hits of the recently processed activities cache since the server started. Omitted if the cache is disabled.
+type SeenActivityCacheStats struct {
+	// HitRate Fraction of the polled activities skipped as recently processed.
+	HitRate float64 `json:"hitRate"`
+
+	// Hits Number of polled activities skipped as recently processed.
+	Hits int64 `json:"hits"`
+
+	// Misses Number of polled activities that were processed.
+	Misses int64 `json:"misses"`
 }
 
 // Source defines model for Source.
 type Source struct {
-	Description string     `json:"description"`
-	IconUrl     string     `json:"iconUrl"`
-	Name        string     `json:"name"`
-	TopicTags   []TopicTag `json:"topicTags"`
-	Type        SourceType `json:"type"`
-	Uid         string     `json:"uid"`
-	Url         string     `json:"url"`
+	Description string `json:"description"`
+	IconUrl     string `json:"iconUrl"`
+	Name        string `json:"name"`
+
+	// Stats Helps to judge whether the source is alive and productive.
+	Stats     *SourceStats `json:"stats,omitempty"`
+	TopicTags []TopicTag   `json:"topicTags"`
+	Type      SourceType   `json:"type"`
+	Uid       string       `json:"uid"`
+	Url       string       `json:"url"`
+}
+
+// SourceActivityCount defines model for SourceActivityCount.
+type SourceActivityCount struct {
+	// ActivityCount Number of stored activities from the source.
+	ActivityCount int `json:"activityCount"`
+
+	// RetentionCap Max number of kept activities, the oldest ones above it are deleted periodically. 0 if the activities are kept indefinitely.
+	RetentionCap int    `json:"retentionCap"`
+	SourceUid    string `json:"sourceUid"`
+}
+
+// SourceActivityCountsResponse defines model for SourceActivityCountsResponse.
+type SourceActivityCountsResponse struct {
+	Results []SourceActivityCount `json:"results"`
+}
+
+// SourceFilter Keywords are matched case-insensitively against the activity title and body.
+type SourceFilter struct {
+	// ExcludeKeywords Drops the activities containing any of the keywords. Takes precedence over the include keywords. Example: ["daily discussion"]
+	ExcludeKeywords *[]string `json:"excludeKeywords,omitempty" validate:"omitempty,max=50,dive,max=100"`
+
+	// IncludeKeywords Only keeps the activities containing at least one of the keywords.
+	IncludeKeywords *[]string `json:"includeKeywords,omitempty" validate:"omitempty,max=50,dive,max=100"`
+}
+
+// SourceOverride defines model for SourceOverride.
+type SourceOverride struct {
+	// DisplayName Name of the source within the feed. Example: Go News
+	DisplayName *string `json:"displayName,omitempty" validate:"omitempty,max=100"`
+
+	// IconUrl Icon of the source within the feed. Must be an http(s) URL, served through the image proxy (if configured).
+	IconUrl *string `json:"iconUrl,omitempty" validate:"omitempty,url"`
+}
+
+// SourceStats Helps to judge whether the source is alive and productive.
+type SourceStats struct {
+	// ActivityCount Number of stored activities from the source. Updated periodically.
+	ActivityCount int `json:"activityCount"`
+
+	// DisabledReason Why the source isn't polled (e.g. missing provider credentials, or removed as stale). Omitted if the source is active.
+	DisabledReason *string `json:"disabledReason,omitempty"`
+
+	// LastNewActivityAt Creation time of the newest activity from the source, or the time the source was first polled if it had no activities yet.
+	LastNewActivityAt *time.Time `json:"lastNewActivityAt,omitempty"`
+
+	// LastPolledAt Last time the source was polled. Omitted if the source wasn't polled since the server started (e.g. it isn't used by any feed).
+	LastPolledAt *time.Time `json:"lastPolledAt,omitempty"`
+
+	// LastSucceededAt Last time the source was polled without errors.
+	LastSucceededAt *time.Time `json:"lastSucceededAt,omitempty"`
+
+	// StaleSince Set when the source had no new activities for a long time (e.g. dead feeds), and may be removed from polling.
+	StaleSince *time.Time `json:"staleSince,omitempty"`
 }
 
 // SourceType defines model for SourceType.
 type SourceType string
 
+// SourceTypeMeta defines model for SourceTypeMeta.
+type SourceTypeMeta struct {
+	Emoji string `json:"emoji"`
+
+	// Label Display label of the provider. Example: Lobsters
+	Label string     `json:"label"`
+	Type  SourceType `json:"type"`
+}
+
+// SourceValidationError defines model for SourceValidationError.
+type SourceValidationError struct {
+	// Field Name of the invalid config field. Empty if the error isn't specific to a field.
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
+
+	// Rule Failed validation rule. Example: required, url.
+	Rule *string `json:"rule,omitempty"`
+}
+
+// SourceValidationResult defines model for SourceValidationResult.
+type SourceValidationResult struct {
+	Errors []SourceValidationError `json:"errors"`
+	Valid  bool                    `json:"valid"`
+}
+
+// SourceWebhookSecret defines model for SourceWebhookSecret.
+type SourceWebhookSecret struct {
+	// Secret Secret for signing the provider webhooks of the source (e.g. the GitHub repository webhook secret).
+	Secret string `json:"secret"`
+}
+
+// TimelineActivity defines model for TimelineActivity.
+type TimelineActivity struct {
+	Activity Activity `json:"activity"`
+
+	// FeedUids User feeds that include the activity.
+	FeedUids []string `json:"feedUids"`
+}
+
 // TopicTag Specific niche technology/startup interests
 type TopicTag string
 
+// TopicTagMeta defines model for TopicTagMeta.
+type TopicTagMeta struct {
+	// Label Display label. Example: Large language models
+	Label string `json:"label"`
+
+	// Tag Specific niche technology/startup interests
+	Tag TopicTag `json:"tag"`
+}
+
+// TopicsListResponse defines model for TopicsListResponse.
+type TopicsListResponse struct {
+	Topics []ActivityTopic `json:"topics"`
+}
+
 // UpdateFeedRequest defines model for UpdateFeedRequest.
 type UpdateFeedRequest = CreateFeedRequest
 
@@ -184,15 +611,49 @@ type User struct {
 	Id string `json:"id"`
 }
 
+// ValidateSourceRequest defines model for ValidateSourceRequest.
+type ValidateSourceRequest struct {
+	// Config Source specific config. Example: {"url": "https://example.com/feed.xml"} for RSS feeds.
+	Config map[string]interface{} `json:"config" validate:"required"`
+	Type   SourceType             `json:"type"`
+}
+
+// WeekStart First day of the week, depending on the user's locale.
+type WeekStart string
+
+// ListRelatedActivitiesParams defines parameters for ListRelatedActivities.
+type ListRelatedActivitiesParams struct {
+	// Limit Maximum number of activities to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSourceActivityCountsParams defines parameters for ListSourceActivityCounts.
+type ListSourceActivityCountsParams struct {
+	// Limit Maximum number of sources to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateOwnFeedParams defines parameters for CreateOwnFeed.
+type CreateOwnFeedParams struct {
+	// IdempotencyKey Unique client-generated key. Retried requests with the same key return the originally created feed instead of creating a duplicate. Requests with a key that is still being processed, or whose feed was deleted, are rejected with 409.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
 // ListFeedActivitiesParams defines parameters for ListFeedActivities.
 type ListFeedActivitiesParams struct {
 	// Period Time period to filter activities from. Defaults to 'all' for all time.
 	Period *ActivityPeriod `form:"period,omitempty" json:"period,omitempty"`
 
+	// Timezone IANA time zone (e.g. 'Europe/Berlin') to compute the period boundaries in. Defaults to the server time zone.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// WeekStart First day of the week for the 'week' period. Defaults to 'monday'.
+	WeekStart *WeekStart `form:"weekStart,omitempty" json:"weekStart,omitempty"`
+
 	// SortBy Sort method.
 	SortBy *ActivitySortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 
-	// Query Filter query. Authenticated users can override the default feed query.
+	// Query Filter query. Authenticated users can override the default feed query. Unauthenticated users can override the query of public feeds if enabled by the server (rate limited per IP).
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 
 	// Limit Maximum number of activities to return.
@@ -200,6 +661,85 @@ type ListFeedActivitiesParams struct {
 
 	// RewriteQuery Whether to rewrite the query to sub-queries and return results by topics.
 	RewriteQuery *bool `form:"rewriteQuery,omitempty" json:"rewriteQuery,omitempty"`
+
+	// WSim Similarity weight of the weighted score ranking (the similarity sort with a query). Defaults to the server weight.
+	// The weights are relative, since they're normalized to sum to 1. Ignored for unauthenticated users.
+	WSim *float64 `form:"wSim,omitempty" json:"wSim,omitempty"`
+
+	// WSocial Social score weight of the weighted score ranking, see `wSim`. Defaults to the server weight.
+	WSocial *float64 `form:"wSocial,omitempty" json:"wSocial,omitempty"`
+
+	// WRecency Recency weight of the weighted score ranking, see `wSim`. Defaults to the server weight of the period.
+	WRecency *float64 `form:"wRecency,omitempty" json:"wRecency,omitempty"`
+
+	// Paginate Whether to return a page of the latest activities, instead of the top activities diversified by source. Pagination is currently only supported when sorting by creation date without query rewrites. The paginated results are sorted by creation date only, so they ignore the feed source weights and aren't interleaved by source.
+	Paginate *bool `form:"paginate,omitempty" json:"paginate,omitempty"`
+
+	// Cursor Opaque cursor from the `nextCursor` field of the previous response, used to fetch the next page of results. Requires `paginate`. Omit to fetch the first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetFeedDigestParams defines parameters for GetFeedDigest.
+type GetFeedDigestParams struct {
+	// Period Time period to summarize activities from. Defaults to 'week'.
+	Period *ActivityPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Timezone IANA time zone (e.g. 'Europe/Berlin') to compute the period boundaries in. Defaults to the server time zone.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// WeekStart First day of the week for the 'week' period. Defaults to 'monday'.
+	WeekStart *WeekStart `form:"weekStart,omitempty" json:"weekStart,omitempty"`
+}
+
+// ExportFeedActivitiesParams defines parameters for ExportFeedActivities.
+type ExportFeedActivitiesParams struct {
+	// Format Export format. Defaults to 'jsonl'.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ListFeedTopicsParams defines parameters for ListFeedTopics.
+type ListFeedTopicsParams struct {
+	// Period Time period to filter activities from. Defaults to 'all' for all time.
+	Period *ActivityPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Timezone IANA time zone (e.g. 'Europe/Berlin') to compute the period boundaries in. Defaults to the server time zone.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// WeekStart First day of the week for the 'week' period. Defaults to 'monday'.
+	WeekStart *WeekStart `form:"weekStart,omitempty" json:"weekStart,omitempty"`
+
+	// Query Filter query. Authenticated users can override the default feed query. Unauthenticated users can override the query of public feeds if enabled by the server (rate limited per IP).
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Limit Maximum number of activities to group into topics.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetHomeTimelineParams defines parameters for GetHomeTimeline.
+type GetHomeTimelineParams struct {
+	// Period Time period to filter activities from. Defaults to 'all' for all time.
+	Period *ActivityPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Timezone IANA time zone (e.g. 'Europe/Berlin') to compute the period boundaries in. Defaults to the server time zone.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// WeekStart First day of the week for the 'week' period. Defaults to 'monday'.
+	WeekStart *WeekStart `form:"weekStart,omitempty" json:"weekStart,omitempty"`
+
+	// SortBy Sort method.
+	SortBy *ActivitySortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Limit Maximum number of activities to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ProxyImageParams defines parameters for ProxyImage.
+type ProxyImageParams struct {
+	// Url Absolute http(s) URL of the image
+	Url string `form:"url" json:"url"`
+
+	// Sig Signature of the image URL, set on the proxied image URLs returned by the API
+	Sig string `form:"sig" json:"sig"`
 }
 
 // ListSourcesParams defines parameters for ListSources.
@@ -211,20 +751,86 @@ type ListSourcesParams struct {
 	Topics *[]TopicTag `form:"topics,omitempty" json:"topics,omitempty"`
 }
 
+// GetSourceParams defines parameters for GetSource.
+type GetSourceParams struct {
+	// FeedUid Applies the display name and icon overrides of the given feed.
+	FeedUid *string `form:"feedUid,omitempty" json:"feedUid,omitempty"`
+}
+
+// ReceiveSourceWebhookJSONBody defines parameters for ReceiveSourceWebhook.
+type ReceiveSourceWebhookJSONBody map[string]interface{}
+
+// SetActivityFeedbackJSONRequestBody defines body for SetActivityFeedback for application/json ContentType.
+type SetActivityFeedbackJSONRequestBody = ActivityFeedbackRequest
+
+// StartReprocessJSONRequestBody defines body for StartReprocess for application/json ContentType.
+type StartReprocessJSONRequestBody = ReprocessRequest
+
+// CreateCollectionJSONRequestBody defines body for CreateCollection for application/json ContentType.
+type CreateCollectionJSONRequestBody = CreateCollectionRequest
+
+// AddCollectionActivityJSONRequestBody defines body for AddCollectionActivity for application/json ContentType.
+type AddCollectionActivityJSONRequestBody = AddCollectionActivityRequest
+
 // CreateOwnFeedJSONRequestBody defines body for CreateOwnFeed for application/json ContentType.
 type CreateOwnFeedJSONRequestBody = CreateFeedRequest
 
+// SetFeedOrderJSONRequestBody defines body for SetFeedOrder for application/json ContentType.
+type SetFeedOrderJSONRequestBody = FeedOrderRequest
+
 // UpdateOwnFeedJSONRequestBody defines body for UpdateOwnFeed for application/json ContentType.
 type UpdateOwnFeedJSONRequestBody = UpdateFeedRequest
 
+// PauseOwnFeedJSONRequestBody defines body for PauseOwnFeed for application/json ContentType.
+type PauseOwnFeedJSONRequestBody = PauseFeedRequest
+
+// MarkFeedReadJSONRequestBody defines body for MarkFeedRead for application/json ContentType.
+type MarkFeedReadJSONRequestBody = MarkFeedReadRequest
+
+// IngestActivitiesJSONRequestBody defines body for IngestActivities for application/json ContentType.
+type IngestActivitiesJSONRequestBody = IngestRequest
+
+// ValidateSourceJSONRequestBody defines body for ValidateSource for application/json ContentType.
+type ValidateSourceJSONRequestBody = ValidateSourceRequest
+
+// ReceiveSourceWebhookJSONRequestBody defines body for ReceiveSourceWebhook for application/json ContentType.
+type ReceiveSourceWebhookJSONRequestBody ReceiveSourceWebhookJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Give relevance feedback on an activity
+	// (POST /activities/{uid}/feedback)
+	SetActivityFeedback(w http.ResponseWriter, r *http.Request, uid string)
+	// List activities related to an activity
+	// (GET /activities/{uid}/related)
+	ListRelatedActivities(w http.ResponseWriter, r *http.Request, uid string, params ListRelatedActivitiesParams)
+	// Start reprocessing activities
+	// (POST /admin/reprocess)
+	StartReprocess(w http.ResponseWriter, r *http.Request)
+	// Get reprocess job progress
+	// (GET /admin/reprocess/{jobId})
+	GetReprocessJob(w http.ResponseWriter, r *http.Request, jobId string)
+	// List the stored activity counts per source
+	// (GET /admin/source-counts)
+	ListSourceActivityCounts(w http.ResponseWriter, r *http.Request, params ListSourceActivityCountsParams)
+	// Create a collection belonging to the authenticated user
+	// (POST /collections)
+	CreateCollection(w http.ResponseWriter, r *http.Request)
+	// List the activities of a collection
+	// (GET /collections/{uid}/activities)
+	ListCollectionActivities(w http.ResponseWriter, r *http.Request, uid string)
+	// Add an activity to a collection
+	// (POST /collections/{uid}/activities)
+	AddCollectionActivity(w http.ResponseWriter, r *http.Request, uid string)
 	// List public feeds and/or those belonging to the authenticated user
 	// (GET /feeds)
 	ListFeeds(w http.ResponseWriter, r *http.Request)
 	// Create a feed belonging to the authenticated user
 	// (POST /feeds)
-	CreateOwnFeed(w http.ResponseWriter, r *http.Request)
+	CreateOwnFeed(w http.ResponseWriter, r *http.Request, params CreateOwnFeedParams)
+	// Set the feed order of the authenticated user
+	// (PUT /feeds/order)
+	SetFeedOrder(w http.ResponseWriter, r *http.Request)
 	// Delete a feed belonging to the authenticated user
 	// (DELETE /feeds/{uid})
 	DeleteOwnFeed(w http.ResponseWriter, r *http.Request, uid string)
@@ -234,15 +840,60 @@ type ServerInterface interface {
 	// List activities for a feed
 	// (GET /feeds/{uid}/activities)
 	ListFeedActivities(w http.ResponseWriter, r *http.Request, uid string, params ListFeedActivitiesParams)
+	// Get a digest of the feed period
+	// (GET /feeds/{uid}/digest)
+	GetFeedDigest(w http.ResponseWriter, r *http.Request, uid string, params GetFeedDigestParams)
+	// Export activities of a feed
+	// (GET /feeds/{uid}/export)
+	ExportFeedActivities(w http.ResponseWriter, r *http.Request, uid string, params ExportFeedActivitiesParams)
+	// Pause or resume polling of a feed belonging to the authenticated user
+	// (PATCH /feeds/{uid}/pause)
+	PauseOwnFeed(w http.ResponseWriter, r *http.Request, uid string)
+	// Mark all feed activities as read
+	// (POST /feeds/{uid}/read-all)
+	MarkFeedRead(w http.ResponseWriter, r *http.Request, uid string)
+	// Restore a deleted feed belonging to the authenticated user
+	// (POST /feeds/{uid}/restore)
+	RestoreOwnFeed(w http.ResponseWriter, r *http.Request, uid string)
+	// List topics for a feed
+	// (GET /feeds/{uid}/topics)
+	ListFeedTopics(w http.ResponseWriter, r *http.Request, uid string, params ListFeedTopicsParams)
+	// Get service health status
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// List activities across all user feeds
+	// (GET /home)
+	GetHomeTimeline(w http.ResponseWriter, r *http.Request, params GetHomeTimelineParams)
+	// Proxy an activity image
+	// (GET /img)
+	ProxyImage(w http.ResponseWriter, r *http.Request, params ProxyImageParams)
+	// Push activities to a custom source
+	// (POST /ingest)
+	IngestActivities(w http.ResponseWriter, r *http.Request)
+	// List the supported source types
+	// (GET /meta/source-types)
+	ListSourceTypes(w http.ResponseWriter, r *http.Request)
+	// List the supported topic tags
+	// (GET /meta/topics)
+	ListTopicTags(w http.ResponseWriter, r *http.Request)
 	// List available sources
 	// (GET /sources)
 	ListSources(w http.ResponseWriter, r *http.Request, params ListSourcesParams)
+	// Validate a source config without adding it
+	// (POST /sources/validate)
+	ValidateSource(w http.ResponseWriter, r *http.Request)
 	// Get source by UID
 	// (GET /sources/{uid})
-	GetSource(w http.ResponseWriter, r *http.Request, uid string)
+	GetSource(w http.ResponseWriter, r *http.Request, uid string, params GetSourceParams)
+	// Get the webhook secret of a source
+	// (GET /sources/{uid}/webhook-secret)
+	GetSourceWebhookSecret(w http.ResponseWriter, r *http.Request, uid string)
 	// Get authenticated user information
 	// (GET /users/me)
 	GetMe(w http.ResponseWriter, r *http.Request)
+	// Receive a provider webhook for a source
+	// (POST /webhooks/sources/{sourceUid})
+	ReceiveSourceWebhook(w http.ResponseWriter, r *http.Request, sourceUid string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -254,29 +905,20 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// ListFeeds operation middleware
-func (siw *ServerInterfaceWrapper) ListFeeds(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// SetActivityFeedback operation middleware
+func (siw *ServerInterfaceWrapper) SetActivityFeedback(w http.ResponseWriter, r *http.Request) {
 
-	r = r.WithContext(ctx)
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListFeeds(w, r)
-	}))
+	// ------------- Path parameter "uid" -------------
+	var uid string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// CreateOwnFeed operation middleware
-func (siw *ServerInterfaceWrapper) CreateOwnFeed(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -284,7 +926,7 @@ func (siw *ServerInterfaceWrapper) CreateOwnFeed(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateOwnFeed(w, r)
+		siw.Handler.SetActivityFeedback(w, r, uid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -294,8 +936,8 @@ func (siw *ServerInterfaceWrapper) CreateOwnFeed(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteOwnFeed operation middleware
-func (siw *ServerInterfaceWrapper) DeleteOwnFeed(w http.ResponseWriter, r *http.Request) {
+// ListRelatedActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListRelatedActivities(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -314,8 +956,19 @@ func (siw *ServerInterfaceWrapper) DeleteOwnFeed(w http.ResponseWriter, r *http.
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRelatedActivitiesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteOwnFeed(w, r, uid)
+		siw.Handler.ListRelatedActivities(w, r, uid, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -325,19 +978,302 @@ func (siw *ServerInterfaceWrapper) DeleteOwnFeed(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateOwnFeed operation middleware
-func (siw *ServerInterfaceWrapper) UpdateOwnFeed(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "uid" -------------
-	var uid string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
-		return
-	}
+// StartReprocess operation middleware
+func (siw *ServerInterfaceWrapper) StartReprocess(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartReprocess(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReprocessJob operation middleware
+func (siw *ServerInterfaceWrapper) GetReprocessJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "jobId" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", r.PathValue("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReprocessJob(w, r, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSourceActivityCounts operation middleware
+func (siw *ServerInterfaceWrapper) ListSourceActivityCounts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSourceActivityCountsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSourceActivityCounts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCollection operation middleware
+func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCollection(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCollectionActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListCollectionActivities(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCollectionActivities(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddCollectionActivity operation middleware
+func (siw *ServerInterfaceWrapper) AddCollectionActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCollectionActivity(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFeeds operation middleware
+func (siw *ServerInterfaceWrapper) ListFeeds(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFeeds(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOwnFeed operation middleware
+func (siw *ServerInterfaceWrapper) CreateOwnFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateOwnFeedParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOwnFeed(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetFeedOrder operation middleware
+func (siw *ServerInterfaceWrapper) SetFeedOrder(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetFeedOrder(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOwnFeed operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOwnFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOwnFeed(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOwnFeed operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOwnFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -356,8 +1292,331 @@ func (siw *ServerInterfaceWrapper) UpdateOwnFeed(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListFeedActivities operation middleware
-func (siw *ServerInterfaceWrapper) ListFeedActivities(w http.ResponseWriter, r *http.Request) {
+// ListFeedActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListFeedActivities(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFeedActivitiesParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "weekStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weekStart", r.URL.Query(), &params.WeekStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weekStart", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rewriteQuery" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rewriteQuery", r.URL.Query(), &params.RewriteQuery)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rewriteQuery", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wSim" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wSim", r.URL.Query(), &params.WSim)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wSim", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wSocial" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wSocial", r.URL.Query(), &params.WSocial)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wSocial", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wRecency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wRecency", r.URL.Query(), &params.WRecency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wRecency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "paginate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paginate", r.URL.Query(), &params.Paginate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "paginate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFeedActivities(w, r, uid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFeedDigest operation middleware
+func (siw *ServerInterfaceWrapper) GetFeedDigest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFeedDigestParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "weekStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weekStart", r.URL.Query(), &params.WeekStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weekStart", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFeedDigest(w, r, uid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportFeedActivities operation middleware
+func (siw *ServerInterfaceWrapper) ExportFeedActivities(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportFeedActivitiesParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportFeedActivities(w, r, uid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseOwnFeed operation middleware
+func (siw *ServerInterfaceWrapper) PauseOwnFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseOwnFeed(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkFeedRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkFeedRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkFeedRead(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreOwnFeed operation middleware
+func (siw *ServerInterfaceWrapper) RestoreOwnFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreOwnFeed(w, r, uid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFeedTopics operation middleware
+func (siw *ServerInterfaceWrapper) ListFeedTopics(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -377,7 +1636,7 @@ func (siw *ServerInterfaceWrapper) ListFeedActivities(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListFeedActivitiesParams
+	var params ListFeedTopicsParams
 
 	// ------------- Optional query parameter "period" -------------
 
@@ -387,11 +1646,19 @@ func (siw *ServerInterfaceWrapper) ListFeedActivities(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Optional query parameter "sortBy" -------------
+	// ------------- Optional query parameter "timezone" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "weekStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weekStart", r.URL.Query(), &params.WeekStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weekStart", Err: err})
 		return
 	}
 
@@ -411,16 +1678,184 @@ func (siw *ServerInterfaceWrapper) ListFeedActivities(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Optional query parameter "rewriteQuery" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFeedTopics(w, r, uid, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "rewriteQuery", r.URL.Query(), &params.RewriteQuery)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHomeTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetHomeTimeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHomeTimelineParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rewriteQuery", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "weekStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weekStart", r.URL.Query(), &params.WeekStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "weekStart", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListFeedActivities(w, r, uid, params)
+		siw.Handler.GetHomeTimeline(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProxyImage operation middleware
+func (siw *ServerInterfaceWrapper) ProxyImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProxyImageParams
+
+	// ------------- Required query parameter "url" -------------
+
+	if paramValue := r.URL.Query().Get("url"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "url"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "url", r.URL.Query(), &params.Url)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "url", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "sig" -------------
+
+	if paramValue := r.URL.Query().Get("sig"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sig"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "sig", r.URL.Query(), &params.Sig)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sig", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProxyImage(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IngestActivities operation middleware
+func (siw *ServerInterfaceWrapper) IngestActivities(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestActivities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSourceTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListSourceTypes(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSourceTypes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTopicTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTopicTags(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTopicTags(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -471,6 +1906,26 @@ func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// ValidateSource operation middleware
+func (siw *ServerInterfaceWrapper) ValidateSource(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateSource(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetSource operation middleware
 func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
 
@@ -491,8 +1946,50 @@ func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Requ
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSourceParams
+
+	// ------------- Optional query parameter "feedUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "feedUid", r.URL.Query(), &params.FeedUid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "feedUid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSource(w, r, uid, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSourceWebhookSecret operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceWebhookSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uid" -------------
+	var uid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uid", r.PathValue("uid"), &uid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uid", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSource(w, r, uid)
+		siw.Handler.GetSourceWebhookSecret(w, r, uid)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -522,6 +2019,31 @@ func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
+// ReceiveSourceWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ReceiveSourceWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sourceUid" -------------
+	var sourceUid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceUid", r.PathValue("sourceUid"), &sourceUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceUid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReceiveSourceWebhook(w, r, sourceUid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -642,14 +2164,38 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("POST "+options.BaseURL+"/activities/{uid}/feedback", wrapper.SetActivityFeedback)
+	m.HandleFunc("GET "+options.BaseURL+"/activities/{uid}/related", wrapper.ListRelatedActivities)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/reprocess", wrapper.StartReprocess)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/reprocess/{jobId}", wrapper.GetReprocessJob)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/source-counts", wrapper.ListSourceActivityCounts)
+	m.HandleFunc("POST "+options.BaseURL+"/collections", wrapper.CreateCollection)
+	m.HandleFunc("GET "+options.BaseURL+"/collections/{uid}/activities", wrapper.ListCollectionActivities)
+	m.HandleFunc("POST "+options.BaseURL+"/collections/{uid}/activities", wrapper.AddCollectionActivity)
 	m.HandleFunc("GET "+options.BaseURL+"/feeds", wrapper.ListFeeds)
 	m.HandleFunc("POST "+options.BaseURL+"/feeds", wrapper.CreateOwnFeed)
+	m.HandleFunc("PUT "+options.BaseURL+"/feeds/order", wrapper.SetFeedOrder)
 	m.HandleFunc("DELETE "+options.BaseURL+"/feeds/{uid}", wrapper.DeleteOwnFeed)
 	m.HandleFunc("PUT "+options.BaseURL+"/feeds/{uid}", wrapper.UpdateOwnFeed)
 	m.HandleFunc("GET "+options.BaseURL+"/feeds/{uid}/activities", wrapper.ListFeedActivities)
+	m.HandleFunc("GET "+options.BaseURL+"/feeds/{uid}/digest", wrapper.GetFeedDigest)
+	m.HandleFunc("GET "+options.BaseURL+"/feeds/{uid}/export", wrapper.ExportFeedActivities)
+	m.HandleFunc("PATCH "+options.BaseURL+"/feeds/{uid}/pause", wrapper.PauseOwnFeed)
+	m.HandleFunc("POST "+options.BaseURL+"/feeds/{uid}/read-all", wrapper.MarkFeedRead)
+	m.HandleFunc("POST "+options.BaseURL+"/feeds/{uid}/restore", wrapper.RestoreOwnFeed)
+	m.HandleFunc("GET "+options.BaseURL+"/feeds/{uid}/topics", wrapper.ListFeedTopics)
+	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/home", wrapper.GetHomeTimeline)
+	m.HandleFunc("GET "+options.BaseURL+"/img", wrapper.ProxyImage)
+	m.HandleFunc("POST "+options.BaseURL+"/ingest", wrapper.IngestActivities)
+	m.HandleFunc("GET "+options.BaseURL+"/meta/source-types", wrapper.ListSourceTypes)
+	m.HandleFunc("GET "+options.BaseURL+"/meta/topics", wrapper.ListTopicTags)
 	m.HandleFunc("GET "+options.BaseURL+"/sources", wrapper.ListSources)
+	m.HandleFunc("POST "+options.BaseURL+"/sources/validate", wrapper.ValidateSource)
 	m.HandleFunc("GET "+options.BaseURL+"/sources/{uid}", wrapper.GetSource)
+	m.HandleFunc("GET "+options.BaseURL+"/sources/{uid}/webhook-secret", wrapper.GetSourceWebhookSecret)
 	m.HandleFunc("GET "+options.BaseURL+"/users/me", wrapper.GetMe)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks/sources/{sourceUid}", wrapper.ReceiveSourceWebhook)
 
 	return m
 }
@@ -10,6 +10,23 @@ type Config struct {
 	// APIKeys is a JSON or comma-separated key=value pairs string containing key-to-userID mapping
 	// Example: {"key1":"user1","key2":"user2"} or "key1=user1,key2=user2"
 	APIKeys string `env:"AUTH_API_KEYS,default={}"`
+	// AdminUserIDs is a comma-separated list of user IDs allowed to use the admin endpoints (e.g. POST /admin/reprocess).
+	AdminUserIDs string `env:"AUTH_ADMIN_USER_IDS,default="`
+}
+
+// IsAdmin reports whether the user is allowed to use the admin endpoints.
+func (c *Config) IsAdmin(userID string) bool {
+	if userID == "" {
+		return false
+	}
+
+	for id := range strings.SplitSeq(c.AdminUserIDs, ",") {
+		if strings.TrimSpace(id) == userID {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ParseAPIKeys parses the JSON string into a map[string]string
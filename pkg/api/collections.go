@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/defeedco/defeed/pkg/api/auth"
+	"github.com/defeedco/defeed/pkg/feeds"
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+type collections interface {
+	Create(ctx context.Context, userID string, name string) (*feeds.Collection, error)
+	AddActivity(ctx context.Context, collectionID string, userID string, activityUID activitytypes.TypedUID) error
+	Activities(ctx context.Context, collectionID string, userID string) ([]*activitytypes.DecoratedActivity, error)
+}
+
+func (s *Server) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	var req CreateCollectionRequest
+	if err := deserializeReq(r, &req); err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	collection, err := s.collections.Create(r.Context(), user.UserID, req.Name)
+	if err != nil {
+		s.internalError(w, err, "create collection")
+		return
+	}
+
+	s.serializeRes(w, serializeCollection(collection))
+}
+
+func (s *Server) AddCollectionActivity(w http.ResponseWriter, r *http.Request, uid string) {
+	var req AddCollectionActivityRequest
+	if err := deserializeReq(r, &req); err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	activityUID, err := lib.NewTypedUIDFromString(req.ActivityUid)
+	if err != nil {
+		s.badRequest(w, err, "deserialize activity uid")
+		return
+	}
+
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	err = s.collections.AddActivity(r.Context(), uid, user.UserID, activityUID)
+	if errors.Is(err, feeds.ErrCollectionNotFound) || errors.Is(err, feeds.ErrActivityNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "add collection activity")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) ListCollectionActivities(w http.ResponseWriter, r *http.Request, uid string) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	acts, err := s.collections.Activities(r.Context(), uid, user.UserID)
+	if errors.Is(err, feeds.ErrCollectionNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "list collection activities")
+		return
+	}
+
+	results, err := serializeActivities(r.Context(), acts, s.imageProxy, nil)
+	if err != nil {
+		s.internalError(w, err, "serialize activities")
+		return
+	}
+
+	s.serializeRes(w, CollectionActivitiesResponse{Results: *results})
+}
+
+func serializeCollection(in *feeds.Collection) Collection {
+	return Collection{
+		Uid:           in.ID,
+		Name:          in.Name,
+		ActivityCount: len(in.ActivityIDs),
+		CreatedAt:     in.CreatedAt,
+	}
+}
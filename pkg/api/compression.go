@@ -0,0 +1,212 @@
+package api
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// compressionMiddleware compresses the responses with gzip or deflate, if accepted by the client (see Config.CompressionEnabled).
+// The responses smaller than minSize, and the already compressed content (e.g. the proxied images) are sent as is.
+func compressionMiddleware(next http.Handler, enabled bool, minSize int) http.Handler {
+	if !enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The responses differ by the Accept-Encoding header, so caches shouldn't mix them up.
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateContentEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressedResponseWriter{
+			ResponseWriter: w,
+			encoding:       encoding,
+			minSize:        minSize,
+		}
+		defer cw.close()
+
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateContentEncoding returns the preferred supported content encoding (gzip over deflate),
+// or an empty string if the client doesn't accept any.
+func negotiateContentEncoding(acceptEncoding string) string {
+	qualities := make(map[string]float64)
+	for _, coding := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(coding), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		qualities[strings.ToLower(strings.TrimSpace(name))] = quality
+	}
+
+	for _, encoding := range []string{"gzip", "deflate"} {
+		quality, ok := qualities[encoding]
+		if !ok {
+			quality, ok = qualities["*"]
+		}
+		if ok && quality > 0 {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressedResponseWriter buffers the start of the body, until it's known whether it's worth compressing.
+type compressedResponseWriter struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+
+	status     int
+	buf        []byte
+	decided    bool
+	compressor io.WriteCloser
+}
+
+func (w *compressedResponseWriter) WriteHeader(status int) {
+	if w.decided {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *compressedResponseWriter) Write(p []byte) (int, error) {
+	if !w.decided {
+		w.buf = append(w.buf, p...)
+		if len(w.buf) < w.minSize {
+			return len(p), nil
+		}
+		if err := w.start(true); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+
+	if w.compressor != nil {
+		return w.compressor.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush starts compressing the streamed responses (e.g. the exports) without waiting for the minimum size.
+func (w *compressedResponseWriter) Flush() {
+	if !w.decided {
+		if err := w.start(true); err != nil {
+			return
+		}
+	}
+
+	if flusher, ok := w.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *compressedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// start writes the headers and the buffered body, compressed if large enough and the content is compressible.
+func (w *compressedResponseWriter) start(largeEnough bool) error {
+	w.decided = true
+
+	compress := largeEnough && w.compressible()
+	header := w.Header()
+	// The compressed body differs from the identity one, so the strong ETags are weakened (see etagMatches).
+	if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") &&
+		(compress || w.status == http.StatusNotModified) {
+		header.Set("ETag", "W/"+etag)
+	}
+
+	if compress {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+
+		if w.encoding == "gzip" {
+			w.compressor = gzip.NewWriter(w.ResponseWriter)
+		} else {
+			compressor, err := flate.NewWriter(w.ResponseWriter, flate.DefaultCompression)
+			if err != nil {
+				return err
+			}
+			w.compressor = compressor
+		}
+	}
+
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if w.compressor != nil {
+		_, err := w.compressor.Write(buf)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+func (w *compressedResponseWriter) compressible() bool {
+	if w.status == http.StatusNoContent || w.status == http.StatusNotModified {
+		return false
+	}
+
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(w.buf)
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case mediaType == "image/svg+xml":
+		return true
+	case strings.HasPrefix(mediaType, "image/"),
+		strings.HasPrefix(mediaType, "video/"),
+		strings.HasPrefix(mediaType, "audio/"),
+		mediaType == "application/gzip",
+		mediaType == "application/zip",
+		mediaType == "application/octet-stream":
+		return false
+	}
+	return true
+}
+
+// close sends the small buffered responses as is, and finishes the compressed stream.
+func (w *compressedResponseWriter) close() {
+	if !w.decided {
+		_ = w.start(false)
+	}
+	if w.compressor != nil {
+		_ = w.compressor.Close()
+	}
+}
@@ -1,6 +1,10 @@
 package api
 
-import "github.com/defeedco/defeed/pkg/api/auth"
+import (
+	"time"
+
+	"github.com/defeedco/defeed/pkg/api/auth"
+)
 
 type Config struct {
 	Host       string `env:"SERVER_HOST,default=localhost"`
@@ -10,6 +14,32 @@ type Config struct {
 	BaseURL    string `env:"SERVER_BASE_URL,default=/"`
 	FaviconURL string `env:"SERVER_FAVICON_URL,default="`
 	// CORSOrigin is a comma-separated list of origins.
-	CORSOrigin string      `env:"CORS_ORIGIN,default=*"`
-	Auth       auth.Config `env:""`
+	CORSOrigin string `env:"CORS_ORIGIN,default=*"`
+	// IdempotencyKeyTTL is the duration for which the Idempotency-Key header values are remembered.
+	IdempotencyKeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL,default=24h"`
+	// PublicQueryRateLimit is the max number of query override requests per minute per IP for unauthenticated users.
+	// Set to 0 to disable.
+	PublicQueryRateLimit float64 `env:"PUBLIC_QUERY_RATE_LIMIT,default=10" validate:"gte=0"`
+	// ETagEnabled enables ETag headers and conditional (If-None-Match) requests on GET endpoints.
+	// Requests with a query override are never cached.
+	ETagEnabled bool `env:"SERVER_ETAG_ENABLED,default=false"`
+	// MsgpackEnabled enables msgpack response bodies for clients sending "Accept: application/msgpack" (or application/x-msgpack).
+	// Other clients keep getting JSON. Plain text errors (e.g. 404, 500) aren't affected.
+	MsgpackEnabled bool `env:"SERVER_MSGPACK_ENABLED,default=false"`
+	// CompressionEnabled enables gzip (or deflate) compression of the responses, for clients sending the Accept-Encoding header.
+	CompressionEnabled bool `env:"SERVER_COMPRESSION_ENABLED,default=false"`
+	// CompressionMinSize is the min response size in bytes to compress, since small bodies don't benefit from it.
+	CompressionMinSize int `env:"SERVER_COMPRESSION_MIN_SIZE,default=1024" validate:"gte=0"`
+	// ImageProxyURL rewrites the activity image URLs to go through an image proxy,
+	// which fixes hotlink-protected and mixed-content (http) images.
+	// The escaped image URL is appended to this value (e.g. https://api.example.com/img?url=). Leave empty to disable.
+	ImageProxyURL string `env:"IMAGE_PROXY_URL,default=" validate:"omitempty,url"`
+	// ImageProxyBuiltin enables the built-in GET /img proxy endpoint.
+	ImageProxyBuiltin bool `env:"IMAGE_PROXY_BUILTIN,default=false"`
+	// ImageProxySecret signs the proxied image URLs (appended as the sig query parameter),
+	// so that the built-in proxy only fetches the images served by the API. Required by the built-in proxy.
+	ImageProxySecret string `env:"IMAGE_PROXY_SECRET,default=" validate:"required_if=ImageProxyBuiltin true"`
+	// ImageProxyMaxBytes is the max size of the images streamed by the built-in proxy.
+	ImageProxyMaxBytes int64       `env:"IMAGE_PROXY_MAX_BYTES,default=10485760" validate:"gte=1"`
+	Auth               auth.Config `env:""`
 }
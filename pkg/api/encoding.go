@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// responseEncoding is the media type of the response bodies, negotiated from the Accept request header.
+type responseEncoding string
+
+const (
+	// encodingJSON is the default, used if the client doesn't accept any other supported encoding.
+	encodingJSON responseEncoding = "application/json"
+	// encodingMsgpack is a binary encoding with the same structure (field names) as JSON,
+	// which is smaller for the large activity lists (e.g. for bandwidth-sensitive mobile clients).
+	encodingMsgpack responseEncoding = "application/msgpack"
+)
+
+// negotiateEncoding returns the msgpack encoding if the client accepts application/msgpack (or application/x-msgpack),
+// with at least the quality of JSON. Falls back to JSON otherwise.
+func negotiateEncoding(accept string) responseEncoding {
+	var jsonQuality, msgpackQuality float64
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+
+		switch mediaType {
+		case "application/msgpack", "application/x-msgpack":
+			msgpackQuality = max(msgpackQuality, quality)
+		case "application/json", "application/*", "*/*":
+			jsonQuality = max(jsonQuality, quality)
+		}
+	}
+
+	if msgpackQuality > 0 && msgpackQuality >= jsonQuality {
+		return encodingMsgpack
+	}
+	return encodingJSON
+}
+
+// encodingMiddleware negotiates the response encoding of each request (see Config.MsgpackEnabled).
+func encodingMiddleware(next http.Handler, enabled bool) http.Handler {
+	if !enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The responses differ by the Accept header, so caches shouldn't mix them up.
+		w.Header().Add("Vary", "Accept")
+
+		next.ServeHTTP(&encodedResponseWriter{
+			ResponseWriter: w,
+			encoding:       negotiateEncoding(r.Header.Get("Accept")),
+		}, r)
+	})
+}
+
+// encodedResponseWriter carries the negotiated encoding to serializeRes, so that the handlers don't need to pass the request.
+type encodedResponseWriter struct {
+	http.ResponseWriter
+	encoding responseEncoding
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController (e.g. to flush the streamed exports).
+func (w *encodedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// responseEncodingOf returns the negotiated encoding, looking through the writers wrapped by the handlers (e.g. the tracing).
+func responseEncodingOf(w http.ResponseWriter) responseEncoding {
+	for {
+		if encoded, ok := w.(*encodedResponseWriter); ok {
+			return encoded.encoding
+		}
+		wrapper, ok := w.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return encodingJSON
+		}
+		w = wrapper.Unwrap()
+	}
+}
+
+// writeEncoded writes the status and the body in the negotiated encoding.
+func writeEncoded(w http.ResponseWriter, status int, res any) error {
+	encoding := responseEncodingOf(w)
+	w.Header().Set("Content-Type", string(encoding))
+	w.WriteHeader(status)
+
+	if encoding == encodingMsgpack {
+		enc := msgpack.NewEncoder(w)
+		// Reuse the JSON field names (and omitempty options) of the generated API types.
+		enc.SetCustomStructTag("json")
+		enc.UseCompactInts(true)
+		return enc.Encode(res)
+	}
+
+	return json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/api/auth"
+	"github.com/defeedco/defeed/pkg/feeds"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// exportFlushInterval is the number of exported records after which the response is flushed to the client.
+const exportFlushInterval = 100
+
+// exportCSVHeader follows the ActivityExportRecord fields.
+var exportCSVHeader = []string{"uid", "title", "summary", "url", "sourceUids", "socialScore", "qualityScore", "createdAt"}
+
+func (s *Server) ExportFeedActivities(w http.ResponseWriter, r *http.Request, uid string, params ExportFeedActivitiesParams) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	format := Jsonl
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	var contentType string
+	switch format {
+	case Jsonl:
+		contentType = "application/x-ndjson"
+	case Csv:
+		contentType = "text/csv"
+	default:
+		s.badRequest(w, fmt.Errorf("unknown export format: %s", format), "deserialize export format")
+		return
+	}
+
+	jsonEncoder := json.NewEncoder(w)
+	csvWriter := csv.NewWriter(w)
+	controller := http.NewResponseController(w)
+
+	// The response is only started with the first activity,
+	// so that the authorization errors can still be responded with a proper status code.
+	exported := 0
+	start := func() error {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="feed-%s.%s"`, uid, format))
+		w.WriteHeader(http.StatusOK)
+		if format == Csv {
+			return csvWriter.Write(exportCSVHeader)
+		}
+		return nil
+	}
+
+	err = s.feedRegistry.ExportActivities(r.Context(), uid, user.UserID, func(act *activitytypes.DecoratedActivity) error {
+		if exported == 0 {
+			if err := start(); err != nil {
+				return fmt.Errorf("start export: %w", err)
+			}
+		}
+
+		record := serializeExportRecord(act)
+		if format == Csv {
+			if err := csvWriter.Write(exportCSVRow(record)); err != nil {
+				return fmt.Errorf("write csv record: %w", err)
+			}
+		} else if err := jsonEncoder.Encode(record); err != nil {
+			return fmt.Errorf("write jsonl record: %w", err)
+		}
+
+		exported++
+		if exported%exportFlushInterval == 0 {
+			csvWriter.Flush()
+			_ = controller.Flush()
+		}
+		return nil
+	})
+	if exported == 0 {
+		if errors.Is(err, feeds.ErrFeedNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, feeds.ErrTooManyConcurrentRequests) {
+			http.Error(w, "too many concurrent requests", http.StatusTooManyRequests)
+			return
+		}
+		if err != nil {
+			s.internalError(w, err, "export feed activities")
+			return
+		}
+		if err := start(); err != nil {
+			s.logger.Err(err).Msg("start export")
+			return
+		}
+	} else if err != nil {
+		// The status code was already sent, so the client can only detect the truncated export.
+		s.logger.Err(err).Str("feed_id", uid).Int("exported", exported).Msg("export feed activities")
+		return
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		s.logger.Err(err).Msg("flush csv export")
+	}
+}
+
+func serializeExportRecord(in *activitytypes.DecoratedActivity) ActivityExportRecord {
+	var summary string
+	if in.Summary != nil {
+		summary = in.Summary.ShortSummary
+	}
+
+	return ActivityExportRecord{
+		Uid:          in.Activity.UID().String(),
+		Title:        in.Activity.Title(),
+		Summary:      summary,
+		Url:          in.Activity.URL(),
+		SourceUids:   serializeSourceUIDs(in.Activity.SourceUIDs()),
+		SocialScore:  in.Activity.SocialScore(),
+		QualityScore: in.QualityScore,
+		CreatedAt:    in.Activity.CreatedAt(),
+	}
+}
+
+func exportCSVRow(in ActivityExportRecord) []string {
+	return []string{
+		in.Uid,
+		in.Title,
+		in.Summary,
+		in.Url,
+		strings.Join(in.SourceUids, " "),
+		strconv.FormatFloat(in.SocialScore, 'f', -1, 64),
+		strconv.FormatFloat(in.QualityScore, 'f', -1, 64),
+		in.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,263 @@
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+)
+
+// imageProxyTimeout bounds fetching a single image, including redirects.
+const imageProxyTimeout = 10 * time.Second
+
+var errNonPublicAddress = errors.New("non-public address")
+
+// newImageProxyClient creates a client that only connects to public addresses,
+// so that the proxy can't be used to reach internal services.
+func newImageProxyClient() *http.Client {
+	return &http.Client{
+		Timeout:   imageProxyTimeout,
+		Transport: newPublicOnlyTransport(),
+	}
+}
+
+// publicOnlyTransport routes the requests through the outbound proxy, if it's configured.
+// The direct connections are checked when dialing, while the proxied connections (made by the outbound proxy itself)
+// are pinned to the checked address of the target host, so that the host can't resolve to another address in between.
+type publicOnlyTransport struct {
+	direct  *http.Transport
+	proxied *http.Transport
+}
+
+func newPublicOnlyTransport() *publicOnlyTransport {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublicIP(net.ParseIP(host)) {
+				return fmt.Errorf("%w: %s", errNonPublicAddress, host)
+			}
+			return nil
+		},
+	}
+
+	proxied := lib.NewHTTPTransport()
+	proxied.MaxIdleConns = 10
+	proxied.IdleConnTimeout = 30 * time.Second
+
+	return &publicOnlyTransport{
+		direct: &http.Transport{
+			DialContext:     dialer.DialContext,
+			MaxIdleConns:    10,
+			IdleConnTimeout: 30 * time.Second,
+		},
+		proxied: proxied,
+	}
+}
+
+// RoundTrip is called for each redirect too, so the redirect targets are checked as well.
+func (t *publicOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	proxyURL, err := t.proxied.Proxy(req)
+	if err != nil {
+		return nil, fmt.Errorf("resolve proxy: %w", err)
+	}
+	if proxyURL == nil {
+		return t.direct.RoundTrip(req)
+	}
+
+	ip, err := resolvePublicIP(req.Context(), req.URL.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	// The TLS server name must be the original host, since the pinned request URL has the IP instead.
+	// The transport is cloned per request for that, so the connections aren't reused.
+	transport := t.proxied.Clone()
+	transport.DisableKeepAlives = true
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.ServerName = req.URL.Hostname()
+
+	resp, err := transport.RoundTrip(pinnedRequest(req, ip))
+	if err != nil {
+		return nil, err
+	}
+	resp.Request = req
+	return resp, nil
+}
+
+// resolvePublicIP returns the first resolved address of the host, if all of its addresses are public.
+func resolvePublicIP(ctx context.Context, host string) (net.IP, error) {
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("resolve host: %w", err)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("resolve host: no addresses for %s", host)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return nil, fmt.Errorf("%w: %s", errNonPublicAddress, addr.IP)
+		}
+	}
+	return addrs[0].IP, nil
+}
+
+// pinnedRequest returns a copy of the request that connects to the given IP, while keeping the original Host header.
+func pinnedRequest(req *http.Request, ip net.IP) *http.Request {
+	pinned := req.Clone(req.Context())
+	if pinned.Host == "" {
+		pinned.Host = req.URL.Host
+	}
+
+	host := ip.String()
+	if ip.To4() == nil {
+		host = "[" + host + "]"
+	}
+	if port := req.URL.Port(); port != "" {
+		host = net.JoinHostPort(ip.String(), port)
+	}
+	pinned.URL.Host = host
+
+	return pinned
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which isn't covered by net.IP.IsPrivate.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPublicIP(ip net.IP) bool {
+	return ip != nil &&
+		ip.IsGlobalUnicast() &&
+		!ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+func (s *Server) ProxyImage(w http.ResponseWriter, r *http.Request, params ProxyImageParams) {
+	// The secret is required by the config validation, but an empty one would make the signatures forgeable.
+	if !s.config.ImageProxyBuiltin || s.config.ImageProxySecret == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Only the signed URLs are fetched, so that the proxy can't be used to fetch arbitrary URLs.
+	if !hmac.Equal([]byte(params.Sig), []byte(imageURLSignature(s.config.ImageProxySecret, params.Url))) {
+		http.Error(w, "invalid image url signature", http.StatusForbidden)
+		return
+	}
+
+	imageURL, err := neturl.Parse(params.Url)
+	if err != nil || (imageURL.Scheme != "http" && imageURL.Scheme != "https") || imageURL.Host == "" {
+		s.badRequest(w, fmt.Errorf("invalid image url: %s", params.Url), "validate image url")
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, imageURL.String(), nil)
+	if err != nil {
+		s.badRequest(w, err, "create image request")
+		return
+	}
+	req.Header.Set("User-Agent", lib.DefeedUserAgentString)
+
+	resp, err := s.imageClient.Do(req)
+	if err != nil {
+		s.badGateway(w, err, "fetch image")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		s.badGateway(w, fmt.Errorf("image http status: %d", resp.StatusCode), "fetch image")
+		return
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !isProxyableImage(contentType) {
+		s.badGateway(w, fmt.Errorf("unsupported image content type: %s", contentType), "fetch image")
+		return
+	}
+
+	if resp.ContentLength > s.config.ImageProxyMaxBytes {
+		s.badGateway(w, fmt.Errorf("image too large: %d bytes", resp.ContentLength), "fetch image")
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if resp.ContentLength >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(resp.ContentLength, 10))
+	}
+
+	// The status is already sent, so errors can only be logged.
+	n, err := io.Copy(w, io.LimitReader(resp.Body, s.config.ImageProxyMaxBytes))
+	if err != nil {
+		s.logger.Warn().Err(err).Str("url", imageURL.String()).Msg("Failed to stream image")
+		return
+	}
+	if n == s.config.ImageProxyMaxBytes {
+		s.logger.Warn().Str("url", imageURL.String()).Msg("Image truncated to the max size")
+	}
+}
+
+// isProxyableImage checks that the content type is a raster image.
+// SVG is excluded, since it can contain scripts.
+func isProxyableImage(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(mediaType, "image/") && mediaType != "image/svg+xml"
+}
+
+// imageProxy rewrites the image URLs to go through the image proxy (see Config.ImageProxyURL).
+// The zero value keeps the original URLs.
+type imageProxy struct {
+	url    string
+	secret string
+}
+
+// proxiedURL rewrites the image URL to go through the proxy, signed if the secret is set (see Config.ImageProxySecret).
+// Returns the original URL if the proxy is disabled or the URL can't be proxied (e.g. data URLs).
+func (p imageProxy) proxiedURL(imageURL string) string {
+	if p.url == "" {
+		return imageURL
+	}
+
+	if !strings.HasPrefix(imageURL, "http://") && !strings.HasPrefix(imageURL, "https://") {
+		return imageURL
+	}
+
+	out := p.url + neturl.QueryEscape(imageURL)
+	if p.secret != "" {
+		out += "&sig=" + imageURLSignature(p.secret, imageURL)
+	}
+	return out
+}
+
+// imageURLSignature returns the hex-encoded HMAC-SHA256 of the image URL.
+func imageURLSignature(secret string, imageURL string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(imageURL))
+	return hex.EncodeToString(mac.Sum(nil))
+}
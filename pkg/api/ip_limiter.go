@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ipLimiterTTL is the duration after which the limiters of inactive IPs are removed.
+const ipLimiterTTL = 10 * time.Minute
+
+// ipRateLimiter rate limits requests per client IP.
+type ipRateLimiter struct {
+	limit rate.Limit
+
+	mu          sync.Mutex
+	limiterByIP map[string]*ipLimiter
+	lastCleanup time.Time
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newIPRateLimiter creates a limiter allowing requestsPerMinute per IP. Zero disables the limit.
+func newIPRateLimiter(requestsPerMinute float64) *ipRateLimiter {
+	limit := rate.Inf
+	if requestsPerMinute > 0 {
+		limit = rate.Every(time.Duration(float64(time.Minute) / requestsPerMinute))
+	}
+
+	return &ipRateLimiter{
+		limit:       limit,
+		limiterByIP: make(map[string]*ipLimiter),
+		lastCleanup: time.Now(),
+	}
+}
+
+func (l *ipRateLimiter) Allow(ip string) bool {
+	if l.limit == rate.Inf {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastCleanup) > ipLimiterTTL {
+		for key, entry := range l.limiterByIP {
+			if now.Sub(entry.lastSeen) > ipLimiterTTL {
+				delete(l.limiterByIP, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	entry, ok := l.limiterByIP[ip]
+	if !ok {
+		entry = &ipLimiter{limiter: rate.NewLimiter(l.limit, 1)}
+		l.limiterByIP[ip] = entry
+	}
+	entry.lastSeen = now
+
+	return entry.limiter.Allow()
+}
+
+// clientIP returns the IP of the client.
+// If proxied, the IP is read from the headers set by the reverse proxy.
+// Only the last X-Forwarded-For entry is used, since it's appended by the proxy,
+// while the preceding entries are sent by the client and can be spoofed.
+func clientIP(r *http.Request, proxied bool) string {
+	if proxied {
+		if forwardedFor := r.Header.Values("X-Forwarded-For"); len(forwardedFor) > 0 {
+			last := forwardedFor[len(forwardedFor)-1]
+			if ip := strings.TrimSpace(last[strings.LastIndex(last, ",")+1:]); ip != "" {
+				return ip
+			}
+		}
+		if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+			return strings.TrimSpace(realIP)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name         string
+		proxied      bool
+		forwardedFor []string
+		realIP       string
+		expectedIP   string
+	}{
+		{name: "not proxied", forwardedFor: []string{"1.1.1.1"}, expectedIP: "192.0.2.1"},
+		{name: "proxy entry", proxied: true, forwardedFor: []string{"1.1.1.1"}, expectedIP: "1.1.1.1"},
+		{name: "spoofed leading entry", proxied: true, forwardedFor: []string{"6.6.6.6, 1.1.1.1"}, expectedIP: "1.1.1.1"},
+		{name: "spoofed leading header", proxied: true, forwardedFor: []string{"6.6.6.6", "1.1.1.1"}, expectedIP: "1.1.1.1"},
+		{name: "real IP", proxied: true, realIP: "1.1.1.1", expectedIP: "1.1.1.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			for _, value := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+
+			if ip := clientIP(r, tt.proxied); ip != tt.expectedIP {
+				t.Errorf("expected %s, got %s", tt.expectedIP, ip)
+			}
+		})
+	}
+}
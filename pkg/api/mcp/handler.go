@@ -134,10 +134,13 @@ func (h *Handler) listFeedActivities(
 		input.FeedUID,
 		h.userID,
 		activitytypes.SortByWeightedScore,
+		nil,
 		limit,
 		"",
 		activitytypes.PeriodDay,
+		activitytypes.DefaultCalendar(),
 		false,
+		"",
 	)
 	if err != nil {
 		return nil, GetFeedActivitiesOutput{}, fmt.Errorf("list feed activities: %w", err)
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/defeedco/defeed/pkg/feeds"
+	"github.com/defeedco/defeed/pkg/lib"
+)
+
+// topicTagValues are the TopicTag enum values from the OpenAPI spec.
+// Values that can't be deserialized are omitted from the meta endpoints,
+// so deserializeTopicTag remains the source of truth for the supported tags.
+var topicTagValues = []TopicTag{
+	LargeLanguageModels,
+	Startups,
+	Devtools,
+	WebPerformance,
+	DistributedSystems,
+	Databases,
+	SecurityEngineering,
+	SystemsProgramming,
+	ProductManagement,
+	GrowthEngineering,
+	ArtificialIntelligence,
+	Robotics,
+	OpenSource,
+	CloudInfrastructure,
+	ComputerScience,
+	Science,
+	Automotive,
+	Finance,
+	Web3,
+}
+
+// sourceTypeValues are the SourceType enum values from the OpenAPI spec.
+// Values that can't be deserialized (e.g. temporarily removed source types) are omitted from the meta endpoints.
+var sourceTypeValues = []SourceType{
+	MastodonAccount,
+	MastodonTag,
+	MastodonTrending,
+	HackernewsPosts,
+	RedditSubreddit,
+	LobstersTag,
+	LobstersFeed,
+	LobstersComments,
+	RssFeed,
+	GithubReleases,
+	GithubIssues,
+	GithubTopics,
+	ChangedetectionWebsite,
+	ProductHuntPosts,
+	Custom,
+}
+
+func (s *Server) ListTopicTags(w http.ResponseWriter, r *http.Request) {
+	out := make([]TopicTagMeta, 0, len(topicTagValues))
+	for _, tag := range topicTagValues {
+		topic, err := deserializeTopicTag(tag)
+		if err != nil {
+			continue
+		}
+		out = append(out, TopicTagMeta{
+			Tag:   tag,
+			Label: lib.Capitalize(strings.ReplaceAll(string(topic), "_", " ")),
+		})
+	}
+
+	s.serializeRes(w, out)
+}
+
+func (s *Server) ListSourceTypes(w http.ResponseWriter, r *http.Request) {
+	out := make([]SourceTypeMeta, 0, len(sourceTypeValues))
+	for _, sourceType := range sourceTypeValues {
+		internalType, err := deserializeSourceType(sourceType)
+		if err != nil {
+			continue
+		}
+
+		emoji, label, err := feeds.SourceTypeLabel(internalType)
+		if err != nil {
+			s.internalError(w, err, "get source type label")
+			return
+		}
+
+		out = append(out, SourceTypeMeta{
+			Type:  sourceType,
+			Label: label,
+			Emoji: emoji,
+		})
+	}
+
+	s.serializeRes(w, out)
+}
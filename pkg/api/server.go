@@ -2,21 +2,26 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
 	_ "embed"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	neturl "net/url"
 	"slices"
 	"strings"
+	"time"
 
 	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
 
 	"github.com/defeedco/defeed/pkg/api/auth"
 	mcphandler "github.com/defeedco/defeed/pkg/api/mcp"
+	"github.com/defeedco/defeed/pkg/sources/providers/custom"
 	"github.com/defeedco/defeed/pkg/sources/providers/github"
 	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/jsonapi"
 	"github.com/defeedco/defeed/pkg/sources/providers/lobsters"
 	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
 	"github.com/defeedco/defeed/pkg/sources/providers/producthunt"
@@ -24,8 +29,11 @@ import (
 	"github.com/defeedco/defeed/pkg/sources/providers/rss"
 
 	"github.com/defeedco/defeed/pkg/feeds"
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 	httpswagger "github.com/swaggo/http-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/defeedco/defeed/pkg/sources"
 	"github.com/rs/zerolog"
@@ -35,18 +43,59 @@ import (
 var openapiSpecYaml string
 
 type Server struct {
-	sourceScheduler *sources.Scheduler
-	sourceRegistry  sourceRegistry
-	feedRegistry    *feeds.Registry
-	logger          *zerolog.Logger
-	http            http.Server
+	sourceScheduler  *sources.Scheduler
+	sourceRegistry   sourceRegistry
+	feedRegistry     *feeds.Registry
+	collections      collections
+	idempotencyStore idempotencyStore
+	provisioner      provisioner
+	reprocessJobs    reprocessJobs
+	sourceTrimmer    sourceTrimmer
+	config           *Config
+	logger           *zerolog.Logger
+	http             http.Server
+	// publicQueryLimiter limits the query overrides of unauthenticated users.
+	publicQueryLimiter *ipRateLimiter
+	// imageClient fetches the images for the built-in image proxy.
+	imageClient *http.Client
+	// imageProxy rewrites the served image URLs to go through the image proxy.
+	imageProxy imageProxy
 }
 
 type sourceRegistry interface {
 	FindByUID(ctx context.Context, uid activitytypes.TypedUID) (sourcetypes.Source, error)
 	Search(ctx context.Context, params sources.SearchRequest) ([]sourcetypes.Source, error)
+	Validate(ctx context.Context, sourceType string, raw []byte) error
+	ParseWebhook(source sourcetypes.Source, ownerIDs []string, header http.Header, payload []byte) ([]activitytypes.Activity, error)
+	WebhookSecret(sourceUID activitytypes.TypedUID, ownerID string) (string, error)
 }
 
+type provisioner interface {
+	NeedsProvision(userID string) bool
+	Provision(ctx context.Context, userID string) error
+}
+
+type idempotencyStore interface {
+	Reserve(ctx context.Context, userID string, key string, createdAt time.Time, expiredBefore time.Time, abandonedBefore time.Time) (bool, error)
+	Find(ctx context.Context, userID string, key string, createdAfter time.Time) (string, error)
+	Complete(ctx context.Context, userID string, key string, resourceID string) error
+	Release(ctx context.Context, userID string, key string) error
+	RemoveCreatedBefore(ctx context.Context, before time.Time) error
+}
+
+// sourceValidationTimeout bounds the source initialization when validating source configs.
+const sourceValidationTimeout = 10 * time.Second
+
+// maxIdempotencyKeyLength is the max accepted length of the Idempotency-Key header value.
+const maxIdempotencyKeyLength = 255
+
+// idempotencyKeyReservationTimeout is how long a key stays reserved without a created feed,
+// before it's considered abandoned (e.g. the server stopped while creating the feed).
+const idempotencyKeyReservationTimeout = time.Minute
+
+// maxWebhookPayloadBytes is the max accepted size of the provider webhook payloads.
+const maxWebhookPayloadBytes = 5 << 20
+
 var _ ServerInterface = (*Server)(nil)
 
 func NewServer(
@@ -56,27 +105,78 @@ func NewServer(
 	sourceRegistry sourceRegistry,
 	sourceScheduler *sources.Scheduler,
 	feedRegistry *feeds.Registry,
+	collections collections,
+	idempotencyStore idempotencyStore,
+	provisioner provisioner,
+	reprocessJobs reprocessJobs,
+	sourceTrimmer sourceTrimmer,
 ) (*Server, error) {
 	mux := http.NewServeMux()
 
 	server := &Server{
-		logger:          logger,
-		sourceRegistry:  sourceRegistry,
-		sourceScheduler: sourceScheduler,
-		feedRegistry:    feedRegistry,
+		logger:             logger,
+		config:             config,
+		sourceRegistry:     sourceRegistry,
+		sourceScheduler:    sourceScheduler,
+		feedRegistry:       feedRegistry,
+		collections:        collections,
+		idempotencyStore:   idempotencyStore,
+		provisioner:        provisioner,
+		reprocessJobs:      reprocessJobs,
+		sourceTrimmer:      sourceTrimmer,
+		publicQueryLimiter: newIPRateLimiter(config.PublicQueryRateLimit),
+		imageClient:        newImageProxyClient(),
+		imageProxy:         imageProxy{url: config.ImageProxyURL, secret: config.ImageProxySecret},
 		http: http.Server{
-			Addr:    fmt.Sprintf("%s:%d", config.Host, config.Port),
-			Handler: authMiddleware.Middleware(corsMiddleware(mux, config.CORSOrigin)),
+			Addr: fmt.Sprintf("%s:%d", config.Host, config.Port),
 		},
 	}
+	server.http.Handler = authMiddleware.Middleware(corsMiddleware(
+		compressionMiddleware(
+			encodingMiddleware(server.provisionMiddleware(mux), config.MsgpackEnabled),
+			config.CompressionEnabled,
+			config.CompressionMinSize,
+		),
+		config.CORSOrigin,
+	))
 
-	HandlerFromMux(server, mux)
+	HandlerFromMux(server, &tracedServeMux{ServeMux: mux})
 	server.registerApiDocsHandlers(mux)
 	server.registerMCPHandler(mux)
 
 	return server, nil
 }
 
+// provisionMiddleware creates the starter feeds on the first authenticated request of new users.
+// The feeds are provisioned in the background and failures are only logged, so that they don't block the request.
+func (s *Server) provisionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := auth.UserFromContext(r.Context())
+		if err == nil && s.provisioner.NeedsProvision(user.UserID) {
+			ctx := context.WithoutCancel(r.Context())
+			go func() {
+				if err := s.provisioner.Provision(ctx, user.UserID); err != nil {
+					s.logger.Error().
+						Err(err).
+						Str("user_id", user.UserID).
+						Msg("Failed to provision starter feeds")
+				}
+			}()
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// tracedServeMux creates a span for each registered API route.
+type tracedServeMux struct {
+	*http.ServeMux
+}
+
+func (m *tracedServeMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	m.Handle(pattern, otelhttp.NewHandler(http.HandlerFunc(handler), pattern))
+}
+
 func corsMiddleware(next http.Handler, originConfig string) http.Handler {
 	origins := strings.Split(originConfig, ",")
 	for i := range origins {
@@ -95,8 +195,9 @@ func corsMiddleware(next http.Handler, originConfig string) http.Handler {
 			w.Header().Set("Access-Control-Allow-Origin", requestOrigin)
 		}
 
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "*")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Next-Cursor, ETag")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -139,6 +240,35 @@ func (s *Server) Stop() error {
 	return s.http.Close()
 }
 
+func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
+	failedActivities, err := s.sourceScheduler.FailedActivityCount(r.Context())
+	if err != nil {
+		s.internalError(w, err, "count failed activities")
+		return
+	}
+
+	res := Health{
+		Status:           "ok",
+		FailedActivities: failedActivities,
+	}
+	if stats, found := s.sourceScheduler.SeenActivityCacheStats(); found {
+		res.SeenActivityCache = &SeenActivityCacheStats{
+			Hits:    stats.Hits,
+			Misses:  stats.Misses,
+			HitRate: stats.HitRate(),
+		}
+	}
+	if stats, found := s.feedRegistry.QueryEmbeddingCacheStats(); found {
+		res.QueryEmbeddingCache = &QueryEmbeddingCacheStats{
+			Hits:    stats.Hits,
+			Misses:  stats.Misses,
+			HitRate: stats.HitRate(),
+		}
+	}
+
+	s.serializeRes(w, res)
+}
+
 func (s *Server) GetMe(w http.ResponseWriter, r *http.Request) {
 	user, err := auth.UserFromContext(r.Context())
 	if err != nil {
@@ -187,17 +317,94 @@ func (s *Server) ListFeedActivities(w http.ResponseWriter, r *http.Request, uid
 
 	period := deserializePeriod(params.Period)
 
-	out, err := s.feedRegistry.Activities(r.Context(), uid, user.UserID, sortBy, limit, queryOverride, period, rewriteQuery)
+	calendar, err := deserializeCalendar(params.Timezone, params.WeekStart)
+	if err != nil {
+		s.badRequest(w, err, "deserialize calendar")
+		return
+	}
+
+	// Only the paginated requests are sorted by the creation date alone,
+	// so that the other requests keep the results weighted and diversified by source.
+	var cursor string
+	if params.Paginate != nil && *params.Paginate {
+		if params.SortBy == nil || *params.SortBy != CreationDate || rewriteQuery {
+			s.badRequest(w, feeds.ErrPaginationUnsupported, "deserialize pagination")
+			return
+		}
+		sortBy = activitytypes.SortByDate
+		if params.Cursor != nil {
+			cursor = *params.Cursor
+		}
+	} else if params.Cursor != nil {
+		s.badRequest(w, errors.New("cursor requires paginate"), "deserialize pagination")
+		return
+	}
+
+	sortWeights, err := deserializeSortWeights(params.WSim, params.WSocial, params.WRecency)
+	if err != nil {
+		s.badRequest(w, err, "deserialize sort weights")
+		return
+	}
+
+	if !s.allowQueryOverride(w, r, user.UserID, queryOverride) {
+		return
+	}
+
+	// Only the authenticated users can experiment with the ranking, like with the query overrides.
+	if user.UserID == "" {
+		sortWeights = nil
+	}
+
+	// Query overrides and sort weights are request-specific and shouldn't be cached.
+	var etag string
+	if s.config.ETagEnabled && queryOverride == "" && sortWeights == nil {
+		version, err := s.feedRegistry.ActivitiesVersion(r.Context(), uid, user.UserID)
+		if errors.Is(err, feeds.ErrFeedNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			s.internalError(w, err, "get activities version")
+			return
+		}
+
+		// Check the ETag before listing the activities, since that's the expensive part (e.g. query rewrites and searches).
+		etag = activitiesETag(r, version)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	out, err := s.feedRegistry.Activities(r.Context(), uid, user.UserID, sortBy, sortWeights, limit, queryOverride, period, calendar, rewriteQuery, cursor)
+	if errors.Is(err, feeds.ErrPaginationUnsupported) || errors.Is(err, feeds.ErrQueryTooLong) {
+		s.badRequest(w, err, "list feed activities")
+		return
+	}
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, feeds.ErrTooManyConcurrentRequests) {
+		http.Error(w, "too many concurrent requests", http.StatusTooManyRequests)
+		return
+	}
 	if err != nil {
 		s.internalError(w, err, "list feed activities")
 		return
 	}
 
-	activities, err := serializeActivities(out.Results)
+	activities, err := serializeActivities(r.Context(), out.Results, s.imageProxy, out.SourceOverrides)
 	if err != nil {
 		s.internalError(w, err, "serialize activities")
 		return
 	}
+	for i := range *activities {
+		isRead := out.ReadActivityIDs[(*activities)[i].Uid]
+		(*activities)[i].IsRead = &isRead
+	}
 
 	topics, err := serializeTopics(out.Topics)
 	if err != nil {
@@ -205,12 +412,388 @@ func (s *Server) ListFeedActivities(w http.ResponseWriter, r *http.Request, uid
 		return
 	}
 
-	s.serializeRes(w, ActivitiesListResponse{
-		Results: *activities,
-		Topics:  *topics,
+	var nextCursor *string
+	if out.NextCursor != "" {
+		nextCursor = &out.NextCursor
+		// Also expose the cursor in the headers for clients that don't parse the body (e.g. proxies, CLI tools).
+		w.Header().Set("X-Next-Cursor", out.NextCursor)
+	}
+
+	res := ActivitiesListResponse{
+		Results:    *activities,
+		Topics:     *topics,
+		NextCursor: nextCursor,
+		HasMore:    &out.HasMore,
+	}
+
+	s.serializeResWithETag(w, r, res, etag)
+}
+
+func (s *Server) ListFeedTopics(w http.ResponseWriter, r *http.Request, uid string, params ListFeedTopicsParams) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	var queryOverride string
+	if params.Query != nil {
+		queryOverride = *params.Query
+	}
+
+	limit := 20
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	period := deserializePeriod(params.Period)
+
+	calendar, err := deserializeCalendar(params.Timezone, params.WeekStart)
+	if err != nil {
+		s.badRequest(w, err, "deserialize calendar")
+		return
+	}
+
+	if !s.allowQueryOverride(w, r, user.UserID, queryOverride) {
+		return
+	}
+
+	out, err := s.feedRegistry.Topics(r.Context(), uid, user.UserID, limit, queryOverride, period, calendar)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, feeds.ErrQueryTooLong) {
+		s.badRequest(w, err, "list feed topics")
+		return
+	}
+	if errors.Is(err, feeds.ErrTooManyConcurrentRequests) {
+		http.Error(w, "too many concurrent requests", http.StatusTooManyRequests)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "list feed topics")
+		return
+	}
+
+	topics, err := serializeTopics(out)
+	if err != nil {
+		s.internalError(w, err, "serialize topics")
+		return
+	}
+
+	s.serializeRes(w, TopicsListResponse{
+		Topics: *topics,
+	})
+}
+
+func (s *Server) GetFeedDigest(w http.ResponseWriter, r *http.Request, uid string, params GetFeedDigestParams) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	period := activitytypes.PeriodWeek
+	if params.Period != nil {
+		period = deserializePeriod(params.Period)
+	}
+
+	calendar, err := deserializeCalendar(params.Timezone, params.WeekStart)
+	if err != nil {
+		s.badRequest(w, err, "deserialize calendar")
+		return
+	}
+
+	out, err := s.feedRegistry.Digest(r.Context(), uid, user.UserID, period, calendar)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "get feed digest")
+		return
+	}
+
+	highlights := make([]FeedHighlight, 0, len(out))
+	for _, highlight := range out {
+		highlights = append(highlights, FeedHighlight{
+			Content:           highlight.Content,
+			SourceActivityIds: highlight.QuoteActivityIDs,
+		})
+	}
+
+	s.serializeRes(w, FeedDigestResponse{
+		Highlights: highlights,
+	})
+}
+
+func (s *Server) GetHomeTimeline(w http.ResponseWriter, r *http.Request, params GetHomeTimelineParams) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	limit := 20
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 100 {
+		s.badRequest(w, fmt.Errorf("limit must be between 1 and 100"), "validate limit")
+		return
+	}
+
+	sortBy, err := deserializeSortBy(params.SortBy)
+	if err != nil {
+		s.badRequest(w, err, "deserialize sort by")
+		return
+	}
+
+	calendar, err := deserializeCalendar(params.Timezone, params.WeekStart)
+	if err != nil {
+		s.badRequest(w, err, "deserialize calendar")
+		return
+	}
+
+	out, err := s.feedRegistry.HomeTimeline(r.Context(), user.UserID, sortBy, deserializePeriod(params.Period), calendar, limit)
+	if errors.Is(err, feeds.ErrTooManyConcurrentRequests) {
+		http.Error(w, "too many concurrent requests", http.StatusTooManyRequests)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "get home timeline")
+		return
+	}
+
+	imageURLs := make([]string, 0, len(out))
+	for _, e := range out {
+		imageURLs = append(imageURLs, e.Activity.Activity.ImageURL())
+	}
+	lib.ValidateImageURLs(r.Context(), imageURLs)
+
+	results := make([]TimelineActivity, 0, len(out))
+	for _, e := range out {
+		activity, err := serializeActivity(r.Context(), e.Activity, s.imageProxy, e.SourceOverrides)
+		if err != nil {
+			s.internalError(w, err, "serialize activity")
+			return
+		}
+		results = append(results, TimelineActivity{
+			Activity: *activity,
+			FeedUids: e.FeedIDs,
+		})
+	}
+
+	s.serializeRes(w, HomeTimelineResponse{
+		Results: results,
 	})
 }
 
+// allowQueryOverride rate limits the query overrides of unauthenticated users per IP.
+// Writes the error response if the request isn't allowed.
+func (s *Server) allowQueryOverride(w http.ResponseWriter, r *http.Request, userID string, queryOverride string) bool {
+	// The query overrides are ignored if not allowed for unauthenticated users.
+	if userID != "" || queryOverride == "" || !s.feedRegistry.PublicQueryOverride() {
+		return true
+	}
+
+	if !s.publicQueryLimiter.Allow(clientIP(r, s.config.Proxied)) {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) ValidateSource(w http.ResponseWriter, r *http.Request) {
+	var req ValidateSourceRequest
+	err := deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	sourceType, err := deserializeSourceType(req.Type)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source type")
+		return
+	}
+
+	raw, err := json.Marshal(req.Config)
+	if err != nil {
+		s.badRequest(w, err, "serialize source config")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), sourceValidationTimeout)
+	defer cancel()
+
+	err = s.sourceRegistry.Validate(ctx, sourceType, raw)
+
+	s.serializeRes(w, serializeSourceValidationResult(err))
+}
+
+func (s *Server) IngestActivities(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	var req IngestRequest
+	err = deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	sourceUID, err := sources.NewTypedUID(req.SourceUid)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source UID")
+		return
+	}
+
+	acts := make([]activitytypes.Activity, len(req.Activities))
+	for i, in := range req.Activities {
+		item, err := custom.NewItemFromPayload(user.UserID, sourceUID, deserializeIngestActivity(in))
+		if errors.Is(err, custom.ErrNotSourceOwner) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			s.badRequest(w, err, "deserialize activity")
+			return
+		}
+		acts[i] = item
+	}
+
+	accepted, err := s.sourceScheduler.Ingest(sourceUID, acts)
+	if err != nil {
+		s.internalError(w, err, "ingest activities")
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(IngestResponse{Accepted: accepted})
+}
+
+func (s *Server) ReceiveSourceWebhook(w http.ResponseWriter, r *http.Request, sourceUid string) {
+	sourceUID, err := sources.NewTypedUID(sourceUid)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source UID")
+		return
+	}
+
+	source, err := s.sourceScheduler.Get(sourceUID)
+	if err != nil {
+		s.internalError(w, err, "get source")
+		return
+	}
+	if source == nil {
+		http.Error(w, "source not found", http.StatusNotFound)
+		return
+	}
+
+	// The signature is computed over the raw payload, so it can't be decoded beforehand.
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadBytes))
+	if err != nil {
+		s.badRequest(w, err, "read webhook payload")
+		return
+	}
+
+	// Each owner signs the webhooks with their own secret, see GetSourceWebhookSecret.
+	ownerIDs, err := s.feedRegistry.SourceOwnerIDs(r.Context(), sourceUID)
+	if err != nil {
+		s.internalError(w, err, "get source owners")
+		return
+	}
+
+	acts, err := s.sourceRegistry.ParseWebhook(source, ownerIDs, r.Header, payload)
+	if errors.Is(err, sourcetypes.ErrInvalidWebhookSignature) {
+		s.logger.Warn().
+			Err(err).
+			Str("source_uid", sourceUID.String()).
+			Msg("Rejected webhook")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		s.badRequest(w, err, "parse webhook")
+		return
+	}
+
+	accepted, err := s.sourceScheduler.Ingest(sourceUID, acts)
+	if err != nil {
+		s.internalError(w, err, "ingest webhook activities")
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(IngestResponse{Accepted: accepted})
+}
+
+func (s *Server) GetSourceWebhookSecret(w http.ResponseWriter, r *http.Request, uid string) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	sourceUID, err := sources.NewTypedUID(uid)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source UID")
+		return
+	}
+
+	// The secrets are scoped to the source owners, so that the other users can't push to the source.
+	ownerIDs, err := s.feedRegistry.SourceOwnerIDs(r.Context(), sourceUID)
+	if err != nil {
+		s.internalError(w, err, "get source owners")
+		return
+	}
+	if !slices.Contains(ownerIDs, user.UserID) {
+		http.Error(w, "source not found", http.StatusNotFound)
+		return
+	}
+
+	secret, err := s.sourceRegistry.WebhookSecret(sourceUID, user.UserID)
+	if errors.Is(err, sources.ErrWebhookNotSupported) || errors.Is(err, sources.ErrWebhooksDisabled) {
+		s.badRequest(w, err, "get webhook secret")
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "get webhook secret")
+		return
+	}
+
+	s.serializeRes(w, SourceWebhookSecret{
+		Secret: secret,
+	})
+}
+
+func deserializeIngestActivity(in IngestActivity) *custom.Payload {
+	out := &custom.Payload{
+		ID:    in.Id,
+		Title: in.Title,
+	}
+	if in.Body != nil {
+		out.Body = *in.Body
+	}
+	if in.Url != nil {
+		out.URL = *in.Url
+	}
+	if in.ImageUrl != nil {
+		out.ImageURL = *in.ImageUrl
+	}
+	if in.CreatedAt != nil {
+		out.CreatedAt = *in.CreatedAt
+	}
+	return out
+}
+
 func (s *Server) ListSources(w http.ResponseWriter, r *http.Request, params ListSourcesParams) {
 	var query string
 	if params.Query != nil {
@@ -242,89 +825,442 @@ func (s *Server) ListSources(w http.ResponseWriter, r *http.Request, params List
 		return
 	}
 
+	stats := s.sourceStats(r.Context())
+	for i := range res {
+		res[i].Stats = serializeSourceStats(stats, res[i].Uid)
+	}
+
 	s.serializeRes(w, res)
 }
 
-func (s *Server) GetSource(w http.ResponseWriter, r *http.Request, uid string) {
+func (s *Server) GetSource(w http.ResponseWriter, r *http.Request, uid string, params GetSourceParams) {
 	typedUID, err := sources.NewTypedUID(uid)
 	if err != nil {
-		s.badRequest(w, err, "deserialize source UID")
+		s.badRequest(w, err, "deserialize source UID")
+		return
+	}
+
+	var sourceOverrides map[string]feeds.SourceOverride
+	if params.FeedUid != nil {
+		user, err := auth.UserFromContext(r.Context())
+		if err != nil {
+			s.internalError(w, err, "get user from context")
+			return
+		}
+
+		feed, err := s.feedRegistry.GetByID(r.Context(), *params.FeedUid, user.UserID)
+		if errors.Is(err, feeds.ErrFeedNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			s.internalError(w, err, "get feed")
+			return
+		}
+		sourceOverrides = feed.SourceOverrides
+	}
+
+	out, err := s.sourceRegistry.FindByUID(r.Context(), typedUID)
+	if err != nil {
+		s.internalError(w, err, fmt.Sprintf("find source by UID: %s", typedUID.String()))
+		return
+	}
+
+	source, err := serializeSource(out, sourceOverrides, s.imageProxy)
+	if err != nil {
+		s.internalError(w, err, "serialize source")
+		return
+	}
+	source.Stats = serializeSourceStats(s.sourceStats(r.Context()), source.Uid)
+
+	s.serializeRes(w, source)
+}
+
+// sourceStats returns the stats by source UID.
+// Stats are optional, so the failures are only logged.
+func (s *Server) sourceStats(ctx context.Context) map[string]sources.SourceStats {
+	stats, err := s.sourceScheduler.SourceStats(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to get source stats")
+		return nil
+	}
+	return stats
+}
+
+func (s *Server) CreateOwnFeed(w http.ResponseWriter, r *http.Request, params CreateOwnFeedParams) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = strings.TrimSpace(*params.IdempotencyKey)
+	}
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		s.badRequest(w, fmt.Errorf("idempotency key exceeds %d characters", maxIdempotencyKeyLength), "validate idempotency key")
+		return
+	}
+
+	var req CreateFeedRequest
+	err = deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	sourceUIDs, err := deserializeSourceUIDs(req.SourceUids)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source UIDs")
+		return
+	}
+
+	sourceWeights, err := deserializeSourceWeights(req.SourceWeights, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source weights")
+		return
+	}
+
+	sourceOverrides, err := deserializeSourceOverrides(req.SourceOverrides, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source overrides")
+		return
+	}
+
+	sourceFilters, err := deserializeSourceFilters(req.SourceFilters, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source filters")
+		return
+	}
+
+	sections, err := deserializeFeedSections(req.Sections, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize feed sections")
+		return
+	}
+
+	createReq := feeds.CreateRequest{
+		Name:            req.Name,
+		Icon:            req.Icon,
+		Query:           req.Query,
+		SourceUIDs:      sourceUIDs,
+		SourceWeights:   sourceWeights,
+		SourceOverrides: sourceOverrides,
+		SourceFilters:   sourceFilters,
+		MinQualityScore: deserializeMinQualityScore(req.MinQualityScore),
+		Sections:        sections,
+		Language:        deserializeLanguage(req.Language),
+		UserID:          user.UserID,
+	}
+
+	if idempotencyKey != "" {
+		// Reserve the key before creating the feed, so that concurrent retries can't both create a feed.
+		now := time.Now()
+		reserved, err := s.idempotencyStore.Reserve(r.Context(), user.UserID, idempotencyKey, now, now.Add(-s.config.IdempotencyKeyTTL), now.Add(-idempotencyKeyReservationTimeout))
+		if err != nil {
+			s.internalError(w, err, "reserve idempotency key")
+			return
+		}
+		if !reserved {
+			s.writeIdempotentFeed(w, r, user.UserID, idempotencyKey)
+			return
+		}
+	}
+
+	createdFeed, err := s.feedRegistry.Create(r.Context(), createReq)
+	if err != nil && idempotencyKey != "" {
+		// Allow the client to retry with the same key.
+		if err := s.idempotencyStore.Release(context.WithoutCancel(r.Context()), user.UserID, idempotencyKey); err != nil {
+			s.logger.Error().Err(err).Msg("release idempotency key")
+		}
+	}
+	if errors.Is(err, feeds.ErrTooManySources) || errors.Is(err, feeds.ErrQueryTooLong) || errors.Is(err, custom.ErrNotSourceOwner) {
+		s.badRequest(w, err, "create feed")
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "create feed")
+		return
+	}
+
+	if idempotencyKey != "" {
+		s.completeIdempotencyKey(r.Context(), user.UserID, idempotencyKey, createdFeed.ID)
+	}
+
+	s.serializeRes(w, serializeFeed(createdFeed))
+}
+
+// writeIdempotentFeed responds with the feed previously created with the idempotency key.
+func (s *Server) writeIdempotentFeed(w http.ResponseWriter, r *http.Request, userID string, key string) {
+	feedID, err := s.idempotencyStore.Find(r.Context(), userID, key, time.Now().Add(-s.config.IdempotencyKeyTTL))
+	if err != nil {
+		s.internalError(w, err, "find idempotency key")
+		return
+	}
+	if feedID == "" {
+		http.Error(w, "a request with the same idempotency key is in progress", http.StatusConflict)
+		return
+	}
+
+	feed, err := s.feedRegistry.GetByID(r.Context(), feedID, userID)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, "the feed created with the idempotency key was deleted", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "get idempotent feed")
+		return
+	}
+
+	s.serializeRes(w, serializeFeed(feed))
+}
+
+func (s *Server) completeIdempotencyKey(ctx context.Context, userID string, key string, feedID string) {
+	// The feed was already created, so only log the errors.
+	// The reservation is taken over once abandoned, so a retry could create a duplicate in the worst case.
+	if err := s.idempotencyStore.Complete(ctx, userID, key, feedID); err != nil {
+		s.logger.Error().Err(err).Str("feed_id", feedID).Msg("complete idempotency key")
+	}
+
+	if err := s.idempotencyStore.RemoveCreatedBefore(ctx, time.Now().Add(-s.config.IdempotencyKeyTTL)); err != nil {
+		s.logger.Error().Err(err).Msg("remove expired idempotency keys")
+	}
+}
+
+func (s *Server) ListFeeds(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	feedList, err := s.feedRegistry.ListByUserID(r.Context(), user.UserID)
+	if err != nil {
+		s.internalError(w, err, "list feeds")
+		return
+	}
+
+	res := serializeFeeds(feedList)
+
+	resBytes, err := json.Marshal(res)
+	if err != nil {
+		s.internalError(w, err, "serialize response")
+		return
+	}
+
+	s.serializeResWithETag(w, r, res, bytesETag(resBytes))
+}
+
+func (s *Server) UpdateOwnFeed(w http.ResponseWriter, r *http.Request, uid string) {
+	var req UpdateFeedRequest
+	err := deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	sourceUIDs, err := deserializeSourceUIDs(req.SourceUids)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source UIDs")
+		return
+	}
+
+	sourceWeights, err := deserializeSourceWeights(req.SourceWeights, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source weights")
+		return
+	}
+
+	sourceOverrides, err := deserializeSourceOverrides(req.SourceOverrides, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source overrides")
+		return
+	}
+
+	sourceFilters, err := deserializeSourceFilters(req.SourceFilters, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize source filters")
+		return
+	}
+
+	sections, err := deserializeFeedSections(req.Sections, sourceUIDs)
+	if err != nil {
+		s.badRequest(w, err, "deserialize feed sections")
+		return
+	}
+
+	updatedFeed, err := s.feedRegistry.Update(r.Context(), feeds.UpdateRequest{
+		ID:              uid,
+		UserID:          user.UserID,
+		Name:            req.Name,
+		Icon:            req.Icon,
+		Query:           req.Query,
+		SourceUIDs:      sourceUIDs,
+		SourceWeights:   sourceWeights,
+		SourceOverrides: sourceOverrides,
+		SourceFilters:   sourceFilters,
+		MinQualityScore: deserializeMinQualityScore(req.MinQualityScore),
+		Sections:        sections,
+		Language:        deserializeLanguage(req.Language),
+	})
+	if errors.Is(err, feeds.ErrTooManySources) || errors.Is(err, feeds.ErrQueryTooLong) || errors.Is(err, custom.ErrNotSourceOwner) {
+		s.badRequest(w, err, "update feed")
+		return
+	}
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "update feed")
+		return
+	}
+
+	s.serializeRes(w, serializeFeed(updatedFeed))
+}
+
+func (s *Server) PauseOwnFeed(w http.ResponseWriter, r *http.Request, uid string) {
+	var req PauseFeedRequest
+	err := deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
+		return
+	}
+
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	updatedFeed, err := s.feedRegistry.SetPaused(r.Context(), uid, user.UserID, req.Paused)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "pause feed")
+		return
+	}
+
+	s.serializeRes(w, serializeFeed(updatedFeed))
+}
+
+func (s *Server) SetFeedOrder(w http.ResponseWriter, r *http.Request) {
+	var req FeedOrderRequest
+	err := deserializeReq(r, &req)
+	if err != nil {
+		s.badRequest(w, err, "deserialize request")
 		return
 	}
 
-	out, err := s.sourceRegistry.FindByUID(r.Context(), typedUID)
+	user, err := auth.UserFromContext(r.Context())
 	if err != nil {
-		s.internalError(w, err, fmt.Sprintf("find source by UID: %s", typedUID.String()))
+		s.internalError(w, err, "get user from context")
 		return
 	}
 
-	source, err := serializeSource(out)
+	orderedFeeds, err := s.feedRegistry.SetOrder(r.Context(), user.UserID, req.FeedUids)
+	if errors.Is(err, feeds.ErrInvalidFeedOrder) {
+		s.badRequest(w, err, "set feed order")
+		return
+	}
 	if err != nil {
-		s.internalError(w, err, "serialize source")
+		s.internalError(w, err, "set feed order")
 		return
 	}
 
-	s.serializeRes(w, source)
+	s.serializeRes(w, serializeFeeds(orderedFeeds))
 }
 
-func (s *Server) CreateOwnFeed(w http.ResponseWriter, r *http.Request) {
+func (s *Server) MarkFeedRead(w http.ResponseWriter, r *http.Request, uid string) {
+	// The request body is optional
+	var req MarkFeedReadRequest
+	if r.ContentLength != 0 {
+		err := deserializeReq(r, &req)
+		if err != nil {
+			s.badRequest(w, err, "deserialize request")
+			return
+		}
+	}
+
 	user, err := auth.UserFromContext(r.Context())
 	if err != nil {
 		s.internalError(w, err, "get user from context")
 		return
 	}
 
-	var req CreateFeedRequest
-	err = deserializeReq(r, &req)
-	if err != nil {
-		s.badRequest(w, err, "deserialize request")
-		return
+	before := time.Now()
+	if req.Before != nil {
+		before = *req.Before
 	}
 
-	sourceUIDs, err := deserializeSourceUIDs(req.SourceUids)
+	marked, err := s.feedRegistry.MarkAllRead(r.Context(), uid, user.UserID, before)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
-		s.badRequest(w, err, "deserialize source UIDs")
+		s.internalError(w, err, "mark feed as read")
 		return
 	}
 
-	createReq := feeds.CreateRequest{
-		Name:       req.Name,
-		Icon:       req.Icon,
-		Query:      req.Query,
-		SourceUIDs: sourceUIDs,
-		UserID:     user.UserID,
-	}
+	s.serializeRes(w, MarkFeedReadResponse{Marked: marked})
+}
 
-	createdFeed, err := s.feedRegistry.Create(r.Context(), createReq)
+func (s *Server) SetActivityFeedback(w http.ResponseWriter, r *http.Request, uid string) {
+	var req ActivityFeedbackRequest
+	err := deserializeReq(r, &req)
 	if err != nil {
-		s.internalError(w, err, "create feed")
+		s.badRequest(w, err, "deserialize request")
 		return
 	}
 
-	s.serializeRes(w, serializeFeed(createdFeed))
-}
+	activityUID, err := lib.NewTypedUIDFromString(uid)
+	if err != nil {
+		s.badRequest(w, err, "deserialize activity uid")
+		return
+	}
 
-func (s *Server) ListFeeds(w http.ResponseWriter, r *http.Request) {
 	user, err := auth.UserFromContext(r.Context())
 	if err != nil {
 		s.internalError(w, err, "get user from context")
 		return
 	}
 
-	feedList, err := s.feedRegistry.ListByUserID(r.Context(), user.UserID)
+	err = s.feedRegistry.SetActivityFeedback(r.Context(), user.UserID, activityUID, deserializeActivityFeedback(req.Feedback))
+	if errors.Is(err, feeds.ErrActivityNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
-		s.internalError(w, err, "list feeds")
+		s.internalError(w, err, "set activity feedback")
 		return
 	}
 
-	s.serializeRes(w, serializeFeeds(feedList))
+	w.WriteHeader(http.StatusNoContent)
 }
 
-func (s *Server) UpdateOwnFeed(w http.ResponseWriter, r *http.Request, uid string) {
-	var req UpdateFeedRequest
-	err := deserializeReq(r, &req)
+func (s *Server) ListRelatedActivities(w http.ResponseWriter, r *http.Request, uid string, params ListRelatedActivitiesParams) {
+	activityUID, err := lib.NewTypedUIDFromString(uid)
 	if err != nil {
-		s.badRequest(w, err, "deserialize request")
+		s.badRequest(w, err, "deserialize activity uid")
+		return
+	}
+
+	limit := 10
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 50 {
+		s.badRequest(w, fmt.Errorf("limit must be between 1 and 50"), "validate limit")
 		return
 	}
 
@@ -334,25 +1270,34 @@ func (s *Server) UpdateOwnFeed(w http.ResponseWriter, r *http.Request, uid strin
 		return
 	}
 
-	sourceUIDs, err := deserializeSourceUIDs(req.SourceUids)
+	related, err := s.feedRegistry.RelatedActivities(r.Context(), user.UserID, activityUID, limit)
+	if errors.Is(err, feeds.ErrActivityNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
-		s.badRequest(w, err, "deserialize source UIDs")
+		s.internalError(w, err, "list related activities")
 		return
 	}
-	updatedFeed, err := s.feedRegistry.Update(r.Context(), feeds.UpdateRequest{
-		ID:         uid,
-		UserID:     user.UserID,
-		Name:       req.Name,
-		Icon:       req.Icon,
-		Query:      req.Query,
-		SourceUIDs: sourceUIDs,
-	})
+
+	results, err := serializeActivities(r.Context(), related, s.imageProxy, nil)
 	if err != nil {
-		s.internalError(w, err, "update feed")
+		s.internalError(w, err, "serialize activities")
 		return
 	}
 
-	s.serializeRes(w, serializeFeed(updatedFeed))
+	s.serializeRes(w, RelatedActivitiesResponse{Results: *results})
+}
+
+func deserializeActivityFeedback(in ActivityFeedback) feeds.FeedbackValue {
+	switch in {
+	case More:
+		return feeds.FeedbackMore
+	case Less:
+		return feeds.FeedbackLess
+	default:
+		return feeds.FeedbackNone
+	}
 }
 
 func (s *Server) DeleteOwnFeed(w http.ResponseWriter, r *http.Request, uid string) {
@@ -363,6 +1308,10 @@ func (s *Server) DeleteOwnFeed(w http.ResponseWriter, r *http.Request, uid strin
 	}
 
 	err = s.feedRegistry.Remove(r.Context(), uid, user.UserID)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		s.internalError(w, err, "delete feed")
 		return
@@ -371,6 +1320,26 @@ func (s *Server) DeleteOwnFeed(w http.ResponseWriter, r *http.Request, uid strin
 	s.serializeRes(w, map[string]string{"message": "Feed deleted successfully"})
 }
 
+func (s *Server) RestoreOwnFeed(w http.ResponseWriter, r *http.Request, uid string) {
+	user, err := auth.UserFromContext(r.Context())
+	if err != nil {
+		s.internalError(w, err, "get user from context")
+		return
+	}
+
+	restoredFeed, err := s.feedRegistry.Restore(r.Context(), uid, user.UserID)
+	if errors.Is(err, feeds.ErrFeedNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.internalError(w, err, "restore feed")
+		return
+	}
+
+	s.serializeRes(w, serializeFeed(restoredFeed))
+}
+
 func deserializeReq[Req any](r *http.Request, req *Req) error {
 	contentType := r.Header.Get("Content-Type")
 	if contentType != "application/json" {
@@ -387,30 +1356,90 @@ func deserializeReq[Req any](r *http.Request, req *Req) error {
 		return fmt.Errorf("deserialize request body: %w", err)
 	}
 
+	// The validation rules are defined in the OpenAPI spec (see x-oapi-codegen-extra-tags).
+	err = lib.ValidateStruct(req)
+	if err != nil {
+		return fmt.Errorf("validate request body: %w", err)
+	}
+
 	return nil
 }
 
+// serializeRes writes the response in the encoding negotiated by encodingMiddleware (JSON by default).
 func (s *Server) serializeRes(w http.ResponseWriter, res any) {
-	w.Header().Add("Content-Type", "application/json")
-
 	if res == nil {
+		w.Header().Add("Content-Type", string(responseEncodingOf(w)))
 		w.WriteHeader(http.StatusOK)
 		return
 	}
 
-	err := json.NewEncoder(w).Encode(res)
+	err := writeEncoded(w, http.StatusOK, res)
 	if err != nil {
 		s.internalError(w, err, "serialize response")
 	}
 }
 
+// serializeResWithETag responds with 304 Not Modified if the request's If-None-Match header matches the ETag.
+// Falls back to serializeRes if ETags are disabled.
+func (s *Server) serializeResWithETag(w http.ResponseWriter, r *http.Request, res any, etag string) {
+	if !s.config.ETagEnabled || etag == "" {
+		s.serializeRes(w, res)
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	s.serializeRes(w, res)
+}
+
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func bytesETag(in []byte) string {
+	return fmt.Sprintf(`"%x"`, sha256.Sum256(in))
+}
+
+// activitiesETag is derived from the activities version (see feeds.Registry.ActivitiesVersion) and the request params,
+// so that it changes when the feed is edited, or when the activities of its sources or the user's read state change.
+func activitiesETag(r *http.Request, version string) string {
+	// Encode sorts the params, so that the same request yields the same ETag regardless of the param order.
+	return bytesETag(fmt.Appendf(nil, "%s:%s", version, r.URL.Query().Encode()))
+}
+
 func (s *Server) internalError(w http.ResponseWriter, err error, msg string) {
 	s.logger.Err(err).Msg(msg)
 	http.Error(w, err.Error(), http.StatusInternalServerError)
 }
 
+func (s *Server) badGateway(w http.ResponseWriter, err error, msg string) {
+	s.logger.Err(err).Msg(msg)
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
+
 func (s *Server) badRequest(w http.ResponseWriter, err error, msg string) {
 	s.logger.Err(err).Msg(msg)
+
+	var validationErrs lib.ValidationErrors
+	if errors.As(err, &validationErrs) && len(validationErrs.Fields) > 0 {
+		_ = writeEncoded(w, http.StatusBadRequest, RequestValidationError{
+			Errors: serializeValidationErrors(validationErrs),
+		})
+		return
+	}
+
 	http.Error(w, err.Error(), http.StatusBadRequest)
 }
 
@@ -423,16 +1452,76 @@ func serializeFeeds(in []*feeds.Feed) []Feed {
 }
 
 func serializeFeed(in *feeds.Feed) Feed {
+	var sourceWeights *map[string]float64
+	if len(in.SourceWeights) > 0 {
+		sourceWeights = &in.SourceWeights
+	}
+
+	var sourceOverrides *map[string]SourceOverride
+	if len(in.SourceOverrides) > 0 {
+		out := make(map[string]SourceOverride, len(in.SourceOverrides))
+		for uid, override := range in.SourceOverrides {
+			var serialized SourceOverride
+			if override.DisplayName != "" {
+				serialized.DisplayName = &override.DisplayName
+			}
+			if override.IconOverride != "" {
+				serialized.IconUrl = &override.IconOverride
+			}
+			out[uid] = serialized
+		}
+		sourceOverrides = &out
+	}
+
+	var sourceFilters *map[string]SourceFilter
+	if len(in.SourceFilters) > 0 {
+		out := make(map[string]SourceFilter, len(in.SourceFilters))
+		for uid, filter := range in.SourceFilters {
+			var serialized SourceFilter
+			if len(filter.IncludeKeywords) > 0 {
+				serialized.IncludeKeywords = &filter.IncludeKeywords
+			}
+			if len(filter.ExcludeKeywords) > 0 {
+				serialized.ExcludeKeywords = &filter.ExcludeKeywords
+			}
+			out[uid] = serialized
+		}
+		sourceFilters = &out
+	}
+
 	return Feed{
-		Uid:        in.ID,
-		Name:       in.Name,
-		Icon:       in.Icon,
-		Query:      in.Query,
-		IsPublic:   in.Public,
-		CreatedBy:  in.UserID,
-		CreatedAt:  in.CreatedAt,
-		SourceUids: serializeSourceUIDs(in.SourceUIDs),
+		Uid:             in.ID,
+		Name:            in.Name,
+		Icon:            in.Icon,
+		Query:           in.Query,
+		IsPublic:        in.Public,
+		IsPaused:        in.Paused,
+		CreatedBy:       in.UserID,
+		CreatedAt:       in.CreatedAt,
+		SourceUids:      serializeSourceUIDs(in.SourceUIDs),
+		SourceWeights:   sourceWeights,
+		SourceOverrides: sourceOverrides,
+		SourceFilters:   sourceFilters,
+		MinQualityScore: &in.MinQualityScore,
+		Sections:        serializeFeedSections(in.Sections),
+		Language:        &in.Language,
+	}
+}
+
+func serializeFeedSections(in []feeds.FeedSection) *[]FeedSection {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]FeedSection, len(in))
+	for i, section := range in {
+		out[i] = FeedSection{
+			Name:       section.Name,
+			Icon:       section.Icon,
+			SourceUids: serializeSourceUIDs(section.SourceUIDs),
+		}
 	}
+	return &out
 }
 
 func serializeSourceUIDs(in []activitytypes.TypedUID) []string {
@@ -443,11 +1532,21 @@ func serializeSourceUIDs(in []activitytypes.TypedUID) []string {
 	return out
 }
 
-func serializeActivities(in []*activitytypes.DecoratedActivity) (*[]Activity, error) {
-	out := make([]Activity, 0, len(in))
+func serializeActivities(
+	ctx context.Context,
+	in []*activitytypes.DecoratedActivity,
+	imageProxy imageProxy,
+	sourceOverrides map[string]feeds.SourceOverride,
+) (*[]Activity, error) {
+	imageURLs := make([]string, 0, len(in))
+	for _, e := range in {
+		imageURLs = append(imageURLs, e.Activity.ImageURL())
+	}
+	lib.ValidateImageURLs(ctx, imageURLs)
 
+	out := make([]Activity, 0, len(in))
 	for _, e := range in {
-		activity, err := serializeActivity(e)
+		activity, err := serializeActivity(ctx, e, imageProxy, sourceOverrides)
 		if err != nil {
 			return nil, fmt.Errorf("serialize activity: %w", err)
 		}
@@ -467,18 +1566,26 @@ func serializeTopics(in []*feeds.Topic) (*[]ActivityTopic, error) {
 			queries = []string{}
 		}
 		out = append(out, ActivityTopic{
-			Title:       topic.Title,
-			Emoji:       topic.Emoji,
-			Summary:     topic.Summary,
-			Queries:     queries,
-			ActivityIds: topic.ActivityIDs,
+			Title:         topic.Title,
+			Emoji:         topic.Emoji,
+			Summary:       topic.Summary,
+			Queries:       queries,
+			ActivityIds:   topic.ActivityIDs,
+			ActivityCount: len(topic.ActivityIDs),
 		})
 	}
 
 	return &out, nil
 }
 
-func serializeActivity(in *activitytypes.DecoratedActivity) (*Activity, error) {
+// serializeActivity drops the invalid images (see lib.ValidImageURL), so that they don't break the UI cards.
+// The source overrides of the feed the activity is rendered in are optional.
+func serializeActivity(
+	ctx context.Context,
+	in *activitytypes.DecoratedActivity,
+	imageProxy imageProxy,
+	sourceOverrides map[string]feeds.SourceOverride,
+) (*Activity, error) {
 	sourceUIDs := in.Activity.SourceUIDs()
 
 	// Assume all sources are of the same type.
@@ -492,18 +1599,50 @@ func serializeActivity(in *activitytypes.DecoratedActivity) (*Activity, error) {
 		return nil, fmt.Errorf("serialize source type: %w", err)
 	}
 
+	sources := make([]ActivitySource, 0, len(sourceUIDs))
+	for _, uid := range sourceUIDs {
+		uidSourceType, err := serializeSourceType(uid.Type())
+		if err != nil {
+			return nil, fmt.Errorf("serialize source type: %w", err)
+		}
+		source := ActivitySource{
+			Uid:  uid.String(),
+			Type: uidSourceType,
+		}
+		if override, ok := sourceOverrides[uid.String()]; ok {
+			if override.DisplayName != "" {
+				source.DisplayName = &override.DisplayName
+			}
+			if override.IconOverride != "" {
+				iconURL := imageProxy.proxiedURL(override.IconOverride)
+				source.IconUrl = &iconURL
+			}
+		}
+		sources = append(sources, source)
+	}
+
+	var updatedAt *time.Time
+	if updated, ok := in.Activity.(activitytypes.UpdatedActivity); ok {
+		if t := updated.UpdatedAt(); !t.IsZero() {
+			updatedAt = &t
+		}
+	}
+
 	return &Activity{
 		Body:               in.Activity.Body(),
 		CreatedAt:          in.Activity.CreatedAt(),
-		ImageUrl:           in.Activity.ImageURL(),
+		UpdatedAt:          updatedAt,
+		ImageUrl:           imageProxy.proxiedURL(lib.ValidImageURL(ctx, in.Activity.ImageURL())),
 		FullSummary:        in.Summary.FullSummary,
 		ShortSummary:       in.Summary.ShortSummary,
 		SourceUids:         serializeSourceUIDs(sourceUIDs),
 		SourceType:         sourceType,
+		Sources:            sources,
 		Title:              in.Activity.Title(),
 		Uid:                in.Activity.UID().String(),
 		Url:                in.Activity.URL(),
 		Similarity:         &in.Similarity,
+		QualityScore:       &in.QualityScore,
 		UpvotesCount:       in.Activity.UpvotesCount(),
 		CommentsCount:      in.Activity.CommentsCount(),
 		AmplificationCount: in.Activity.AmplificationCount(),
@@ -514,7 +1653,7 @@ func serializeSources(in []sourcetypes.Source) ([]Source, error) {
 	out := make([]Source, 0, len(in))
 
 	for _, e := range in {
-		source, err := serializeSource(e)
+		source, err := serializeSource(e, nil, imageProxy{})
 		if err != nil {
 			return nil, fmt.Errorf("serialize source: %w", err)
 		}
@@ -524,7 +1663,9 @@ func serializeSources(in []sourcetypes.Source) ([]Source, error) {
 	return out, nil
 }
 
-func serializeSource(in sourcetypes.Source) (Source, error) {
+// serializeSource applies the source overrides of the feed the source is rendered in, if any.
+// The overridden icons are user provided, so they're served through the image proxy (if configured).
+func serializeSource(in sourcetypes.Source, sourceOverrides map[string]feeds.SourceOverride, imageProxy imageProxy) (Source, error) {
 	sourceType, err := serializeSourceType(in.UID().Type())
 	if err != nil {
 		return Source{}, fmt.Errorf("serialize source type: %w", err)
@@ -536,7 +1677,7 @@ func serializeSource(in sourcetypes.Source) (Source, error) {
 		apiTags = append(apiTags, TopicTag(t))
 	}
 
-	return Source{
+	out := Source{
 		Uid:         in.UID().String(),
 		Type:        sourceType,
 		Url:         in.URL(),
@@ -544,7 +1685,45 @@ func serializeSource(in sourcetypes.Source) (Source, error) {
 		Name:        in.Name(),
 		Description: in.Description(),
 		TopicTags:   apiTags,
-	}, nil
+	}
+	if override, ok := sourceOverrides[out.Uid]; ok {
+		if override.DisplayName != "" {
+			out.Name = override.DisplayName
+		}
+		if override.IconOverride != "" {
+			out.IconUrl = imageProxy.proxiedURL(override.IconOverride)
+		}
+	}
+
+	return out, nil
+}
+
+func serializeSourceStats(stats map[string]sources.SourceStats, uid string) *SourceStats {
+	if stats == nil {
+		return nil
+	}
+
+	in := stats[uid]
+	out := &SourceStats{
+		ActivityCount: in.ActivityCount,
+	}
+	if !in.LastPolledAt.IsZero() {
+		out.LastPolledAt = &in.LastPolledAt
+	}
+	if !in.LastSucceededAt.IsZero() {
+		out.LastSucceededAt = &in.LastSucceededAt
+	}
+	if in.DisabledReason != "" {
+		out.DisabledReason = &in.DisabledReason
+	}
+	if !in.LastNewActivityAt.IsZero() {
+		out.LastNewActivityAt = &in.LastNewActivityAt
+	}
+	if !in.StaleSince.IsZero() {
+		out.StaleSince = &in.StaleSince
+	}
+
+	return out
 }
 
 func serializeSourceType(in string) (SourceType, error) {
@@ -553,6 +1732,8 @@ func serializeSourceType(in string) (SourceType, error) {
 		return MastodonAccount, nil
 	case mastodon.TypeMastodonTag:
 		return MastodonTag, nil
+	case mastodon.TypeMastodonTrending:
+		return MastodonTrending, nil
 	case hackernews.TypeHackerNewsPosts:
 		return HackernewsPosts, nil
 	case reddit.TypeRedditSubreddit:
@@ -561,6 +1742,8 @@ func serializeSourceType(in string) (SourceType, error) {
 		return LobstersTag, nil
 	case lobsters.TypeLobstersFeed:
 		return LobstersFeed, nil
+	case lobsters.TypeLobstersComments:
+		return LobstersComments, nil
 	case rss.TypeRSSFeed:
 		return RssFeed, nil
 	case github.TypeGithubReleases:
@@ -571,6 +1754,10 @@ func serializeSourceType(in string) (SourceType, error) {
 		return GithubTopics, nil
 	case producthunt.TypeProductHuntPosts:
 		return ProductHuntPosts, nil
+	case custom.TypeCustom:
+		return Custom, nil
+	case jsonapi.TypeJSONAPIEndpoint:
+		return JsonApiEndpoint, nil
 		// Note: temporarily removed in commit a8c728a86cefadd20f67a424363dc6f61c41cf66
 		// case changedetection.TypeChangedetectionWebsite:
 		// return ChangedetectionWebsite, nil
@@ -579,6 +1766,82 @@ func serializeSourceType(in string) (SourceType, error) {
 	return "", fmt.Errorf("unknown source type: %s", in)
 }
 
+func deserializeSourceType(in SourceType) (string, error) {
+	switch in {
+	case MastodonAccount:
+		return mastodon.TypeMastodonAccount, nil
+	case MastodonTag:
+		return mastodon.TypeMastodonTag, nil
+	case MastodonTrending:
+		return mastodon.TypeMastodonTrending, nil
+	case HackernewsPosts:
+		return hackernews.TypeHackerNewsPosts, nil
+	case RedditSubreddit:
+		return reddit.TypeRedditSubreddit, nil
+	case LobstersTag:
+		return lobsters.TypeLobstersTag, nil
+	case LobstersFeed:
+		return lobsters.TypeLobstersFeed, nil
+	case LobstersComments:
+		return lobsters.TypeLobstersComments, nil
+	case RssFeed:
+		return rss.TypeRSSFeed, nil
+	case GithubReleases:
+		return github.TypeGithubReleases, nil
+	case GithubIssues:
+		return github.TypeGithubIssues, nil
+	case GithubTopics:
+		return github.TypeGithubTopic, nil
+	case ProductHuntPosts:
+		return producthunt.TypeProductHuntPosts, nil
+	case Custom:
+		return custom.TypeCustom, nil
+	case JsonApiEndpoint:
+		return jsonapi.TypeJSONAPIEndpoint, nil
+	}
+
+	return "", fmt.Errorf("unknown source type: %s", in)
+}
+
+func serializeSourceValidationResult(err error) SourceValidationResult {
+	if err == nil {
+		return SourceValidationResult{
+			Valid:  true,
+			Errors: []SourceValidationError{},
+		}
+	}
+
+	var validationErrs lib.ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs.Fields) == 0 {
+		return SourceValidationResult{
+			Valid:  false,
+			Errors: []SourceValidationError{{Message: err.Error()}},
+		}
+	}
+
+	return SourceValidationResult{
+		Valid:  false,
+		Errors: serializeValidationErrors(validationErrs),
+	}
+}
+
+func serializeValidationErrors(validationErrs lib.ValidationErrors) []SourceValidationError {
+	out := make([]SourceValidationError, 0, len(validationErrs.Fields))
+	for _, f := range validationErrs.Fields {
+		message := fmt.Sprintf("%s failed on the '%s' rule", f.Field, f.Rule)
+		if f.Param != "" {
+			message = fmt.Sprintf("%s failed on the '%s=%s' rule", f.Field, f.Rule, f.Param)
+		}
+		out = append(out, SourceValidationError{
+			Field:   &f.Field,
+			Rule:    &f.Rule,
+			Message: message,
+		})
+	}
+
+	return out
+}
+
 func deserializeTopicTags(in []TopicTag) ([]sourcetypes.TopicTag, error) {
 	out := make([]sourcetypes.TopicTag, len(in))
 	for i, t := range in {
@@ -648,6 +1911,133 @@ func deserializeSourceUIDs(in []string) ([]activitytypes.TypedUID, error) {
 	return out, nil
 }
 
+func deserializeMinQualityScore(in *float64) float64 {
+	if in == nil {
+		return 0
+	}
+	return *in
+}
+
+func deserializeLanguage(in *string) string {
+	if in == nil {
+		return ""
+	}
+	return *in
+}
+
+func deserializeSourceWeights(in *map[string]float64, sourceUIDs []activitytypes.TypedUID) (map[string]float64, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	known := make(map[string]bool, len(sourceUIDs))
+	for _, uid := range sourceUIDs {
+		known[uid.String()] = true
+	}
+
+	out := make(map[string]float64, len(*in))
+	for uid, weight := range *in {
+		if !known[uid] {
+			return nil, fmt.Errorf("weight for unknown source: %s", uid)
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight for source %s must be positive", uid)
+		}
+		out[uid] = weight
+	}
+	return out, nil
+}
+
+func deserializeSourceOverrides(in *map[string]SourceOverride, sourceUIDs []activitytypes.TypedUID) (map[string]feeds.SourceOverride, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	known := make(map[string]bool, len(sourceUIDs))
+	for _, uid := range sourceUIDs {
+		known[uid.String()] = true
+	}
+
+	out := make(map[string]feeds.SourceOverride, len(*in))
+	for uid, override := range *in {
+		if !known[uid] {
+			return nil, fmt.Errorf("override for unknown source: %s", uid)
+		}
+		var result feeds.SourceOverride
+		if override.DisplayName != nil {
+			result.DisplayName = *override.DisplayName
+		}
+		if override.IconUrl != nil {
+			iconURL, err := neturl.Parse(*override.IconUrl)
+			if err != nil || (iconURL.Scheme != "http" && iconURL.Scheme != "https") || iconURL.Host == "" {
+				return nil, fmt.Errorf("invalid icon URL for source: %s", uid)
+			}
+			result.IconOverride = *override.IconUrl
+		}
+		out[uid] = result
+	}
+	return out, nil
+}
+
+func deserializeSourceFilters(in *map[string]SourceFilter, sourceUIDs []activitytypes.TypedUID) (map[string]activitytypes.KeywordFilter, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	known := make(map[string]bool, len(sourceUIDs))
+	for _, uid := range sourceUIDs {
+		known[uid.String()] = true
+	}
+
+	out := make(map[string]activitytypes.KeywordFilter, len(*in))
+	for uid, filter := range *in {
+		if !known[uid] {
+			return nil, fmt.Errorf("filter for unknown source: %s", uid)
+		}
+		var result activitytypes.KeywordFilter
+		if filter.IncludeKeywords != nil {
+			result.IncludeKeywords = *filter.IncludeKeywords
+		}
+		if filter.ExcludeKeywords != nil {
+			result.ExcludeKeywords = *filter.ExcludeKeywords
+		}
+		out[uid] = result
+	}
+	return out, nil
+}
+
+func deserializeFeedSections(in *[]FeedSection, sourceUIDs []activitytypes.TypedUID) ([]feeds.FeedSection, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	known := make(map[string]bool, len(sourceUIDs))
+	for _, uid := range sourceUIDs {
+		known[uid.String()] = true
+	}
+
+	out := make([]feeds.FeedSection, len(*in))
+	for i, section := range *in {
+		for _, uid := range section.SourceUids {
+			if !known[uid] {
+				return nil, fmt.Errorf("section %q has unknown source: %s", section.Name, uid)
+			}
+		}
+
+		sectionSourceUIDs, err := deserializeSourceUIDs(section.SourceUids)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = feeds.FeedSection{
+			Name:       section.Name,
+			Icon:       section.Icon,
+			SourceUIDs: sectionSourceUIDs,
+		}
+	}
+	return out, nil
+}
+
 // TODO(social-feed-ranking): should we change the sort to best/new or remove it entirely?
 func deserializeSortBy(in *ActivitySortBy) (activitytypes.SortBy, error) {
 	if in == nil {
@@ -664,6 +2054,50 @@ func deserializeSortBy(in *ActivitySortBy) (activitytypes.SortBy, error) {
 	return "", fmt.Errorf("unknown sort by: %s", *in)
 }
 
+// deserializeSortWeights returns nil if none of the weights are set, so that the defaults are used.
+func deserializeSortWeights(similarity, socialScore, recency *float64) (*activities.SortWeights, error) {
+	if similarity == nil && socialScore == nil && recency == nil {
+		return nil, nil
+	}
+
+	weights := &activities.SortWeights{
+		Similarity:  similarity,
+		SocialScore: socialScore,
+		Recency:     recency,
+	}
+	if err := weights.Validate(); err != nil {
+		return nil, err
+	}
+	return weights, nil
+}
+
+func deserializeCalendar(timezone *string, weekStart *WeekStart) (activitytypes.Calendar, error) {
+	calendar := activitytypes.DefaultCalendar()
+
+	if timezone != nil && *timezone != "" {
+		loc, err := time.LoadLocation(*timezone)
+		if err != nil {
+			return calendar, fmt.Errorf("unknown timezone: %s", *timezone)
+		}
+		calendar.Location = loc
+	}
+
+	if weekStart != nil {
+		switch *weekStart {
+		case Monday:
+			calendar.WeekStart = time.Monday
+		case Sunday:
+			calendar.WeekStart = time.Sunday
+		case Saturday:
+			calendar.WeekStart = time.Saturday
+		default:
+			return calendar, fmt.Errorf("unknown week start: %s", *weekStart)
+		}
+	}
+
+	return calendar, nil
+}
+
 func deserializePeriod(in *ActivityPeriod) activitytypes.Period {
 	if in == nil {
 		return activitytypes.PeriodAll
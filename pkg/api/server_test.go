@@ -0,0 +1,321 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/api/auth"
+	"github.com/defeedco/defeed/pkg/feeds"
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/rs/zerolog"
+)
+
+// testActivity is a minimal activity seen in the given sources.
+type testActivity struct {
+	sources []activitytypes.TypedUID
+}
+
+func (a *testActivity) MarshalJSON() ([]byte, error)         { return []byte("{}"), nil }
+func (a *testActivity) UnmarshalJSON([]byte) error           { return nil }
+func (a *testActivity) UID() activitytypes.TypedUID          { return lib.NewTypedUID("test", "activity") }
+func (a *testActivity) SourceUIDs() []activitytypes.TypedUID { return a.sources }
+func (a *testActivity) Title() string                        { return "" }
+func (a *testActivity) Body() string                         { return "" }
+func (a *testActivity) URL() string                          { return "" }
+func (a *testActivity) ImageURL() string                     { return "" }
+func (a *testActivity) CreatedAt() time.Time                 { return time.Time{} }
+func (a *testActivity) UpvotesCount() int                    { return -1 }
+func (a *testActivity) DownvotesCount() int                  { return -1 }
+func (a *testActivity) CommentsCount() int                   { return -1 }
+func (a *testActivity) AmplificationCount() int              { return -1 }
+func (a *testActivity) SocialScore() float64                 { return -1 }
+
+func TestSerializeActivity_SourceOverrides(t *testing.T) {
+	overridden := lib.NewTypedUID(hackernews.TypeHackerNewsPosts, "top")
+	canonical := lib.NewTypedUID(hackernews.TypeHackerNewsPosts, "new")
+	in := &activitytypes.DecoratedActivity{
+		Activity: &testActivity{sources: []activitytypes.TypedUID{overridden, canonical}},
+		Summary:  &activitytypes.ActivitySummary{},
+	}
+	overrides := map[string]feeds.SourceOverride{
+		overridden.String(): {DisplayName: "Top News", IconOverride: "https://example.com/icon.png"},
+	}
+
+	out, err := serializeActivity(context.Background(), in, imageProxy{url: "https://proxy.example.com/?url="}, overrides)
+	if err != nil {
+		t.Fatalf("serialize activity: %v", err)
+	}
+
+	if len(out.Sources) != 2 {
+		t.Fatalf("expected 2 sources, got %d", len(out.Sources))
+	}
+	source := out.Sources[0]
+	if source.DisplayName == nil || *source.DisplayName != "Top News" {
+		t.Errorf("expected overridden display name, got %v", source.DisplayName)
+	}
+	if source.IconUrl == nil || *source.IconUrl != "https://proxy.example.com/?url=https%3A%2F%2Fexample.com%2Ficon.png" {
+		t.Errorf("expected proxied icon, got %v", source.IconUrl)
+	}
+	if out.Sources[1].DisplayName != nil || out.Sources[1].IconUrl != nil {
+		t.Errorf("expected no overrides for the other source, got %+v", out.Sources[1])
+	}
+}
+
+func TestDeserializeSourceOverrides_IconURL(t *testing.T) {
+	uid := lib.NewTypedUID(hackernews.TypeHackerNewsPosts, "top")
+
+	tests := []struct {
+		name    string
+		iconURL string
+		wantErr bool
+	}{
+		{name: "https", iconURL: "https://example.com/icon.png"},
+		{name: "javascript", iconURL: "javascript:alert(1)", wantErr: true},
+		{name: "relative", iconURL: "/icon.png", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := map[string]SourceOverride{uid.String(): {IconUrl: &tt.iconURL}}
+			_, err := deserializeSourceOverrides(&in, []activitytypes.TypedUID{uid})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("deserializeSourceOverrides() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// feedStoreFunc returns the feed (or error) of the GetByID calls.
+type feedStoreFunc func(uid string) (*feeds.Feed, error)
+
+func (f feedStoreFunc) Upsert(context.Context, feeds.Feed) error { return nil }
+func (f feedStoreFunc) Remove(context.Context, string) error     { return nil }
+func (f feedStoreFunc) List(context.Context) ([]*feeds.Feed, error) {
+	return nil, nil
+}
+func (f feedStoreFunc) ListByOwner(context.Context, string) ([]*feeds.Feed, error) {
+	return nil, nil
+}
+func (f feedStoreFunc) GetByID(_ context.Context, uid string) (*feeds.Feed, error) {
+	return f(uid)
+}
+func (f feedStoreFunc) ListDeletedBefore(context.Context, time.Time) ([]*feeds.Feed, error) {
+	return nil, nil
+}
+func (f feedStoreFunc) FindBySourceUIDs(context.Context, []activitytypes.TypedUID) ([]*feeds.Feed, error) {
+	return nil, nil
+}
+func (f feedStoreFunc) ListPositions(context.Context, string) (map[string]int, error) {
+	return nil, nil
+}
+func (f feedStoreFunc) SetPositions(context.Context, string, []string) error { return nil }
+
+func TestGetSource_FeedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "missing feed", err: feeds.ErrFeedNotFound, wantStatus: http.StatusNotFound},
+		{name: "store error", err: errors.New("connection refused"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			store := feedStoreFunc(func(string) (*feeds.Feed, error) { return nil, tt.err })
+			server := &Server{
+				feedRegistry: feeds.NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &feeds.Config{}, &logger),
+				config:       &Config{},
+				logger:       &logger,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/sources/hackernewsposts:top", nil)
+			req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey_, auth.User{UserID: "user"}))
+			w := httptest.NewRecorder()
+			feedUID := "feed"
+			server.GetSource(w, req, "hackernewsposts:top", GetSourceParams{FeedUid: &feedUID})
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestListFeedActivities_ForeignFeed(t *testing.T) {
+	logger := zerolog.Nop()
+	store := feedStoreFunc(func(uid string) (*feeds.Feed, error) { return &feeds.Feed{ID: uid, UserID: "other"}, nil })
+	server := &Server{
+		feedRegistry: feeds.NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &feeds.Config{}, &logger),
+		config:       &Config{},
+		logger:       &logger,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/feeds/feed/activities", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey_, auth.User{UserID: "user"}))
+	w := httptest.NewRecorder()
+	server.ListFeedActivities(w, req, "feed", ListFeedActivitiesParams{})
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestListFeedActivities_PaginationParams(t *testing.T) {
+	paginate, cursor := true, "cursor"
+	creationDate, similarity := CreationDate, Similarity
+	tests := []struct {
+		name   string
+		params ListFeedActivitiesParams
+	}{
+		{name: "cursor without paginate", params: ListFeedActivitiesParams{Cursor: &cursor, SortBy: &creationDate}},
+		{name: "paginate without creation date sort", params: ListFeedActivitiesParams{Paginate: &paginate, SortBy: &similarity}},
+		{name: "paginate with query rewrite", params: ListFeedActivitiesParams{Paginate: &paginate, SortBy: &creationDate, RewriteQuery: &paginate}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			server := &Server{config: &Config{}, logger: &logger}
+
+			req := httptest.NewRequest(http.MethodGet, "/feeds/feed/activities", nil)
+			req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey_, auth.User{UserID: "user"}))
+			w := httptest.NewRecorder()
+			server.ListFeedActivities(w, req, "feed", tt.params)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
+
+func TestActivitiesETag(t *testing.T) {
+	etag := func(target string, version string) string {
+		return activitiesETag(httptest.NewRequest(http.MethodGet, target, nil), version)
+	}
+
+	want := etag("/feeds/feed/activities?limit=2&period=week", "v1")
+	if got := etag("/feeds/feed/activities?period=week&limit=2", "v1"); got != want {
+		t.Errorf("expected the same ETag regardless of the param order, got %s and %s", want, got)
+	}
+	if got := etag("/feeds/feed/activities?limit=2&period=month", "v1"); got == want {
+		t.Error("expected a different ETag for different params")
+	}
+	if got := etag("/feeds/feed/activities?limit=2&period=week", "v2"); got == want {
+		t.Error("expected a different ETag when the activities version changes")
+	}
+}
+
+func TestProxyImage_Signature(t *testing.T) {
+	// Signed URLs pass the signature check, but the local image server isn't public.
+	images := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+	}))
+	defer images.Close()
+	imageURL := images.URL + "/image.png"
+
+	tests := []struct {
+		name     string
+		sig      string
+		wantCode int
+	}{
+		{name: "unsigned", wantCode: http.StatusForbidden},
+		{name: "signed with another secret", sig: imageURLSignature("other", imageURL), wantCode: http.StatusForbidden},
+		{name: "signed", sig: imageURLSignature("secret", imageURL), wantCode: http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			server := &Server{
+				config:      &Config{ImageProxyBuiltin: true, ImageProxySecret: "secret", ImageProxyMaxBytes: 1024},
+				logger:      &logger,
+				imageClient: newImageProxyClient(),
+			}
+
+			rec := httptest.NewRecorder()
+			server.ProxyImage(rec, httptest.NewRequest(http.MethodGet, "/img", nil), ProxyImageParams{Url: imageURL, Sig: tt.sig})
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("expected status %d, got %d", tt.wantCode, rec.Code)
+			}
+		})
+	}
+}
+
+func TestImageProxy_ProxiedURL(t *testing.T) {
+	proxy := imageProxy{url: "https://api.example.com/img?url=", secret: "secret"}
+
+	got := proxy.proxiedURL("https://example.com/image.png")
+	want := "https://api.example.com/img?url=https%3A%2F%2Fexample.com%2Fimage.png&sig=" + imageURLSignature("secret", "https://example.com/image.png")
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	if got := proxy.proxiedURL("data:image/png;base64,AAAA"); got != "data:image/png;base64,AAAA" {
+		t.Errorf("expected the data URL to be kept, got %q", got)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "93.184.216.34", want: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{ip: "127.0.0.1", want: false},
+		{ip: "10.0.0.1", want: false},
+		{ip: "169.254.169.254", want: false},
+		{ip: "100.64.0.1", want: false},
+		{ip: "100.127.255.254", want: false},
+		{ip: "100.128.0.1", want: true},
+		{ip: "::1", want: false},
+		{ip: "fd00::1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := isPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPinnedRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		ip       string
+		wantHost string
+	}{
+		{name: "ipv4", url: "https://example.com/image.png", ip: "93.184.216.34", wantHost: "93.184.216.34"},
+		{name: "ipv4 with port", url: "http://example.com:8080/image.png", ip: "93.184.216.34", wantHost: "93.184.216.34:8080"},
+		{name: "ipv6", url: "https://example.com/image.png", ip: "2606:2800:220:1::1", wantHost: "[2606:2800:220:1::1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req.Host = ""
+
+			pinned := pinnedRequest(req, net.ParseIP(tt.ip))
+			if pinned.URL.Host != tt.wantHost {
+				t.Errorf("expected the %q URL host, got %q", tt.wantHost, pinned.URL.Host)
+			}
+			if pinned.Host != req.URL.Host {
+				t.Errorf("expected the original %q Host header, got %q", req.URL.Host, pinned.Host)
+			}
+		})
+	}
+}
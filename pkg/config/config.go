@@ -6,10 +6,14 @@ import (
 	"github.com/defeedco/defeed/pkg/feeds"
 	"github.com/defeedco/defeed/pkg/lib"
 	"github.com/defeedco/defeed/pkg/sources"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	"github.com/defeedco/defeed/pkg/sources/nlp"
+	"github.com/defeedco/defeed/pkg/sources/providers/jsonapi"
 	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
 
 	"github.com/defeedco/defeed/pkg/api"
 	"github.com/defeedco/defeed/pkg/lib/log"
+	"github.com/defeedco/defeed/pkg/lib/tracing"
 	"github.com/defeedco/defeed/pkg/llms"
 	"github.com/defeedco/defeed/pkg/storage/postgres"
 	"github.com/joeshaw/envdecode"
@@ -19,10 +23,17 @@ type Config struct {
 	DB              postgres.Config            `env:""`
 	API             api.Config                 `env:""`
 	Log             log.Config                 `env:""`
+	Tracing         tracing.Config             `env:""`
 	Feeds           feeds.Config               `env:""`
 	Sources         sources.Config             `env:""`
+	Activities      activities.Config          `env:""`
 	SourceProviders sourcetypes.ProviderConfig `env:""`
 	LLMs            llms.Config                `env:""`
+
+	// HTTPProxyURL routes all outbound requests through a proxy.
+	// When empty, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables are used.
+	HTTPProxyURL string `env:"OUTBOUND_PROXY_URL" validate:"omitempty,url"`
+
 	// Dev-only variables
 
 	// SourceInitialization true if the scheduler should not be initialized to process existing sources.
@@ -40,5 +51,25 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("validate config: %w", err)
 	}
 
+	if _, err := cfg.Activities.RecencyWeightFactors(); err != nil {
+		return nil, fmt.Errorf("validate config: %w", err)
+	}
+
+	if _, err := cfg.Activities.SourceRetentionCaps(); err != nil {
+		return nil, fmt.Errorf("validate config: %w", err)
+	}
+
+	if _, err := nlp.ParseEmbeddingInputs(cfg.LLMs.EmbeddingInputSourceTypes); err != nil {
+		return nil, fmt.Errorf("validate config: %w", err)
+	}
+
+	if _, err := jsonapi.LoadEndpoints(cfg.SourceProviders.JSONAPIEndpoints); err != nil {
+		return nil, fmt.Errorf("validate config: %w", err)
+	}
+
+	if err := lib.SetHTTPProxy(cfg.HTTPProxyURL); err != nil {
+		return nil, fmt.Errorf("set http proxy: %w", err)
+	}
+
 	return &cfg, nil
 }
@@ -0,0 +1,157 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// ErrCollectionNotFound is used when the collection doesn't exist or belongs to another user.
+var ErrCollectionNotFound = errors.New("collection not found")
+
+// Collection is a hand-picked set of activities (e.g. "Interview Prep"), which can span multiple feeds.
+// Unlike feeds, collections aren't defined by sources and a query, the activities are added explicitly.
+type Collection struct {
+	ID     string
+	UserID string
+	Name   string
+	// ActivityIDs are the UIDs of the collected activities, in the order they were added.
+	ActivityIDs []string
+	CreatedAt   time.Time
+}
+
+type collectionStore interface {
+	Create(ctx context.Context, collection Collection) error
+	// GetByID returns ErrCollectionNotFound if the collection doesn't exist.
+	GetByID(ctx context.Context, id string) (*Collection, error)
+	// AddActivity appends the activity to the end of the collection, unless it's already collected.
+	AddActivity(ctx context.Context, collectionID string, activityID string) error
+}
+
+// Collections manages the activity collections of the users.
+type Collections struct {
+	store            collectionStore
+	activityRegistry *activities.Registry
+	logger           *zerolog.Logger
+}
+
+func NewCollections(store collectionStore, activityRegistry *activities.Registry, logger *zerolog.Logger) *Collections {
+	return &Collections{
+		store:            store,
+		activityRegistry: activityRegistry,
+		logger:           logger,
+	}
+}
+
+// Create creates an empty collection of the user.
+func (c *Collections) Create(ctx context.Context, userID string, name string) (*Collection, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+
+	collection := Collection{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+
+	if err := c.store.Create(ctx, collection); err != nil {
+		return nil, fmt.Errorf("create collection: %w", err)
+	}
+
+	return &collection, nil
+}
+
+// AddActivity adds the activity to the end of the user's collection.
+// Returns ErrActivityNotFound if the activity doesn't exist.
+func (c *Collections) AddActivity(ctx context.Context, collectionID string, userID string, activityUID activitytypes.TypedUID) error {
+	if _, err := c.authorizedCollection(ctx, collectionID, userID); err != nil {
+		return err
+	}
+
+	res, err := c.activityRegistry.Search(ctx, activities.SearchRequest{
+		ActivityUIDs: []activitytypes.TypedUID{activityUID},
+		Limit:        1,
+	})
+	if err != nil {
+		return fmt.Errorf("search activity: %w", err)
+	}
+	if len(res.Activities) == 0 {
+		return ErrActivityNotFound
+	}
+
+	if err := c.store.AddActivity(ctx, collectionID, activityUID.String()); err != nil {
+		return fmt.Errorf("add activity: %w", err)
+	}
+
+	return nil
+}
+
+// Activities returns the activities of the user's collection, in the order they were added.
+// The activities deleted since (e.g. by the retention) are omitted.
+func (c *Collections) Activities(ctx context.Context, collectionID string, userID string) ([]*activitytypes.DecoratedActivity, error) {
+	collection, err := c.authorizedCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	uids := make([]activitytypes.TypedUID, 0, len(collection.ActivityIDs))
+	for _, id := range collection.ActivityIDs {
+		uid, err := lib.NewTypedUIDFromString(id)
+		if err != nil {
+			c.logger.Warn().Err(err).Str("activity_id", id).Msg("invalid collection activity id")
+			continue
+		}
+		uids = append(uids, uid)
+	}
+	if len(uids) == 0 {
+		return []*activitytypes.DecoratedActivity{}, nil
+	}
+
+	res, err := c.activityRegistry.Search(ctx, activities.SearchRequest{
+		ActivityUIDs: uids,
+		Limit:        len(uids),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search collection activities: %w", err)
+	}
+
+	return collectionOrder(collection.ActivityIDs, res.Activities), nil
+}
+
+// collectionOrder sorts the activities in the collection order, since the search doesn't preserve it.
+func collectionOrder(activityIDs []string, acts []*activitytypes.DecoratedActivity) []*activitytypes.DecoratedActivity {
+	byID := make(map[string]*activitytypes.DecoratedActivity, len(acts))
+	for _, act := range acts {
+		byID[act.Activity.UID().String()] = act
+	}
+
+	out := make([]*activitytypes.DecoratedActivity, 0, len(acts))
+	for _, id := range activityIDs {
+		if act, ok := byID[id]; ok {
+			out = append(out, act)
+		}
+	}
+	return out
+}
+
+// authorizedCollection returns the collection, if it belongs to the user.
+// Collections are private, so the collections of other users are reported as not found.
+func (c *Collections) authorizedCollection(ctx context.Context, collectionID string, userID string) (*Collection, error) {
+	collection, err := c.store.GetByID(ctx, collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+	if collection.UserID != userID {
+		return nil, ErrCollectionNotFound
+	}
+	return collection, nil
+}
@@ -0,0 +1,87 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+// memoryCollectionStore keeps the collections in memory.
+type memoryCollectionStore struct {
+	collections map[string]Collection
+}
+
+func (s *memoryCollectionStore) Create(_ context.Context, collection Collection) error {
+	s.collections[collection.ID] = collection
+	return nil
+}
+
+func (s *memoryCollectionStore) GetByID(_ context.Context, id string) (*Collection, error) {
+	collection, ok := s.collections[id]
+	if !ok {
+		return nil, ErrCollectionNotFound
+	}
+	return &collection, nil
+}
+
+func (s *memoryCollectionStore) AddActivity(_ context.Context, collectionID string, activityID string) error {
+	collection := s.collections[collectionID]
+	if !slices.Contains(collection.ActivityIDs, activityID) {
+		collection.ActivityIDs = append(collection.ActivityIDs, activityID)
+	}
+	s.collections[collectionID] = collection
+	return nil
+}
+
+func TestCollections_Authorization(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	collections := NewCollections(&memoryCollectionStore{collections: make(map[string]Collection)}, nil, &logger)
+
+	collection, err := collections.Create(ctx, "user", "Interview Prep")
+	if err != nil {
+		t.Fatalf("create collection: %v", err)
+	}
+
+	acts, err := collections.Activities(ctx, collection.ID, "user")
+	if err != nil {
+		t.Fatalf("list activities of empty collection: %v", err)
+	}
+	if len(acts) != 0 {
+		t.Errorf("expected no activities, got %d", len(acts))
+	}
+
+	if _, err := collections.Activities(ctx, collection.ID, "other"); !errors.Is(err, ErrCollectionNotFound) {
+		t.Errorf("expected collection of another user to be not found, got %v", err)
+	}
+	if _, err := collections.Activities(ctx, "missing", "user"); !errors.Is(err, ErrCollectionNotFound) {
+		t.Errorf("expected missing collection to be not found, got %v", err)
+	}
+	if _, err := collections.Create(ctx, "", "Anonymous"); err == nil {
+		t.Error("expected collections of unauthenticated users to be rejected")
+	}
+}
+
+func TestCollectionOrder(t *testing.T) {
+	acts := []*activitytypes.DecoratedActivity{
+		{Activity: &timelineActivity{id: "b"}},
+		{Activity: &timelineActivity{id: "a"}},
+		{Activity: &timelineActivity{id: "c"}},
+	}
+
+	// The deleted activity "d" isn't returned by the search.
+	ordered := collectionOrder([]string{"test:c", "test:d", "test:a", "test:b"}, acts)
+
+	var ids []string
+	for _, act := range ordered {
+		ids = append(ids, act.Activity.UID().String())
+	}
+	want := []string{"test:c", "test:a", "test:b"}
+	if !slices.Equal(ids, want) {
+		t.Errorf("expected activities in the collection order %v, got %v", want, ids)
+	}
+}
@@ -1,5 +1,7 @@
 package feeds
 
+import "time"
+
 type Config struct {
 	// SummarizeTopics controls whether summaries are computed for each activity topic returned from GET /feed/{id}/activities
 	SummarizeTopics bool `env:"SUMMARIZE_TOPICS,default=false"`
@@ -8,4 +10,78 @@ type Config struct {
 	AllowQueryRewrite bool `env:"ALLOW_QUERY_REWRITE,default=true"`
 	// MinSimilarity controls the minimum similarity score threeshold, when searching by query embedding.
 	MinSimilarity float32 `env:"MIN_SIMILARITY,default=0.3"`
+	// SearchConcurrency is the max number of concurrent activity searches per feed request (e.g. per source or topic query).
+	SearchConcurrency int `env:"FEED_SEARCH_CONCURRENCY,default=10" validate:"gte=1"`
+	// MaxConcurrentSearches is the max number of concurrent activity searches shared across all feed requests.
+	// Searches may compute query embeddings and run expensive vector queries, so this protects the DB and the LLM provider.
+	MaxConcurrentSearches int `env:"FEED_MAX_CONCURRENT_SEARCHES,default=20" validate:"gte=1"`
+	// MaxConcurrentRequestsPerUser is the max number of concurrent feed activity requests of a single user
+	// (the activities, the uncached topics, the home timeline and the exports).
+	// Each request may rewrite the query and fan out into many searches, so a client opening many feeds at once
+	// could otherwise take up the shared search slots of the whole instance. Set to 0 to disable.
+	MaxConcurrentRequestsPerUser int `env:"FEED_MAX_CONCURRENT_REQUESTS_PER_USER,default=4" validate:"gte=0"`
+	// UserRequestQueueTimeout is how long the excess concurrent requests of a user wait for a free slot,
+	// before they're rejected with ErrTooManyConcurrentRequests. Set to 0 to reject them immediately.
+	UserRequestQueueTimeout time.Duration `env:"FEED_USER_REQUEST_QUEUE_TIMEOUT,default=10s" validate:"gte=0"`
+	// MaxSourcesPerFeed is the max number of sources in a single feed. Set to 0 to disable.
+	// Feeds are searched per source (to ensure variety) and each source is polled,
+	// so feeds with too many sources degrade both the DB and the scheduler for the whole instance.
+	MaxSourcesPerFeed int `env:"FEED_MAX_SOURCES,default=50" validate:"gte=0"`
+	// MaxQueryLength is the max number of characters of the feed queries and the query overrides by authenticated users.
+	// Queries are embedded and rewritten by the LLM on each request, so long queries inflate the cost. Set to 0 to disable.
+	MaxQueryLength int `env:"FEED_MAX_QUERY_LENGTH,default=500" validate:"gte=0"`
+	// PublicQueryOverride allows unauthenticated users to override the query of public feeds (e.g. embedded feeds with search).
+	// Note: the API additionally rate limits these requests per IP.
+	PublicQueryOverride bool `env:"PUBLIC_QUERY_OVERRIDE,default=false"`
+	// PublicQueryMaxLength is the max number of characters of the query override by unauthenticated users.
+	PublicQueryMaxLength int `env:"PUBLIC_QUERY_MAX_LENGTH,default=100" validate:"gte=1"`
+	// QueryRewriteFailureThreshold is the number of consecutive query rewrite failures (e.g. LLM provider outage)
+	// after which the query rewrites are skipped, and the feeds are searched by the original query. Set to 0 to disable.
+	QueryRewriteFailureThreshold int `env:"QUERY_REWRITE_FAILURE_THRESHOLD,default=3" validate:"gte=0"`
+	// QueryRewriteCooldown is the duration for which the query rewrites are skipped, before retrying them.
+	QueryRewriteCooldown time.Duration `env:"QUERY_REWRITE_COOLDOWN,default=1m"`
+	// QueryRewriteCacheTTL is how long the rewritten topics are cached per feed query and source set,
+	// so that polling clients don't rerun the (expensive) rewrite on each request. Set to 0 to disable.
+	QueryRewriteCacheTTL time.Duration `env:"QUERY_REWRITE_CACHE_TTL,default=15m"`
+	// QueryRewriteCacheOverrides also caches the rewrites of the query overrides (e.g. ad-hoc searches).
+	// Disabled by default, since the overrides are rarely repeated and would only fill up the cache.
+	QueryRewriteCacheOverrides bool `env:"QUERY_REWRITE_CACHE_OVERRIDES,default=false"`
+	// TopicSearchStrategy controls how the rewritten topic queries are searched:
+	//   - "per_query" runs a separate search for each query and merges the results.
+	//   - "mean" averages the query embeddings into a centroid and runs a single search per topic.
+	//   - "max" max-pools the query embeddings and runs a single search per topic.
+	// The pooled strategies use one batched embedding request and one DB query per topic
+	// (see BenchmarkSearchByTopicQueryGroups), but the results are less precise,
+	// since the centroid of diverse queries may not be close to any of them.
+	TopicSearchStrategy string `env:"TOPIC_SEARCH_STRATEGY,default=per_query" validate:"oneof=per_query mean max"`
+	// MaxTopics is the max number of topics the query is rewritten into.
+	// Each topic query is embedded and searched separately, so the value is capped at 10.
+	MaxTopics int `env:"QUERY_REWRITE_MAX_TOPICS,default=5" validate:"gte=1,lte=10"`
+	// MinResultsPerTopic is the min number of results searched for each rewritten topic.
+	// When the limit can't cover all the topics, the least relevant topics are dropped instead of starving each topic.
+	MinResultsPerTopic int `env:"QUERY_REWRITE_MIN_RESULTS_PER_TOPIC,default=3" validate:"gte=1"`
+	// CollapseEmptyTopics omits the rewritten topics without any matching activities from the responses.
+	CollapseEmptyTopics bool `env:"QUERY_REWRITE_COLLAPSE_EMPTY_TOPICS,default=true"`
+	// TopicSummaryCacheKey controls when the cached topic summaries are recomputed:
+	//   - "topic" caches the summary per period and topic name, so new activities only show up after the cache expires (2h).
+	//   - "activities" also keys the summary by the contributing activities, so it's recomputed when they change.
+	TopicSummaryCacheKey string `env:"TOPIC_SUMMARY_CACHE_KEY,default=activities" validate:"oneof=topic activities"`
+	// RelevanceFeedbackMaxActivities is the max number of the most recent "more/less like this" activities
+	// that personalize the query searches of the user. Set to 0 to disable.
+	RelevanceFeedbackMaxActivities int `env:"RELEVANCE_FEEDBACK_MAX_ACTIVITIES,default=50" validate:"gte=0"`
+	// DeletedFeedRetention is how long the deleted feeds can be restored, before they're purged.
+	// Set to 0 to delete the feeds immediately.
+	DeletedFeedRetention time.Duration `env:"FEED_DELETED_RETENTION,default=168h"`
+	// DeletedFeedPurgeInterval is how often the deleted feeds past the retention are purged.
+	DeletedFeedPurgeInterval time.Duration `env:"FEED_DELETED_PURGE_INTERVAL,default=1h"`
+	// DigestMaxActivities is the max number of top activities summarized into the feed digest.
+	DigestMaxActivities int `env:"FEED_DIGEST_MAX_ACTIVITIES,default=30" validate:"gte=1"`
+	// ExportMaxActivities is the max number of activities exported at once from GET /feeds/{id}/export.
+	ExportMaxActivities int `env:"FEED_EXPORT_MAX_ACTIVITIES,default=10000" validate:"gte=1"`
+	// StarterFeeds are created for new users on their first authenticated request (see ParseStarterFeeds).
+	// The value is a JSON list of feeds ({"name", "icon", "query", "sourceUids"}), or a path to the JSON file prefixed with "file:".
+	// Empty disables the provisioning.
+	StarterFeeds string `env:"STARTER_FEEDS,default="`
+	// StarterFeedsTimeout bounds the starter feeds provisioning, which delays the first request of new users.
+	StarterFeedsTimeout time.Duration `env:"STARTER_FEEDS_TIMEOUT,default=10s"`
 }
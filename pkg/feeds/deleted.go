@@ -0,0 +1,87 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Restore brings back the soft-deleted feed, if it's still within the retention window.
+func (r *Registry) Restore(ctx context.Context, uid string, userID string) (*Feed, error) {
+	feed, err := r.feedRepository.GetByID(ctx, uid)
+	if err != nil || feed.UserID != userID {
+		return nil, ErrFeedNotFound
+	}
+
+	if !feed.Deleted() {
+		return feed, nil
+	}
+
+	// Feeds past the retention may not be purged yet, but shouldn't be restored either.
+	if time.Since(feed.DeletedAt) > r.config.DeletedFeedRetention {
+		return nil, ErrFeedNotFound
+	}
+
+	feed.DeletedAt = time.Time{}
+	feed.UpdatedAt = time.Now()
+
+	// Re-adds the sources, in case they were removed in the meantime (e.g. by the reconciler).
+	err = r.executeAndUpsert(ctx, *feed)
+	if err != nil {
+		return nil, fmt.Errorf("execute and upsert feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+// PurgeDeleted permanently deletes the feeds that were soft-deleted before the retention window,
+// and stops polling their sources, unless used by other feeds. Returns the number of purged feeds.
+func (r *Registry) PurgeDeleted(ctx context.Context) (int, error) {
+	deleted, err := r.feedRepository.ListDeletedBefore(ctx, time.Now().Add(-r.config.DeletedFeedRetention))
+	if err != nil {
+		return 0, fmt.Errorf("list deleted feeds: %w", err)
+	}
+
+	purged := 0
+	for _, feed := range deleted {
+		err := r.feedRepository.Remove(ctx, feed.ID)
+		if err != nil {
+			return purged, fmt.Errorf("remove feed %s: %w", feed.ID, err)
+		}
+		purged++
+
+		err = r.cleanupUnusedSources(ctx, feed.SourceUIDs)
+		if err != nil {
+			r.logger.Error().Err(err).Str("feed_id", feed.ID).Msg("failed to cleanup unused sources")
+		}
+	}
+
+	return purged, nil
+}
+
+// StartPurge periodically purges the deleted feeds past the retention window, until the ctx is canceled.
+func (r *Registry) StartPurge(ctx context.Context) {
+	if r.config.DeletedFeedRetention <= 0 || r.config.DeletedFeedPurgeInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.config.DeletedFeedPurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purged, err := r.PurgeDeleted(ctx)
+				if err != nil {
+					r.logger.Error().Err(err).Msg("Failed to purge deleted feeds")
+				}
+				if purged > 0 {
+					r.logger.Info().Int("count", purged).Msg("Purged deleted feeds")
+				}
+			}
+		}
+	}()
+}
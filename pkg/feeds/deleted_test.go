@@ -0,0 +1,113 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestRemove_SoftDelete(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	store := &memoryFeedStore{feeds: map[string]Feed{"feed": {ID: "feed", UserID: "user"}}}
+	registry := NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &Config{DeletedFeedRetention: time.Hour}, &logger)
+
+	if err := registry.Remove(ctx, "feed", "user"); err != nil {
+		t.Fatalf("remove feed: %v", err)
+	}
+	if _, err := registry.GetByID(ctx, "feed", "user"); err == nil {
+		t.Error("expected deleted feed to be hidden")
+	}
+	if feeds, _ := registry.ListByUserID(ctx, "user"); len(feeds) != 0 {
+		t.Errorf("expected deleted feed to be excluded from the list, got %d feeds", len(feeds))
+	}
+	if err := registry.Remove(ctx, "feed", "user"); !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("expected deleted feed to be not found, got %v", err)
+	}
+
+	if _, err := registry.Restore(ctx, "feed", "other"); !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("expected feed of another user to be not found, got %v", err)
+	}
+	restored, err := registry.Restore(ctx, "feed", "user")
+	if err != nil {
+		t.Fatalf("restore feed: %v", err)
+	}
+	if restored.Deleted() {
+		t.Error("expected restored feed to not be deleted")
+	}
+	if _, err := registry.GetByID(ctx, "feed", "user"); err != nil {
+		t.Errorf("expected restored feed to be visible, got %v", err)
+	}
+}
+
+func TestRemove_RetentionDisabled(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	store := &memoryFeedStore{feeds: map[string]Feed{"feed": {ID: "feed", UserID: "user"}}}
+	registry := NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &Config{}, &logger)
+
+	if err := registry.Remove(ctx, "feed", "user"); err != nil {
+		t.Fatalf("remove feed: %v", err)
+	}
+	if _, ok := store.feeds["feed"]; ok {
+		t.Error("expected feed to be deleted immediately")
+	}
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	now := time.Now()
+	store := &memoryFeedStore{feeds: map[string]Feed{
+		"active":  {ID: "active", UserID: "user"},
+		"recent":  {ID: "recent", UserID: "user", DeletedAt: now.Add(-time.Minute)},
+		"expired": {ID: "expired", UserID: "user", DeletedAt: now.Add(-2 * time.Hour)},
+	}}
+	registry := NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &Config{DeletedFeedRetention: time.Hour}, &logger)
+
+	if _, err := registry.Restore(ctx, "expired", "user"); !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("expected feed past the retention to not be restorable, got %v", err)
+	}
+
+	purged, err := registry.PurgeDeleted(ctx)
+	if err != nil {
+		t.Fatalf("purge deleted feeds: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("expected 1 purged feed, got %d", purged)
+	}
+	if _, ok := store.feeds["expired"]; ok {
+		t.Error("expected feed past the retention to be purged")
+	}
+	for _, id := range []string{"active", "recent"} {
+		if _, ok := store.feeds[id]; !ok {
+			t.Errorf("expected feed %q to be kept", id)
+		}
+	}
+}
+
+func TestUpdate_FeedNotFound(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	store := &memoryFeedStore{feeds: map[string]Feed{
+		"feed":    {ID: "feed", UserID: "user"},
+		"deleted": {ID: "deleted", UserID: "user", DeletedAt: time.Now()},
+	}}
+	registry := NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &Config{}, &logger)
+
+	for _, tc := range []struct{ feedID, userID string }{
+		{"feed", "other"},
+		{"deleted", "user"},
+		{"missing", "user"},
+	} {
+		if _, err := registry.Update(ctx, UpdateRequest{ID: tc.feedID, UserID: tc.userID}); !errors.Is(err, ErrFeedNotFound) {
+			t.Errorf("expected updating %s of %s to be not found, got %v", tc.feedID, tc.userID, err)
+		}
+		if _, err := registry.SetPaused(ctx, tc.feedID, tc.userID, true); !errors.Is(err, ErrFeedNotFound) {
+			t.Errorf("expected pausing %s of %s to be not found, got %v", tc.feedID, tc.userID, err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defeedco/defeed/pkg/lib/tracing"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// exportPageSize is the number of activities searched at once when exporting the feed activities.
+const exportPageSize = 100
+
+// ExportActivities calls fn for each of the latest feed activities, up to the configured max export size.
+// Activities are searched page by page, so that large feeds can be streamed without buffering them.
+// Authorization errors are returned before fn is first called.
+func (r *Registry) ExportActivities(
+	ctx context.Context,
+	feedID string,
+	userID string,
+	fn func(act *activitytypes.DecoratedActivity) error,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "feeds.ExportActivities", attribute.String("feed_id", feedID))
+	defer tracing.End(span, &err)
+
+	feed, err := r.authorizedFeed(ctx, feedID, userID)
+	if err != nil {
+		return err
+	}
+
+	release, err := r.userSlots.acquire(ctx, userID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	opts := searchOptions{sourceFilters: feed.SourceFilters}
+	exported := 0
+	cursor := ""
+	for exported < r.config.ExportMaxActivities {
+		limit := min(exportPageSize, r.config.ExportMaxActivities-exported)
+		res, err := r.searchPage(ctx, opts, feed.SourceUIDs, feed.MinQualityScore, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), feed.Query, limit, cursor)
+		if err != nil {
+			return fmt.Errorf("search page: %w", err)
+		}
+
+		for _, act := range res.Results {
+			if err := fn(act); err != nil {
+				return err
+			}
+		}
+
+		exported += len(res.Results)
+		if !res.HasMore || res.NextCursor == "" || len(res.Results) == 0 {
+			break
+		}
+		cursor = res.NextCursor
+	}
+
+	span.SetAttributes(attribute.Int("exported", exported))
+
+	return nil
+}
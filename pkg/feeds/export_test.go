@@ -0,0 +1,107 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+// getFeedStore only supports getting the given feed.
+type getFeedStore struct {
+	feedStore
+	feed *Feed
+}
+
+func (s *getFeedStore) GetByID(context.Context, string) (*Feed, error) {
+	return s.feed, nil
+}
+
+func TestExportActivities(t *testing.T) {
+	source := lib.NewTypedUID("test", "source")
+	acts := make([]*activitytypes.DecoratedActivity, 250)
+	for i := range acts {
+		acts[i] = &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: fmt.Sprint(i), sources: []activitytypes.TypedUID{source}}}
+	}
+
+	tests := []struct {
+		name          string
+		maxActivities int
+		userID        string
+		busy          bool
+		wantExported  int
+		wantSearches  int
+		wantErr       error
+	}{
+		{name: "all pages", maxActivities: 1000, userID: "user", wantExported: 250, wantSearches: 3},
+		{name: "max export size", maxActivities: 120, userID: "user", wantExported: 120, wantSearches: 2},
+		{name: "unauthorized", maxActivities: 1000, userID: "other", wantErr: ErrFeedNotFound},
+		{name: "too many concurrent requests", maxActivities: 1000, userID: "user", busy: true, wantErr: ErrTooManyConcurrentRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			// Paginates the activities with an offset cursor.
+			searches := 0
+			store := &fakeActivityStore{search: func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+				searches++
+				offset := 0
+				if req.Cursor != "" {
+					var err error
+					if offset, err = strconv.Atoi(req.Cursor); err != nil {
+						return nil, err
+					}
+				}
+				end := min(offset+req.Limit, len(acts))
+				res := &activitytypes.SearchResult{Activities: acts[offset:end], HasMore: end < len(acts)}
+				if res.HasMore {
+					res.NextCursor = strconv.Itoa(end)
+				}
+				return res, nil
+			}}
+			feeds := &getFeedStore{feed: &Feed{ID: "feed", UserID: "user", SourceUIDs: []activitytypes.TypedUID{source}}}
+			activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{})
+			registry := NewRegistry(feeds, nil, nil, nil, nil, activityRegistry, nil, nil, &Config{
+				SearchConcurrency:            1,
+				MaxConcurrentSearches:        1,
+				MaxConcurrentRequestsPerUser: 1,
+				ExportMaxActivities:          tt.maxActivities,
+			}, &logger)
+			if tt.busy {
+				release, err := registry.userSlots.acquire(context.Background(), tt.userID)
+				if err != nil {
+					t.Fatalf("acquire slot: %v", err)
+				}
+				defer release()
+			}
+
+			var exported []string
+			err := registry.ExportActivities(context.Background(), "feed", tt.userID, func(act *activitytypes.DecoratedActivity) error {
+				exported = append(exported, act.Activity.Title())
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if len(exported) != tt.wantExported {
+				t.Errorf("expected %d exported activities, got %d", tt.wantExported, len(exported))
+			}
+			if searches != tt.wantSearches {
+				t.Errorf("expected %d searches, got %d", tt.wantSearches, searches)
+			}
+			for i, title := range exported {
+				if title != fmt.Sprint(i) {
+					t.Fatalf("expected activities in order, got %s at %d", title, i)
+				}
+			}
+		})
+	}
+}
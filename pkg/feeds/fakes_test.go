@@ -0,0 +1,119 @@
+package feeds
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// fakeActivityStore is a configurable activity store.
+// The searches return no activities, unless the search hook is set.
+type fakeActivityStore struct {
+	search        func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error)
+	countBySource map[string]int
+	stats         activitytypes.ActivityStats
+}
+
+func (s *fakeActivityStore) Upsert(context.Context, *activitytypes.DecoratedActivity) error {
+	return nil
+}
+
+func (s *fakeActivityStore) CountBySource(context.Context) (map[string]int, error) {
+	return s.countBySource, nil
+}
+
+func (s *fakeActivityStore) OldestKeptAt(context.Context, string, int) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (s *fakeActivityStore) Stats(context.Context, []string) (activitytypes.ActivityStats, error) {
+	return s.stats, nil
+}
+
+func (s *fakeActivityStore) Search(_ context.Context, req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+	if s.search == nil {
+		return &activitytypes.SearchResult{}, nil
+	}
+	return s.search(req)
+}
+
+// memoryFeedStore keeps the feeds in memory.
+type memoryFeedStore struct {
+	feeds     map[string]Feed
+	positions map[string]map[string]int
+}
+
+func (s *memoryFeedStore) Upsert(_ context.Context, feed Feed) error {
+	s.feeds[feed.ID] = feed
+	return nil
+}
+
+func (s *memoryFeedStore) Remove(_ context.Context, uid string) error {
+	delete(s.feeds, uid)
+	return nil
+}
+
+func (s *memoryFeedStore) List(context.Context) ([]*Feed, error) {
+	out := make([]*Feed, 0, len(s.feeds))
+	for _, feed := range s.feeds {
+		out = append(out, &feed)
+	}
+	return out, nil
+}
+
+func (s *memoryFeedStore) GetByID(_ context.Context, uid string) (*Feed, error) {
+	feed, ok := s.feeds[uid]
+	if !ok {
+		return nil, ErrFeedNotFound
+	}
+	return &feed, nil
+}
+
+func (s *memoryFeedStore) ListByOwner(_ context.Context, userID string) ([]*Feed, error) {
+	out := make([]*Feed, 0)
+	for _, feed := range s.feeds {
+		if feed.UserID == userID && !feed.Deleted() {
+			out = append(out, &feed)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryFeedStore) ListDeletedBefore(_ context.Context, before time.Time) ([]*Feed, error) {
+	out := make([]*Feed, 0)
+	for _, feed := range s.feeds {
+		if feed.Deleted() && feed.DeletedAt.Before(before) {
+			out = append(out, &feed)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryFeedStore) FindBySourceUIDs(_ context.Context, sourceUIDs []activitytypes.TypedUID) ([]*Feed, error) {
+	out := make([]*Feed, 0)
+	for _, feed := range s.feeds {
+		if slices.ContainsFunc(feed.SourceUIDs, func(uid activitytypes.TypedUID) bool {
+			return slices.ContainsFunc(sourceUIDs, func(sourceUID activitytypes.TypedUID) bool { return sourceUID.String() == uid.String() })
+		}) {
+			out = append(out, &feed)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryFeedStore) ListPositions(_ context.Context, userID string) (map[string]int, error) {
+	return s.positions[userID], nil
+}
+
+func (s *memoryFeedStore) SetPositions(_ context.Context, userID string, feedIDs []string) error {
+	if s.positions == nil {
+		s.positions = make(map[string]map[string]int)
+	}
+	s.positions[userID] = make(map[string]int, len(feedIDs))
+	for i, id := range feedIDs {
+		s.positions[userID][id] = i
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// ErrActivityNotFound is used when the activity doesn't exist.
+var ErrActivityNotFound = errors.New("activity not found")
+
+// FeedbackValue is the relevance feedback of the user on an activity.
+type FeedbackValue int
+
+const (
+	FeedbackLess FeedbackValue = -1
+	FeedbackNone FeedbackValue = 0
+	FeedbackMore FeedbackValue = 1
+)
+
+type ActivityFeedback struct {
+	ActivityID string
+	Value      FeedbackValue
+}
+
+type feedbackStore interface {
+	SetFeedback(ctx context.Context, userID string, activityID string, value FeedbackValue) error
+	ListFeedback(ctx context.Context, userID string, limit int) ([]*ActivityFeedback, error)
+}
+
+// SetActivityFeedback stores the "more/less like this" feedback of the user on the activity,
+// which personalizes the query searches of the user (see relevanceFeedback). FeedbackNone removes the feedback.
+func (r *Registry) SetActivityFeedback(ctx context.Context, userID string, activityUID activitytypes.TypedUID, value FeedbackValue) error {
+	res, err := r.activityRegistry.Search(ctx, activities.SearchRequest{
+		ActivityUIDs: []activitytypes.TypedUID{activityUID},
+		Limit:        1,
+	})
+	if err != nil {
+		return fmt.Errorf("search activity: %w", err)
+	}
+	if len(res.Activities) == 0 {
+		return ErrActivityNotFound
+	}
+
+	err = r.feedbackRepo.SetFeedback(ctx, userID, activityUID.String(), value)
+	if err != nil {
+		return fmt.Errorf("set feedback: %w", err)
+	}
+	r.cache.Delete(feedbackCacheKey(userID))
+
+	return nil
+}
+
+// relevanceFeedback returns the embeddings of the activities the user recently gave feedback on,
+// or nil if there's none (e.g. anonymous users) or the feedback is disabled.
+func (r *Registry) relevanceFeedback(ctx context.Context, userID string) (*activities.RelevanceFeedback, error) {
+	if userID == "" || r.feedbackRepo == nil || r.config.RelevanceFeedbackMaxActivities == 0 {
+		return nil, nil
+	}
+
+	cacheKey := feedbackCacheKey(userID)
+	if cached, found := r.cache.Get(cacheKey); found {
+		if feedback, ok := cached.(*activities.RelevanceFeedback); ok {
+			return feedback, nil
+		}
+	}
+
+	feedbacks, err := r.feedbackRepo.ListFeedback(ctx, userID, r.config.RelevanceFeedbackMaxActivities)
+	if err != nil {
+		return nil, fmt.Errorf("list feedback: %w", err)
+	}
+
+	var feedback *activities.RelevanceFeedback
+	if len(feedbacks) > 0 {
+		feedback, err = r.feedbackEmbeddings(ctx, feedbacks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Also cache the missing feedback, since most users don't have any.
+	r.cache.Set(cacheKey, feedback)
+
+	return feedback, nil
+}
+
+func (r *Registry) feedbackEmbeddings(ctx context.Context, feedbacks []*ActivityFeedback) (*activities.RelevanceFeedback, error) {
+	values := make(map[string]FeedbackValue, len(feedbacks))
+	uids := make([]activitytypes.TypedUID, 0, len(feedbacks))
+	for _, feedback := range feedbacks {
+		uid, err := lib.NewTypedUIDFromString(feedback.ActivityID)
+		if err != nil {
+			r.logger.Warn().Err(err).Str("activity_id", feedback.ActivityID).Msg("invalid feedback activity id")
+			continue
+		}
+		values[uid.String()] = feedback.Value
+		uids = append(uids, uid)
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+
+	res, err := r.activityRegistry.Search(ctx, activities.SearchRequest{
+		ActivityUIDs: uids,
+		Limit:        len(uids),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search feedback activities: %w", err)
+	}
+
+	feedback := &activities.RelevanceFeedback{}
+	for _, act := range res.Activities {
+		if len(act.Embedding) == 0 {
+			continue
+		}
+		switch values[act.Activity.UID().String()] {
+		case FeedbackMore:
+			feedback.Liked = append(feedback.Liked, act.Embedding)
+		case FeedbackLess:
+			feedback.Disliked = append(feedback.Disliked, act.Embedding)
+		}
+	}
+
+	return feedback, nil
+}
+
+func feedbackCacheKey(userID string) string {
+	return fmt.Sprintf("relevance_feedback:%s", userID)
+}
@@ -0,0 +1,99 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+// memoryFeedbackStore stores the feedback of a single user.
+type memoryFeedbackStore struct {
+	values map[string]FeedbackValue
+}
+
+func (s *memoryFeedbackStore) SetFeedback(_ context.Context, _ string, activityID string, value FeedbackValue) error {
+	if value == FeedbackNone {
+		delete(s.values, activityID)
+		return nil
+	}
+	s.values[activityID] = value
+	return nil
+}
+
+func (s *memoryFeedbackStore) ListFeedback(context.Context, string, int) ([]*ActivityFeedback, error) {
+	var out []*ActivityFeedback
+	for id, value := range s.values {
+		out = append(out, &ActivityFeedback{ActivityID: id, Value: value})
+	}
+	return out, nil
+}
+
+func TestRelevanceFeedback(t *testing.T) {
+	liked := &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: "liked"}, Embedding: []float32{1, 0}}
+	disliked := &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: "disliked"}, Embedding: []float32{0, 1}}
+	byUID := map[string]*activitytypes.DecoratedActivity{
+		liked.Activity.UID().String():    liked,
+		disliked.Activity.UID().String(): disliked,
+	}
+	// Returns the searched activities by UID.
+	store := &fakeActivityStore{search: func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+		var acts []*activitytypes.DecoratedActivity
+		for _, uid := range req.ActivityUIDs {
+			if act, ok := byUID[uid.String()]; ok {
+				acts = append(acts, act)
+			}
+		}
+		return &activitytypes.SearchResult{Activities: acts}, nil
+	}}
+
+	logger := zerolog.Nop()
+	activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{})
+	feedback := &memoryFeedbackStore{values: map[string]FeedbackValue{}}
+	registry := NewRegistry(nil, nil, feedback, nil, nil, activityRegistry, nil, nil, &Config{
+		RelevanceFeedbackMaxActivities: 10,
+	}, &logger)
+	ctx := context.Background()
+
+	err := registry.SetActivityFeedback(ctx, "user", lib.NewTypedUID("test", "missing"), FeedbackMore)
+	if !errors.Is(err, ErrActivityNotFound) {
+		t.Fatalf("expected activity not found, got %v", err)
+	}
+
+	if err := registry.SetActivityFeedback(ctx, "user", liked.Activity.UID(), FeedbackMore); err != nil {
+		t.Fatalf("set feedback: %v", err)
+	}
+	if err := registry.SetActivityFeedback(ctx, "user", disliked.Activity.UID(), FeedbackLess); err != nil {
+		t.Fatalf("set feedback: %v", err)
+	}
+
+	got, err := registry.relevanceFeedback(ctx, "user")
+	if err != nil {
+		t.Fatalf("relevance feedback: %v", err)
+	}
+	if got == nil || len(got.Liked) != 1 || !slices.Equal(got.Liked[0], liked.Embedding) ||
+		len(got.Disliked) != 1 || !slices.Equal(got.Disliked[0], disliked.Embedding) {
+		t.Fatalf("unexpected feedback: %+v", got)
+	}
+
+	// Removing the feedback must invalidate the cached embeddings.
+	if err := registry.SetActivityFeedback(ctx, "user", disliked.Activity.UID(), FeedbackNone); err != nil {
+		t.Fatalf("remove feedback: %v", err)
+	}
+	got, err = registry.relevanceFeedback(ctx, "user")
+	if err != nil {
+		t.Fatalf("relevance feedback: %v", err)
+	}
+	if len(got.Disliked) != 0 {
+		t.Errorf("expected the removed feedback to be dropped, got %+v", got)
+	}
+
+	if got, _ := registry.relevanceFeedback(ctx, ""); got != nil {
+		t.Errorf("expected no feedback for anonymous users, got %+v", got)
+	}
+}
@@ -0,0 +1,115 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/defeedco/defeed/pkg/lib/tracing"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type TimelineActivity struct {
+	Activity *activitytypes.DecoratedActivity
+	// FeedIDs are the user feeds that include any of the activity sources.
+	FeedIDs []string
+	// SourceOverrides are the overrides of the activity sources (see Feed.SourceOverrides).
+	// If the source is overridden by multiple feeds, any of the overrides is used.
+	SourceOverrides map[string]SourceOverride
+}
+
+// HomeTimeline returns the activities across all the (not paused) feeds owned by the user.
+// The sources of all feeds are searched at once, so that the ranking considers the full set,
+// and activities that appear in multiple feeds are only returned once.
+// The feed queries aren't applied, since a single search can't rank by multiple queries,
+// so the timeline is ranked like feeds without a query.
+func (r *Registry) HomeTimeline(
+	ctx context.Context,
+	userID string,
+	sortBy activitytypes.SortBy,
+	period activitytypes.Period,
+	calendar activitytypes.Calendar,
+	limit int,
+) (_ []*TimelineActivity, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.HomeTimeline")
+	defer tracing.End(span, &err)
+
+	release, err := r.userSlots.acquire(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	feeds, err := r.feedRepository.ListByOwner(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w", err)
+	}
+
+	feedIDsBySource := make(map[string][]string)
+	overridesBySource := make(map[string]SourceOverride)
+	sourceWeights := make(map[string]float64)
+	sourceUIDs := make([]activitytypes.TypedUID, 0)
+	// Use the least restrictive quality filter, since the search is shared by all feeds.
+	// For the same reason, the feed-specific keyword filters (see Feed.SourceFilters) aren't applied.
+	minQualityScore := math.Inf(1)
+	for _, feed := range feeds {
+		if feed.Paused {
+			continue
+		}
+
+		minQualityScore = min(minQualityScore, feed.MinQualityScore)
+		for _, sourceUID := range feed.SourceUIDs {
+			uid := sourceUID.String()
+			if _, seen := feedIDsBySource[uid]; !seen {
+				sourceUIDs = append(sourceUIDs, sourceUID)
+			}
+			feedIDsBySource[uid] = append(feedIDsBySource[uid], feed.ID)
+			if weight, ok := feed.SourceWeights[uid]; ok {
+				sourceWeights[uid] = max(sourceWeights[uid], weight)
+			}
+			if override, ok := feed.SourceOverrides[uid]; ok {
+				overridesBySource[uid] = override
+			}
+		}
+	}
+
+	span.SetAttributes(attribute.Int("source_count", len(sourceUIDs)))
+
+	if len(sourceUIDs) == 0 {
+		return []*TimelineActivity{}, nil
+	}
+
+	acts, err := r.search(ctx, searchOptions{}, sourceUIDs, sourceWeights, minQualityScore, sortBy, period, calendar, "", limit)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	out := make([]*TimelineActivity, 0, len(acts))
+	for _, act := range acts {
+		feedIDs := make([]string, 0)
+		var sourceOverrides map[string]SourceOverride
+		for _, sourceUID := range act.Activity.SourceUIDs() {
+			uid := sourceUID.String()
+			for _, feedID := range feedIDsBySource[uid] {
+				if !slices.Contains(feedIDs, feedID) {
+					feedIDs = append(feedIDs, feedID)
+				}
+			}
+			if override, ok := overridesBySource[uid]; ok {
+				if sourceOverrides == nil {
+					sourceOverrides = make(map[string]SourceOverride)
+				}
+				sourceOverrides[uid] = override
+			}
+		}
+		out = append(out, &TimelineActivity{
+			Activity:        act,
+			FeedIDs:         feedIDs,
+			SourceOverrides: sourceOverrides,
+		})
+	}
+
+	return out, nil
+}
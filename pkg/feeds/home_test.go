@@ -0,0 +1,124 @@
+package feeds
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+// listFeedStore only supports listing the given feeds.
+type listFeedStore struct {
+	feedStore
+	feeds []*Feed
+}
+
+func (s *listFeedStore) List(context.Context) ([]*Feed, error) {
+	return s.feeds, nil
+}
+
+func (s *listFeedStore) ListByOwner(_ context.Context, userID string) ([]*Feed, error) {
+	out := make([]*Feed, 0)
+	for _, feed := range s.feeds {
+		if feed.UserID == userID && !feed.Deleted() {
+			out = append(out, feed)
+		}
+	}
+	return out, nil
+}
+
+// timelineActivity is a minimal activity seen in the given sources.
+type timelineActivity struct {
+	id      string
+	sources []activitytypes.TypedUID
+}
+
+func (a *timelineActivity) MarshalJSON() ([]byte, error)         { return []byte("{}"), nil }
+func (a *timelineActivity) UnmarshalJSON([]byte) error           { return nil }
+func (a *timelineActivity) UID() activitytypes.TypedUID          { return lib.NewTypedUID("test", a.id) }
+func (a *timelineActivity) SourceUIDs() []activitytypes.TypedUID { return a.sources }
+func (a *timelineActivity) Title() string                        { return a.id }
+func (a *timelineActivity) Body() string                         { return "" }
+func (a *timelineActivity) URL() string                          { return "" }
+func (a *timelineActivity) ImageURL() string                     { return "" }
+func (a *timelineActivity) CreatedAt() time.Time                 { return time.Time{} }
+func (a *timelineActivity) UpvotesCount() int                    { return -1 }
+func (a *timelineActivity) DownvotesCount() int                  { return -1 }
+func (a *timelineActivity) CommentsCount() int                   { return -1 }
+func (a *timelineActivity) AmplificationCount() int              { return -1 }
+func (a *timelineActivity) SocialScore() float64                 { return -1 }
+
+func TestHomeTimeline(t *testing.T) {
+	hn := lib.NewTypedUID("test", "hn")
+	reddit := lib.NewTypedUID("test", "reddit")
+	lobsters := lib.NewTypedUID("test", "lobsters")
+
+	crossPosted := &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: "cross", sources: []activitytypes.TypedUID{hn, reddit}}}
+	redditOnly := &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: "reddit", sources: []activitytypes.TypedUID{reddit}}}
+	lobstersOnly := &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: "lobsters", sources: []activitytypes.TypedUID{lobsters}}}
+
+	bySource := map[string][]*activitytypes.DecoratedActivity{
+		hn.String():       {crossPosted},
+		reddit.String():   {crossPosted, redditOnly},
+		lobsters.String(): {lobstersOnly},
+	}
+	// Returns the activities of the searched sources.
+	store := &fakeActivityStore{search: func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+		var acts []*activitytypes.DecoratedActivity
+		for _, uid := range req.SourceUIDs {
+			acts = append(acts, bySource[uid.String()]...)
+		}
+		return &activitytypes.SearchResult{Activities: acts}, nil
+	}}
+	feeds := &listFeedStore{feeds: []*Feed{
+		{ID: "tech", UserID: "user", SourceUIDs: []activitytypes.TypedUID{hn}},
+		{ID: "social", UserID: "user", SourceUIDs: []activitytypes.TypedUID{reddit}, SourceOverrides: map[string]SourceOverride{
+			reddit.String(): {DisplayName: "Social"},
+		}},
+		{ID: "paused", UserID: "user", Paused: true, SourceUIDs: []activitytypes.TypedUID{lobsters}},
+		{ID: "deleted", UserID: "user", DeletedAt: time.Now(), SourceUIDs: []activitytypes.TypedUID{lobsters}},
+		{ID: "other", UserID: "other", Public: true, SourceUIDs: []activitytypes.TypedUID{lobsters}},
+	}}
+
+	logger := zerolog.Nop()
+	activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{})
+	registry := NewRegistry(feeds, nil, nil, nil, nil, activityRegistry, nil, nil, &Config{
+		SearchConcurrency:     2,
+		MaxConcurrentSearches: 2,
+	}, &logger)
+
+	out, err := registry.HomeTimeline(context.Background(), "user", activitytypes.SortByDate, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	feedIDsByActivity := make(map[string][]string)
+	for _, act := range out {
+		id := act.Activity.Activity.UID().String()
+		if _, seen := feedIDsByActivity[id]; seen {
+			t.Errorf("duplicated activity %s", id)
+		}
+		slices.Sort(act.FeedIDs)
+		feedIDsByActivity[id] = act.FeedIDs
+	}
+
+	if len(feedIDsByActivity) != 2 {
+		t.Fatalf("expected activities from the active user feeds only, got %v", feedIDsByActivity)
+	}
+	if got := feedIDsByActivity[crossPosted.Activity.UID().String()]; !slices.Equal(got, []string{"social", "tech"}) {
+		t.Errorf("expected cross-posted activity in both feeds, got %v", got)
+	}
+	if got := feedIDsByActivity[redditOnly.Activity.UID().String()]; !slices.Equal(got, []string{"social"}) {
+		t.Errorf("expected activity in a single feed, got %v", got)
+	}
+	for _, act := range out {
+		if act.SourceOverrides[reddit.String()].DisplayName != "Social" {
+			t.Errorf("expected the feed source override, got %v", act.SourceOverrides)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package feeds
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalidFeedOrder is used when the feed order lists a feed more than once.
+var ErrInvalidFeedOrder = errors.New("invalid feed order")
+
+// SetOrder persists the user's preferred order of the given feeds, replacing the previous one.
+// The feeds the user can't access (anymore) are ignored, and the omitted ones are listed after the ordered ones.
+// Returns the feeds accessible to the user in the new order.
+func (r *Registry) SetOrder(ctx context.Context, userID string, feedIDs []string) ([]*Feed, error) {
+	seen := make(map[string]bool, len(feedIDs))
+	for _, id := range feedIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("%w: feed %s is listed more than once", ErrInvalidFeedOrder, id)
+		}
+		seen[id] = true
+	}
+
+	feeds, err := r.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := make(map[string]bool, len(feeds))
+	for _, feed := range feeds {
+		accessible[feed.ID] = true
+	}
+
+	ordered := make([]string, 0, len(feedIDs))
+	for _, id := range feedIDs {
+		if accessible[id] {
+			ordered = append(ordered, id)
+		}
+	}
+
+	err = r.feedRepository.SetPositions(ctx, userID, ordered)
+	if err != nil {
+		return nil, fmt.Errorf("set feed positions: %w", err)
+	}
+
+	positions := make(map[string]int, len(ordered))
+	for i, id := range ordered {
+		positions[id] = i
+	}
+	sortFeeds(feeds, userID, positions)
+
+	return feeds, nil
+}
+
+// sortFeeds sorts the feeds by the user's preferred order.
+// The feeds without a position keep their order, with the user's own feeds before the public feeds of others.
+func sortFeeds(feeds []*Feed, userID string, positions map[string]int) {
+	rank := func(feed *Feed) (int, int) {
+		if position, ok := positions[feed.ID]; ok {
+			return 0, position
+		}
+		if feed.UserID == userID {
+			return 1, 0
+		}
+		return 2, 0
+	}
+
+	slices.SortStableFunc(feeds, func(a, b *Feed) int {
+		aGroup, aPosition := rank(a)
+		bGroup, bPosition := rank(b)
+		return cmp.Or(cmp.Compare(aGroup, bGroup), cmp.Compare(aPosition, bPosition))
+	})
+}
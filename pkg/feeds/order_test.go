@@ -0,0 +1,69 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestSetOrder(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	store := &memoryFeedStore{feeds: map[string]Feed{
+		"own-a":   {ID: "own-a", UserID: "user"},
+		"own-b":   {ID: "own-b", UserID: "user"},
+		"own-c":   {ID: "own-c", UserID: "user"},
+		"public":  {ID: "public", UserID: "other", Public: true},
+		"private": {ID: "private", UserID: "other"},
+	}}
+	registry := NewRegistry(store, nil, nil, nil, nil, nil, nil, nil, &Config{}, &logger)
+
+	ordered, err := registry.SetOrder(ctx, "user", []string{"public", "private", "own-b", "missing"})
+	if err != nil {
+		t.Fatalf("set order: %v", err)
+	}
+
+	feedIDs := func(feeds []*Feed) []string {
+		out := make([]string, len(feeds))
+		for i, feed := range feeds {
+			out[i] = feed.ID
+		}
+		return out
+	}
+
+	got := feedIDs(ordered)
+	if !slices.Equal(got[:2], []string{"public", "own-b"}) || len(got) != 4 {
+		t.Errorf("expected the accessible feeds in the given order, got %v", got)
+	}
+	if !slices.Equal(feedIDs(ordered[2:]), []string{"own-a", "own-c"}) && !slices.Equal(feedIDs(ordered[2:]), []string{"own-c", "own-a"}) {
+		t.Errorf("expected the omitted own feeds at the end, got %v", got)
+	}
+	if _, ok := store.positions["user"]["private"]; ok {
+		t.Error("expected the inaccessible feed to not be positioned")
+	}
+
+	listed, err := registry.ListByUserID(ctx, "user")
+	if err != nil {
+		t.Fatalf("list feeds: %v", err)
+	}
+	if !slices.Equal(feedIDs(listed)[:2], []string{"public", "own-b"}) {
+		t.Errorf("expected the listed feeds in the preferred order, got %v", feedIDs(listed))
+	}
+
+	// Public feeds of others are listed after the own feeds by default.
+	store.positions = nil
+	listed, err = registry.ListByUserID(ctx, "user")
+	if err != nil {
+		t.Fatalf("list feeds: %v", err)
+	}
+	if listed[len(listed)-1].ID != "public" {
+		t.Errorf("expected the public feed at the end, got %v", feedIDs(listed))
+	}
+
+	if _, err := registry.SetOrder(ctx, "user", []string{"own-a", "own-a"}); !errors.Is(err, ErrInvalidFeedOrder) {
+		t.Errorf("expected duplicate feeds to be rejected, got %v", err)
+	}
+}
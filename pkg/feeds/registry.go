@@ -1,23 +1,30 @@
 package feeds
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
-	"sort"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/lib/tracing"
 	"github.com/defeedco/defeed/pkg/sources/activities"
+	"github.com/defeedco/defeed/pkg/sources/providers/custom"
 	"github.com/defeedco/defeed/pkg/sources/providers/github"
 	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/jsonapi"
 	"github.com/defeedco/defeed/pkg/sources/providers/lobsters"
 	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
 	"github.com/defeedco/defeed/pkg/sources/providers/producthunt"
 	"github.com/defeedco/defeed/pkg/sources/providers/reddit"
 	"github.com/defeedco/defeed/pkg/sources/providers/rss"
 	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"go.opentelemetry.io/otel/attribute"
 
 	"golang.org/x/sync/errgroup"
 
@@ -28,12 +35,30 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// ErrTooManySources is used when the feed exceeds the max number of sources.
+var ErrTooManySources = errors.New("too many sources")
+
+// ErrQueryTooLong is used when the feed query (or its override) exceeds the allowed length.
+var ErrQueryTooLong = errors.New("query is too long")
+
 // ErrAuthUsersOnly is used when an action can't be performed without authentication.
 // TODO(subscription): Change to "ErrPayingUsersOnly" once we have subscription plans.
 var ErrAuthUsersOnly = errors.New("query override supported for authenticated users only")
 
+// ErrFeedNotFound is used when the feed doesn't exist or the user isn't allowed to access it.
+var ErrFeedNotFound = errors.New("feed not found")
+
+// ErrPaginationUnsupported is used when a cursor is provided for a search that can't be paginated.
+var ErrPaginationUnsupported = errors.New("pagination is only supported when sorting by date without query rewrites")
+
+// errQueryRewriteUnavailable is used when the query can't be rewritten (e.g. LLM provider outage),
+// and the search should fall back to the original query.
+var errQueryRewriteUnavailable = errors.New("query rewrite unavailable")
+
 type Registry struct {
 	feedRepository   feedStore
+	readActivityRepo readActivityStore
+	feedbackRepo     feedbackStore
 	sourceScheduler  *sources.Scheduler
 	sourceRegistry   sourceRegistry
 	activityRegistry *activities.Registry
@@ -41,19 +66,40 @@ type Registry struct {
 	queryRewriter    *nlp.QueryRewriter
 	config           *Config
 	cache            *lib.Cache
+	rewriteCache     *lib.Cache
 	logger           *zerolog.Logger
+	// searchSlots bounds the number of concurrent activity searches across all requests.
+	searchSlots chan struct{}
+	// userSlots bounds the number of concurrent feed activity requests per user.
+	userSlots *userSlots
+	// rewriteBreaker skips the query rewrites while the LLM provider is failing.
+	rewriteBreaker *lib.CircuitBreaker
 }
 
 type feedStore interface {
 	Upsert(ctx context.Context, feed Feed) error
 	Remove(ctx context.Context, uid string) error
 	List(ctx context.Context) ([]*Feed, error)
+	// ListByOwner returns the (not deleted) feeds owned by the user.
+	ListByOwner(ctx context.Context, userID string) ([]*Feed, error)
 	GetByID(ctx context.Context, uid string) (*Feed, error)
+	ListDeletedBefore(ctx context.Context, before time.Time) ([]*Feed, error)
 	FindBySourceUIDs(ctx context.Context, sourceUIDs []activitytypes.TypedUID) ([]*Feed, error)
+	ListPositions(ctx context.Context, userID string) (map[string]int, error)
+	SetPositions(ctx context.Context, userID string, feedIDs []string) error
+}
+
+type readActivityStore interface {
+	MarkAllRead(ctx context.Context, userID string, sourceUIDs []activitytypes.TypedUID, before time.Time) (int, error)
+	// ReadActivityIDs returns the subset of the given activities that were read by the user.
+	ReadActivityIDs(ctx context.Context, userID string, activityIDs []string) (map[string]bool, error)
+	// LastReadAt returns the time the user last marked activities as read, or zero if never.
+	LastReadAt(ctx context.Context, userID string) (time.Time, error)
 }
 
 type summarizer interface {
 	SummarizeTopic(ctx context.Context, topic *nlp.TopicQueryGroup, activities []*activitytypes.DecoratedActivity) (string, error)
+	SummarizeDigest(ctx context.Context, period activitytypes.Period, activities []*activitytypes.DecoratedActivity) ([]*nlp.DigestHighlight, error)
 }
 
 type sourceRegistry interface {
@@ -62,6 +108,8 @@ type sourceRegistry interface {
 
 func NewRegistry(
 	feedRepository feedStore,
+	readActivityRepository readActivityStore,
+	feedbackRepository feedbackStore,
 	sourceScheduler *sources.Scheduler,
 	sourceRegistry sourceRegistry,
 	activityRegistry *activities.Registry,
@@ -72,6 +120,8 @@ func NewRegistry(
 ) *Registry {
 	return &Registry{
 		feedRepository:   feedRepository,
+		readActivityRepo: readActivityRepository,
+		feedbackRepo:     feedbackRepository,
 		sourceScheduler:  sourceScheduler,
 		sourceRegistry:   sourceRegistry,
 		activityRegistry: activityRegistry,
@@ -79,8 +129,12 @@ func NewRegistry(
 		queryRewriter:    queryRewriter,
 		config:           config,
 		// TODO: be smarter about when to revalidate summaries and or queries (e.g. when the activities are sufficiently different)
-		cache:  lib.NewCache(2*time.Hour, logger),
-		logger: logger,
+		cache:          lib.NewCache(2*time.Hour, logger),
+		rewriteCache:   lib.NewCache(config.QueryRewriteCacheTTL, logger),
+		logger:         logger,
+		searchSlots:    make(chan struct{}, config.MaxConcurrentSearches),
+		userSlots:      newUserSlots(config.MaxConcurrentRequestsPerUser, config.UserRequestQueueTimeout),
+		rewriteBreaker: lib.NewCircuitBreaker(config.QueryRewriteFailureThreshold, config.QueryRewriteCooldown),
 	}
 }
 
@@ -93,13 +147,55 @@ type Feed struct {
 	Query string
 	// SourceUIDs is a list of sources where activities are pulled from.
 	SourceUIDs []activitytypes.TypedUID
+	// SourceWeights biases how many activities are picked from each source (keyed by source UID).
+	// Sources without a weight default to 1, so an empty map distributes activities evenly.
+	SourceWeights map[string]float64
+	// SourceOverrides are the feed-specific display names and icons of the sources (keyed by source UID).
+	// The sources keep their canonical names and icons elsewhere (e.g. in other feeds).
+	SourceOverrides map[string]SourceOverride
+	// SourceFilters drop the noise (e.g. daily discussion threads) from the sources by keywords (keyed by source UID).
+	// The filters are applied when searching, since the sources (and their activities) are shared with other feeds.
+	SourceFilters map[string]activitytypes.KeywordFilter
 	// UserID is the user who owns the feed.
 	UserID string
 	// Public is true if any user can access the feed.
 	Public bool
+	// Paused is true if the feed's sources shouldn't be polled.
+	// Sources shared with other active feeds continue to be polled.
+	Paused bool
+	// MinQualityScore excludes low-substance activities (e.g. link-only posts) below the threshold (0-1).
+	// Zero disables the filter.
+	MinQualityScore float64
+	// Sections group the activities by the user defined subsets of sources, instead of the source types.
+	Sections []FeedSection
+	// Language is the BCP 47 tag of the language the topics are generated in (e.g. "de"). Empty uses English.
+	Language string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set for the soft-deleted feeds, which can be restored until they're purged (see Config.DeletedFeedRetention).
+	DeletedAt time.Time
+}
+
+// Deleted is true if the feed was soft-deleted.
+func (f *Feed) Deleted() bool {
+	return !f.DeletedAt.IsZero()
+}
+
+// SourceOverride renames a source or changes its icon within a feed (e.g. "r/golang" to "Go News").
+// Empty fields keep the canonical source name and icon.
+type SourceOverride struct {
+	DisplayName string
+	// IconOverride is the URL of the icon.
+	IconOverride string
+}
+
+type FeedSection struct {
+	Name string
+	// Icon is a string of emoji characters.
+	Icon string
+	// SourceUIDs is a subset of the feed sources.
+	SourceUIDs []activitytypes.TypedUID
 }
 
 type FeedHighlight struct {
@@ -110,11 +206,22 @@ type FeedHighlight struct {
 }
 
 type CreateRequest struct {
-	Name       string
-	Icon       string
-	Query      string
-	SourceUIDs []activitytypes.TypedUID
-	UserID     string
+	Name          string
+	Icon          string
+	Query         string
+	SourceUIDs    []activitytypes.TypedUID
+	SourceWeights map[string]float64
+	// SourceOverrides see Feed.SourceOverrides.
+	SourceOverrides map[string]SourceOverride
+	// SourceFilters see Feed.SourceFilters.
+	SourceFilters map[string]activitytypes.KeywordFilter
+	// MinQualityScore see Feed.MinQualityScore.
+	MinQualityScore float64
+	// Sections see Feed.Sections.
+	Sections []FeedSection
+	// Language see Feed.Language.
+	Language string
+	UserID   string
 }
 
 func (r *Registry) Create(ctx context.Context, req CreateRequest) (*Feed, error) {
@@ -123,16 +230,34 @@ func (r *Registry) Create(ctx context.Context, req CreateRequest) (*Feed, error)
 		return nil, errors.New("user ID is required")
 	}
 
+	if err := r.validateSourceCount(req.SourceUIDs); err != nil {
+		return nil, err
+	}
+
+	if err := validateSourceOwners(req.SourceUIDs, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := r.validateQueryLength(req.Query); err != nil {
+		return nil, err
+	}
+
 	feed := Feed{
-		ID:         uuid.New().String(),
-		Name:       req.Name,
-		Icon:       req.Icon,
-		Query:      req.Query,
-		SourceUIDs: req.SourceUIDs,
-		UserID:     req.UserID,
-		Public:     false,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		ID:              uuid.New().String(),
+		Name:            req.Name,
+		Icon:            req.Icon,
+		Query:           req.Query,
+		SourceUIDs:      req.SourceUIDs,
+		SourceWeights:   req.SourceWeights,
+		SourceOverrides: req.SourceOverrides,
+		SourceFilters:   req.SourceFilters,
+		MinQualityScore: req.MinQualityScore,
+		Sections:        req.Sections,
+		Language:        req.Language,
+		UserID:          req.UserID,
+		Public:          false,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	err := r.executeAndUpsert(ctx, feed)
@@ -144,18 +269,41 @@ func (r *Registry) Create(ctx context.Context, req CreateRequest) (*Feed, error)
 }
 
 type UpdateRequest struct {
-	ID         string
-	UserID     string
-	Name       string
-	Icon       string
-	Query      string
-	SourceUIDs []activitytypes.TypedUID
+	ID            string
+	UserID        string
+	Name          string
+	Icon          string
+	Query         string
+	SourceUIDs    []activitytypes.TypedUID
+	SourceWeights map[string]float64
+	// SourceOverrides see Feed.SourceOverrides.
+	SourceOverrides map[string]SourceOverride
+	// SourceFilters see Feed.SourceFilters.
+	SourceFilters map[string]activitytypes.KeywordFilter
+	// MinQualityScore see Feed.MinQualityScore.
+	MinQualityScore float64
+	// Sections see Feed.Sections.
+	Sections []FeedSection
+	// Language see Feed.Language.
+	Language string
 }
 
 func (r *Registry) Update(ctx context.Context, req UpdateRequest) (*Feed, error) {
-	feed, err := r.feedRepository.GetByID(ctx, req.ID)
+	if err := r.validateSourceCount(req.SourceUIDs); err != nil {
+		return nil, err
+	}
+
+	if err := validateSourceOwners(req.SourceUIDs, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := r.validateQueryLength(req.Query); err != nil {
+		return nil, err
+	}
+
+	feed, err := r.activeFeed(ctx, req.ID)
 	if err != nil || feed.UserID != req.UserID {
-		return nil, errors.New("feed not found")
+		return nil, ErrFeedNotFound
 	}
 
 	oldSourceUIDs := feed.SourceUIDs
@@ -164,6 +312,12 @@ func (r *Registry) Update(ctx context.Context, req UpdateRequest) (*Feed, error)
 	feed.Icon = req.Icon
 	feed.Query = req.Query
 	feed.SourceUIDs = req.SourceUIDs
+	feed.SourceWeights = req.SourceWeights
+	feed.SourceOverrides = req.SourceOverrides
+	feed.SourceFilters = req.SourceFilters
+	feed.MinQualityScore = req.MinQualityScore
+	feed.Sections = req.Sections
+	feed.Language = req.Language
 	feed.UpdatedAt = time.Now()
 
 	err = r.executeAndUpsert(ctx, *feed)
@@ -180,12 +334,33 @@ func (r *Registry) Update(ctx context.Context, req UpdateRequest) (*Feed, error)
 	return feed, nil
 }
 
+func (r *Registry) validateSourceCount(sourceUIDs []activitytypes.TypedUID) error {
+	if r.config.MaxSourcesPerFeed > 0 && len(sourceUIDs) > r.config.MaxSourcesPerFeed {
+		return fmt.Errorf("%w: got %d, max %d", ErrTooManySources, len(sourceUIDs), r.config.MaxSourcesPerFeed)
+	}
+	return nil
+}
+
+// validateSourceOwners prevents the users from reading the custom sources of other users.
+func validateSourceOwners(sourceUIDs []activitytypes.TypedUID, userID string) error {
+	for _, uid := range sourceUIDs {
+		if err := custom.ValidateOwner(uid, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *Registry) executeAndUpsert(ctx context.Context, feed Feed) error {
 	err := r.feedRepository.Upsert(ctx, feed)
 	if err != nil {
 		return fmt.Errorf("upsert feed: %w", err)
 	}
 
+	if feed.Paused {
+		return nil
+	}
+
 	for _, sourceUID := range feed.SourceUIDs {
 		source, err := r.sourceRegistry.FindByUID(ctx, sourceUID)
 		if err != nil {
@@ -201,10 +376,49 @@ func (r *Registry) executeAndUpsert(ctx context.Context, feed Feed) error {
 	return nil
 }
 
+// SetPaused pauses or resumes polling of the feed's sources.
+// Unlike Remove, the feed configuration and its activities are preserved.
+func (r *Registry) SetPaused(ctx context.Context, uid string, userID string, paused bool) (*Feed, error) {
+	feed, err := r.activeFeed(ctx, uid)
+	if err != nil || feed.UserID != userID {
+		return nil, ErrFeedNotFound
+	}
+
+	if feed.Paused == paused {
+		return feed, nil
+	}
+
+	feed.Paused = paused
+	feed.UpdatedAt = time.Now()
+
+	// Re-adds (and re-initializes) the sources when resuming.
+	err = r.executeAndUpsert(ctx, *feed)
+	if err != nil {
+		return nil, fmt.Errorf("execute and upsert feed: %w", err)
+	}
+
+	if paused {
+		err = r.cleanupUnusedSources(ctx, feed.SourceUIDs)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("failed to cleanup unused sources")
+		}
+	}
+
+	return feed, nil
+}
+
+// Remove soft-deletes the feed, so that it can be restored within the retention window (see Restore),
+// or deletes it immediately if the retention is disabled.
 func (r *Registry) Remove(ctx context.Context, uid string, userID string) error {
-	feed, err := r.feedRepository.GetByID(ctx, uid)
+	feed, err := r.activeFeed(ctx, uid)
 	if err != nil || feed.UserID != userID {
-		return errors.New("feed not found")
+		return ErrFeedNotFound
+	}
+
+	if r.config.DeletedFeedRetention > 0 {
+		// The sources keep being polled until the feed is purged, so that the restored feeds have no gaps.
+		feed.DeletedAt = time.Now()
+		return r.feedRepository.Upsert(ctx, *feed)
 	}
 
 	err = r.feedRepository.Remove(ctx, uid)
@@ -220,8 +434,22 @@ func (r *Registry) Remove(ctx context.Context, uid string, userID string) error
 	return nil
 }
 
+// GetByID returns the feed if the user owns it or if it's public.
+func (r *Registry) GetByID(ctx context.Context, uid string, userID string) (*Feed, error) {
+	feed, err := r.activeFeed(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get feed: %w", err)
+	}
+	if feed.UserID != userID && !feed.Public {
+		return nil, ErrFeedNotFound
+	}
+
+	return feed, nil
+}
+
 // ListByUserID returns both the feeds that the user owns and public ones.
 // If userID is empty, only public feeds are returned.
+// The feeds are sorted by the user's preferred order (see SetOrder).
 func (r *Registry) ListByUserID(ctx context.Context, userID string) ([]*Feed, error) {
 	feeds, err := r.feedRepository.List(ctx)
 	if err != nil {
@@ -230,17 +458,42 @@ func (r *Registry) ListByUserID(ctx context.Context, userID string) ([]*Feed, er
 
 	authorizedFeeds := make([]*Feed, 0)
 	for _, feed := range feeds {
+		if feed.Deleted() {
+			continue
+		}
 		if feed.UserID == userID || feed.Public {
 			authorizedFeeds = append(authorizedFeeds, feed)
 		}
 	}
 
+	// Anonymous users can't order the feeds.
+	if userID == "" {
+		return authorizedFeeds, nil
+	}
+
+	positions, err := r.feedRepository.ListPositions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list feed positions: %w", err)
+	}
+	sortFeeds(authorizedFeeds, userID, positions)
+
 	return authorizedFeeds, nil
 }
 
 type ActivitiesResponse struct {
 	Results []*activitytypes.DecoratedActivity
 	Topics  []*Topic
+	// NextCursor is an opaque cursor for fetching the next page of results.
+	// Empty if the results can't be paginated or there are no more results.
+	NextCursor string
+	HasMore    bool
+	// QueryRewriteSkipped is true if the query rewrite was requested, but skipped due to failures.
+	QueryRewriteSkipped bool
+	// SourceOverrides are the display overrides of the feed sources, see Feed.SourceOverrides.
+	SourceOverrides map[string]SourceOverride
+	// ReadActivityIDs are the results read by the user (e.g. marked as read with MarkAllRead).
+	// Empty for anonymous users.
+	ReadActivityIDs map[string]bool
 }
 
 type Topic struct {
@@ -256,71 +509,414 @@ func (r *Registry) Activities(
 	feedID string,
 	userID string,
 	sortBy activitytypes.SortBy,
+	sortWeights *activities.SortWeights,
 	limit int,
 	query string,
 	period activitytypes.Period,
+	calendar activitytypes.Calendar,
 	rewriteQuery bool,
-) (*ActivitiesResponse, error) {
-	feed, err := r.feedRepository.GetByID(ctx, feedID)
+	cursor string,
+) (_ *ActivitiesResponse, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.Activities", attribute.String("feed_id", feedID), attribute.Bool("rewrite_query", rewriteQuery))
+	defer tracing.End(span, &err)
+
+	release, err := r.userSlots.acquire(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("get feed: %w", err)
+		return nil, err
 	}
+	defer release()
 
-	// Public feeds can be accessed by anyone (even non-authenticated user)
-	if feed.UserID != userID && !feed.Public {
-		return nil, errors.New("feed not found")
+	feed, err := r.authorizedFeed(ctx, feedID, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Unauthenticated users can't override the query to prevent (costly) abuse.
-	// Fallback to default query if override is empty.
-	if userID == "" || query == "" {
-		query = feed.Query
+	query, err = r.effectiveQuery(feed, userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	feedback, err := r.relevanceFeedback(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("load relevance feedback: %w", err)
+	}
+
+	res, err := r.feedActivities(ctx, feed, searchOptions{feedback: feedback, weights: sortWeights}, sortBy, limit, query, period, calendar, rewriteQuery, cursor)
+	if err != nil {
+		return nil, err
+	}
+	res.SourceOverrides = feed.SourceOverrides
+
+	res.ReadActivityIDs, err = r.readActivityIDs(ctx, userID, res.Results)
+	if err != nil {
+		return nil, fmt.Errorf("load read activities: %w", err)
+	}
+
+	return res, nil
+}
+
+// ActivitiesVersion returns an opaque version of the state the feed activities are computed from:
+// the feed settings, the stored activities of the feed sources, and the read state and relevance feedback of the user.
+// It's cheap compared to Activities, so that the clients can skip refetching the unchanged activities (e.g. with ETags).
+func (r *Registry) ActivitiesVersion(ctx context.Context, feedID string, userID string) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.ActivitiesVersion", attribute.String("feed_id", feedID))
+	defer tracing.End(span, &err)
+
+	feed, err := r.authorizedFeed(ctx, feedID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	stats, err := r.activityRegistry.Stats(ctx, feed.SourceUIDs)
+	if err != nil {
+		return "", fmt.Errorf("activity stats: %w", err)
+	}
+
+	params := []string{
+		userID,
+		strconv.FormatInt(feed.UpdatedAt.UnixNano(), 10),
+		strconv.Itoa(stats.Count),
+		strconv.FormatInt(stats.LatestCreatedAt.UnixNano(), 10),
+		strconv.Itoa(stats.UpdateCount),
+	}
+
+	if userID != "" {
+		lastReadAt, err := r.readActivityRepo.LastReadAt(ctx, userID)
+		if err != nil {
+			return "", fmt.Errorf("last read at: %w", err)
+		}
+		params = append(params, strconv.FormatInt(lastReadAt.UnixNano(), 10))
+	}
+
+	// The same feedback as used by relevanceFeedback, without loading the embeddings.
+	if userID != "" && r.feedbackRepo != nil && r.config.RelevanceFeedbackMaxActivities > 0 {
+		feedbacks, err := r.feedbackRepo.ListFeedback(ctx, userID, r.config.RelevanceFeedbackMaxActivities)
+		if err != nil {
+			return "", fmt.Errorf("list feedback: %w", err)
+		}
+		for _, feedback := range feedbacks {
+			params = append(params, fmt.Sprintf("%s=%d", feedback.ActivityID, feedback.Value))
+		}
 	}
 
+	return lib.HashParams(params...), nil
+}
+
+func (r *Registry) readActivityIDs(ctx context.Context, userID string, acts []*activitytypes.DecoratedActivity) (map[string]bool, error) {
+	if userID == "" || len(acts) == 0 {
+		return map[string]bool{}, nil
+	}
+
+	activityIDs := make([]string, len(acts))
+	for i, act := range acts {
+		activityIDs[i] = act.Activity.UID().String()
+	}
+
+	return r.readActivityRepo.ReadActivityIDs(ctx, userID, activityIDs)
+}
+
+func (r *Registry) feedActivities(
+	ctx context.Context,
+	feed *Feed,
+	opts searchOptions,
+	sortBy activitytypes.SortBy,
+	limit int,
+	query string,
+	period activitytypes.Period,
+	calendar activitytypes.Calendar,
+	rewriteQuery bool,
+	cursor string,
+) (*ActivitiesResponse, error) {
+	opts.sourceFilters = feed.SourceFilters
+
 	// Do not fallback to feed.Query,
 	// so that consumer can purposefully set an empty query.
+	rewriteSkipped := false
 	if query != "" && rewriteQuery && r.config.AllowQueryRewrite {
-		return r.searchByRewrittenQueries(ctx, feed.SourceUIDs, query, sortBy, period, limit)
+		if cursor != "" {
+			return nil, ErrPaginationUnsupported
+		}
+
+		res, err := r.searchByRewrittenQueries(ctx, opts, feed.SourceUIDs, feed.MinQualityScore, query, feed.Language, r.rewriteCacheKey(feed, query), sortBy, period, calendar, limit)
+		if !errors.Is(err, errQueryRewriteUnavailable) {
+			return res, err
+		}
+
+		// Keep the feeds responsive during LLM provider outages by searching without the rewrites.
+		r.logger.Warn().Err(err).
+			Str("feed_id", feed.ID).
+			Msg("query rewrite unavailable, searching without topic grouping")
+		rewriteSkipped = true
+	}
+
+	// Only date sort supports (cursor) pagination for now.
+	if sortBy == activitytypes.SortByDate {
+		res, err := r.searchPage(ctx, opts, feed.SourceUIDs, feed.MinQualityScore, period, calendar, query, limit, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if len(feed.Sections) > 0 {
+			res.Topics = topicsBySection(feed.Sections, res.Results)
+		}
+		res.QueryRewriteSkipped = rewriteSkipped
+		return res, nil
+	}
+
+	if cursor != "" {
+		return nil, ErrPaginationUnsupported
 	}
 
 	// Select top activities from each source to ensure variety
-	acts, err := r.search(ctx, feed.SourceUIDs, activitytypes.SortBySocialScore, period, query, limit)
+	acts, err := r.search(ctx, opts, feed.SourceUIDs, feed.SourceWeights, feed.MinQualityScore, activitytypes.SortBySocialScore, period, calendar, query, limit)
 	if err != nil {
 		return nil, fmt.Errorf("search: %w", err)
 	}
 
+	topics := r.topicsBySourceType(acts)
+	if len(feed.Sections) > 0 {
+		topics = topicsBySection(feed.Sections, acts)
+	}
+
 	return &ActivitiesResponse{
-		Results: acts,
-		Topics:  r.topicsBySourceType(acts),
+		Results:             acts,
+		Topics:              topics,
+		QueryRewriteSkipped: rewriteSkipped,
 	}, nil
 }
 
+// Topics returns only the topic breakdown of the feed activities, without the activities themselves.
+// Results are cached, since clients can lazy-load the activities per topic after rendering the topics.
+func (r *Registry) Topics(
+	ctx context.Context,
+	feedID string,
+	userID string,
+	limit int,
+	query string,
+	period activitytypes.Period,
+	calendar activitytypes.Calendar,
+) (_ []*Topic, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.Topics", attribute.String("feed_id", feedID))
+	defer tracing.End(span, &err)
+
+	// Authorize before the cache lookup, so that cached topics of private feeds aren't leaked.
+	feed, err := r.authorizedFeed(ctx, feedID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err = r.effectiveQuery(feed, userID, query)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := topicsCacheKey(feed, query, period, calendar, limit)
+
+	feedback, err := r.relevanceFeedback(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("load relevance feedback: %w", err)
+	}
+	if feedback != nil {
+		// The topics are personalized by the feedback, so they can't be shared with the other users.
+		cacheKey += ":" + userID
+	}
+
+	if cached, found := r.cache.Get(cacheKey); found {
+		if topics, ok := cached.([]*Topic); ok {
+			return topics, nil
+		}
+	}
+
+	// Only the cache misses take a slot, since they run the same query rewrite and search as the activities.
+	release, err := r.userSlots.acquire(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := r.feedActivities(ctx, feed, searchOptions{feedback: feedback}, activitytypes.SortByWeightedScore, limit, query, period, calendar, true, "")
+	if err != nil {
+		return nil, fmt.Errorf("list activities: %w", err)
+	}
+
+	// Don't cache the degraded topics, so that the rewritten topics are returned once the LLM provider recovers.
+	if !res.QueryRewriteSkipped {
+		r.cache.Set(cacheKey, res.Topics)
+	}
+
+	return res.Topics, nil
+}
+
+// Digest returns a narrative digest of the top feed activities for the period.
+// Results are cached per feed and period, like the topic summaries.
+func (r *Registry) Digest(
+	ctx context.Context,
+	feedID string,
+	userID string,
+	period activitytypes.Period,
+	calendar activitytypes.Calendar,
+) (_ []*FeedHighlight, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.Digest", attribute.String("feed_id", feedID))
+	defer tracing.End(span, &err)
+
+	// Authorize before the cache lookup, so that cached digests of private feeds aren't leaked.
+	feed, err := r.authorizedFeed(ctx, feedID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := digestCacheKey(feed, period, calendar)
+
+	if cached, found := r.cache.Get(cacheKey); found {
+		if highlights, ok := cached.([]*FeedHighlight); ok {
+			return highlights, nil
+		}
+	}
+
+	acts, err := r.search(ctx, searchOptions{sourceFilters: feed.SourceFilters}, feed.SourceUIDs, feed.SourceWeights, feed.MinQualityScore, activitytypes.SortBySocialScore, period, calendar, feed.Query, r.config.DigestMaxActivities)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	digest, err := r.summarizer.SummarizeDigest(ctx, period, acts)
+	if err != nil {
+		return nil, fmt.Errorf("summarize digest: %w", err)
+	}
+
+	highlights := make([]*FeedHighlight, 0, len(digest))
+	for _, highlight := range digest {
+		highlights = append(highlights, &FeedHighlight{
+			Content:          highlight.Content,
+			QuoteActivityIDs: highlight.ActivityIDs,
+		})
+	}
+
+	r.cache.Set(cacheKey, highlights)
+
+	return highlights, nil
+}
+
+// MarkAllRead marks all the feed activities created at or before the given time as read by the user.
+// Returns the number of newly marked activities.
+func (r *Registry) MarkAllRead(ctx context.Context, feedID string, userID string, before time.Time) (int, error) {
+	feed, err := r.authorizedFeed(ctx, feedID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.readActivityRepo.MarkAllRead(ctx, userID, feed.SourceUIDs, before)
+	if err != nil {
+		return 0, fmt.Errorf("mark activities as read: %w", err)
+	}
+
+	return count, nil
+}
+
+// authorizedFeed returns the feed if the user is allowed to read it.
+func (r *Registry) authorizedFeed(ctx context.Context, feedID string, userID string) (*Feed, error) {
+	feed, err := r.activeFeed(ctx, feedID)
+	if err != nil {
+		return nil, fmt.Errorf("get feed: %w", err)
+	}
+
+	// Public feeds can be accessed by anyone (even non-authenticated user)
+	if feed.UserID != userID && !feed.Public {
+		return nil, ErrFeedNotFound
+	}
+
+	return feed, nil
+}
+
+// activeFeed returns the feed, unless it was soft-deleted.
+func (r *Registry) activeFeed(ctx context.Context, feedID string) (*Feed, error) {
+	feed, err := r.feedRepository.GetByID(ctx, feedID)
+	if err != nil {
+		return nil, err
+	}
+	if feed.Deleted() {
+		return nil, ErrFeedNotFound
+	}
+	return feed, nil
+}
+
+// QueryEmbeddingCacheStats returns the hits of the search query embedding cache, or false if the cache is disabled.
+func (r *Registry) QueryEmbeddingCacheStats() (_ lib.CacheStats, found bool) {
+	return r.activityRegistry.QueryEmbeddingCacheStats()
+}
+
+// PublicQueryOverride reports whether unauthenticated users can override the query of public feeds.
+func (r *Registry) PublicQueryOverride() bool {
+	return r.config.PublicQueryOverride
+}
+
+// effectiveQuery returns the query that should be used to search the feed activities.
+func (r *Registry) effectiveQuery(feed *Feed, userID string, query string) (string, error) {
+	query, err := r.overrideQuery(feed, userID, query)
+	if err != nil {
+		return "", err
+	}
+
+	// Feeds created before the max length was introduced (or lowered) may still exceed it.
+	if err := r.validateQueryLength(query); err != nil {
+		return "", err
+	}
+
+	return query, nil
+}
+
+func (r *Registry) overrideQuery(feed *Feed, userID string, query string) (string, error) {
+	// Fallback to default query if override is empty.
+	if query == "" {
+		return feed.Query, nil
+	}
+
+	if userID != "" {
+		return query, nil
+	}
+
+	// Unauthenticated users can't override the query to prevent (costly) abuse,
+	// unless explicitly allowed for public feeds (with a stricter query length and rate limit).
+	if !r.config.PublicQueryOverride || !feed.Public {
+		return feed.Query, nil
+	}
+
+	if utf8.RuneCountInString(query) > r.config.PublicQueryMaxLength {
+		return "", fmt.Errorf("%w: max %d characters", ErrQueryTooLong, r.config.PublicQueryMaxLength)
+	}
+
+	return query, nil
+}
+
+// validateQueryLength limits the cost of the embedding and query rewrite requests, which grow with the query length.
+func (r *Registry) validateQueryLength(query string) error {
+	if r.config.MaxQueryLength > 0 && utf8.RuneCountInString(query) > r.config.MaxQueryLength {
+		return fmt.Errorf("%w: max %d characters", ErrQueryTooLong, r.config.MaxQueryLength)
+	}
+	return nil
+}
+
 func (r *Registry) searchByRewrittenQueries(
 	ctx context.Context,
+	opts searchOptions,
 	sourceUIDs []activitytypes.TypedUID,
+	minQualityScore float64,
 	query string,
+	language string,
+	rewriteCacheKey string,
 	sortBy activitytypes.SortBy,
 	period activitytypes.Period,
+	calendar activitytypes.Calendar,
 	limit int,
-) (*ActivitiesResponse, error) {
-	// For now list active sources from the scheduler instead of the source registry,
-	// since the source registry is fetching some sources from the 3rd party APIs and may hit rate limits.
-	feedSources, err := r.sourceScheduler.List(sources.ListRequest{
-		SourceUIDs: sourceUIDs,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("list sources: %w", err)
-	}
+) (_ *ActivitiesResponse, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.searchByRewrittenQueries")
+	defer tracing.End(span, &err)
 
-	topicQueryGroups, err := r.queryRewriter.RewriteToTopics(ctx, nlp.RewriteRequest{
-		Query:   query,
-		Sources: feedSources,
-	})
+	topicQueryGroups, err := r.rewriteToTopics(ctx, sourceUIDs, query, language, rewriteCacheKey)
 	if err != nil {
-		return nil, fmt.Errorf("rewrite query to topics: %w", err)
+		return nil, err
 	}
 
-	acts, activityToTopic, err := r.searchByTopicQueryGroups(ctx, sourceUIDs, topicQueryGroups, sortBy, period, limit)
+	topicQueryGroups = r.topicsWithinLimit(topicQueryGroups, limit)
+
+	acts, activityToTopic, err := r.searchByTopicQueryGroups(ctx, opts, sourceUIDs, minQualityScore, topicQueryGroups, sortBy, period, calendar, limit)
 	if err != nil {
 		return nil, fmt.Errorf("search by topic query groups: %w", err)
 	}
@@ -359,18 +955,157 @@ func (r *Registry) searchByRewrittenQueries(
 
 	return &ActivitiesResponse{
 		Results: acts,
-		Topics:  topics,
+		Topics:  r.rankTopics(topics),
 	}, nil
 }
 
+// rankTopics orders the topics by their number of activities, keeping the rewriter relevance order for ties,
+// and drops the topics without activities if CollapseEmptyTopics is enabled.
+func (r *Registry) rankTopics(topics []*Topic) []*Topic {
+	if r.config.CollapseEmptyTopics {
+		topics = slices.DeleteFunc(topics, func(topic *Topic) bool {
+			return len(topic.ActivityIDs) == 0
+		})
+	}
+
+	slices.SortStableFunc(topics, func(a, b *Topic) int {
+		return cmp.Compare(len(b.ActivityIDs), len(a.ActivityIDs))
+	})
+
+	return topics
+}
+
+// rewriteToTopics rewrites the query into topic query groups.
+// The rewrites are cached under the given key, unless it's empty.
+func (r *Registry) rewriteToTopics(
+	ctx context.Context,
+	sourceUIDs []activitytypes.TypedUID,
+	query string,
+	language string,
+	cacheKey string,
+) ([]*nlp.TopicQueryGroup, error) {
+	if cacheKey != "" {
+		if cached, found := r.rewriteCache.Get(cacheKey); found {
+			if topics, ok := cached.([]*nlp.TopicQueryGroup); ok {
+				return topics, nil
+			}
+		}
+	}
+
+	// For now list active sources from the scheduler instead of the source registry,
+	// since the source registry is fetching some sources from the 3rd party APIs and may hit rate limits.
+	feedSources, err := r.sourceScheduler.List(sources.ListRequest{
+		SourceUIDs: sourceUIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	if !r.rewriteBreaker.Allow() {
+		return nil, fmt.Errorf("%w: too many recent failures", errQueryRewriteUnavailable)
+	}
+
+	topics, err := r.queryRewriter.RewriteToTopics(ctx, nlp.RewriteRequest{
+		Query:     query,
+		Sources:   feedSources,
+		MaxTopics: r.config.MaxTopics,
+		Language:  language,
+	})
+	if err != nil {
+		// Canceled requests don't indicate an LLM provider failure.
+		if ctx.Err() != nil {
+			r.rewriteBreaker.Cancel()
+			return nil, fmt.Errorf("rewrite query to topics: %w", err)
+		}
+		if r.rewriteBreaker.Failure() {
+			r.logger.Error().Err(err).
+				Dur("cooldown", r.config.QueryRewriteCooldown).
+				Msg("query rewrites are failing, skipping them until the cooldown passes")
+		}
+		return nil, fmt.Errorf("%w: %w", errQueryRewriteUnavailable, err)
+	}
+	r.rewriteBreaker.Success()
+
+	if cacheKey != "" {
+		r.rewriteCache.Set(cacheKey, topics)
+	}
+
+	return topics, nil
+}
+
+// topicsCacheKey returns the key of the cached topics of the feed.
+// The topics are generated in the feed language and from the feed sources, so the cached topics are invalidated when either changes,
+// as well as on any other feed update (e.g. the sections or source weights).
+func topicsCacheKey(feed *Feed, query string, period activitytypes.Period, calendar activitytypes.Calendar, limit int) string {
+	return fmt.Sprintf("feed_topics:%s:%s:%s:%s:%d:%s:%s:%d", feed.ID, feed.Language, period, calendar, limit, lib.HashParams(query), sourceUIDsHash(feed.SourceUIDs), feed.UpdatedAt.UnixNano())
+}
+
+// digestCacheKey returns the key of the cached digest of the feed.
+// Like the topics, the cached digest is invalidated when the feed sources change or the feed is otherwise updated (e.g. the source filters).
+func digestCacheKey(feed *Feed, period activitytypes.Period, calendar activitytypes.Calendar) string {
+	return fmt.Sprintf("feed_digest:%s:%s:%s:%s:%s:%d", feed.ID, period, calendar, lib.HashParams(feed.Query), sourceUIDsHash(feed.SourceUIDs), feed.UpdatedAt.UnixNano())
+}
+
+// sourceUIDsHash returns a hash of the source UIDs, regardless of their order.
+func sourceUIDsHash(uids []activitytypes.TypedUID) string {
+	sourceUIDs := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		sourceUIDs = append(sourceUIDs, uid.String())
+	}
+	slices.Sort(sourceUIDs)
+	return lib.HashParams(sourceUIDs...)
+}
+
+// rewriteCacheKey returns the key of the cached query rewrites of the feed,
+// or an empty key if the rewrites of the query shouldn't be cached.
+func (r *Registry) rewriteCacheKey(feed *Feed, query string) string {
+	if r.config.QueryRewriteCacheTTL <= 0 {
+		return ""
+	}
+	if query != feed.Query && !r.config.QueryRewriteCacheOverrides {
+		return ""
+	}
+
+	// The rewrites depend on the feed sources, so they're invalidated when the sources change.
+	return fmt.Sprintf("feed_rewrite:%s:%d:%s:%s:%s", feed.ID, r.config.MaxTopics, feed.Language, lib.HashParams(query), sourceUIDsHash(feed.SourceUIDs))
+}
+
+// topicsWithinLimit drops the least relevant topics (ordered last by the query rewriter),
+// so that each remaining topic gets at least the configured min number of results within the limit.
+func (r *Registry) topicsWithinLimit(topics []*nlp.TopicQueryGroup, limit int) []*nlp.TopicQueryGroup {
+	maxTopics := len(topics)
+	if r.config.MaxTopics > 0 {
+		maxTopics = min(maxTopics, r.config.MaxTopics)
+	}
+	if r.config.MinResultsPerTopic > 0 {
+		maxTopics = min(maxTopics, max(1, limit/r.config.MinResultsPerTopic))
+	}
+
+	if len(topics) > maxTopics {
+		r.logger.Debug().
+			Int("topic_count", len(topics)).
+			Int("kept_topic_count", maxTopics).
+			Int("limit", limit).
+			Msg("dropping least relevant topics to fit the limit")
+		return topics[:maxTopics]
+	}
+	return topics
+}
+
 func (r *Registry) searchByTopicQueryGroups(
 	ctx context.Context,
+	opts searchOptions,
 	sourceUIDs []activitytypes.TypedUID,
+	minQualityScore float64,
 	topics []*nlp.TopicQueryGroup,
 	sortBy activitytypes.SortBy,
 	period activitytypes.Period,
+	calendar activitytypes.Calendar,
 	limit int,
-) ([]*activitytypes.DecoratedActivity, map[string]string, error) {
+) (_ []*activitytypes.DecoratedActivity, _ map[string]string, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.searchByTopicQueryGroups", attribute.Int("topic_count", len(topics)))
+	defer tracing.End(span, &err)
+
 	actsByGroupByQuery := make([][][]*activitytypes.DecoratedActivity, len(topics))
 
 	// Calculate limit per topic to ensure we don't exceed the total limit
@@ -380,19 +1115,61 @@ func (r *Registry) searchByTopicQueryGroups(
 	}
 
 	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(-1) // no limit
+	g.SetLimit(r.config.SearchConcurrency)
 
 	for ti, topic := range topics {
+		if pooling, ok := topicQueryPooling(r.config.TopicSearchStrategy); ok {
+			actsByGroupByQuery[ti] = make([][]*activitytypes.DecoratedActivity, 1)
+			if len(topic.Queries) == 0 {
+				continue
+			}
+			g.Go(func() (err error) {
+				qctx, span := tracing.Start(gctx, "feeds.searchTopicQueries", attribute.String("topic", topic.Name))
+				defer tracing.End(span, &err)
+
+				res, err := r.searchActivities(qctx, activities.SearchRequest{
+					Queries:         topic.Queries,
+					QueryPooling:    pooling,
+					SourceUIDs:      sourceUIDs,
+					MinSimilarity:   r.config.MinSimilarity,
+					MinQualityScore: minQualityScore,
+					Limit:           limitPerTopic,
+					SortBy:          sortBy,
+					Period:          period,
+					Calendar:        &calendar,
+					Feedback:        opts.feedback,
+					Weights:         opts.weights,
+					SourceFilters:   opts.sourceFilters,
+				})
+				if err != nil {
+					return fmt.Errorf("search activities for topic %s: %w", topic.Name, err)
+				}
+
+				actsByGroupByQuery[ti][0] = res.Activities
+
+				return nil
+			})
+			continue
+		}
+
 		actsByGroupByQuery[ti] = make([][]*activitytypes.DecoratedActivity, len(topic.Queries))
 		for qi, query := range topic.Queries {
-			g.Go(func() error {
-				res, err := r.activityRegistry.Search(gctx, activities.SearchRequest{
-					Query:         query,
-					SourceUIDs:    sourceUIDs,
-					MinSimilarity: r.config.MinSimilarity,
-					Limit:         limitPerTopic,
-					SortBy:        sortBy,
-					Period:        period,
+			g.Go(func() (err error) {
+				qctx, span := tracing.Start(gctx, "feeds.searchTopicQuery", attribute.String("topic", topic.Name))
+				defer tracing.End(span, &err)
+
+				res, err := r.searchActivities(qctx, activities.SearchRequest{
+					Query:           query,
+					SourceUIDs:      sourceUIDs,
+					MinSimilarity:   r.config.MinSimilarity,
+					MinQualityScore: minQualityScore,
+					Limit:           limitPerTopic,
+					SortBy:          sortBy,
+					Period:          period,
+					Calendar:        &calendar,
+					Feedback:        opts.feedback,
+					Weights:         opts.weights,
+					SourceFilters:   opts.sourceFilters,
 				})
 				if err != nil {
 					return fmt.Errorf("search activities for topic %s: %w", topic.Name, err)
@@ -426,13 +1203,26 @@ func (r *Registry) searchByTopicQueryGroups(
 		}
 	}
 
-	sort.Slice(acts, func(i, j int) bool {
-		return acts[i].Similarity > acts[j].Similarity
+	sortActivities(acts, func(a, b *activitytypes.DecoratedActivity) int {
+		return cmp.Compare(b.Similarity, a.Similarity)
 	})
 
 	return acts, activityToTopic, nil
 }
 
+// topicQueryPooling returns the pooling used to search the topic queries at once,
+// or false if each query should be searched separately.
+func topicQueryPooling(strategy string) (activities.QueryPooling, bool) {
+	switch strategy {
+	case "mean":
+		return activities.QueryPoolingMean, true
+	case "max":
+		return activities.QueryPoolingMax, true
+	default:
+		return "", false
+	}
+}
+
 func (r *Registry) summarizeTopics(
 	ctx context.Context,
 	period activitytypes.Period,
@@ -492,7 +1282,7 @@ func (r *Registry) summarizeTopicWithCache(
 		return "", nil
 	}
 
-	cacheKey := fmt.Sprintf("topic_summary:%s:%s", period, topic.Name)
+	cacheKey := r.topicSummaryCacheKey(period, topic, activities)
 
 	if cached, found := r.cache.Get(cacheKey); found {
 		if summary, ok := cached.(string); ok {
@@ -518,29 +1308,106 @@ func (r *Registry) summarizeTopicWithCache(
 	return summary, nil
 }
 
+func (r *Registry) topicSummaryCacheKey(
+	period activitytypes.Period,
+	topic *nlp.TopicQueryGroup,
+	activities []*activitytypes.DecoratedActivity,
+) string {
+	key := fmt.Sprintf("topic_summary:%s:%s", period, topic.Name)
+	if r.config.TopicSummaryCacheKey != "activities" {
+		return key
+	}
+
+	// Sort the IDs, since the activities aren't ordered consistently.
+	activityIDs := make([]string, 0, len(activities))
+	for _, act := range activities {
+		activityIDs = append(activityIDs, act.Activity.UID().String())
+	}
+	slices.Sort(activityIDs)
+
+	return fmt.Sprintf("%s:%s", key, lib.HashParams(activityIDs...))
+}
+
+// searchPage returns a page of the latest activities across all sources.
+// Unlike search, the results are not weighted nor diversified by source, since that can't be done consistently across pages.
+func (r *Registry) searchPage(
+	ctx context.Context,
+	opts searchOptions,
+	sourceUIDs []activitytypes.TypedUID,
+	minQualityScore float64,
+	period activitytypes.Period,
+	calendar activitytypes.Calendar,
+	query string,
+	limit int,
+	cursor string,
+) (_ *ActivitiesResponse, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.searchPage", attribute.Int("source_count", len(sourceUIDs)))
+	defer tracing.End(span, &err)
+
+	if len(sourceUIDs) == 0 {
+		return &ActivitiesResponse{}, nil
+	}
+
+	result, err := r.searchActivities(ctx, activities.SearchRequest{
+		SourceUIDs:      sourceUIDs,
+		SortBy:          activitytypes.SortByDate,
+		Period:          period,
+		Calendar:        &calendar,
+		Limit:           limit,
+		Query:           query,
+		Cursor:          cursor,
+		MinSimilarity:   r.config.MinSimilarity,
+		MinQualityScore: minQualityScore,
+		Feedback:        opts.feedback,
+		Weights:         opts.weights,
+		SourceFilters:   opts.sourceFilters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search activities: %w", err)
+	}
+
+	return &ActivitiesResponse{
+		Results:    result.Activities,
+		Topics:     r.topicsBySourceType(result.Activities),
+		NextCursor: result.NextCursor,
+		HasMore:    result.HasMore,
+	}, nil
+}
+
 // search selects top activities from each source to ensure diversity
 func (r *Registry) search(
 	ctx context.Context,
+	opts searchOptions,
 	sourceUIDs []activitytypes.TypedUID,
+	sourceWeights map[string]float64,
+	minQualityScore float64,
 	sortBy activitytypes.SortBy,
 	period activitytypes.Period,
+	calendar activitytypes.Calendar,
 	query string,
 	limit int,
-) ([]*activitytypes.DecoratedActivity, error) {
+) (_ []*activitytypes.DecoratedActivity, err error) {
+	ctx, span := tracing.Start(ctx, "feeds.search", attribute.Int("source_count", len(sourceUIDs)))
+	defer tracing.End(span, &err)
 
 	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(10)
+	g.SetLimit(r.config.SearchConcurrency)
 
 	activitiesBySourceIndex := make([][]*activitytypes.DecoratedActivity, len(sourceUIDs))
 	for i, sourceUID := range sourceUIDs {
 		g.Go(func() error {
-			result, err := r.activityRegistry.Search(gctx, activities.SearchRequest{
-				SourceUIDs:    []activitytypes.TypedUID{sourceUID},
-				SortBy:        sortBy,
-				Period:        period,
-				Limit:         limit,
-				Query:         query,
-				MinSimilarity: r.config.MinSimilarity,
+			result, err := r.searchActivities(gctx, activities.SearchRequest{
+				SourceUIDs:      []activitytypes.TypedUID{sourceUID},
+				SortBy:          sortBy,
+				Period:          period,
+				Calendar:        &calendar,
+				Limit:           limit,
+				Query:           query,
+				MinSimilarity:   r.config.MinSimilarity,
+				MinQualityScore: minQualityScore,
+				Feedback:        opts.feedback,
+				Weights:         opts.weights,
+				SourceFilters:   opts.sourceFilters,
 			})
 			if err != nil {
 				return fmt.Errorf("search activities for source %s: %w", sourceUID, err)
@@ -561,7 +1428,8 @@ func (r *Registry) search(
 
 	// Activity can be associated with multiple sources,
 	// so we need to deduplicate them.
-	activitiesBySource := make(map[activitytypes.TypedUID][]*activitytypes.DecoratedActivity)
+	activitiesBySource := make([][]*activitytypes.DecoratedActivity, len(sourceUIDs))
+	weights := make([]float64, len(sourceUIDs))
 	seenActivities := make(map[string]bool)
 	for i, activities := range activitiesBySourceIndex {
 		unseenActivities := make([]*activitytypes.DecoratedActivity, 0)
@@ -571,45 +1439,37 @@ func (r *Registry) search(
 				seenActivities[activity.Activity.UID().String()] = true
 			}
 		}
-		activitiesBySource[sourceUIDs[i]] = unseenActivities
+		activitiesBySource[i] = unseenActivities
+		weights[i] = sourceWeights[sourceUIDs[i].String()]
 	}
 
-	allActivities := make([]*activitytypes.DecoratedActivity, 0)
-	remainingLimit := limit
-
-	for remainingLimit > 0 {
-		prevRemainingLimit := remainingLimit
-		limitPerSource := remainingLimit / len(activitiesBySource)
-		for sourceUID, activities := range activitiesBySource {
-			takeCount := min(limitPerSource, len(activities))
-			takeCount = min(takeCount, remainingLimit)
-
-			if takeCount > 0 {
-				allActivities = append(allActivities, activities[:takeCount]...)
-				remainingLimit -= takeCount
-				activitiesBySource[sourceUID] = activities[takeCount:]
-			}
-		}
-		if prevRemainingLimit == remainingLimit {
-			// no more activities to take
-			break
-		}
-	}
+	allActivities := interleaveByWeight(activitiesBySource, weights, limit)
 
 	switch sortBy {
 	case activitytypes.SortByDate:
-		sort.Slice(allActivities, func(i, j int) bool {
-			return allActivities[i].Activity.CreatedAt().After(allActivities[j].Activity.CreatedAt())
+		sortActivities(allActivities, func(a, b *activitytypes.DecoratedActivity) int {
+			return b.Activity.CreatedAt().Compare(a.Activity.CreatedAt())
 		})
 	case activitytypes.SortBySocialScore:
-		sort.Slice(allActivities, func(i, j int) bool {
-			return allActivities[i].Activity.SocialScore() > allActivities[j].Activity.SocialScore()
+		sortActivities(allActivities, func(a, b *activitytypes.DecoratedActivity) int {
+			return cmp.Compare(b.Activity.SocialScore(), a.Activity.SocialScore())
 		})
 	}
 
 	return allActivities, nil
 }
 
+// sortActivities sorts the activities with the descending activity UID as a tiebreaker (same as the date cursors),
+// so that the activities with equal scores don't reorder between identical requests (e.g. UI flicker, pagination gaps).
+func sortActivities(acts []*activitytypes.DecoratedActivity, compare func(a, b *activitytypes.DecoratedActivity) int) {
+	slices.SortStableFunc(acts, func(a, b *activitytypes.DecoratedActivity) int {
+		return cmp.Or(
+			compare(a, b),
+			cmp.Compare(b.Activity.UID().String(), a.Activity.UID().String()),
+		)
+	})
+}
+
 func (r *Registry) topicsBySourceType(activities []*activitytypes.DecoratedActivity) []*Topic {
 	activitiesByTopic := make(map[topicKey][]string)
 	for _, activity := range activities {
@@ -641,23 +1501,118 @@ func (r *Registry) topicsBySourceType(activities []*activitytypes.DecoratedActiv
 	return topics
 }
 
-func (r *Registry) cleanupUnusedSources(ctx context.Context, sourceUIDs []activitytypes.TypedUID) error {
-	if len(sourceUIDs) == 0 {
-		return nil
+// topicsBySection groups the activities by the feed sections, in the order of the sections.
+// Activities can be in multiple sections, if their sources are. Activities that aren't in any section are grouped under "Other".
+func topicsBySection(sections []FeedSection, activities []*activitytypes.DecoratedActivity) []*Topic {
+	topics := make([]*Topic, 0, len(sections)+1)
+	other := &Topic{Title: "Other", Emoji: "📦"}
+	sectionSources := make([]map[string]bool, len(sections))
+	for i, section := range sections {
+		topics = append(topics, &Topic{Title: section.Name, Emoji: section.Icon, ActivityIDs: []string{}})
+		sectionSources[i] = make(map[string]bool, len(section.SourceUIDs))
+		for _, uid := range section.SourceUIDs {
+			sectionSources[i][uid.String()] = true
+		}
 	}
 
+	for _, activity := range activities {
+		activityID := activity.Activity.UID().String()
+		inSection := false
+		for i := range sections {
+			for _, sourceUID := range activity.Activity.SourceUIDs() {
+				if sectionSources[i][sourceUID.String()] {
+					topics[i].ActivityIDs = append(topics[i].ActivityIDs, activityID)
+					inSection = true
+					break
+				}
+			}
+		}
+		if !inSection {
+			other.ActivityIDs = append(other.ActivityIDs, activityID)
+		}
+	}
+
+	if len(other.ActivityIDs) > 0 {
+		topics = append(topics, other)
+	}
+
+	return topics
+}
+
+// UsedSourceUIDs returns the subset of the given source UIDs that are used by at least one feed.
+// Sources only referenced by paused feeds are not considered used.
+// Sources of the soft-deleted feeds are used until the feeds are purged, since they can still be restored.
+func (r *Registry) UsedSourceUIDs(ctx context.Context, sourceUIDs []activitytypes.TypedUID) (map[string]bool, error) {
 	feedsUsingSource, err := r.feedRepository.FindBySourceUIDs(ctx, sourceUIDs)
 	if err != nil {
-		return fmt.Errorf("find feeds by source UIDs: %w", err)
+		return nil, fmt.Errorf("find feeds by source UIDs: %w", err)
 	}
 
 	usedSourceUIDs := make(map[string]bool)
 	for _, feed := range feedsUsingSource {
+		if feed.Paused {
+			continue
+		}
 		for _, uid := range feed.SourceUIDs {
 			usedSourceUIDs[uid.String()] = true
 		}
 	}
 
+	return usedSourceUIDs, nil
+}
+
+// KeepActivity reports whether any feed using the activity sources keeps the activity with its keyword filters (see Feed.SourceFilters).
+// The activities of the sources without feeds are kept.
+func (r *Registry) KeepActivity(ctx context.Context, activity activitytypes.Activity) (bool, error) {
+	feeds, err := r.feedRepository.FindBySourceUIDs(ctx, activity.SourceUIDs())
+	if err != nil {
+		return false, fmt.Errorf("find feeds by source UIDs: %w", err)
+	}
+
+	used := false
+	for _, feed := range feeds {
+		for _, uid := range activity.SourceUIDs() {
+			if !slices.ContainsFunc(feed.SourceUIDs, func(feedUID activitytypes.TypedUID) bool { return feedUID.String() == uid.String() }) {
+				continue
+			}
+			used = true
+			if feed.SourceFilters[uid.String()].Keeps(activity) {
+				return true, nil
+			}
+		}
+	}
+
+	return !used, nil
+}
+
+// SourceOwnerIDs returns the users with (not deleted) feeds that use the source.
+func (r *Registry) SourceOwnerIDs(ctx context.Context, sourceUID activitytypes.TypedUID) ([]string, error) {
+	feeds, err := r.feedRepository.FindBySourceUIDs(ctx, []activitytypes.TypedUID{sourceUID})
+	if err != nil {
+		return nil, fmt.Errorf("find feeds by source UIDs: %w", err)
+	}
+
+	ownerIDs := make([]string, 0, len(feeds))
+	for _, feed := range feeds {
+		if feed.Deleted() || slices.Contains(ownerIDs, feed.UserID) {
+			continue
+		}
+		ownerIDs = append(ownerIDs, feed.UserID)
+	}
+
+	return ownerIDs, nil
+}
+
+func (r *Registry) cleanupUnusedSources(ctx context.Context, sourceUIDs []activitytypes.TypedUID) error {
+	if len(sourceUIDs) == 0 {
+		return nil
+	}
+
+	usedSourceUIDs, err := r.UsedSourceUIDs(ctx, sourceUIDs)
+	if err != nil {
+		return err
+	}
+
 	for _, uid := range sourceUIDs {
 		if !usedSourceUIDs[uid.String()] {
 			err := r.sourceScheduler.Remove(uid.String())
@@ -698,15 +1653,24 @@ func (t topicKey) Title() string {
 	return strings.Split(string(t), "|")[1]
 }
 
+// SourceTypeLabel returns the display emoji and label of the source type's provider.
+func SourceTypeLabel(sourceType string) (emoji string, label string, err error) {
+	key, err := sourceTypeToTopicKey(sourceType)
+	if err != nil {
+		return "", "", err
+	}
+	return key.Emoji(), key.Title(), nil
+}
+
 func sourceTypeToTopicKey(in string) (topicKey, error) {
 	switch in {
-	case mastodon.TypeMastodonAccount, mastodon.TypeMastodonTag:
+	case mastodon.TypeMastodonAccount, mastodon.TypeMastodonTag, mastodon.TypeMastodonTrending:
 		return newTopicKey("🐘", "Mastodon"), nil
 	case hackernews.TypeHackerNewsPosts:
 		return newTopicKey("🧑‍💻", "HackerNews"), nil
 	case reddit.TypeRedditSubreddit:
 		return newTopicKey("🔥", "Reddit"), nil
-	case lobsters.TypeLobstersTag, lobsters.TypeLobstersFeed:
+	case lobsters.TypeLobstersTag, lobsters.TypeLobstersFeed, lobsters.TypeLobstersComments:
 		return newTopicKey("🐙", "Lobsters"), nil
 	case rss.TypeRSSFeed:
 		return newTopicKey("📰", "RSS Feeds"), nil
@@ -716,6 +1680,10 @@ func sourceTypeToTopicKey(in string) (topicKey, error) {
 		return newTopicKey("⭐", "Github Repositories"), nil
 	case producthunt.TypeProductHuntPosts:
 		return newTopicKey("🚀", "Product Hunt"), nil
+	case custom.TypeCustom:
+		return newTopicKey("🔌", "Custom"), nil
+	case jsonapi.TypeJSONAPIEndpoint:
+		return newTopicKey("🧩", "JSON APIs"), nil
 	}
 
 	return "", fmt.Errorf("unknown source type: %s", in)
@@ -736,3 +1704,68 @@ func findRemovedSourceUIDs(oldUIDs, newUIDs []activitytypes.TypedUID) []activity
 
 	return removed
 }
+
+// searchActivities waits for a free search slot before searching the activities.
+func (r *Registry) searchActivities(ctx context.Context, req activities.SearchRequest) (*activitytypes.SearchResult, error) {
+	select {
+	case r.searchSlots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-r.searchSlots }()
+
+	return r.activityRegistry.Search(ctx, req)
+}
+
+// searchOptions are the per-request search inputs, passed to each activities search of the request.
+type searchOptions struct {
+	// feedback adjusts the query embeddings towards the liked activities of the user, nil disables it.
+	feedback *activities.RelevanceFeedback
+	// weights override the weighted score weights, nil uses the defaults.
+	weights *activities.SortWeights
+	// sourceFilters are the keyword filters of the feed sources (see Feed.SourceFilters).
+	sourceFilters map[string]activitytypes.KeywordFilter
+}
+
+// interleaveByWeight picks up to limit activities from the per-source lists using smooth weighted round-robin,
+// so that each source gets a share of the slots proportional to its weight.
+// Non-positive weights default to 1. Slots of exhausted sources are redistributed to the remaining ones.
+func interleaveByWeight(activitiesBySource [][]*activitytypes.DecoratedActivity, weights []float64, limit int) []*activitytypes.DecoratedActivity {
+	result := make([]*activitytypes.DecoratedActivity, 0, limit)
+	offsets := make([]int, len(activitiesBySource))
+	current := make([]float64, len(activitiesBySource))
+
+	weightAt := func(i int) float64 {
+		if i < len(weights) && weights[i] > 0 {
+			return weights[i]
+		}
+		return 1
+	}
+
+	for len(result) < limit {
+		selected := -1
+		totalWeight := 0.0
+		for i, activities := range activitiesBySource {
+			if offsets[i] >= len(activities) {
+				continue
+			}
+			weight := weightAt(i)
+			current[i] += weight
+			totalWeight += weight
+			// Ties are broken by source order to keep the result deterministic.
+			if selected == -1 || current[i] > current[selected] {
+				selected = i
+			}
+		}
+		if selected == -1 {
+			// no more activities to take
+			break
+		}
+
+		current[selected] -= totalWeight
+		result = append(result, activitiesBySource[selected][offsets[selected]])
+		offsets[selected]++
+	}
+
+	return result
+}
@@ -0,0 +1,898 @@
+package feeds
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/nlp"
+	"github.com/rs/zerolog"
+)
+
+// TestInterleaveByWeight checks how many slots each source gets in the diversity interleaving.
+func TestInterleaveByWeight(t *testing.T) {
+	tests := []struct {
+		name        string
+		sourceSizes []int
+		weights     []float64
+		limit       int
+		want        []int
+	}{
+		{
+			name:        "equal weights split evenly",
+			sourceSizes: []int{10, 10, 10},
+			weights:     nil,
+			limit:       6,
+			want:        []int{2, 2, 2},
+		},
+		{
+			name:        "uneven weights",
+			sourceSizes: []int{10, 10},
+			weights:     []float64{3, 1},
+			limit:       8,
+			want:        []int{6, 2},
+		},
+		{
+			name:        "uneven weights across three sources",
+			sourceSizes: []int{10, 10, 10},
+			weights:     []float64{1, 2, 1},
+			limit:       8,
+			want:        []int{2, 4, 2},
+		},
+		{
+			name:        "limit smaller than source count",
+			sourceSizes: []int{5, 5, 5, 5},
+			weights:     nil,
+			limit:       2,
+			want:        []int{1, 1, 0, 0},
+		},
+		{
+			name:        "limit smaller than source count prefers heavier sources",
+			sourceSizes: []int{5, 5, 5, 5},
+			weights:     []float64{1, 1, 4, 2},
+			limit:       2,
+			want:        []int{0, 0, 1, 1},
+		},
+		{
+			name:        "exhausted source slots are redistributed",
+			sourceSizes: []int{1, 10},
+			weights:     []float64{5, 1},
+			limit:       4,
+			want:        []int{1, 3},
+		},
+		{
+			name:        "non-positive weights default to 1",
+			sourceSizes: []int{10, 10},
+			weights:     []float64{0, -2},
+			limit:       4,
+			want:        []int{2, 2},
+		},
+		{
+			name:        "fewer activities than limit",
+			sourceSizes: []int{1, 2},
+			weights:     nil,
+			limit:       10,
+			want:        []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activitiesBySource := make([][]*activitytypes.DecoratedActivity, len(tt.sourceSizes))
+			sourceByActivity := make(map[*activitytypes.DecoratedActivity]int)
+			for i, size := range tt.sourceSizes {
+				for j := 0; j < size; j++ {
+					act := &activitytypes.DecoratedActivity{
+						Summary: &activitytypes.ActivitySummary{ShortSummary: fmt.Sprintf("%d-%d", i, j)},
+					}
+					activitiesBySource[i] = append(activitiesBySource[i], act)
+					sourceByActivity[act] = i
+				}
+			}
+
+			result := interleaveByWeight(activitiesBySource, tt.weights, tt.limit)
+
+			got := make([]int, len(tt.sourceSizes))
+			for _, act := range result {
+				got[sourceByActivity[act]]++
+			}
+
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("expected counts %v, got %v", tt.want, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+// TestInterleaveByWeight_PreservesSourceOrder checks that activities are taken from the top of each source.
+func TestInterleaveByWeight_PreservesSourceOrder(t *testing.T) {
+	a := []*activitytypes.DecoratedActivity{{Similarity: 0.9}, {Similarity: 0.8}, {Similarity: 0.7}}
+	b := []*activitytypes.DecoratedActivity{{Similarity: 0.6}}
+
+	result := interleaveByWeight([][]*activitytypes.DecoratedActivity{a, b}, []float64{2, 1}, 3)
+	if len(result) != 3 {
+		t.Fatalf("expected 3 activities, got %d", len(result))
+	}
+
+	if result[0] != a[0] || result[1] != b[0] || result[2] != a[1] {
+		t.Errorf("unexpected interleaving order")
+	}
+}
+
+// TestSortActivities_Deterministic checks that the activities with equal dates and scores
+// are sorted in the same order, regardless of the input order.
+func TestSortActivities_Deterministic(t *testing.T) {
+	newActivities := func(ids ...string) []*activitytypes.DecoratedActivity {
+		out := make([]*activitytypes.DecoratedActivity, len(ids))
+		for i, id := range ids {
+			out[i] = &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: id}, Similarity: 0.5}
+		}
+		return out
+	}
+	compares := map[string]func(a, b *activitytypes.DecoratedActivity) int{
+		"date": func(a, b *activitytypes.DecoratedActivity) int {
+			return b.Activity.CreatedAt().Compare(a.Activity.CreatedAt())
+		},
+		"similarity": func(a, b *activitytypes.DecoratedActivity) int {
+			return cmp.Compare(b.Similarity, a.Similarity)
+		},
+	}
+
+	for name, compare := range compares {
+		t.Run(name, func(t *testing.T) {
+			first := newActivities("b", "d", "a", "c")
+			second := newActivities("c", "a", "d", "b")
+			sortActivities(first, compare)
+			sortActivities(second, compare)
+
+			if !slices.Equal(activityIDs(first), activityIDs(second)) {
+				t.Fatalf("expected identical order, got %v and %v", activityIDs(first), activityIDs(second))
+			}
+			if got := activityIDs(first); !slices.Equal(got, []string{"d", "c", "b", "a"}) {
+				t.Errorf("expected descending UID tiebreaker, got %v", got)
+			}
+		})
+	}
+}
+
+func activityIDs(acts []*activitytypes.DecoratedActivity) []string {
+	out := make([]string, len(acts))
+	for i, act := range acts {
+		out[i] = act.Activity.Title()
+	}
+	return out
+}
+
+// TestSearch_ConcurrencyLimit simulates a feed with many sources to check that concurrent searches are capped.
+func TestSearch_ConcurrencyLimit(t *testing.T) {
+	tests := []struct {
+		name                  string
+		searchConcurrency     int
+		maxConcurrentSearches int
+		parallelRequests      int
+		wantMax               int32
+	}{
+		{
+			name:                  "per request limit",
+			searchConcurrency:     3,
+			maxConcurrentSearches: 100,
+			parallelRequests:      1,
+			wantMax:               3,
+		},
+		{
+			name:                  "shared limit across requests",
+			searchConcurrency:     10,
+			maxConcurrentSearches: 4,
+			parallelRequests:      5,
+			wantMax:               4,
+		},
+	}
+
+	logger := zerolog.Nop()
+	sourceUIDs := make([]activitytypes.TypedUID, 50)
+	for i := range sourceUIDs {
+		sourceUIDs[i] = lib.NewTypedUID("test", fmt.Sprintf("source-%d", i))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Records the max number of concurrent searches.
+			var current, maxCurrent atomic.Int32
+			store := &fakeActivityStore{search: func(activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+				curr := current.Add(1)
+				defer current.Add(-1)
+
+				for {
+					prevMax := maxCurrent.Load()
+					if curr <= prevMax || maxCurrent.CompareAndSwap(prevMax, curr) {
+						break
+					}
+				}
+
+				time.Sleep(5 * time.Millisecond)
+
+				return &activitytypes.SearchResult{}, nil
+			}}
+			activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{})
+			registry := NewRegistry(nil, nil, nil, nil, nil, activityRegistry, nil, nil, &Config{
+				SearchConcurrency:     tt.searchConcurrency,
+				MaxConcurrentSearches: tt.maxConcurrentSearches,
+			}, &logger)
+
+			var wg sync.WaitGroup
+			for range tt.parallelRequests {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := registry.search(context.Background(), searchOptions{}, sourceUIDs, nil, 0, activitytypes.SortBySocialScore, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), "", 20)
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := maxCurrent.Load(); got > tt.wantMax {
+				t.Errorf("expected at most %d concurrent searches, got %d", tt.wantMax, got)
+			}
+		})
+	}
+}
+
+func TestEffectiveQuery(t *testing.T) {
+	publicFeed := &Feed{Query: "default", Public: true}
+	privateFeed := &Feed{Query: "default", UserID: "owner"}
+
+	tests := []struct {
+		name                string
+		publicQueryOverride bool
+		maxQueryLength      int
+		feed                *Feed
+		userID              string
+		query               string
+		want                string
+		wantErr             error
+	}{
+		{name: "empty override", feed: privateFeed, userID: "owner", want: "default"},
+		{name: "authenticated override", feed: privateFeed, userID: "owner", query: "go", want: "go"},
+		{name: "unauthenticated override disabled", feed: publicFeed, query: "go", want: "default"},
+		{name: "unauthenticated override enabled", publicQueryOverride: true, feed: publicFeed, query: "go", want: "go"},
+		{name: "unauthenticated override of private feed", publicQueryOverride: true, feed: privateFeed, query: "go", want: "default"},
+		{name: "unauthenticated override too long", publicQueryOverride: true, feed: publicFeed, query: "golang news", wantErr: ErrQueryTooLong},
+		{name: "authenticated override ignores max length", publicQueryOverride: true, feed: publicFeed, userID: "user", query: "golang news", want: "golang news"},
+		{name: "authenticated override too long", maxQueryLength: 8, feed: privateFeed, userID: "owner", query: "golang news", wantErr: ErrQueryTooLong},
+		{name: "default query too long", maxQueryLength: 5, feed: &Feed{Query: "golang news", UserID: "owner"}, userID: "owner", wantErr: ErrQueryTooLong},
+		{name: "default query within max length", maxQueryLength: 8, feed: privateFeed, userID: "owner", want: "default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Registry{config: &Config{
+				PublicQueryOverride:  tt.publicQueryOverride,
+				PublicQueryMaxLength: 5,
+				MaxQueryLength:       tt.maxQueryLength,
+			}}
+
+			got, err := r.effectiveQuery(tt.feed, tt.userID, tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected query %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// countingEmbedder counts the embedding requests.
+type countingEmbedder struct {
+	calls atomic.Int32
+}
+
+func (e *countingEmbedder) EmbedActivity(_ context.Context, _ activitytypes.Activity, _ *activitytypes.ActivitySummary) ([]float32, error) {
+	e.calls.Add(1)
+	return []float32{1, 0}, nil
+}
+
+func (e *countingEmbedder) EmbedActivityQuery(_ context.Context, _ string) ([]float32, error) {
+	e.calls.Add(1)
+	return []float32{1, 0}, nil
+}
+
+func (e *countingEmbedder) Model() string { return "test" }
+
+func (e *countingEmbedder) EmbedActivityQueries(_ context.Context, queries []string) ([][]float32, error) {
+	e.calls.Add(1)
+	out := make([][]float32, len(queries))
+	for i := range queries {
+		out[i] = []float32{float32(i), 1}
+	}
+	return out, nil
+}
+
+// searchCounter counts the searches of its activity store, each taking the given latency.
+type searchCounter struct {
+	latency  time.Duration
+	searches atomic.Int32
+	// limit is the limit of the last search.
+	limit atomic.Int32
+}
+
+func (c *searchCounter) store() *fakeActivityStore {
+	return &fakeActivityStore{search: func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+		c.searches.Add(1)
+		c.limit.Store(int32(req.Limit))
+		time.Sleep(c.latency)
+		return &activitytypes.SearchResult{}, nil
+	}}
+}
+
+func newTopicSearchRegistry(strategy string, counter *searchCounter, embedder *countingEmbedder) *Registry {
+	logger := zerolog.Nop()
+	activityRegistry := activities.NewRegistry(&logger, counter.store(), nil, embedder, &activities.Config{})
+	return NewRegistry(nil, nil, nil, nil, nil, activityRegistry, nil, nil, &Config{
+		SearchConcurrency:     10,
+		MaxConcurrentSearches: 20,
+		TopicSearchStrategy:   strategy,
+	}, &logger)
+}
+
+func testTopics(topicCount, queriesPerTopic int) []*nlp.TopicQueryGroup {
+	topics := make([]*nlp.TopicQueryGroup, topicCount)
+	for i := range topics {
+		queries := make([]string, queriesPerTopic)
+		for j := range queries {
+			queries[j] = fmt.Sprintf("topic %d query %d", i, j)
+		}
+		topics[i] = &nlp.TopicQueryGroup{Name: fmt.Sprintf("topic %d", i), Queries: queries}
+	}
+	return topics
+}
+
+func TestSearchByTopicQueryGroups_Strategy(t *testing.T) {
+	tests := []struct {
+		strategy       string
+		wantSearches   int32
+		wantEmbeddings int32
+	}{
+		{strategy: "per_query", wantSearches: 12, wantEmbeddings: 12},
+		{strategy: "mean", wantSearches: 4, wantEmbeddings: 4},
+		{strategy: "max", wantSearches: 4, wantEmbeddings: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			counter := &searchCounter{}
+			embedder := &countingEmbedder{}
+			registry := newTopicSearchRegistry(tt.strategy, counter, embedder)
+
+			_, _, err := registry.searchByTopicQueryGroups(context.Background(), searchOptions{}, nil, 0, testTopics(4, 3), activitytypes.SortBySimilarity, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), 20)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := counter.searches.Load(); got != tt.wantSearches {
+				t.Errorf("expected %d searches, got %d", tt.wantSearches, got)
+			}
+			if got := embedder.calls.Load(); got != tt.wantEmbeddings {
+				t.Errorf("expected %d embedding requests, got %d", tt.wantEmbeddings, got)
+			}
+		})
+	}
+}
+
+func TestTopicsWithinLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		topicCount int
+		limit      int
+		wantTopics int
+		wantLimit  int32
+	}{
+		{name: "limit covers all topics", topicCount: 4, limit: 20, wantTopics: 4, wantLimit: 5},
+		{name: "more topics than max topics", topicCount: 8, limit: 50, wantTopics: 5, wantLimit: 10},
+		{name: "limit smaller than topics", topicCount: 8, limit: 5, wantTopics: 1, wantLimit: 5},
+		{name: "limit can't cover min results of all topics", topicCount: 4, limit: 10, wantTopics: 3, wantLimit: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &searchCounter{}
+			registry := newTopicSearchRegistry("per_query", counter, &countingEmbedder{})
+			registry.config.MaxTopics = 5
+			registry.config.MinResultsPerTopic = 3
+
+			topics := registry.topicsWithinLimit(testTopics(tt.topicCount, 1), tt.limit)
+			if len(topics) != tt.wantTopics {
+				t.Fatalf("expected %d topics, got %d", tt.wantTopics, len(topics))
+			}
+			// The most relevant topics are kept.
+			for i, topic := range topics {
+				if want := fmt.Sprintf("topic %d", i); topic.Name != want {
+					t.Errorf("expected topic %q at %d, got %q", want, i, topic.Name)
+				}
+			}
+
+			_, _, err := registry.searchByTopicQueryGroups(context.Background(), searchOptions{}, nil, 0, topics, activitytypes.SortBySimilarity, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := counter.limit.Load(); got != tt.wantLimit {
+				t.Errorf("expected limit per topic %d, got %d", tt.wantLimit, got)
+			}
+		})
+	}
+}
+
+func TestSearchByRewrittenQueries_EmptyTopics(t *testing.T) {
+	activity := func(id string) *activitytypes.DecoratedActivity {
+		return &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: id}}
+	}
+	stored := []*activitytypes.DecoratedActivity{activity("rust-1"), activity("golang-1"), activity("golang-2")}
+	// Returns the activities with IDs matching the searched keywords.
+	store := &fakeActivityStore{search: func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+		var acts []*activitytypes.DecoratedActivity
+		for _, act := range stored {
+			if slices.ContainsFunc(req.Keywords, func(keyword string) bool {
+				return strings.HasPrefix(act.Activity.UID().String(), "test:"+keyword)
+			}) {
+				acts = append(acts, act)
+			}
+		}
+		return &activitytypes.SearchResult{Activities: acts}, nil
+	}}
+	topics := []*nlp.TopicQueryGroup{
+		{Name: "Rust", Queries: []string{"rust"}},
+		{Name: "Zig", Queries: []string{"zig"}},
+		{Name: "Go", Queries: []string{"golang"}},
+		{Name: "Haskell", Queries: []string{"haskell"}},
+	}
+
+	tests := []struct {
+		name          string
+		collapseEmpty bool
+		wantTopics    []string
+	}{
+		{name: "collapse empty topics", collapseEmpty: true, wantTopics: []string{"Go", "Rust"}},
+		{name: "keep empty topics", collapseEmpty: false, wantTopics: []string{"Go", "Rust", "Zig", "Haskell"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{KeywordSearchOnly: true})
+			registry := NewRegistry(nil, nil, nil, nil, nil, activityRegistry, nil, nil, &Config{
+				SearchConcurrency:     2,
+				MaxConcurrentSearches: 2,
+				CollapseEmptyTopics:   tt.collapseEmpty,
+				QueryRewriteCacheTTL:  time.Minute,
+			}, &logger)
+			// The cached rewrites skip the query rewriter.
+			registry.rewriteCache.Set("rewrite", topics)
+
+			res, err := registry.searchByRewrittenQueries(context.Background(), searchOptions{}, nil, 0, "languages", "", "rewrite",
+				activitytypes.SortBySocialScore, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), 20)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			gotTopics := make([]string, len(res.Topics))
+			for i, topic := range res.Topics {
+				gotTopics[i] = topic.Title
+			}
+			if !slices.Equal(gotTopics, tt.wantTopics) {
+				t.Errorf("expected topics %v, got %v", tt.wantTopics, gotTopics)
+			}
+			if len(res.Results) != 3 {
+				t.Errorf("expected all the activities, got %d", len(res.Results))
+			}
+		})
+	}
+}
+
+func TestTopicSummaryCacheKey(t *testing.T) {
+	activity := func(id string) *activitytypes.DecoratedActivity {
+		return &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: id}}
+	}
+	topic := &nlp.TopicQueryGroup{Name: "rust"}
+
+	registry := &Registry{config: &Config{TopicSummaryCacheKey: "activities"}}
+	key := registry.topicSummaryCacheKey(activitytypes.PeriodWeek, topic, []*activitytypes.DecoratedActivity{activity("a"), activity("b")})
+	if reordered := registry.topicSummaryCacheKey(activitytypes.PeriodWeek, topic, []*activitytypes.DecoratedActivity{activity("b"), activity("a")}); reordered != key {
+		t.Errorf("expected the same key regardless of the activity order, got %q and %q", key, reordered)
+	}
+	if changed := registry.topicSummaryCacheKey(activitytypes.PeriodWeek, topic, []*activitytypes.DecoratedActivity{activity("a"), activity("c")}); changed == key {
+		t.Error("expected a different key when the activities change")
+	}
+
+	registry.config.TopicSummaryCacheKey = "topic"
+	if got := registry.topicSummaryCacheKey(activitytypes.PeriodWeek, topic, []*activitytypes.DecoratedActivity{activity("a")}); got != "topic_summary:week:rust" {
+		t.Errorf("expected the key by topic, got %q", got)
+	}
+}
+
+func TestRewriteCacheKey(t *testing.T) {
+	feed := &Feed{
+		ID:         "feed",
+		Query:      "rust compilers",
+		SourceUIDs: []activitytypes.TypedUID{lib.NewTypedUID("rss", "a"), lib.NewTypedUID("rss", "b")},
+	}
+	registry := &Registry{config: &Config{QueryRewriteCacheTTL: time.Minute}}
+
+	key := registry.rewriteCacheKey(feed, feed.Query)
+	if key == "" {
+		t.Fatal("expected the feed query rewrites to be cached")
+	}
+
+	reordered := *feed
+	reordered.SourceUIDs = []activitytypes.TypedUID{lib.NewTypedUID("rss", "b"), lib.NewTypedUID("rss", "a")}
+	if got := registry.rewriteCacheKey(&reordered, feed.Query); got != key {
+		t.Errorf("expected the same key regardless of the source order, got %q and %q", key, got)
+	}
+
+	changed := *feed
+	changed.SourceUIDs = []activitytypes.TypedUID{lib.NewTypedUID("rss", "a")}
+	if got := registry.rewriteCacheKey(&changed, feed.Query); got == key {
+		t.Error("expected a different key when the sources change")
+	}
+
+	translated := *feed
+	translated.Language = "de"
+	if got := registry.rewriteCacheKey(&translated, feed.Query); got == key {
+		t.Error("expected a different key when the language changes")
+	}
+
+	if got := registry.rewriteCacheKey(feed, "go generics"); got != "" {
+		t.Errorf("expected query overrides not to be cached, got %q", got)
+	}
+	registry.config.QueryRewriteCacheOverrides = true
+	if got := registry.rewriteCacheKey(feed, "go generics"); got == "" || got == key {
+		t.Errorf("expected a separate key for the query override, got %q", got)
+	}
+}
+
+func TestTopicsCacheKey(t *testing.T) {
+	feed := &Feed{
+		ID:         "feed",
+		SourceUIDs: []activitytypes.TypedUID{lib.NewTypedUID("rss", "a"), lib.NewTypedUID("rss", "b")},
+		UpdatedAt:  time.Now(),
+	}
+	key := topicsCacheKey(feed, "rust", activitytypes.PeriodWeek, activitytypes.DefaultCalendar(), 20)
+
+	reordered := *feed
+	reordered.SourceUIDs = []activitytypes.TypedUID{lib.NewTypedUID("rss", "b"), lib.NewTypedUID("rss", "a")}
+	if got := topicsCacheKey(&reordered, "rust", activitytypes.PeriodWeek, activitytypes.DefaultCalendar(), 20); got != key {
+		t.Errorf("expected the same key regardless of the source order, got %q and %q", key, got)
+	}
+
+	changed := *feed
+	changed.SourceUIDs = []activitytypes.TypedUID{lib.NewTypedUID("rss", "a")}
+	if got := topicsCacheKey(&changed, "rust", activitytypes.PeriodWeek, activitytypes.DefaultCalendar(), 20); got == key {
+		t.Error("expected a different key when the sources change")
+	}
+
+	updated := *feed
+	updated.UpdatedAt = feed.UpdatedAt.Add(time.Second)
+	if got := topicsCacheKey(&updated, "rust", activitytypes.PeriodWeek, activitytypes.DefaultCalendar(), 20); got == key {
+		t.Error("expected a different key when the feed is updated")
+	}
+}
+
+func TestDigestCacheKey(t *testing.T) {
+	feed := &Feed{
+		ID:         "feed",
+		Query:      "rust",
+		SourceUIDs: []activitytypes.TypedUID{lib.NewTypedUID("rss", "a"), lib.NewTypedUID("rss", "b")},
+		UpdatedAt:  time.Now(),
+	}
+	key := digestCacheKey(feed, activitytypes.PeriodWeek, activitytypes.DefaultCalendar())
+
+	reordered := *feed
+	reordered.SourceUIDs = []activitytypes.TypedUID{lib.NewTypedUID("rss", "b"), lib.NewTypedUID("rss", "a")}
+	if got := digestCacheKey(&reordered, activitytypes.PeriodWeek, activitytypes.DefaultCalendar()); got != key {
+		t.Errorf("expected the same key regardless of the source order, got %q and %q", key, got)
+	}
+
+	changed := *feed
+	changed.SourceUIDs = []activitytypes.TypedUID{lib.NewTypedUID("rss", "a")}
+	if got := digestCacheKey(&changed, activitytypes.PeriodWeek, activitytypes.DefaultCalendar()); got == key {
+		t.Error("expected a different key when the sources change")
+	}
+
+	updated := *feed
+	updated.UpdatedAt = feed.UpdatedAt.Add(time.Second)
+	if got := digestCacheKey(&updated, activitytypes.PeriodWeek, activitytypes.DefaultCalendar()); got == key {
+		t.Error("expected a different key when the feed is updated")
+	}
+}
+
+func TestTopicsBySection(t *testing.T) {
+	releases := lib.NewTypedUID("test", "releases")
+	hn := lib.NewTypedUID("test", "hn")
+	reddit := lib.NewTypedUID("test", "reddit")
+	rss := lib.NewTypedUID("test", "rss")
+
+	sections := []FeedSection{
+		{Name: "Releases", Icon: "🚀", SourceUIDs: []activitytypes.TypedUID{releases}},
+		{Name: "Discussion", Icon: "💬", SourceUIDs: []activitytypes.TypedUID{hn, reddit}},
+	}
+	acts := []*activitytypes.DecoratedActivity{
+		{Activity: &timelineActivity{id: "release", sources: []activitytypes.TypedUID{releases}}},
+		{Activity: &timelineActivity{id: "cross", sources: []activitytypes.TypedUID{hn, reddit}}},
+		{Activity: &timelineActivity{id: "post", sources: []activitytypes.TypedUID{rss}}},
+	}
+
+	topics := topicsBySection(sections, acts)
+
+	got := make(map[string][]string)
+	titles := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		titles = append(titles, topic.Title)
+		got[topic.Title] = topic.ActivityIDs
+	}
+
+	if want := []string{"Releases", "Discussion", "Other"}; fmt.Sprint(titles) != fmt.Sprint(want) {
+		t.Fatalf("expected sections %v in order, got %v", want, titles)
+	}
+	if want := []string{acts[1].Activity.UID().String()}; fmt.Sprint(got["Discussion"]) != fmt.Sprint(want) {
+		t.Errorf("expected cross-posted activity once in the section, got %v", got["Discussion"])
+	}
+	if want := []string{acts[2].Activity.UID().String()}; fmt.Sprint(got["Other"]) != fmt.Sprint(want) {
+		t.Errorf("expected activity without section under other, got %v", got["Other"])
+	}
+}
+
+// BenchmarkSearchByTopicQueryGroups compares the topic search strategies on a feed with many topic queries.
+// The store latency simulates a vector search query, so the results reflect the DB round-trips.
+func BenchmarkSearchByTopicQueryGroups(b *testing.B) {
+	topics := testTopics(5, 3)
+
+	for _, strategy := range []string{"per_query", "mean", "max"} {
+		b.Run(strategy, func(b *testing.B) {
+			counter := &searchCounter{latency: time.Millisecond}
+			embedder := &countingEmbedder{}
+			registry := newTopicSearchRegistry(strategy, counter, embedder)
+
+			for b.Loop() {
+				_, _, err := registry.searchByTopicQueryGroups(context.Background(), searchOptions{}, nil, 0, topics, activitytypes.SortBySimilarity, activitytypes.PeriodAll, activitytypes.DefaultCalendar(), 20)
+				if err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			b.ReportMetric(float64(counter.searches.Load())/float64(b.N), "searches/op")
+			b.ReportMetric(float64(embedder.calls.Load())/float64(b.N), "embeddings/op")
+		})
+	}
+}
+
+func TestFeedActivities_SourceFilters(t *testing.T) {
+	source := lib.NewTypedUID("test", "source")
+	filters := map[string]activitytypes.KeywordFilter{
+		source.String(): {ExcludeKeywords: []string{"daily discussion"}},
+	}
+
+	for _, sortBy := range []activitytypes.SortBy{activitytypes.SortByDate, activitytypes.SortBySocialScore} {
+		t.Run(string(sortBy), func(t *testing.T) {
+			logger := zerolog.Nop()
+			// Records the source filters of the searches.
+			var mu sync.Mutex
+			var searchedFilters []map[string]activitytypes.KeywordFilter
+			store := &fakeActivityStore{search: func(req activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				searchedFilters = append(searchedFilters, req.SourceFilters)
+				return &activitytypes.SearchResult{}, nil
+			}}
+			activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{})
+			registry := NewRegistry(nil, nil, nil, nil, nil, activityRegistry, nil, nil, &Config{
+				SearchConcurrency:     1,
+				MaxConcurrentSearches: 1,
+			}, &logger)
+
+			feed := &Feed{ID: "feed", SourceUIDs: []activitytypes.TypedUID{source}, SourceFilters: filters}
+			_, err := registry.feedActivities(context.Background(), feed, searchOptions{}, sortBy, 10, "", activitytypes.PeriodAll, activitytypes.DefaultCalendar(), false, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(searchedFilters) == 0 {
+				t.Fatal("expected the activities to be searched")
+			}
+			for _, got := range searchedFilters {
+				if !reflect.DeepEqual(got, filters) {
+					t.Errorf("expected the feed source filters %v, got %v", filters, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKeepActivity(t *testing.T) {
+	shared := lib.NewTypedUID("test", "shared")
+	other := lib.NewTypedUID("test", "other")
+	excludeDiscussions := map[string]activitytypes.KeywordFilter{
+		shared.String(): {ExcludeKeywords: []string{"daily discussion"}},
+	}
+
+	tests := []struct {
+		name  string
+		feeds map[string]Feed
+		want  bool
+	}{
+		{
+			name: "excluded by all feeds",
+			feeds: map[string]Feed{
+				"a": {ID: "a", SourceUIDs: []activitytypes.TypedUID{shared}, SourceFilters: excludeDiscussions},
+				"b": {ID: "b", SourceUIDs: []activitytypes.TypedUID{shared, other}, SourceFilters: excludeDiscussions},
+			},
+			want: false,
+		},
+		{
+			name: "kept by a feed without filters",
+			feeds: map[string]Feed{
+				"a": {ID: "a", SourceUIDs: []activitytypes.TypedUID{shared}, SourceFilters: excludeDiscussions},
+				"b": {ID: "b", SourceUIDs: []activitytypes.TypedUID{shared}},
+			},
+			want: true,
+		},
+		{
+			name: "filters of the other sources are ignored",
+			feeds: map[string]Feed{
+				"a": {ID: "a", SourceUIDs: []activitytypes.TypedUID{shared, other}, SourceFilters: map[string]activitytypes.KeywordFilter{
+					other.String(): {ExcludeKeywords: []string{"daily discussion"}},
+				}},
+			},
+			want: true,
+		},
+		{
+			name:  "source without feeds",
+			feeds: map[string]Feed{},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			registry := NewRegistry(&memoryFeedStore{feeds: tt.feeds}, nil, nil, nil, nil, nil, nil, nil, &Config{}, &logger)
+
+			activity := &timelineActivity{id: "Daily discussion", sources: []activitytypes.TypedUID{shared}}
+			keep, err := registry.KeepActivity(context.Background(), activity)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if keep != tt.want {
+				t.Errorf("expected keep %v, got %v", tt.want, keep)
+			}
+		})
+	}
+}
+
+// memoryReadActivityStore stores the read activities of a single user.
+type memoryReadActivityStore struct {
+	read       map[string]bool
+	lastReadAt time.Time
+}
+
+func (s *memoryReadActivityStore) MarkAllRead(context.Context, string, []activitytypes.TypedUID, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (s *memoryReadActivityStore) LastReadAt(context.Context, string) (time.Time, error) {
+	return s.lastReadAt, nil
+}
+
+func (s *memoryReadActivityStore) ReadActivityIDs(_ context.Context, _ string, activityIDs []string) (map[string]bool, error) {
+	out := make(map[string]bool)
+	for _, id := range activityIDs {
+		if s.read[id] {
+			out[id] = true
+		}
+	}
+	return out, nil
+}
+
+func TestActivities_ReadState(t *testing.T) {
+	source := lib.NewTypedUID("test", "source")
+	acts := make([]*activitytypes.DecoratedActivity, 3)
+	for i := range acts {
+		acts[i] = &activitytypes.DecoratedActivity{Activity: &timelineActivity{id: fmt.Sprint(i), sources: []activitytypes.TypedUID{source}}}
+	}
+	readID := acts[1].Activity.UID().String()
+
+	tests := []struct {
+		name     string
+		userID   string
+		wantRead map[string]bool
+	}{
+		{name: "authenticated user", userID: "user", wantRead: map[string]bool{readID: true}},
+		{name: "anonymous user", userID: "", wantRead: map[string]bool{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			feeds := &getFeedStore{feed: &Feed{ID: "feed", UserID: "user", Public: true, SourceUIDs: []activitytypes.TypedUID{source}}}
+			reads := &memoryReadActivityStore{read: map[string]bool{readID: true}}
+			activityRegistry := activities.NewRegistry(&logger, &fakeActivityStore{search: func(activitytypes.SearchRequest) (*activitytypes.SearchResult, error) {
+				return &activitytypes.SearchResult{Activities: acts}, nil
+			}}, nil, nil, &activities.Config{})
+			registry := NewRegistry(feeds, reads, nil, nil, nil, activityRegistry, nil, nil, &Config{
+				SearchConcurrency:     1,
+				MaxConcurrentSearches: 1,
+			}, &logger)
+
+			res, err := registry.Activities(context.Background(), "feed", tt.userID, activitytypes.SortByDate, nil, 10, "", activitytypes.PeriodAll, activitytypes.DefaultCalendar(), false, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(res.Results) != len(acts) {
+				t.Fatalf("expected the read activities to be returned, got %d results", len(res.Results))
+			}
+			if !reflect.DeepEqual(res.ReadActivityIDs, tt.wantRead) {
+				t.Errorf("expected read activities %v, got %v", tt.wantRead, res.ReadActivityIDs)
+			}
+		})
+	}
+}
+
+func TestActivitiesVersion(t *testing.T) {
+	logger := zerolog.Nop()
+	source := lib.NewTypedUID("test", "source")
+	feed := &Feed{ID: "feed", UserID: "user", SourceUIDs: []activitytypes.TypedUID{source}, UpdatedAt: time.Now()}
+	store := &fakeActivityStore{stats: activitytypes.ActivityStats{Count: 2, LatestCreatedAt: time.Now(), UpdateCount: 3}}
+	reads := &memoryReadActivityStore{}
+	activityRegistry := activities.NewRegistry(&logger, store, nil, nil, &activities.Config{})
+	registry := NewRegistry(&getFeedStore{feed: feed}, reads, nil, nil, nil, activityRegistry, nil, nil, &Config{}, &logger)
+
+	version := func() string {
+		t.Helper()
+		got, err := registry.ActivitiesVersion(context.Background(), "feed", "user")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return got
+	}
+
+	initial := version()
+	if got := version(); got != initial {
+		t.Errorf("expected the same version without changes, got %s and %s", initial, got)
+	}
+
+	store.stats.UpdateCount++
+	updated := version()
+	if updated == initial {
+		t.Error("expected a different version when the activities are updated")
+	}
+
+	reads.lastReadAt = time.Now()
+	read := version()
+	if read == updated {
+		t.Error("expected a different version when activities are marked as read")
+	}
+
+	feed.UpdatedAt = feed.UpdatedAt.Add(time.Second)
+	if got := version(); got == read {
+		t.Error("expected a different version when the feed is updated")
+	}
+
+	if _, err := registry.ActivitiesVersion(context.Background(), "feed", "other"); !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("expected ErrFeedNotFound for a private feed of another user, got %v", err)
+	}
+}
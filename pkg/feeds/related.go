@@ -0,0 +1,62 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// RelatedActivities returns the activities most similar to the given one ("more like this"),
+// from the sources of the feeds the user can access (see ListByUserID).
+// Returns ErrActivityNotFound if the activity doesn't exist or isn't from any of these sources.
+func (r *Registry) RelatedActivities(
+	ctx context.Context,
+	userID string,
+	activityUID activitytypes.TypedUID,
+	limit int,
+) ([]*activitytypes.DecoratedActivity, error) {
+	feeds, err := r.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := make(map[string]bool)
+	sourceUIDs := make([]activitytypes.TypedUID, 0)
+	for _, feed := range feeds {
+		for _, uid := range feed.SourceUIDs {
+			if !accessible[uid.String()] {
+				accessible[uid.String()] = true
+				sourceUIDs = append(sourceUIDs, uid)
+			}
+		}
+	}
+
+	res, err := r.activityRegistry.Search(ctx, activities.SearchRequest{
+		ActivityUIDs: []activitytypes.TypedUID{activityUID},
+		Limit:        1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search activity: %w", err)
+	}
+	if len(res.Activities) == 0 || !hasAccessibleSource(res.Activities[0].Activity, accessible) {
+		return nil, ErrActivityNotFound
+	}
+
+	related, err := r.activityRegistry.Related(ctx, res.Activities[0], sourceUIDs, limit)
+	if err != nil {
+		return nil, fmt.Errorf("find related activities: %w", err)
+	}
+
+	return related, nil
+}
+
+func hasAccessibleSource(act activitytypes.Activity, accessible map[string]bool) bool {
+	for _, uid := range act.SourceUIDs() {
+		if accessible[uid.String()] {
+			return true
+		}
+	}
+	return false
+}
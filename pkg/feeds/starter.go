@@ -0,0 +1,201 @@
+package feeds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+// starterFeedsFilePrefix marks the starter feeds config value as a path to the JSON file (e.g. "file:./starter-feeds.json").
+const starterFeedsFilePrefix = "file:"
+
+// StarterFeed is a feed template that is created for new users.
+type StarterFeed struct {
+	Name       string
+	Icon       string
+	Query      string
+	SourceUIDs []activitytypes.TypedUID
+}
+
+type starterFeedJSON struct {
+	Name       string   `json:"name"`
+	Icon       string   `json:"icon"`
+	Query      string   `json:"query"`
+	SourceUIDs []string `json:"sourceUids"`
+}
+
+// ParseStarterFeeds parses the JSON list of starter feeds, either inline or from the file prefixed with "file:".
+func (c *Config) ParseStarterFeeds() ([]StarterFeed, error) {
+	value := strings.TrimSpace(c.StarterFeeds)
+	if path, ok := strings.CutPrefix(value, starterFeedsFilePrefix); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read starter feeds file: %w", err)
+		}
+		value = string(data)
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	var raw []starterFeedJSON
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal starter feeds: %w", err)
+	}
+
+	out := make([]StarterFeed, len(raw))
+	for i, feed := range raw {
+		if feed.Name == "" {
+			return nil, fmt.Errorf("starter feed %d: name is required", i)
+		}
+		if len(feed.SourceUIDs) == 0 {
+			return nil, fmt.Errorf("starter feed %q: at least one source is required", feed.Name)
+		}
+
+		sourceUIDs := make([]activitytypes.TypedUID, len(feed.SourceUIDs))
+		for j, uid := range feed.SourceUIDs {
+			typedUID, err := sources.NewTypedUID(uid)
+			if err != nil {
+				return nil, fmt.Errorf("starter feed %q: parse source UID: %w", feed.Name, err)
+			}
+			sourceUIDs[j] = typedUID
+		}
+
+		out[i] = StarterFeed{
+			Name:       feed.Name,
+			Icon:       feed.Icon,
+			Query:      feed.Query,
+			SourceUIDs: sourceUIDs,
+		}
+	}
+
+	return out, nil
+}
+
+type provisionStore interface {
+	// Claim marks the user as provisioned, and returns false if the user was already provisioned.
+	Claim(ctx context.Context, userID string, provisionedAt time.Time) (bool, error)
+	// Release removes the claim, so that the user is provisioned again.
+	Release(ctx context.Context, userID string) error
+}
+
+// Provisioner creates the starter feeds for new users, so that they don't land on an empty app.
+type Provisioner struct {
+	registry     *Registry
+	store        provisionStore
+	starterFeeds []StarterFeed
+	config       *Config
+	logger       *zerolog.Logger
+	// checkedUsers avoids hitting the DB on every request of the already provisioned users.
+	checkedUsers sync.Map // map[string]bool
+	// provisioningUsers avoids starting concurrent provisionings of the same user.
+	provisioningUsers sync.Map // map[string]bool
+}
+
+func NewProvisioner(registry *Registry, store provisionStore, starterFeeds []StarterFeed, config *Config, logger *zerolog.Logger) *Provisioner {
+	return &Provisioner{
+		registry:     registry,
+		store:        store,
+		starterFeeds: starterFeeds,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// NeedsProvision returns false if the user was already provisioned by this instance,
+// so that the callers can skip starting the provisioning.
+func (p *Provisioner) NeedsProvision(userID string) bool {
+	if len(p.starterFeeds) == 0 || userID == "" {
+		return false
+	}
+	_, checked := p.checkedUsers.Load(userID)
+	return !checked
+}
+
+// Provision creates the starter feeds for the user, if the user wasn't provisioned yet and has no feeds.
+// The user is claimed before creating the feeds, so that concurrent requests don't provision it twice.
+// If none of the feeds are created, the claim is released and the user is provisioned again on a later request.
+func (p *Provisioner) Provision(ctx context.Context, userID string) error {
+	if !p.NeedsProvision(userID) {
+		return nil
+	}
+
+	if _, provisioning := p.provisioningUsers.LoadOrStore(userID, true); provisioning {
+		return nil
+	}
+	defer p.provisioningUsers.Delete(userID)
+
+	// Don't abort the provisioning half-way if the client disconnects.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.config.StarterFeedsTimeout)
+	defer cancel()
+
+	claimed, err := p.store.Claim(ctx, userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("claim user provision: %w", err)
+	}
+
+	if !claimed {
+		p.checkedUsers.Store(userID, true)
+		return nil
+	}
+
+	created, err := p.createStarterFeeds(ctx, userID)
+	if err != nil && created == 0 {
+		if releaseErr := p.store.Release(ctx, userID); releaseErr != nil {
+			return errors.Join(err, fmt.Errorf("release user provision: %w", releaseErr))
+		}
+		return err
+	}
+	p.checkedUsers.Store(userID, true)
+
+	return err
+}
+
+// createStarterFeeds returns the number of the created feeds.
+func (p *Provisioner) createStarterFeeds(ctx context.Context, userID string) (int, error) {
+	// Existing users (e.g. before the starter feeds were configured) keep their feeds as is.
+	feeds, err := p.registry.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("list user feeds: %w", err)
+	}
+	for _, feed := range feeds {
+		if feed.UserID == userID {
+			return 0, nil
+		}
+	}
+
+	var errs []error
+	created := 0
+	for _, starter := range p.starterFeeds {
+		_, err := p.registry.Create(ctx, CreateRequest{
+			Name:       starter.Name,
+			Icon:       starter.Icon,
+			Query:      starter.Query,
+			SourceUIDs: starter.SourceUIDs,
+			UserID:     userID,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("create starter feed %q: %w", starter.Name, err))
+			continue
+		}
+		created++
+	}
+
+	p.logger.Info().
+		Str("user_id", userID).
+		Int("created_count", created).
+		Int("failed_count", len(errs)).
+		Msg("Provisioned starter feeds")
+
+	return created, errors.Join(errs...)
+}
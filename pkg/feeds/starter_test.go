@@ -0,0 +1,157 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestParseStarterFeeds(t *testing.T) {
+	const starterJSON = `[{"name": "Tech", "icon": "💻", "query": "software", "sourceUids": ["hackernewsposts:top", "lobsterstag:go"]}]`
+
+	path := filepath.Join(t.TempDir(), "starter-feeds.json")
+	if err := os.WriteFile(path, []byte(starterJSON), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		value     string
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "disabled", value: "", wantCount: 0},
+		{name: "inline", value: starterJSON, wantCount: 1},
+		{name: "file", value: "file:" + path, wantCount: 1},
+		{name: "missing file", value: "file:" + filepath.Join(t.TempDir(), "missing.json"), wantErr: true},
+		{name: "missing sources", value: `[{"name": "Empty"}]`, wantErr: true},
+		{name: "invalid JSON", value: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{StarterFeeds: tt.value}
+			got, err := cfg.ParseStarterFeeds()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseStarterFeeds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("expected %d starter feeds, got %d", tt.wantCount, len(got))
+			}
+			if tt.wantCount > 0 && got[0].SourceUIDs[1].String() != "lobsterstag:go" {
+				t.Errorf("unexpected source UID: %s", got[0].SourceUIDs[1])
+			}
+		})
+	}
+}
+
+// claimedStore reports all users as already provisioned.
+type claimedStore struct {
+	claims int
+}
+
+func (s *claimedStore) Claim(context.Context, string, time.Time) (bool, error) {
+	s.claims++
+	return false, nil
+}
+
+func (s *claimedStore) Release(context.Context, string) error {
+	return nil
+}
+
+// memoryProvisionStore keeps the claimed users in memory.
+type memoryProvisionStore struct {
+	claimed  map[string]bool
+	releases int
+}
+
+func (s *memoryProvisionStore) Claim(_ context.Context, userID string, _ time.Time) (bool, error) {
+	if s.claimed[userID] {
+		return false, nil
+	}
+	s.claimed[userID] = true
+	return true, nil
+}
+
+func (s *memoryProvisionStore) Release(_ context.Context, userID string) error {
+	delete(s.claimed, userID)
+	s.releases++
+	return nil
+}
+
+// failingFeedStore fails to list the feeds.
+type failingFeedStore struct {
+	memoryFeedStore
+}
+
+func (s *failingFeedStore) List(context.Context) ([]*Feed, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestProvision_OnlyOnce(t *testing.T) {
+	logger := zerolog.Nop()
+	store := &claimedStore{}
+	starterFeeds := []StarterFeed{{Name: "Tech"}}
+	provisioner := NewProvisioner(nil, store, starterFeeds, &Config{StarterFeedsTimeout: time.Second}, &logger)
+
+	for range 3 {
+		if err := provisioner.Provision(context.Background(), "user"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Already provisioned users are cached, and the registry is never called.
+	if store.claims != 1 {
+		t.Errorf("expected a single claim, got %d", store.claims)
+	}
+}
+
+func TestProvision_ReleasedOnFailure(t *testing.T) {
+	logger := zerolog.Nop()
+	registry := NewRegistry(&failingFeedStore{}, nil, nil, nil, nil, nil, nil, nil, &Config{}, &logger)
+	store := &memoryProvisionStore{claimed: make(map[string]bool)}
+	starterFeeds := []StarterFeed{{Name: "Tech"}}
+	provisioner := NewProvisioner(registry, store, starterFeeds, &Config{StarterFeedsTimeout: time.Second}, &logger)
+
+	if err := provisioner.Provision(context.Background(), "user"); err == nil {
+		t.Fatal("expected provisioning error")
+	}
+	if store.claimed["user"] || store.releases != 1 {
+		t.Fatalf("expected the failed provisioning to be released, got %d releases", store.releases)
+	}
+
+	// The user is provisioned again on the next request.
+	if !provisioner.NeedsProvision("user") {
+		t.Fatal("expected the user to need provisioning after the failure")
+	}
+	if err := provisioner.Provision(context.Background(), "user"); err == nil {
+		t.Fatal("expected provisioning error")
+	}
+	if store.releases != 2 {
+		t.Errorf("expected the provisioning to be retried, got %d releases", store.releases)
+	}
+}
+
+func TestProvision_Created(t *testing.T) {
+	logger := zerolog.Nop()
+	feedStore := &memoryFeedStore{feeds: make(map[string]Feed), positions: make(map[string]map[string]int)}
+	registry := NewRegistry(feedStore, nil, nil, nil, nil, nil, nil, nil, &Config{}, &logger)
+	store := &memoryProvisionStore{claimed: make(map[string]bool)}
+	starterFeeds := []StarterFeed{{Name: "Tech"}}
+	provisioner := NewProvisioner(registry, store, starterFeeds, &Config{StarterFeedsTimeout: time.Second}, &logger)
+
+	if err := provisioner.Provision(context.Background(), "user"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(feedStore.feeds) != 1 || !store.claimed["user"] {
+		t.Fatalf("expected a claimed user with 1 starter feed, got %d feeds", len(feedStore.feeds))
+	}
+	if provisioner.NeedsProvision("user") {
+		t.Error("expected the provisioned user to be skipped")
+	}
+}
@@ -0,0 +1,100 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrTooManyConcurrentRequests is returned when the user has too many feed requests in flight (see Config.MaxConcurrentRequestsPerUser).
+var ErrTooManyConcurrentRequests = errors.New("too many concurrent feed requests")
+
+// userSlots bounds the number of concurrent feed requests per user,
+// so that a single user (e.g. a client opening many feeds at once) can't saturate the LLM provider and the DB.
+type userSlots struct {
+	limit   int
+	timeout time.Duration
+
+	mu    sync.Mutex
+	users map[string]*userSlot
+}
+
+type userSlot struct {
+	slots chan struct{}
+	// refs is the number of requests holding or waiting for a slot, the entry is removed once it drops to zero.
+	refs int
+}
+
+func newUserSlots(limit int, timeout time.Duration) *userSlots {
+	return &userSlots{
+		limit:   limit,
+		timeout: timeout,
+		users:   make(map[string]*userSlot),
+	}
+}
+
+// acquire waits up to the queue timeout for a free slot of the user, and returns the func releasing it.
+// The requests of unauthenticated users aren't bounded (the query overrides are rate limited per IP by the API instead).
+func (u *userSlots) acquire(ctx context.Context, userID string) (func(), error) {
+	if u.limit <= 0 || userID == "" {
+		return func() {}, nil
+	}
+
+	slot := u.ref(userID)
+
+	select {
+	case slot.slots <- struct{}{}:
+		return func() {
+			<-slot.slots
+			u.unref(userID)
+		}, nil
+	default:
+	}
+
+	if u.timeout <= 0 {
+		u.unref(userID)
+		return nil, ErrTooManyConcurrentRequests
+	}
+
+	timer := time.NewTimer(u.timeout)
+	defer timer.Stop()
+
+	select {
+	case slot.slots <- struct{}{}:
+		return func() {
+			<-slot.slots
+			u.unref(userID)
+		}, nil
+	case <-timer.C:
+		u.unref(userID)
+		return nil, ErrTooManyConcurrentRequests
+	case <-ctx.Done():
+		u.unref(userID)
+		return nil, ctx.Err()
+	}
+}
+
+func (u *userSlots) ref(userID string) *userSlot {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	slot, ok := u.users[userID]
+	if !ok {
+		slot = &userSlot{slots: make(chan struct{}, u.limit)}
+		u.users[userID] = slot
+	}
+	slot.refs++
+	return slot
+}
+
+func (u *userSlots) unref(userID string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	slot := u.users[userID]
+	slot.refs--
+	if slot.refs == 0 {
+		delete(u.users, userID)
+	}
+}
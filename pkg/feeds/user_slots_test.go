@@ -0,0 +1,73 @@
+package feeds
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUserSlots(t *testing.T) {
+	ctx := context.Background()
+	slots := newUserSlots(2, 0)
+
+	release1, err := slots.acquire(ctx, "user")
+	if err != nil {
+		t.Fatalf("acquire first slot: %v", err)
+	}
+	release2, err := slots.acquire(ctx, "user")
+	if err != nil {
+		t.Fatalf("acquire second slot: %v", err)
+	}
+	if _, err := slots.acquire(ctx, "user"); !errors.Is(err, ErrTooManyConcurrentRequests) {
+		t.Errorf("expected excess request to be rejected, got %v", err)
+	}
+
+	release, err := slots.acquire(ctx, "other")
+	if err != nil {
+		t.Errorf("expected other users to not be limited, got %v", err)
+	}
+	release()
+	for range 3 {
+		if _, err := slots.acquire(ctx, ""); err != nil {
+			t.Errorf("expected unauthenticated requests to not be limited, got %v", err)
+		}
+	}
+
+	release1()
+	release, err = slots.acquire(ctx, "user")
+	if err != nil {
+		t.Fatalf("expected released slot to be reusable, got %v", err)
+	}
+	release()
+	release2()
+
+	if len(slots.users) != 0 {
+		t.Errorf("expected idle users to be removed, got %d", len(slots.users))
+	}
+}
+
+func TestUserSlots_Queue(t *testing.T) {
+	ctx := context.Background()
+	slots := newUserSlots(1, time.Second)
+
+	release, err := slots.acquire(ctx, "user")
+	if err != nil {
+		t.Fatalf("acquire slot: %v", err)
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		release()
+	}()
+
+	queued, err := slots.acquire(ctx, "user")
+	if err != nil {
+		t.Fatalf("expected queued request to get the released slot, got %v", err)
+	}
+
+	slots.timeout = 10 * time.Millisecond
+	if _, err := slots.acquire(ctx, "user"); !errors.Is(err, ErrTooManyConcurrentRequests) {
+		t.Errorf("expected queued request to be rejected after the timeout, got %v", err)
+	}
+	queued()
+}
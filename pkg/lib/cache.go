@@ -74,6 +74,21 @@ func (c *Cache) Clear() {
 	c.entries = make(map[string]cacheEntry)
 }
 
+// CacheStats reports the hits of a cache, e.g. to verify that it's effective.
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// HitRate returns the fraction of the lookups that were hits, or 0 if there were no lookups yet.
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
 func HashParams(params ...string) string {
 	hash := sha256.Sum256([]byte(strings.Join(params, ",")))
 	return fmt.Sprintf("%x", hash)
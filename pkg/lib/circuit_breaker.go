@@ -0,0 +1,87 @@
+package lib
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitBreaker short-circuits calls to a failing dependency (e.g. LLM provider).
+// After threshold consecutive failures it opens for the cooldown duration,
+// then lets a single trial call through to check if the dependency recovered.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	trialing bool
+}
+
+// NewCircuitBreaker creates a circuit breaker. A threshold of 0 disables the breaker.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow reports whether the call should be attempted.
+func (b *CircuitBreaker) Allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	if b.trialing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+
+	b.trialing = true
+	return true
+}
+
+// Success records a successful call and closes the breaker.
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.trialing = false
+}
+
+// Cancel records a call that was abandoned before it could succeed or fail (e.g. the request was canceled).
+// It releases the trial of the open breaker without changing its state, so that the next call can be the trial.
+func (b *CircuitBreaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trialing = false
+}
+
+// Failure records a failed call. Returns true if the breaker (re)opened.
+func (b *CircuitBreaker) Failure() bool {
+	if b.threshold <= 0 {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.trialing = false
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+		return true
+	}
+
+	return false
+}
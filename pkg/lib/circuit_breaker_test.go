@@ -0,0 +1,84 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := NewCircuitBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	if !b.Allow() {
+		t.Fatal("expected closed breaker to allow calls")
+	}
+
+	if b.Failure() {
+		t.Error("expected breaker to stay closed below threshold")
+	}
+	if !b.Allow() {
+		t.Fatal("expected breaker to allow calls below threshold")
+	}
+
+	if !b.Failure() {
+		t.Error("expected breaker to open at threshold")
+	}
+	if b.Allow() {
+		t.Fatal("expected open breaker to reject calls")
+	}
+
+	now = now.Add(time.Minute)
+	if !b.Allow() {
+		t.Fatal("expected a trial call after cooldown")
+	}
+	if b.Allow() {
+		t.Fatal("expected only a single trial call")
+	}
+
+	if !b.Failure() {
+		t.Error("expected failed trial to reopen the breaker")
+	}
+	if b.Allow() {
+		t.Fatal("expected reopened breaker to reject calls")
+	}
+
+	now = now.Add(time.Minute)
+	if !b.Allow() {
+		t.Fatal("expected a trial call after cooldown")
+	}
+	b.Success()
+	if !b.Allow() || !b.Allow() {
+		t.Fatal("expected breaker to close after successful trial")
+	}
+}
+
+func TestCircuitBreaker_CanceledTrial(t *testing.T) {
+	now := time.Now()
+	b := NewCircuitBreaker(1, time.Minute)
+	b.now = func() time.Time { return now }
+
+	b.Failure()
+	now = now.Add(time.Minute)
+	if !b.Allow() {
+		t.Fatal("expected a trial call after cooldown")
+	}
+
+	b.Cancel()
+	if !b.Allow() {
+		t.Fatal("expected canceled trial to allow another trial call")
+	}
+	if b.Allow() {
+		t.Fatal("expected breaker to stay open during the new trial")
+	}
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	b := NewCircuitBreaker(0, time.Minute)
+	for range 10 {
+		b.Failure()
+	}
+	if !b.Allow() {
+		t.Error("expected disabled breaker to always allow calls")
+	}
+}
@@ -0,0 +1,85 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
+)
+
+// ErrDisallowedDomain is returned when the URL domain is excluded by the configured DomainPolicy.
+var ErrDisallowedDomain = errors.New("disallowed domain")
+
+// domainPolicy is the policy consulted before fetching external content, configured with SetDomainPolicy.
+var domainPolicy atomic.Pointer[DomainPolicy]
+
+// SetDomainPolicy restricts the domains fetched by FetchURL and FetchTextFromURL. Nil allows all domains.
+func SetDomainPolicy(policy *DomainPolicy) {
+	domainPolicy.Store(policy)
+}
+
+// DomainPolicy allows or denies domains of the external content.
+// Patterns match the domain exactly, or any of its subdomains when prefixed with "*." (e.g. "*.example.com").
+// Denied domains take precedence, and an empty allow list allows all the other domains.
+type DomainPolicy struct {
+	allow []string
+	deny  []string
+}
+
+func NewDomainPolicy(allow []string, deny []string) *DomainPolicy {
+	return &DomainPolicy{
+		allow: normalizeDomainPatterns(allow),
+		deny:  normalizeDomainPatterns(deny),
+	}
+}
+
+// Allowed reports whether the host (without the port) can be fetched.
+func (p *DomainPolicy) Allowed(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	for _, pattern := range p.deny {
+		if matchDomain(pattern, host) {
+			return false
+		}
+	}
+
+	if len(p.allow) == 0 {
+		return true
+	}
+
+	for _, pattern := range p.allow {
+		if matchDomain(pattern, host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDomainPolicy returns ErrDisallowedDomain if the URL is excluded by the configured policy.
+func checkDomainPolicy(u *url.URL) error {
+	policy := domainPolicy.Load()
+	if policy == nil || policy.Allowed(u.Hostname()) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrDisallowedDomain, u.Hostname())
+}
+
+func matchDomain(pattern string, host string) bool {
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.HasSuffix(host, "."+suffix)
+	}
+	return host == pattern
+}
+
+func normalizeDomainPatterns(patterns []string) []string {
+	out := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(pattern)), ".")
+		if pattern != "" {
+			out = append(out, pattern)
+		}
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestDomainPolicy(t *testing.T) {
+	policy := NewDomainPolicy([]string{"example.com", " *.Blog.dev "}, []string{"private.blog.dev", ""})
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "example.com", want: true},
+		{host: "EXAMPLE.com.", want: true},
+		{host: "www.example.com", want: false},
+		{host: "team.blog.dev", want: true},
+		{host: "a.b.blog.dev", want: true},
+		{host: "blog.dev", want: false},
+		{host: "notblog.dev", want: false},
+		{host: "private.blog.dev", want: false},
+		{host: "other.org", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Allowed(tt.host); got != tt.want {
+			t.Errorf("Allowed(%q) = %v, want %v", tt.host, got, tt.want)
+		}
+	}
+
+	denyOnly := NewDomainPolicy(nil, []string{"*.example.com"})
+	if !denyOnly.Allowed("other.org") {
+		t.Error("expected empty allow list to allow other domains")
+	}
+	if denyOnly.Allowed("www.example.com") {
+		t.Error("expected denied subdomain")
+	}
+}
+
+func TestFetchURLDisallowedDomain(t *testing.T) {
+	SetDomainPolicy(NewDomainPolicy(nil, []string{"denied.example"}))
+	t.Cleanup(func() { SetDomainPolicy(nil) })
+
+	logger := zerolog.Nop()
+	_, err := FetchURL(context.Background(), &logger, "https://denied.example/article")
+	if !errors.Is(err, ErrDisallowedDomain) {
+		t.Errorf("expected ErrDisallowedDomain, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package lib
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// fetchSlots bounds the concurrent external content fetches across all sources (see SetMaxConcurrentFetches).
+var fetchSlots atomic.Pointer[semaphore.Weighted]
+
+// SetMaxConcurrentFetches bounds the number of concurrent external content fetches (e.g. articles, thumbnails) instance-wide,
+// so that many sources polling at once don't exhaust the file descriptors or get rate limited by the origin servers.
+// Set to 0 to disable the limit.
+func SetMaxConcurrentFetches(n int) {
+	if n <= 0 {
+		fetchSlots.Store(nil)
+		return
+	}
+	fetchSlots.Store(semaphore.NewWeighted(int64(n)))
+}
+
+// acquireFetchSlot blocks until an external fetch can be started.
+// The returned release func must be called once the fetch is done.
+func acquireFetchSlot(ctx context.Context) (func(), error) {
+	slots := fetchSlots.Load()
+	if slots == nil {
+		return func() {}, nil
+	}
+
+	if err := slots.Acquire(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { slots.Release(1) })
+	}, nil
+}
+
+// releasingBody releases the fetch slot when the response body is closed,
+// since the connection is in use until the body is fully read.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,70 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestSetMaxConcurrentFetches(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	SetMaxConcurrentFetches(2)
+	t.Cleanup(func() { SetMaxConcurrentFetches(0) })
+
+	logger := zerolog.Nop()
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := FetchURL(context.Background(), &logger, server.URL)
+			if err != nil {
+				t.Errorf("fetch url: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("expected at most 2 concurrent fetches, got %d", got)
+	}
+
+	// The slot is held until the response body is closed.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	held := make([]*http.Response, 0, 2)
+	for range 2 {
+		resp, err := FetchURL(context.Background(), &logger, server.URL)
+		if err != nil {
+			t.Fatalf("fetch url: %v", err)
+		}
+		held = append(held, resp)
+	}
+	if _, err := FetchURL(ctx, &logger, server.URL); err == nil {
+		t.Error("expected fetch to wait for a free slot")
+	}
+	for _, resp := range held {
+		resp.Body.Close()
+	}
+}
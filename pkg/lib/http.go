@@ -5,16 +5,70 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sync/atomic"
 	"time"
 )
 
 const defaultClientTimeout = 5 * time.Second
 
-var DefaultHTTPClient = &http.Client{
-	Transport: &http.Transport{
-		MaxIdleConnsPerHost: 10,
-	},
-	Timeout: defaultClientTimeout,
+// httpProxyURL is the proxy configured with SetHTTPProxy.
+var httpProxyURL atomic.Pointer[url.URL]
+
+// sharedTransport is reused by clients created with NewHTTPClient to pool connections.
+var sharedTransport = newSharedTransport()
+
+var DefaultHTTPClient = NewHTTPClient(defaultClientTimeout)
+
+// SetHTTPProxy routes all outbound requests through the given proxy URL.
+// An empty URL falls back to the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables.
+func SetHTTPProxy(rawURL string) error {
+	if rawURL == "" {
+		httpProxyURL.Store(nil)
+		return nil
+	}
+
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("parse proxy url: %w", err)
+	}
+	if proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return fmt.Errorf("invalid proxy url: %s", rawURL)
+	}
+
+	httpProxyURL.Store(proxyURL)
+	return nil
+}
+
+// NewHTTPTransport returns a new transport that respects the configured outbound proxy.
+// Use it when a client needs custom transport settings, otherwise prefer NewHTTPClient.
+func NewHTTPTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyFromConfig
+	return transport
+}
+
+// NewHTTPClient returns a client that shares a proxy-aware transport.
+// A zero timeout means no timeout.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: sharedTransport,
+		Timeout:   timeout,
+	}
+}
+
+func newSharedTransport() *http.Transport {
+	transport := NewHTTPTransport()
+	transport.MaxIdleConnsPerHost = 10
+	return transport
+}
+
+// proxyFromConfig is resolved per request, so clients created before SetHTTPProxy also use the proxy.
+func proxyFromConfig(req *http.Request) (*url.URL, error) {
+	if proxyURL := httpProxyURL.Load(); proxyURL != nil {
+		return proxyURL, nil
+	}
+	return http.ProxyFromEnvironment(req)
 }
 
 var BuildVersion = "dev"
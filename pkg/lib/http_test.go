@@ -0,0 +1,38 @@
+package lib
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSetHTTPProxy(t *testing.T) {
+	t.Cleanup(func() { _ = SetHTTPProxy("") })
+	t.Setenv("HTTPS_PROXY", "")
+	t.Setenv("HTTP_PROXY", "")
+
+	req, err := http.NewRequest("GET", "https://example.com", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+
+	if err := SetHTTPProxy("http://proxy.internal:3128"); err != nil {
+		t.Fatalf("set proxy: %v", err)
+	}
+	proxyURL, err := NewHTTPTransport().Proxy(req)
+	if err != nil {
+		t.Fatalf("resolve proxy: %v", err)
+	}
+	if proxyURL == nil || proxyURL.Host != "proxy.internal:3128" {
+		t.Errorf("expected configured proxy, got %v", proxyURL)
+	}
+
+	// Shared clients created before configuration must pick up the proxy too.
+	sharedURL, err := sharedTransport.Proxy(req)
+	if err != nil || sharedURL == nil || sharedURL.Host != "proxy.internal:3128" {
+		t.Errorf("expected shared transport to use configured proxy, got %v (%v)", sharedURL, err)
+	}
+
+	if err := SetHTTPProxy("proxy.internal"); err == nil {
+		t.Error("expected error for proxy url without scheme")
+	}
+}
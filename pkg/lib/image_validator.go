@@ -0,0 +1,196 @@
+package lib
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	neturl "net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// imageValidator is the validator used by ValidImageURL, configured with SetImageValidator.
+var imageValidator atomic.Pointer[ImageValidator]
+
+// SetImageValidator enables the validation of the activity images. Nil disables the validation.
+func SetImageValidator(validator *ImageValidator) {
+	imageValidator.Store(validator)
+}
+
+// ValidImageURL returns the image URL if it points to a raster image within the size limit, or an empty string otherwise.
+// URLs are returned as is if the validation is disabled (see SetImageValidator).
+func ValidImageURL(ctx context.Context, url string) string {
+	validator := imageValidator.Load()
+	if validator == nil || url == "" {
+		return url
+	}
+	if !validator.Valid(ctx, url) {
+		return ""
+	}
+	return url
+}
+
+// ValidateImageURLs validates the image URLs concurrently, so that the following ValidImageURL calls are served from the cache.
+func ValidateImageURLs(ctx context.Context, urls []string) {
+	validator := imageValidator.Load()
+	if validator == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			validator.Valid(ctx, url)
+		}()
+	}
+	wg.Wait()
+}
+
+type imageCheck struct {
+	valid      bool
+	expiration time.Time
+}
+
+// ImageValidator checks that the image URLs point to raster images (e.g. not SVGs, data URLs or HTML error pages)
+// within the size limit, with a HEAD request. The results are cached, since the same images are served repeatedly.
+type ImageValidator struct {
+	mu       sync.Mutex
+	checks   map[string]imageCheck
+	ttl      time.Duration
+	maxBytes int64
+	// contentTypes is the allowlist of the image media types. Empty allows all raster images.
+	contentTypes []string
+	client       *http.Client
+	now          func() time.Time
+	// lastEviction is when the expired checks were last evicted, which is done at most once per TTL.
+	lastEviction time.Time
+}
+
+func NewImageValidator(ttl time.Duration, maxBytes int64) *ImageValidator {
+	return &ImageValidator{
+		checks:   make(map[string]imageCheck),
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		client:   NewHTTPClient(5 * time.Second),
+		now:      time.Now,
+	}
+}
+
+// WithContentTypes only allows the images of the given media types (e.g. "image/webp"). Empty values are ignored.
+func (v *ImageValidator) WithContentTypes(contentTypes []string) *ImageValidator {
+	for _, contentType := range contentTypes {
+		if contentType = strings.ToLower(strings.TrimSpace(contentType)); contentType != "" {
+			v.contentTypes = append(v.contentTypes, contentType)
+		}
+	}
+	return v
+}
+
+// Valid reports whether the URL points to a valid image.
+// Images that can't be checked (e.g. HEAD isn't allowed) are considered valid, unless they're obviously not raster images.
+func (v *ImageValidator) Valid(ctx context.Context, url string) bool {
+	v.mu.Lock()
+	check, found := v.checks[url]
+	v.mu.Unlock()
+	if found && v.now().Before(check.expiration) {
+		return check.valid
+	}
+
+	valid, err := v.check(ctx, url)
+	if err != nil {
+		// Don't cache the transient failures (e.g. timeouts, canceled requests).
+		return ctx.Err() == nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.now().Sub(v.lastEviction) > v.ttl {
+		v.evictExpired()
+	}
+	v.checks[url] = imageCheck{valid: valid, expiration: v.now().Add(v.ttl)}
+
+	return valid
+}
+
+func (v *ImageValidator) check(ctx context.Context, url string) (bool, error) {
+	parsed, err := neturl.Parse(url)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return false, nil
+	}
+	if strings.HasSuffix(strings.ToLower(parsed.Path), ".svg") {
+		return false, nil
+	}
+
+	release, err := acquireFetchSlot(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, nil
+	}
+	req.Header.Set("User-Agent", DefeedUserAgentString)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusMethodNotAllowed:
+		return true, nil
+	case resp.StatusCode != http.StatusOK:
+		return false, nil
+	case resp.ContentLength > v.maxBytes:
+		return false, nil
+	}
+
+	return v.allowedContentType(resp.Header.Get("Content-Type")), nil
+}
+
+func (v *ImageValidator) allowedContentType(contentType string) bool {
+	if !isRasterImage(contentType) {
+		return false
+	}
+	if len(v.contentTypes) == 0 {
+		return true
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return slices.Contains(v.contentTypes, mediaType)
+}
+
+func (v *ImageValidator) evictExpired() {
+	now := v.now()
+	v.lastEviction = now
+	for url, check := range v.checks {
+		if now.After(check.expiration) {
+			delete(v.checks, url)
+		}
+	}
+}
+
+// isRasterImage checks that the content type is an image, other than SVG (which can contain scripts).
+func isRasterImage(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "image/") && mediaType != "image/svg+xml"
+}
+
+// isFaviconURL reports whether the image URL looks like a site icon, which renders poorly as a thumbnail.
+func isFaviconURL(url string) bool {
+	lower := strings.ToLower(url)
+	return strings.Contains(lower, "favicon") || strings.Contains(lower, "apple-touch-icon") || strings.HasSuffix(lower, ".ico")
+}
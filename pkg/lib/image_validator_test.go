@@ -0,0 +1,88 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestImageValidator(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/image.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Header().Set("Content-Length", "100")
+		case "/large.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Header().Set("Content-Length", "2000")
+		case "/icon":
+			w.Header().Set("Content-Type", "image/svg+xml")
+		case "/page":
+			w.Header().Set("Content-Type", "text/html")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	validator := NewImageValidator(time.Hour, 1000)
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: server.URL + "/image.png", want: true},
+		{url: server.URL + "/large.jpg", want: false},
+		{url: server.URL + "/icon", want: false},
+		{url: server.URL + "/page", want: false},
+		{url: server.URL + "/missing.png", want: false},
+		{url: server.URL + "/logo.svg", want: false},
+		{url: "data:image/png;base64,iVBORw0KGgo=", want: false},
+	}
+	for _, tt := range tests {
+		if got := validator.Valid(context.Background(), tt.url); got != tt.want {
+			t.Errorf("Valid(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+
+	checked := requests.Load()
+	if !validator.Valid(context.Background(), server.URL+"/image.png") {
+		t.Error("expected cached image to be valid")
+	}
+	if requests.Load() != checked {
+		t.Errorf("expected no requests for cached checks, got %d", requests.Load()-checked)
+	}
+
+	allowlisted := NewImageValidator(time.Hour, 1000).WithContentTypes([]string{" image/JPEG", "", "image/webp"})
+	if allowlisted.Valid(context.Background(), server.URL+"/image.png") {
+		t.Error("expected image of a content type outside the allowlist to be invalid")
+	}
+}
+
+func TestFindThumbnailsInHTML(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><head>
+		<meta name="twitter:image" content="/favicon.png">
+		<meta property="og:image" content="https://cdn.example.com/cover.jpg">
+		<link rel="image_src" href="https://cdn.example.com/cover.jpg">
+	</head></html>`))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	pageURL, _ := neturl.Parse("https://example.com/post")
+
+	got := findThumbnailsInHTML(doc, pageURL)
+	want := []string{"https://cdn.example.com/cover.jpg", "https://example.com/favicon.png"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -24,9 +24,7 @@ type OpenAILimiter struct {
 
 func NewOpenAILimiter(logger *zerolog.Logger) *OpenAILimiter {
 	return &OpenAILimiter{
-		client: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		client:       NewHTTPClient(60 * time.Second),
 		logger:       logger,
 		usageTracker: nil,
 	}
@@ -35,9 +33,7 @@ func NewOpenAILimiter(logger *zerolog.Logger) *OpenAILimiter {
 // NewOpenAILimiterWithTracker creates a limiter with usage tracker
 func NewOpenAILimiterWithTracker(logger *zerolog.Logger, usageTracker *UsageTracker) *OpenAILimiter {
 	return &OpenAILimiter{
-		client: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		client:       NewHTTPClient(60 * time.Second),
 		logger:       logger,
 		usageTracker: usageTracker,
 	}
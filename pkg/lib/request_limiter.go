@@ -0,0 +1,58 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// RequestLimiter throttles the requests sent to the provider APIs (see NewProviderHTTPClient).
+type RequestLimiter interface {
+	// Wait blocks until a request to the provider host can be sent or the context is canceled.
+	Wait(ctx context.Context, provider string, host string) error
+}
+
+// requestLimiter is the limiter configured with SetRequestLimiter.
+var requestLimiter atomic.Pointer[RequestLimiter]
+
+// SetRequestLimiter throttles the requests of all provider clients instance-wide.
+// Set to nil to disable the limit.
+func SetRequestLimiter(limiter RequestLimiter) {
+	if limiter == nil {
+		requestLimiter.Store(nil)
+		return
+	}
+	requestLimiter.Store(&limiter)
+}
+
+// NewProviderHTTPClient returns a client like NewHTTPClient,
+// that waits for the request limiter before each request sent to the provider API.
+// A zero timeout means no timeout.
+func NewProviderHTTPClient(provider string, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &limitingTransport{provider: provider, base: sharedTransport},
+		Timeout:   timeout,
+	}
+}
+
+// limitingTransport is resolved per request, so clients created before SetRequestLimiter are also limited.
+type limitingTransport struct {
+	provider string
+	base     http.RoundTripper
+}
+
+func (t *limitingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := requestLimiter.Load(); limiter != nil {
+		if err := (*limiter).Wait(req.Context(), t.provider, req.URL.Hostname()); err != nil {
+			// The transport must close the request body, even on errors.
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+			return nil, fmt.Errorf("wait for rate limiter: %w", err)
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
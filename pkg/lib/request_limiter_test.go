@@ -0,0 +1,57 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type recordingLimiter struct {
+	calls []string
+	err   error
+}
+
+func (l *recordingLimiter) Wait(_ context.Context, provider string, host string) error {
+	l.calls = append(l.calls, provider+"@"+host)
+	return l.err
+}
+
+func TestProviderHTTPClient_WaitsPerRequest(t *testing.T) {
+	t.Cleanup(func() { SetRequestLimiter(nil) })
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	limiter := &recordingLimiter{}
+	// Set after the client is created, to check the limiter is resolved per request.
+	client := NewProviderHTTPClient("example", 0)
+	SetRequestLimiter(limiter)
+
+	for range 3 {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(limiter.calls) != 3 {
+		t.Fatalf("expected a wait per request, got %v", limiter.calls)
+	}
+	if limiter.calls[0] != "example@127.0.0.1" {
+		t.Errorf("expected provider and host, got %s", limiter.calls[0])
+	}
+
+	limiter.err = errors.New("limited")
+	if _, err := client.Get(server.URL); err == nil {
+		t.Error("expected limiter error")
+	}
+	if requests != 3 {
+		t.Errorf("expected limited request not to be sent, got %d requests", requests)
+	}
+}
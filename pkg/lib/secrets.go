@@ -0,0 +1,174 @@
+package lib
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// secretPrefix marks encrypted values, so that plaintext values stored before the encryption was enabled can still be read.
+const secretPrefix = "enc:v1:"
+
+// ErrSecretKeyMissing is returned when an encrypted value is read without a configured key.
+var ErrSecretKeyMissing = errors.New("secret is encrypted, but no encryption key is configured")
+
+// SecretCipher encrypts the struct fields tagged with `secret:"true"` (e.g. source access tokens) using AES-GCM.
+// A nil cipher leaves the secrets in plaintext.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher creates a cipher from a base64-encoded 32 byte key.
+// Returns a nil cipher if the key is empty.
+func NewSecretCipher(key string) (*SecretCipher, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decode key: %w", err)
+	}
+	if len(rawKey) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, got %d", len(rawKey))
+	}
+
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	return &SecretCipher{aead: aead}, nil
+}
+
+// Encrypt encrypts the value. Empty and already encrypted values are returned as is.
+func (c *SecretCipher) Encrypt(value string) (string, error) {
+	if c == nil || value == "" || strings.HasPrefix(value, secretPrefix) {
+		return value, nil
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(value), nil)
+	return secretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts the value. Plaintext values are returned as is.
+func (c *SecretCipher) Decrypt(value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, secretPrefix)
+	if !ok {
+		return value, nil
+	}
+	if c == nil {
+		return "", ErrSecretKeyMissing
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decode secret: %w", err)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", fmt.Errorf("secret is too short")
+	}
+
+	plaintext, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt secret: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// SealSecrets returns a shallow copy of the struct pointer with the secret fields encrypted.
+// The original struct is left untouched, since it may still be in use.
+func (c *SecretCipher) SealSecrets(v any) (any, error) {
+	if c == nil {
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return v, nil
+	}
+
+	out := reflect.New(rv.Elem().Type())
+	out.Elem().Set(rv.Elem())
+
+	err := forEachSecretField(out.Elem(), c.Encrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Interface(), nil
+}
+
+// OpenSecrets decrypts the secret fields of the struct pointer in place.
+func (c *SecretCipher) OpenSecrets(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	return forEachSecretField(rv.Elem(), c.Decrypt)
+}
+
+// forEachSecretField replaces the settable fields tagged with `secret:"true"` with the result of fn, including the embedded structs.
+// Both the string fields and the values of the map[string]string fields (e.g. request headers) are supported.
+// Maps are replaced with a new map instead of being updated in place, since a shallow copy shares them with the original struct.
+func forEachSecretField(v reflect.Value, fn func(value string) (string, error)) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		value := v.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if err := forEachSecretField(value, fn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if field.Tag.Get("secret") != "true" || !value.CanSet() {
+			continue
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.String:
+			out, err := fn(value.String())
+			if err != nil {
+				return fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			value.SetString(out)
+		case field.Type.Kind() == reflect.Map && field.Type.Key().Kind() == reflect.String && field.Type.Elem().Kind() == reflect.String:
+			if value.IsNil() {
+				continue
+			}
+			out := reflect.MakeMapWithSize(field.Type, value.Len())
+			iter := value.MapRange()
+			for iter.Next() {
+				transformed, err := fn(iter.Value().String())
+				if err != nil {
+					return fmt.Errorf("field %s[%s]: %w", field.Name, iter.Key().String(), err)
+				}
+				out.SetMapIndex(iter.Key(), reflect.ValueOf(transformed).Convert(field.Type.Elem()))
+			}
+			value.Set(out)
+		}
+	}
+	return nil
+}
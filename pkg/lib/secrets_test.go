@@ -0,0 +1,120 @@
+package lib
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type secretsTestEmbedded struct {
+	Password string `secret:"true"`
+}
+
+type secretsTestStruct struct {
+	secretsTestEmbedded
+	Name    string
+	Token   string            `secret:"true"`
+	Headers map[string]string `secret:"true"`
+}
+
+func newTestSecretCipher(t *testing.T, key byte) *SecretCipher {
+	t.Helper()
+
+	raw := make([]byte, 32)
+	for i := range raw {
+		raw[i] = key
+	}
+
+	c, err := NewSecretCipher(base64.StdEncoding.EncodeToString(raw))
+	if err != nil {
+		t.Fatalf("NewSecretCipher() error = %v", err)
+	}
+	return c
+}
+
+func TestSecretCipher_SealAndOpenSecrets(t *testing.T) {
+	c := newTestSecretCipher(t, 1)
+
+	in := &secretsTestStruct{
+		secretsTestEmbedded: secretsTestEmbedded{Password: "hunter2"},
+		Name:                "public",
+		Token:               "ghp_token",
+		Headers:             map[string]string{"Authorization": "Bearer token"},
+	}
+
+	sealedAny, err := c.SealSecrets(in)
+	if err != nil {
+		t.Fatalf("SealSecrets() error = %v", err)
+	}
+	sealed := sealedAny.(*secretsTestStruct)
+
+	if in.Token != "ghp_token" || in.Password != "hunter2" || in.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("SealSecrets() modified the original struct: %+v", in)
+	}
+	if !strings.HasPrefix(sealed.Token, secretPrefix) || !strings.HasPrefix(sealed.Password, secretPrefix) || !strings.HasPrefix(sealed.Headers["Authorization"], secretPrefix) {
+		t.Errorf("SealSecrets() secrets not encrypted: %+v", sealed)
+	}
+	if sealed.Name != "public" {
+		t.Errorf("SealSecrets() Name = %q, want %q", sealed.Name, "public")
+	}
+
+	if err := c.OpenSecrets(sealed); err != nil {
+		t.Fatalf("OpenSecrets() error = %v", err)
+	}
+	if sealed.Token != "ghp_token" || sealed.Password != "hunter2" || sealed.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("OpenSecrets() = %+v, want original secrets", sealed)
+	}
+}
+
+func TestSecretCipher_Decrypt(t *testing.T) {
+	c := newTestSecretCipher(t, 1)
+	other := newTestSecretCipher(t, 2)
+
+	encrypted, err := c.Encrypt("secret")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cipher  *SecretCipher
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "encrypted value", cipher: c, value: encrypted, want: "secret"},
+		{name: "plaintext value", cipher: c, value: "legacy", want: "legacy"},
+		{name: "empty value", cipher: c, value: "", want: ""},
+		{name: "wrong key", cipher: other, value: encrypted, wantErr: true},
+		{name: "missing key", cipher: nil, value: encrypted, wantErr: true},
+		{name: "missing key with plaintext value", cipher: nil, value: "legacy", want: "legacy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cipher.Decrypt(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decrypt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decrypt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (*SecretCipher)(nil).Decrypt(encrypted); !errors.Is(err, ErrSecretKeyMissing) {
+		t.Errorf("Decrypt() without key error = %v, want %v", err, ErrSecretKeyMissing)
+	}
+}
+
+func TestNewSecretCipher(t *testing.T) {
+	c, err := NewSecretCipher("")
+	if err != nil || c != nil {
+		t.Errorf("NewSecretCipher(\"\") = %v, %v, want nil, nil", c, err)
+	}
+
+	if _, err := NewSecretCipher(base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Error("NewSecretCipher() with a short key expected an error")
+	}
+}
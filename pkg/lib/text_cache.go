@@ -0,0 +1,256 @@
+package lib
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// textCache is the cache used by FetchTextFromURL, configured with SetTextCache.
+var textCache atomic.Pointer[TextCache]
+
+// SetTextCache enables caching of the extracted article texts. Nil disables the cache.
+func SetTextCache(cache *TextCache) {
+	textCache.Store(cache)
+}
+
+// textCacheTrimInterval is how often the persistent store is trimmed to its size cap.
+const textCacheTrimInterval = time.Hour
+
+// TextCacheStore persists the cached article texts, so that they survive restarts and are shared between instances.
+type TextCacheStore interface {
+	// GetText returns false if the text of the URL isn't stored.
+	GetText(ctx context.Context, url string) (CachedText, bool, error)
+	SetText(ctx context.Context, url string, text CachedText) error
+	// TrimTexts removes the least recently fetched texts until their total size is within maxBytes.
+	TrimTexts(ctx context.Context, maxBytes int) error
+}
+
+// CachedText is the article text extracted from a page.
+type CachedText struct {
+	Text string
+	// ETag is used to revalidate the text once it's stale.
+	ETag      string
+	FetchedAt time.Time
+}
+
+// TextCache caches the extracted article text by canonical URL,
+// so that articles referenced by multiple sources or polls (e.g. HN, Reddit) aren't re-fetched and re-parsed.
+// Stale entries are revalidated with the ETag, if the server provided one.
+// The total size of the cached texts is capped by evicting the least recently used entries.
+// Texts missing in memory are looked up in the persistent store, if configured with WithStore.
+type TextCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	maxBytes int
+	size     int
+	// order has the most recently used entries at the front.
+	order   *list.List
+	entries map[string]*list.Element
+	now     func() time.Time
+
+	store         TextCacheStore
+	storeMaxBytes int
+	trimmedAt     time.Time
+}
+
+type textCacheEntry struct {
+	key       string
+	text      string
+	etag      string
+	fetchedAt time.Time
+}
+
+func NewTextCache(ttl time.Duration, maxBytes int) *TextCache {
+	return &TextCache{
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		now:      time.Now,
+	}
+}
+
+// WithStore persists the cached texts in the store, capped to maxBytes.
+func (c *TextCache) WithStore(store TextCacheStore, maxBytes int) *TextCache {
+	c.store = store
+	c.storeMaxBytes = maxBytes
+	return c
+}
+
+func (c *TextCache) fetchText(ctx context.Context, logger *zerolog.Logger, url string, fallbacks TextFallbacks) (string, error) {
+	key := CanonicalURL(url)
+
+	entry, found := c.lookup(ctx, logger, key)
+	if found && c.now().Sub(entry.fetchedAt) < c.ttl {
+		return entry.text, nil
+	}
+
+	headers := map[string]string{"User-Agent": DefeedUserAgentString}
+	if found && entry.etag != "" {
+		headers["If-None-Match"] = entry.etag
+	}
+
+	release, err := acquireFetchSlot(ctx)
+	if err != nil {
+		return "", fmt.Errorf("acquire fetch slot: %w", err)
+	}
+	defer release()
+
+	resp, err := fetchURLWithHeaders(ctx, url, headers)
+	if err != nil {
+		return "", fmt.Errorf("fetch url: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		c.save(ctx, logger, key, entry.text, entry.etag)
+		return entry.text, nil
+	}
+
+	text, err := TextFromHTTPResponse(ctx, logger, resp, fallbacks)
+	if err != nil {
+		return "", fmt.Errorf("text from http response: %w", err)
+	}
+
+	c.save(ctx, logger, key, text, resp.Header.Get("ETag"))
+
+	return text, nil
+}
+
+// textFromResponse returns the cached text of the already fetched page, or extracts and caches it.
+func (c *TextCache) textFromResponse(ctx context.Context, logger *zerolog.Logger, url string, resp *http.Response, fallbacks TextFallbacks) (string, error) {
+	key := CanonicalURL(url)
+
+	entry, found := c.lookup(ctx, logger, key)
+	if found && (c.now().Sub(entry.fetchedAt) < c.ttl || (entry.etag != "" && entry.etag == resp.Header.Get("ETag"))) {
+		return entry.text, nil
+	}
+
+	text, err := TextFromHTTPResponse(ctx, logger, resp, fallbacks)
+	if err != nil {
+		return "", err
+	}
+
+	c.save(ctx, logger, key, text, resp.Header.Get("ETag"))
+
+	return text, nil
+}
+
+// lookup returns the cached entry from memory, or the persistent store.
+// Store errors are logged, so that the text is fetched again instead.
+func (c *TextCache) lookup(ctx context.Context, logger *zerolog.Logger, key string) (textCacheEntry, bool) {
+	if entry, found := c.get(key); found || c.store == nil {
+		return entry, found
+	}
+
+	stored, found, err := c.store.GetText(ctx, key)
+	if err != nil {
+		logger.Warn().Err(err).Str("url", key).Msg("Failed to get the stored article text")
+		return textCacheEntry{}, false
+	}
+	if !found {
+		return textCacheEntry{}, false
+	}
+
+	entry := textCacheEntry{
+		key:       key,
+		text:      stored.Text,
+		etag:      stored.ETag,
+		fetchedAt: stored.FetchedAt,
+	}
+	c.put(entry)
+
+	return entry, true
+}
+
+// save caches the text in memory and the persistent store.
+func (c *TextCache) save(ctx context.Context, logger *zerolog.Logger, key string, text string, etag string) {
+	c.set(key, text, etag)
+	if c.store == nil {
+		return
+	}
+
+	err := c.store.SetText(ctx, key, CachedText{Text: text, ETag: etag, FetchedAt: c.now()})
+	if err != nil {
+		logger.Warn().Err(err).Str("url", key).Msg("Failed to store the article text")
+	}
+
+	if !c.shouldTrim() {
+		return
+	}
+	if err := c.store.TrimTexts(ctx, c.storeMaxBytes); err != nil {
+		logger.Warn().Err(err).Msg("Failed to trim the stored article texts")
+	}
+}
+
+// shouldTrim returns true at most once per textCacheTrimInterval.
+func (c *TextCache) shouldTrim() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.now().Sub(c.trimmedAt) < textCacheTrimInterval {
+		return false
+	}
+	c.trimmedAt = c.now()
+	return true
+}
+
+func (c *TextCache) get(key string) (textCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return textCacheEntry{}, false
+	}
+
+	c.order.MoveToFront(elem)
+	return *elem.Value.(*textCacheEntry), true
+}
+
+func (c *TextCache) set(key string, text string, etag string) {
+	c.put(textCacheEntry{
+		key:       key,
+		text:      text,
+		etag:      etag,
+		fetchedAt: c.now(),
+	})
+}
+
+func (c *TextCache) put(entry textCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(entry.key)
+
+	// Texts larger than the whole cache would evict everything else.
+	if len(entry.text) > c.maxBytes {
+		return
+	}
+
+	c.entries[entry.key] = c.order.PushFront(&entry)
+	c.size += len(entry.text)
+
+	for c.size > c.maxBytes {
+		oldest := c.order.Back()
+		c.remove(oldest.Value.(*textCacheEntry).key)
+	}
+}
+
+func (c *TextCache) remove(key string) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return
+	}
+
+	c.order.Remove(elem)
+	delete(c.entries, key)
+	c.size -= len(elem.Value.(*textCacheEntry).text)
+}
@@ -0,0 +1,164 @@
+package lib
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestTextCache(t *testing.T) {
+	var requests, revalidations atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("<html><head><title>Article</title></head><body><article><p>" +
+			strings.Repeat("The article text is long enough to be extracted. ", 20) +
+			"</p></article></body></html>"))
+	}))
+	defer server.Close()
+
+	logger := zerolog.Nop()
+	now := time.Now()
+	cache := NewTextCache(time.Hour, 1<<20)
+	cache.now = func() time.Time { return now }
+
+	text, err := cache.fetchText(context.Background(), &logger, server.URL, TextFallbacks{})
+	if err != nil {
+		t.Fatalf("fetch text: %v", err)
+	}
+	if !strings.Contains(text, "article text") {
+		t.Fatalf("unexpected text: %q", text)
+	}
+	fetched := requests.Load()
+
+	cached, err := cache.fetchText(context.Background(), &logger, server.URL+"#comments", TextFallbacks{})
+	if err != nil || cached != text {
+		t.Fatalf("expected cached text, got %q (%v)", cached, err)
+	}
+	if requests.Load() != fetched {
+		t.Errorf("expected no requests for fresh entries, got %d", requests.Load()-fetched)
+	}
+
+	now = now.Add(2 * time.Hour)
+	revalidated, err := cache.fetchText(context.Background(), &logger, server.URL, TextFallbacks{})
+	if err != nil || revalidated != text {
+		t.Fatalf("expected revalidated text, got %q (%v)", revalidated, err)
+	}
+	if revalidations.Load() != 1 || requests.Load() != fetched+1 {
+		t.Errorf("expected a single conditional request, got %d requests and %d revalidations",
+			requests.Load()-fetched, revalidations.Load())
+	}
+}
+
+func TestTextCacheEviction(t *testing.T) {
+	cache := NewTextCache(time.Hour, 10)
+
+	cache.set("a", "12345", "")
+	cache.set("b", "12345", "")
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("expected entry within the size cap")
+	}
+
+	// "b" is now the least recently used entry.
+	cache.set("c", "12345", "")
+	if _, ok := cache.get("b"); ok {
+		t.Error("expected least recently used entry to be evicted")
+	}
+	if _, ok := cache.get("a"); !ok {
+		t.Error("expected recently used entry to be kept")
+	}
+
+	cache.set("d", "12345678901", "")
+	if _, ok := cache.get("d"); ok {
+		t.Error("expected text larger than the cache to be skipped")
+	}
+	if cache.size != 10 {
+		t.Errorf("expected size 10, got %d", cache.size)
+	}
+}
+
+type memoryTextStore struct {
+	texts map[string]CachedText
+	trims int
+}
+
+func (s *memoryTextStore) GetText(_ context.Context, url string) (CachedText, bool, error) {
+	text, ok := s.texts[url]
+	return text, ok, nil
+}
+
+func (s *memoryTextStore) SetText(_ context.Context, url string, text CachedText) error {
+	s.texts[url] = text
+	return nil
+}
+
+func (s *memoryTextStore) TrimTexts(context.Context, int) error {
+	s.trims++
+	return nil
+}
+
+func TestTextCacheStore(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html><head><title>Article</title></head><body><article><p>" +
+			strings.Repeat("The article text is long enough to be extracted. ", 20) +
+			"</p></article></body></html>"))
+	}))
+	defer server.Close()
+
+	logger := zerolog.Nop()
+	store := &memoryTextStore{texts: make(map[string]CachedText)}
+
+	text, err := NewTextCache(time.Hour, 1<<20).WithStore(store, 1<<20).
+		fetchText(context.Background(), &logger, server.URL, TextFallbacks{})
+	if err != nil {
+		t.Fatalf("fetch text: %v", err)
+	}
+	if stored := store.texts[CanonicalURL(server.URL)]; stored.Text != text {
+		t.Fatalf("expected stored text, got %q", stored.Text)
+	}
+	if store.trims != 1 {
+		t.Errorf("expected the store to be trimmed once, got %d", store.trims)
+	}
+
+	// A new cache (e.g. after a restart) is served from the store.
+	restarted := NewTextCache(time.Hour, 1<<20).WithStore(store, 1<<20)
+	cached, err := restarted.fetchText(context.Background(), &logger, server.URL, TextFallbacks{})
+	if err != nil || cached != text {
+		t.Fatalf("expected stored text, got %q (%v)", cached, err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected a single request, got %d", requests.Load())
+	}
+}
+
+func TestTextCacheFromResponse(t *testing.T) {
+	logger := zerolog.Nop()
+	cache := NewTextCache(time.Hour, 1<<20)
+	cache.set(CanonicalURL("https://example.com/article"), "cached text", "")
+
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/html"}},
+		Body:       io.NopCloser(strings.NewReader("<html><body><p>fetched text</p></body></html>")),
+	}
+	text, err := cache.textFromResponse(context.Background(), &logger, "https://example.com/article#comments", resp, TextFallbacks{})
+	if err != nil || text != "cached text" {
+		t.Errorf("expected cached text, got %q (%v)", text, err)
+	}
+}
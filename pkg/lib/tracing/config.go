@@ -0,0 +1,12 @@
+package tracing
+
+type Config struct {
+	// OTLPEndpoint is the OTLP/HTTP collector endpoint (e.g. localhost:4318). Tracing is disabled if empty.
+	OTLPEndpoint string `env:"OTEL_EXPORTER_OTLP_ENDPOINT,default="`
+	// OTLPInsecure disables TLS when exporting spans to the collector.
+	OTLPInsecure bool `env:"OTEL_EXPORTER_OTLP_INSECURE,default=false"`
+	// ServiceName is reported as the service.name resource attribute.
+	ServiceName string `env:"OTEL_SERVICE_NAME,default=defeed"`
+	// SampleRatio is the fraction of traces that are sampled.
+	SampleRatio float64 `env:"OTEL_TRACES_SAMPLE_RATIO,default=1" validate:"gte=0,lte=1"`
+}
@@ -2,16 +2,46 @@ package lib
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
 
-var goValidator = validator.New()
+var goValidator = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// Report the JSON field names, since those are the names the users see (e.g. in source configs).
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			return ""
+		case "":
+			return field.Name
+		default:
+			return name
+		}
+	})
+	return v
+}
 
 // ValidationErrors represents multiple validation errors.
 type ValidationErrors struct {
 	Errors []string `json:"errors"`
+	// Fields are the individual field errors, used to point the user to the invalid input.
+	Fields []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError represents a single struct field that failed validation.
+type FieldError struct {
+	// Field is the struct field name.
+	Field string `json:"field"`
+	// Rule is the failed validation tag (e.g. "required", "url").
+	Rule string `json:"rule"`
+	// Param is the validation tag parameter (e.g. "10" for "lte=10").
+	Param string `json:"param,omitempty"`
 }
 
 // Error implements the error interface.
@@ -31,6 +61,11 @@ func ValidateStruct(s any) error {
 			out := ValidationErrors{Errors: []string{err.Error()}}
 			for _, e := range ve {
 				out.Errors = append(out.Errors, fmt.Sprintf("%s %s", e.Field(), e.ActualTag()))
+				out.Fields = append(out.Fields, FieldError{
+					Field: e.Field(),
+					Rule:  e.ActualTag(),
+					Param: e.Param(),
+				})
 			}
 			return out
 		}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	neturl "net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -23,6 +24,26 @@ var (
 	ErrHTMLParsingFailed      = errors.New("html parsing failed")
 )
 
+// TextFallbacks toggles the strategies used to fetch the full article text,
+// when the text extracted from the original page looks truncated (e.g. by a paywall).
+type TextFallbacks struct {
+	// AMP fetches the page referenced by <link rel="amphtml">.
+	AMP bool
+	// Print fetches the print variant of the page (?print=1).
+	Print bool
+	// Googlebot fetches the page with the Googlebot user agent.
+	Googlebot bool
+}
+
+func (f TextFallbacks) enabled() bool {
+	return f.AMP || f.Print || f.Googlebot
+}
+
+// minFullArticleLength is the text length below which the article is considered truncated.
+const minFullArticleLength = 1500
+
+const googlebotUserAgent = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+
 func FetchThumbnailFromURL(ctx context.Context, logger *zerolog.Logger, url string) (string, error) {
 	resp, err := FetchURL(ctx, logger, url)
 	if err != nil {
@@ -39,7 +60,13 @@ func FetchThumbnailFromURL(ctx context.Context, logger *zerolog.Logger, url stri
 	return thumbnailURL, nil
 }
 
-func FetchTextFromURL(ctx context.Context, logger *zerolog.Logger, url string) (string, error) {
+// FetchTextFromURL fetches the URL and extracts the article text.
+// The text is served from the cache configured with SetTextCache, if any.
+func FetchTextFromURL(ctx context.Context, logger *zerolog.Logger, url string, fallbacks TextFallbacks) (string, error) {
+	if cache := textCache.Load(); cache != nil {
+		return cache.fetchText(ctx, logger, url, fallbacks)
+	}
+
 	resp, err := FetchURL(ctx, logger, url)
 	if err != nil {
 		return "", fmt.Errorf("fetch url: %w", err)
@@ -47,7 +74,7 @@ func FetchTextFromURL(ctx context.Context, logger *zerolog.Logger, url string) (
 
 	defer resp.Body.Close()
 
-	text, err := TextFromHTTPResponse(ctx, logger, resp)
+	text, err := TextFromHTTPResponse(ctx, logger, resp, fallbacks)
 	if err != nil {
 		return "", fmt.Errorf("text from http response: %w", err)
 	}
@@ -55,13 +82,65 @@ func FetchTextFromURL(ctx context.Context, logger *zerolog.Logger, url string) (
 	return text, nil
 }
 
+// CachedTextFromHTTPResponse is like TextFromHTTPResponse for the already fetched page of the URL,
+// but serves the text from the cache configured with SetTextCache (if any), so that it isn't parsed again.
+func CachedTextFromHTTPResponse(ctx context.Context, logger *zerolog.Logger, url string, resp *http.Response, fallbacks TextFallbacks) (string, error) {
+	if cache := textCache.Load(); cache != nil {
+		return cache.textFromResponse(ctx, logger, url, resp, fallbacks)
+	}
+
+	return TextFromHTTPResponse(ctx, logger, resp, fallbacks)
+}
+
 // FetchURL fetches a URL and returns the http response.
-// The response body should be closed by the caller.
+// The response body should be closed by the caller, which also frees the slot of the concurrent fetches limit.
 func FetchURL(ctx context.Context, logger *zerolog.Logger, url string) (*http.Response, error) {
+	release, err := acquireFetchSlot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire fetch slot: %w", err)
+	}
+
+	resp, err := fetchURLAs(ctx, url, DefeedUserAgentString)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// BufferResponse reads the response body into memory, so that the response can be parsed multiple times
+// (e.g. for the favicon, thumbnail and article text). Each returned response reads the body from the start.
+// The original body must still be closed by the caller.
+func BufferResponse(resp *http.Response) (func() *http.Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	return func() *http.Response {
+		copied := *resp
+		copied.Body = io.NopCloser(bytes.NewReader(body))
+		return &copied
+	}, nil
+}
+
+func fetchURLAs(ctx context.Context, url string, userAgent string) (*http.Response, error) {
+	return fetchURLWithHeaders(ctx, url, map[string]string{"User-Agent": userAgent})
+}
+
+func fetchURLWithHeaders(ctx context.Context, url string, headers map[string]string) (*http.Response, error) {
+	transport := NewHTTPTransport()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	client := &http.Client{
-		Timeout: 10 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return checkDomainPolicy(req.URL)
 		},
 	}
 
@@ -70,7 +149,13 @@ func FetchURL(ctx context.Context, logger *zerolog.Logger, url string) (*http.Re
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	req.Header.Set("User-Agent", DefeedUserAgentString)
+	if err := checkDomainPolicy(req.URL); err != nil {
+		return nil, err
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -80,7 +165,9 @@ func FetchURL(ctx context.Context, logger *zerolog.Logger, url string) (*http.Re
 	return resp, nil
 }
 
-func TextFromHTTPResponse(ctx context.Context, logger *zerolog.Logger, resp *http.Response) (string, error) {
+// TextFromHTTPResponse extracts the article text from the response.
+// When the extracted HTML text looks truncated, the enabled fallbacks are tried and the longest text is returned.
+func TextFromHTTPResponse(ctx context.Context, logger *zerolog.Logger, resp *http.Response, fallbacks TextFallbacks) (string, error) {
 	if resp.StatusCode != http.StatusOK {
 		return "", fmt.Errorf("http status: %d", resp.StatusCode)
 	}
@@ -93,7 +180,23 @@ func TextFromHTTPResponse(ctx context.Context, logger *zerolog.Logger, resp *htt
 	}
 
 	if strings.Contains(contentType, "text/html") || strings.Contains(contentType, "application/xhtml+xml") {
-		return extractTextFromHTML(logger, url)
+		text, err := extractTextFromHTML(logger, resp.Body, resp.Request.URL)
+		if !fallbacks.enabled() || len(text) >= minFullArticleLength {
+			return text, err
+		}
+
+		fallbackText, strategy := fetchFallbackText(ctx, logger, url, fallbacks)
+		if len(fallbackText) > len(text) {
+			logger.Info().
+				Str("url", url).
+				Str("strategy", strategy).
+				Int("original_length", len(text)).
+				Int("fallback_length", len(fallbackText)).
+				Msg("Fetched article text with fallback strategy")
+			return fallbackText, nil
+		}
+
+		return text, err
 	}
 
 	logger.Warn().
@@ -128,7 +231,9 @@ func extractTextFromPDF(body io.ReadCloser) (string, error) {
 	return string(textBytes), nil
 }
 
-func extractTextFromHTML(logger *zerolog.Logger, url string) (string, error) {
+// extractTextFromHTML extracts the article text from the already fetched page,
+// so that the page isn't fetched twice (and bypassing the outbound proxy).
+func extractTextFromHTML(logger *zerolog.Logger, body io.Reader, pageURL *neturl.URL) (string, error) {
 	var result string
 	var resultErr error
 
@@ -137,15 +242,15 @@ func extractTextFromHTML(logger *zerolog.Logger, url string) (string, error) {
 			// We seem to be getting an occasional panic here.
 			// Log to investigate further.
 			logger.Error().
-				Str("url", url).
+				Str("url", pageURL.String()).
 				Interface("panic", r).
 				Msg("html parsing panic")
 		}
 	}()
 
-	article, err := readability.FromURL(url, 5*time.Second)
+	article, err := readability.FromReader(body, pageURL)
 	if err != nil {
-		resultErr = fmt.Errorf("readability from url: %w", err)
+		resultErr = fmt.Errorf("readability from reader: %w", err)
 		return result, resultErr
 	}
 
@@ -153,6 +258,141 @@ func extractTextFromHTML(logger *zerolog.Logger, url string) (string, error) {
 	return result, resultErr
 }
 
+// fetchFallbackText tries the enabled fallback strategies in order and returns the longest text
+// along with the name of the strategy that produced it.
+// Stops early once a strategy returns text that doesn't look truncated.
+func fetchFallbackText(ctx context.Context, logger *zerolog.Logger, url string, fallbacks TextFallbacks) (string, string) {
+	strategies := []struct {
+		name    string
+		enabled bool
+		fetch   func(ctx context.Context, url string) (string, error)
+	}{
+		{name: "amp", enabled: fallbacks.AMP, fetch: fetchAMPText},
+		{name: "print", enabled: fallbacks.Print, fetch: fetchPrintText},
+		{name: "googlebot", enabled: fallbacks.Googlebot, fetch: fetchGooglebotText},
+	}
+
+	var best, bestStrategy string
+	for _, strategy := range strategies {
+		if !strategy.enabled {
+			continue
+		}
+
+		text, err := strategy.fetch(ctx, url)
+		if err != nil {
+			logger.Debug().
+				Err(err).
+				Str("url", url).
+				Str("strategy", strategy.name).
+				Msg("Article text fallback failed")
+			continue
+		}
+
+		if len(text) > len(best) {
+			best = text
+			bestStrategy = strategy.name
+		}
+
+		if len(best) >= minFullArticleLength {
+			break
+		}
+	}
+
+	return best, bestStrategy
+}
+
+func fetchAMPText(ctx context.Context, url string) (string, error) {
+	resp, err := fetchURLAs(ctx, url, DefeedUserAgentString)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("http status: %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("parse html: %w", err)
+	}
+
+	ampURL := findAMPURL(doc, resp.Request.URL)
+	if ampURL == "" {
+		return "", fmt.Errorf("no amp link found")
+	}
+
+	return fetchArticleText(ctx, ampURL, DefeedUserAgentString)
+}
+
+func fetchPrintText(ctx context.Context, url string) (string, error) {
+	printURL, err := printVariantURL(url)
+	if err != nil {
+		return "", err
+	}
+
+	return fetchArticleText(ctx, printURL, DefeedUserAgentString)
+}
+
+func fetchGooglebotText(ctx context.Context, url string) (string, error) {
+	return fetchArticleText(ctx, url, googlebotUserAgent)
+}
+
+// findAMPURL returns the absolute URL of the AMP version of the page, or an empty string if there is none.
+func findAMPURL(doc *goquery.Document, pageURL *neturl.URL) string {
+	href, exists := doc.Find("link[rel='amphtml']").First().Attr("href")
+	href = strings.TrimSpace(href)
+	if !exists || href == "" {
+		return ""
+	}
+
+	ref, err := neturl.Parse(href)
+	if err != nil {
+		return ""
+	}
+
+	return pageURL.ResolveReference(ref).String()
+}
+
+func printVariantURL(url string) (string, error) {
+	parsedURL, err := neturl.Parse(url)
+	if err != nil {
+		return "", fmt.Errorf("parse url: %w", err)
+	}
+
+	query := parsedURL.Query()
+	query.Set("print", "1")
+	parsedURL.RawQuery = query.Encode()
+
+	return parsedURL.String(), nil
+}
+
+func fetchArticleText(ctx context.Context, url string, userAgent string) (result string, resultErr error) {
+	defer func() {
+		// See extractTextFromHTML.
+		if r := recover(); r != nil {
+			resultErr = fmt.Errorf("%w: %v", ErrHTMLParsingFailed, r)
+		}
+	}()
+
+	resp, err := fetchURLAs(ctx, url, userAgent)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("http status: %d", resp.StatusCode)
+	}
+
+	article, err := readability.FromReader(resp.Body, resp.Request.URL)
+	if err != nil {
+		return "", fmt.Errorf("readability from reader: %w", err)
+	}
+
+	return article.TextContent, nil
+}
+
 // StripURL removes the protocol, www., and trailing slash from a URL.
 func StripURL(url string) string {
 	url = strings.TrimPrefix(url, "https://")
@@ -162,6 +402,52 @@ func StripURL(url string) string {
 	return url
 }
 
+// trackingQueryParams are query parameters that don't change the referenced resource.
+var trackingQueryParams = map[string]bool{
+	"fbclid":  true,
+	"gclid":   true,
+	"dclid":   true,
+	"msclkid": true,
+	"yclid":   true,
+	"igshid":  true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"_hsenc":  true,
+	"_hsmi":   true,
+	"mkt_tok": true,
+	"ref":     true,
+	"ref_src": true,
+}
+
+// CanonicalURL normalizes the URL, so that variants of the same URL (e.g. http vs https, tracking params)
+// result in the same string. The output is in the same format as StripURL (without the scheme and "www." prefix).
+func CanonicalURL(url string) string {
+	parsedURL, err := neturl.Parse(strings.TrimSpace(url))
+	if err != nil || parsedURL.Host == "" {
+		return StripURL(url)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsedURL.Hostname()), "www.")
+	if port := parsedURL.Port(); port != "" && port != "80" && port != "443" {
+		host = host + ":" + port
+	}
+
+	query := parsedURL.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") || trackingQueryParams[strings.ToLower(key)] {
+			query.Del(key)
+		}
+	}
+
+	out := host + strings.TrimSuffix(parsedURL.EscapedPath(), "/")
+	// Encode sorts the params by key.
+	if encoded := query.Encode(); encoded != "" {
+		out += "?" + encoded
+	}
+
+	return out
+}
+
 func StripURLHost(url string) (string, error) {
 	parsedURL, err := neturl.Parse(url)
 	if err != nil {
@@ -208,7 +494,7 @@ func checkFaviconExists(ctx context.Context, faviconURL string) bool {
 		return false
 	}
 
-	client := &http.Client{Timeout: 5 * time.Second}
+	client := NewHTTPClient(5 * time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return false
@@ -283,15 +569,18 @@ func ThumbnailURLFromHTTPResponse(ctx context.Context, logger *zerolog.Logger, r
 		return "", fmt.Errorf("parse html: %w", err)
 	}
 
-	thumbnailURL := findThumbnailInHTML(doc, resp.Request.URL)
-	if thumbnailURL != "" {
-		return thumbnailURL, nil
+	for _, thumbnailURL := range findThumbnailsInHTML(doc, resp.Request.URL) {
+		if ValidImageURL(ctx, thumbnailURL) != "" {
+			return thumbnailURL, nil
+		}
 	}
 
 	return "", fmt.Errorf("no thumbnail found")
 }
 
-func findThumbnailInHTML(doc *goquery.Document, url *neturl.URL) string {
+// findThumbnailsInHTML returns the thumbnail candidates in the order of preference.
+// Site icons are ordered last, since the larger preview images (e.g. og:image) render better in the feed cards.
+func findThumbnailsInHTML(doc *goquery.Document, url *neturl.URL) []string {
 	thumbnailSelectors := []string{
 		"meta[property='og:image']",
 		"meta[name='twitter:image']",
@@ -300,13 +589,9 @@ func findThumbnailInHTML(doc *goquery.Document, url *neturl.URL) string {
 		"link[rel='image_src']",
 	}
 
-	var foundThumbnail string
+	var thumbnails, icons []string
 	for _, selector := range thumbnailSelectors {
 		doc.Find(selector).Each(func(i int, s *goquery.Selection) {
-			if foundThumbnail != "" {
-				return
-			}
-
 			var content string
 			var exists bool
 
@@ -318,17 +603,18 @@ func findThumbnailInHTML(doc *goquery.Document, url *neturl.URL) string {
 
 			if content != "" {
 				resolvedURL := resolveThumbnailURL(content, url)
-				if resolvedURL != "" {
-					foundThumbnail = resolvedURL
+				switch {
+				case resolvedURL == "" || slices.Contains(thumbnails, resolvedURL) || slices.Contains(icons, resolvedURL):
+				case isFaviconURL(resolvedURL):
+					icons = append(icons, resolvedURL)
+				default:
+					thumbnails = append(thumbnails, resolvedURL)
 				}
 			}
 		})
-		if foundThumbnail != "" {
-			break
-		}
 	}
 
-	return foundThumbnail
+	return append(thumbnails, icons...)
 }
 
 func resolveThumbnailURL(content string, url *neturl.URL) string {
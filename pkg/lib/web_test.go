@@ -0,0 +1,107 @@
+package lib
+
+import (
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestCanonicalURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "plain", url: "https://example.com/post", want: "example.com/post"},
+		{name: "same as strip url for clean urls", url: "https://www.example.com/post/", want: StripURL("https://www.example.com/post/")},
+		{name: "http scheme", url: "http://example.com/post", want: "example.com/post"},
+		{name: "host case", url: "https://Example.COM/post", want: "example.com/post"},
+		{name: "path case is preserved", url: "https://example.com/Post", want: "example.com/Post"},
+		{name: "default port", url: "https://example.com:443/post", want: "example.com/post"},
+		{name: "custom port", url: "https://example.com:8080/post", want: "example.com:8080/post"},
+		{name: "fragment", url: "https://example.com/post#comments", want: "example.com/post"},
+		{name: "tracking params", url: "https://example.com/post?utm_source=rss&utm_medium=feed&fbclid=abc", want: "example.com/post"},
+		{name: "sorted params", url: "https://example.com/post?b=2&utm_campaign=x&a=1", want: "example.com/post?a=1&b=2"},
+		{name: "invalid url", url: "://example.com/post", want: StripURL("://example.com/post")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanonicalURL(tt.url); got != tt.want {
+				t.Errorf("CanonicalURL(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindAMPURL(t *testing.T) {
+	pageURL, _ := neturl.Parse("https://example.com/news/post")
+
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{name: "absolute", html: `<link rel="amphtml" href="https://amp.example.com/post">`, want: "https://amp.example.com/post"},
+		{name: "relative path", html: `<link rel="amphtml" href="/amp/post">`, want: "https://example.com/amp/post"},
+		{name: "relative to page", html: `<link rel="amphtml" href="post.amp">`, want: "https://example.com/news/post.amp"},
+		{name: "missing", html: `<link rel="canonical" href="https://example.com/post">`, want: ""},
+		{name: "empty href", html: `<link rel="amphtml" href=" ">`, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.html + "</head></html>"))
+			if err != nil {
+				t.Fatalf("parse html: %v", err)
+			}
+			if got := findAMPURL(doc, pageURL); got != tt.want {
+				t.Errorf("findAMPURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintVariantURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://example.com/post", want: "https://example.com/post?print=1"},
+		{url: "https://example.com/post?id=2", want: "https://example.com/post?id=2&print=1"},
+		{url: "https://example.com/post?print=0", want: "https://example.com/post?print=1"},
+	}
+
+	for _, tt := range tests {
+		got, err := printVariantURL(tt.url)
+		if err != nil {
+			t.Fatalf("printVariantURL(%q) error = %v", tt.url, err)
+		}
+		if got != tt.want {
+			t.Errorf("printVariantURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestBufferResponse(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("<html><body>page</body></html>")),
+	}
+
+	page, err := BufferResponse(resp)
+	if err != nil {
+		t.Fatalf("buffer response: %v", err)
+	}
+
+	for range 2 {
+		body, err := io.ReadAll(page().Body)
+		if err != nil || string(body) != "<html><body>page</body></html>" {
+			t.Errorf("expected the whole body on every read, got %q (%v)", body, err)
+		}
+	}
+}
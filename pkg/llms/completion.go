@@ -2,7 +2,6 @@ package llms
 
 import (
 	"fmt"
-	"net/http"
 
 	"github.com/defeedco/defeed/pkg/lib"
 	"github.com/rs/zerolog"
@@ -23,7 +22,7 @@ func NewCompletionModel(config *Config, logger *zerolog.Logger) (completionModel
 		}
 		return openaiModel, nil
 	case "ollama":
-		return NewOllamaModel(config.OllamaBaseURL, config.CompletionModel, http.DefaultClient, config.OllamaContextSize), nil
+		return NewOllamaModel(config.OllamaBaseURL, config.CompletionModel, lib.NewHTTPClient(0), config.OllamaContextSize), nil
 	default:
 		return nil, fmt.Errorf("unsupported provider: %s", config.CompletionProvider)
 	}
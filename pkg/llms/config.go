@@ -4,11 +4,53 @@ type Config struct {
 	// Embedding
 	EmbeddingProvider string `env:"LLM_EMBEDDING_PROVIDER,default=openai"`
 	EmbeddingModel    string `env:"LLM_EMBEDDING_MODEL,default=text-embedding-3-large"`
+	// EmbeddingInputSourceTypes overrides the text the activities of the given source types are embedded from,
+	// as comma-separated type=strategy pairs (e.g. "rssfeed=body,githubreleases=summary").
+	// Strategies: "summary" (default) embeds the title and short summary, "full_summary" the title and full summary,
+	// and "body" the title, short summary and the start of the body (see EmbeddingInputMaxBodyChars).
+	// Changing the strategy only affects the new activities, the existing ones of the source type must be reprocessed
+	// (e.g. `reprocess -source-type rssfeed -force-reprocess-embeddings`).
+	EmbeddingInputSourceTypes string `env:"EMBEDDING_INPUT_SOURCE_TYPES,default="`
+	// EmbeddingInputMaxBodyChars is the max number of body characters embedded with the "body" strategy. Set to 0 to embed the whole body.
+	EmbeddingInputMaxBodyChars int `env:"EMBEDDING_INPUT_MAX_BODY_CHARS,default=4000" validate:"gte=0"`
 
 	// Completion
 	CompletionProvider string `env:"LLM_COMPLETION_PROVIDER,default=openai"`
 	CompletionModel    string `env:"LLM_COMPLETION_MODEL,default=gpt-5-nano-2025-08-07"`
 
+	// Summarizer
+	// SummarizerMaxInputChars is the max number of characters of the activity body passed to the summarizer.
+	// Longer bodies (e.g. full articles or PDFs) are truncated to reduce the token cost. Set to 0 to disable.
+	SummarizerMaxInputChars int `env:"SUMMARIZER_MAX_INPUT_CHARS,default=8000" validate:"gte=0"`
+	// SummarizerMaxAttempts is the max number of completions generated when the summary exceeds the word limit.
+	SummarizerMaxAttempts int `env:"SUMMARIZER_MAX_ATTEMPTS,default=3" validate:"gte=1"`
+	// SummarizerWordTolerance is the fraction of words the summary can exceed the word limit by (e.g. 0.1 = 10%).
+	SummarizerWordTolerance float64 `env:"SUMMARIZER_WORD_TOLERANCE,default=0.1" validate:"gte=0"`
+
+	// SummarizerFullPrompt, SummarizerShortPrompt and SummarizerTopicPrompt override the built-in summarizer prompts.
+	// Values are Go text/template strings, or paths to the template files prefixed with "file:".
+	// Available placeholders: {{.Input}} (required), {{.MaxWords}} and {{.TopicName}} (required for the topic prompt).
+	SummarizerFullPrompt  string `env:"SUMMARIZER_FULL_PROMPT,default="`
+	SummarizerShortPrompt string `env:"SUMMARIZER_SHORT_PROMPT,default="`
+	SummarizerTopicPrompt string `env:"SUMMARIZER_TOPIC_PROMPT,default="`
+
+	// SummarizerFullTemperature, SummarizerShortTemperature and SummarizerTopicTemperature are the sampling temperatures
+	// of the full, short and topic summaries. Lower values make the summaries more deterministic.
+	// Note: the OpenAI reasoning models (e.g. gpt-5-mini) only support the temperature of 1, see ValidateSummarizerParams.
+	SummarizerFullTemperature  float64 `env:"SUMMARIZER_FULL_TEMPERATURE,default=1" validate:"gte=0,lte=2"`
+	SummarizerShortTemperature float64 `env:"SUMMARIZER_SHORT_TEMPERATURE,default=0" validate:"gte=0,lte=2"`
+	SummarizerTopicTemperature float64 `env:"SUMMARIZER_TOPIC_TEMPERATURE,default=1" validate:"gte=0,lte=2"`
+	// SummarizerFullTopP, SummarizerShortTopP and SummarizerTopicTopP are the nucleus sampling probabilities of the summaries.
+	// Set to 0 to use the model default. Note: only applied by the ollama provider, the openai client doesn't send it yet.
+	SummarizerFullTopP  float64 `env:"SUMMARIZER_FULL_TOP_P,default=0" validate:"gte=0,lte=1"`
+	SummarizerShortTopP float64 `env:"SUMMARIZER_SHORT_TOP_P,default=0" validate:"gte=0,lte=1"`
+	SummarizerTopicTopP float64 `env:"SUMMARIZER_TOPIC_TOP_P,default=0" validate:"gte=0,lte=1"`
+	// SummarizerFullMaxTokens, SummarizerShortMaxTokens and SummarizerTopicMaxTokens limit the completion tokens of the summaries.
+	// For the reasoning models the limit includes the reasoning tokens. Set to 0 for no limit.
+	SummarizerFullMaxTokens  int `env:"SUMMARIZER_FULL_MAX_TOKENS,default=0" validate:"gte=0"`
+	SummarizerShortMaxTokens int `env:"SUMMARIZER_SHORT_MAX_TOKENS,default=0" validate:"gte=0"`
+	SummarizerTopicMaxTokens int `env:"SUMMARIZER_TOPIC_MAX_TOKENS,default=0" validate:"gte=0"`
+
 	// Provider specific configurations
 	OllamaBaseURL     string `env:"OLLAMA_BASE_URL,default=http://host.docker.internal:11434"` // replace with localhost if running outside docker
 	OllamaContextSize int    `env:"OLLAMA_CONTEXT_SIZE,default=32768"`                         // context window size in tokens
@@ -14,7 +14,7 @@ func NewEmbeddingModel(config *Config, logger *zerolog.Logger) (embedderModel, e
 		usageTracker := lib.NewUsageTracker(logger)
 		limiter := lib.NewOpenAILimiterWithTracker(logger, usageTracker)
 		embeddingModel, err := openai.New(
-			openai.WithEmbeddingModel("text-embedding-3-large"),
+			openai.WithEmbeddingModel(config.EmbeddingModel),
 			openai.WithHTTPClient(limiter),
 		)
 		if err != nil {
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/defeedco/defeed/pkg/lib"
 	"github.com/tmc/langchaingo/llms"
 )
 
@@ -33,7 +34,7 @@ type ollamaGenerateResponse struct {
 
 func NewOllamaModel(baseURL, model string, client *http.Client, contextSize int) *OllamaModel {
 	if client == nil {
-		client = http.DefaultClient
+		client = lib.NewHTTPClient(0)
 	}
 	if contextSize == 0 {
 		contextSize = 32768
@@ -72,6 +73,12 @@ func (o *OllamaModel) Call(ctx context.Context, prompt string, options ...llms.C
 	if opts.Temperature != 0 {
 		reqBody.Options["temperature"] = opts.Temperature
 	}
+	if opts.TopP != 0 {
+		reqBody.Options["top_p"] = opts.TopP
+	}
+	if opts.MaxTokens != 0 {
+		reqBody.Options["num_predict"] = opts.MaxTokens
+	}
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
@@ -0,0 +1,94 @@
+package llms
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// CompletionParams are the sampling parameters of a completion. The zero TopP and MaxTokens aren't sent.
+type CompletionParams struct {
+	Temperature float64
+	TopP        float64
+	MaxTokens   int
+}
+
+// CallOptions returns the options passed to the completion model.
+func (p CompletionParams) CallOptions() []llms.CallOption {
+	options := []llms.CallOption{llms.WithTemperature(p.Temperature)}
+	if p.TopP > 0 {
+		options = append(options, llms.WithTopP(p.TopP))
+	}
+	if p.MaxTokens > 0 {
+		options = append(options, llms.WithMaxTokens(p.MaxTokens))
+	}
+	return options
+}
+
+func (c *Config) FullSummaryParams() CompletionParams {
+	return CompletionParams{
+		Temperature: c.SummarizerFullTemperature,
+		TopP:        c.SummarizerFullTopP,
+		MaxTokens:   c.SummarizerFullMaxTokens,
+	}
+}
+
+func (c *Config) ShortSummaryParams() CompletionParams {
+	return CompletionParams{
+		Temperature: c.SummarizerShortTemperature,
+		TopP:        c.SummarizerShortTopP,
+		MaxTokens:   c.SummarizerShortMaxTokens,
+	}
+}
+
+func (c *Config) TopicSummaryParams() CompletionParams {
+	return CompletionParams{
+		Temperature: c.SummarizerTopicTemperature,
+		TopP:        c.SummarizerTopicTopP,
+		MaxTokens:   c.SummarizerTopicMaxTokens,
+	}
+}
+
+// ValidateSummarizerParams checks the summarizer parameters against the known constraints of the completion model.
+// The constraints of the other models (e.g. the ollama ones) aren't known, so their parameters are passed as is.
+func (c *Config) ValidateSummarizerParams() error {
+	if c.CompletionProvider != "openai" {
+		return nil
+	}
+
+	summaries := []struct {
+		name   string
+		params CompletionParams
+	}{
+		{"full", c.FullSummaryParams()},
+		{"short", c.ShortSummaryParams()},
+		{"topic", c.TopicSummaryParams()},
+	}
+
+	var errs []error
+	for _, summary := range summaries {
+		name, params := summary.name, summary.params
+		if isOpenAIReasoningModel(c.CompletionModel) && params.Temperature != 1 {
+			errs = append(errs, fmt.Errorf("%s summary temperature %g is not supported by %s, only 1 is", name, params.Temperature, c.CompletionModel))
+		}
+		if params.TopP > 0 {
+			errs = append(errs, fmt.Errorf("%s summary top-p is ignored by the openai provider", name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isOpenAIReasoningModel reports whether the model only supports the default sampling parameters.
+func isOpenAIReasoningModel(model string) bool {
+	if strings.HasPrefix(model, "gpt-5-chat") {
+		return false
+	}
+	for _, prefix := range []string{"gpt-5", "o1", "o3", "o4"} {
+		if strings.HasPrefix(model, prefix) {
+			return true
+		}
+	}
+	return false
+}
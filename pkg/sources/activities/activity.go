@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/providers/custom"
 	"github.com/defeedco/defeed/pkg/sources/providers/github"
 	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/jsonapi"
 	"github.com/defeedco/defeed/pkg/sources/providers/lobsters"
 	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
 	"github.com/defeedco/defeed/pkg/sources/providers/producthunt"
@@ -21,6 +23,8 @@ func NewActivity(sourceType string) (types.Activity, error) {
 		a = mastodon.NewPost()
 	case mastodon.TypeMastodonTag:
 		a = mastodon.NewPost()
+	case mastodon.TypeMastodonTrending:
+		a = mastodon.NewPost()
 	case hackernews.TypeHackerNewsPosts:
 		a = hackernews.NewPost()
 	case reddit.TypeRedditSubreddit:
@@ -29,6 +33,8 @@ func NewActivity(sourceType string) (types.Activity, error) {
 		a = lobsters.NewPost()
 	case lobsters.TypeLobstersFeed:
 		a = lobsters.NewPost()
+	case lobsters.TypeLobstersComments:
+		a = lobsters.NewComment()
 	case rss.TypeRSSFeed:
 		a = rss.NewFeedItem()
 	case github.TypeGithubReleases:
@@ -39,6 +45,10 @@ func NewActivity(sourceType string) (types.Activity, error) {
 		a = github.NewRepository()
 	case producthunt.TypeProductHuntPosts:
 		a = producthunt.NewPost()
+	case custom.TypeCustom:
+		a = custom.NewItem()
+	case jsonapi.TypeJSONAPIEndpoint:
+		a = jsonapi.NewItem()
 	default:
 		return nil, fmt.Errorf("unknown source type: %s", sourceType)
 	}
@@ -0,0 +1,126 @@
+package activities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+type Config struct {
+	// MinSummaryBodyWords is the minimum number of words in the activity body required to generate LLM summaries.
+	// Activities with shorter bodies use the title/body directly as the summary. Set to 0 to always summarize.
+	MinSummaryBodyWords int `env:"MIN_SUMMARY_BODY_WORDS,default=20" validate:"gte=0"`
+	// EmbeddingDimension is the dimension of the embeddings the activities are indexed with.
+	// Query embeddings with a different dimension (e.g. after an embedding model change) are rejected. Set to 0 to disable.
+	EmbeddingDimension int `env:"EMBEDDING_DIMENSION,default=3072" validate:"oneof=0 1536 3072"`
+	// KeywordSearchOnly skips the activity and query embeddings, and searches the activities by keywords only.
+	// Enabled automatically when pgvector is unavailable (see DB_PGVECTOR_FALLBACK).
+	KeywordSearchOnly bool `env:"KEYWORD_SEARCH_ONLY,default=false"`
+	// QueryExpansion embeds the search queries expanded by the LLM into a hypothetical matching activity summary,
+	// which is closer to the activity embeddings than short keyword queries. Adds a (cached) completion per distinct query.
+	QueryExpansion bool `env:"QUERY_EXPANSION,default=false"`
+	// QueryExpansionCacheTTL is how long the query expansions are cached.
+	QueryExpansionCacheTTL time.Duration `env:"QUERY_EXPANSION_CACHE_TTL,default=24h"`
+	// QueryEmbeddingCacheTTL is how long the search query embeddings are cached, keyed by the query and the embedding model.
+	// The same feed queries are embedded on each feed view, so this cuts the cost and latency of the requests. Set to 0 to disable.
+	QueryEmbeddingCacheTTL time.Duration `env:"QUERY_EMBEDDING_CACHE_TTL,default=2h" validate:"gte=0"`
+	// RecencyWeightDay, RecencyWeightWeek, RecencyWeightMonth and RecencyWeightAll are the weights of the recency score
+	// when sorting by the weighted score within the given period, relative to the similarity (4) and social score (2) weights.
+	// A mild recency weight for longer periods prevents old viral activities from pinning the top of the feed.
+	RecencyWeightDay   float64 `env:"RECENCY_WEIGHT_DAY,default=1" validate:"gte=0"`
+	RecencyWeightWeek  float64 `env:"RECENCY_WEIGHT_WEEK,default=0.5" validate:"gte=0"`
+	RecencyWeightMonth float64 `env:"RECENCY_WEIGHT_MONTH,default=0.25" validate:"gte=0"`
+	RecencyWeightAll   float64 `env:"RECENCY_WEIGHT_ALL,default=0" validate:"gte=0"`
+	// RecencyWeightSourceTypes scales the period recency weight of the given source types, as comma-separated type=factor pairs
+	// (e.g. "githubtopic=0,hackernewsposts=1.5"), so that news-like sources favor recent activities more than reference sources.
+	RecencyWeightSourceTypes string `env:"RECENCY_WEIGHT_SOURCE_TYPES,default="`
+	// FeedbackLikedWeight and FeedbackDislikedWeight move the query embedding towards the liked activities
+	// and away from the disliked ones (Rocchio), relative to the query weight (1). Set to 0 to disable.
+	FeedbackLikedWeight    float64 `env:"FEEDBACK_LIKED_WEIGHT,default=0.3" validate:"gte=0"`
+	FeedbackDislikedWeight float64 `env:"FEEDBACK_DISLIKED_WEIGHT,default=0.15" validate:"gte=0"`
+	// ReprocessChangedContent regenerates the summary and embedding of upserted activities whose content changed
+	// (e.g. edited Reddit posts, updated RSS items). Unchanged activities are never reprocessed.
+	ReprocessChangedContent bool `env:"REPROCESS_CHANGED_CONTENT,default=true"`
+	// CountCacheTTL is how long the activity counts per source are cached, since counting scans the whole table.
+	CountCacheTTL time.Duration `env:"ACTIVITY_COUNT_CACHE_TTL,default=10m"`
+	// SourceRetentionCap is the max number of activities kept per source, so that high-volume sources (e.g. busy subreddits)
+	// don't dominate the storage. The oldest activities above the cap are deleted periodically,
+	// and the new activities older than the kept ones aren't stored again. Set to 0 to disable.
+	SourceRetentionCap int `env:"ACTIVITY_SOURCE_RETENTION_CAP,default=0" validate:"gte=0"`
+	// SourceRetentionCapSourceTypes overrides the retention cap of the given source types, as comma-separated type=cap pairs
+	// (e.g. "redditsubreddit=5000,githubtopic=0"). A cap of 0 keeps all the activities of the source type.
+	SourceRetentionCapSourceTypes string `env:"ACTIVITY_SOURCE_RETENTION_CAP_SOURCE_TYPES,default="`
+	// SourceRetentionInterval is how often the sources above the retention cap are trimmed.
+	SourceRetentionInterval time.Duration `env:"ACTIVITY_SOURCE_RETENTION_INTERVAL,default=1h"`
+}
+
+// RecencyWeight returns the recency weight for the given period.
+func (c *Config) RecencyWeight(period types.Period) float64 {
+	switch period {
+	case types.PeriodDay:
+		return c.RecencyWeightDay
+	case types.PeriodWeek:
+		return c.RecencyWeightWeek
+	case types.PeriodMonth:
+		return c.RecencyWeightMonth
+	default:
+		return c.RecencyWeightAll
+	}
+}
+
+// RecencyWeightFactors parses the RecencyWeightSourceTypes into a map of source type to recency weight factor.
+func (c *Config) RecencyWeightFactors() (map[string]float64, error) {
+	factors := make(map[string]float64)
+
+	for pair := range strings.SplitSeq(c.RecencyWeightSourceTypes, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		sourceType, value, ok := strings.Cut(pair, "=")
+		sourceType = strings.TrimSpace(sourceType)
+		if !ok || sourceType == "" {
+			return nil, fmt.Errorf("invalid source type recency weight: %s", pair)
+		}
+
+		factor, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || factor < 0 {
+			return nil, fmt.Errorf("invalid recency weight factor for %s: %s", sourceType, value)
+		}
+
+		factors[sourceType] = factor
+	}
+
+	return factors, nil
+}
+
+// SourceRetentionCaps parses the SourceRetentionCapSourceTypes into a map of source type to retention cap.
+func (c *Config) SourceRetentionCaps() (map[string]int, error) {
+	caps := make(map[string]int)
+
+	for pair := range strings.SplitSeq(c.SourceRetentionCapSourceTypes, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		sourceType, value, ok := strings.Cut(pair, "=")
+		sourceType = strings.TrimSpace(sourceType)
+		if !ok || sourceType == "" {
+			return nil, fmt.Errorf("invalid source type retention cap: %s", pair)
+		}
+
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid retention cap for %s: %s", sourceType, value)
+		}
+
+		caps[sourceType] = limit
+	}
+
+	return caps, nil
+}
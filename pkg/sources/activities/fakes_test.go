@@ -0,0 +1,45 @@
+package activities
+
+import (
+	"context"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// fakeActivityStore is a configurable activity store, which records the last search request and stores a single activity.
+// The searches return the stored activity, unless the search hook is set.
+type fakeActivityStore struct {
+	req          types.SearchRequest
+	stored       *types.DecoratedActivity
+	oldestKeptAt time.Time
+	search       func(req types.SearchRequest) (*types.SearchResult, error)
+}
+
+func (s *fakeActivityStore) Upsert(_ context.Context, act *types.DecoratedActivity) error {
+	s.stored = act
+	return nil
+}
+
+func (s *fakeActivityStore) CountBySource(context.Context) (map[string]int, error) {
+	return nil, nil
+}
+
+func (s *fakeActivityStore) OldestKeptAt(context.Context, string, int) (time.Time, error) {
+	return s.oldestKeptAt, nil
+}
+
+func (s *fakeActivityStore) Stats(context.Context, []string) (types.ActivityStats, error) {
+	return types.ActivityStats{}, nil
+}
+
+func (s *fakeActivityStore) Search(_ context.Context, req types.SearchRequest) (*types.SearchResult, error) {
+	s.req = req
+	if s.search != nil {
+		return s.search(req)
+	}
+	if s.stored == nil {
+		return &types.SearchResult{}, nil
+	}
+	return &types.SearchResult{Activities: []*types.DecoratedActivity{s.stored}}, nil
+}
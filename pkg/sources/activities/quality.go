@@ -0,0 +1,48 @@
+package activities
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+const (
+	// qualityBodyWords is the number of (non-link) body words at which the length score saturates.
+	qualityBodyWords = 150
+
+	qualityLengthWeight  = 0.5
+	qualityTextWeight    = 0.3
+	qualitySummaryWeight = 0.2
+)
+
+var urlPattern = regexp.MustCompile(`https?://\S+`)
+
+// qualityScore estimates the content substance of the activity (0-1) from the body length,
+// the ratio of links to text and the presence of the summary.
+// Unlike the social score, it is independent of the popularity (e.g. RSS items have no social score).
+func qualityScore(act types.Activity, summary *types.ActivitySummary) float64 {
+	body := strings.TrimSpace(act.Body())
+
+	// Link-only or empty bodies (e.g. link aggregators) have no substance on their own.
+	text := urlPattern.ReplaceAllString(body, "")
+	words := len(strings.Fields(text))
+	lengthScore := min(1, float64(words)/qualityBodyWords)
+
+	textScore := 0.0
+	if body != "" {
+		linkChars := len(body) - len(text)
+		textScore = 1 - float64(linkChars)/float64(len(body))
+	}
+
+	summaryScore := 0.0
+	if summary != nil && summary.ShortSummary != "" && summary.FullSummary != "" {
+		summaryScore = 0.5
+		// Short bodies are used as the summary directly (see Registry.summarize).
+		if summary.FullSummary != body && summary.FullSummary != strings.TrimSpace(act.Title()) {
+			summaryScore = 1
+		}
+	}
+
+	return qualityLengthWeight*lengthScore + qualityTextWeight*textScore + qualitySummaryWeight*summaryScore
+}
@@ -2,20 +2,45 @@ package activities
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/defeedco/defeed/pkg/lib"
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
 	"github.com/rs/zerolog"
 )
 
+// Weights of the similarity and social score when sorting by the weighted score.
+// The recency weight depends on the search period, see Config.RecencyWeight.
+const (
+	similarityWeight  = 4
+	socialScoreWeight = 2
+)
+
+var ErrInvalidSortWeights = errors.New("invalid sort weights")
+
 type Registry struct {
 	activityRepo activityStore
 	logger       *zerolog.Logger
 	summarizer   summarizer
 	embedder     embedder
+	config       *Config
 	// activityLocks provides per-activity ID locking to prevent race conditions
 	activityLocks sync.Map // map[string]*sync.Mutex
+	// countCache caches the activity counts, since counting scans the whole table.
+	countCache *lib.Cache
+	// recencyWeightFactors scales the recency weight per source type, see Config.RecencyWeightSourceTypes.
+	recencyWeightFactors map[string]float64
+	// retentionCaps overrides the retention cap per source type, see Config.SourceRetentionCapSourceTypes.
+	retentionCaps map[string]int
 }
 
 func NewRegistry(
@@ -23,12 +48,30 @@ func NewRegistry(
 	activityRepo activityStore,
 	summarizer summarizer,
 	embedder embedder,
+	config *Config,
 ) *Registry {
+	recencyWeightFactors, err := config.RecencyWeightFactors()
+	if err != nil {
+		// The factors are validated when the config is loaded.
+		logger.Error().Err(err).Msg("Ignoring invalid source type recency weights")
+	}
+
+	retentionCaps, err := config.SourceRetentionCaps()
+	if err != nil {
+		// The caps are validated when the config is loaded.
+		logger.Error().Err(err).Msg("Ignoring invalid source type retention caps")
+	}
+
 	return &Registry{
 		activityRepo: activityRepo,
 		logger:       logger,
 		summarizer:   summarizer,
 		embedder:     embedder,
+		config:       config,
+		countCache:   lib.NewCache(config.CountCacheTTL, logger),
+
+		recencyWeightFactors: recencyWeightFactors,
+		retentionCaps:        retentionCaps,
 	}
 }
 
@@ -39,11 +82,54 @@ type summarizer interface {
 type embedder interface {
 	EmbedActivity(ctx context.Context, act types.Activity, summary *types.ActivitySummary) ([]float32, error)
 	EmbedActivityQuery(ctx context.Context, query string) ([]float32, error)
+	EmbedActivityQueries(ctx context.Context, queries []string) ([][]float32, error)
+	Model() string
+}
+
+// queryCacheEmbedder is implemented by the embedders caching the query embeddings (see Config.QueryEmbeddingCacheTTL).
+type queryCacheEmbedder interface {
+	QueryCacheStats() (lib.CacheStats, bool)
+}
+
+// QueryEmbeddingCacheStats returns the hits of the query embedding cache, or false if the cache is disabled.
+func (r *Registry) QueryEmbeddingCacheStats() (_ lib.CacheStats, found bool) {
+	embedder, ok := r.embedder.(queryCacheEmbedder)
+	if !ok {
+		return lib.CacheStats{}, false
+	}
+	return embedder.QueryCacheStats()
 }
 
 type activityStore interface {
 	Upsert(ctx context.Context, act *types.DecoratedActivity) error
 	Search(ctx context.Context, req types.SearchRequest) (*types.SearchResult, error)
+	CountBySource(ctx context.Context) (map[string]int, error)
+	// OldestKeptAt returns the creation time of the oldest activity within the newest ones of the source,
+	// or zero if the source has fewer activities.
+	OldestKeptAt(ctx context.Context, sourceUID string, keep int) (time.Time, error)
+	Stats(ctx context.Context, sourceUIDs []string) (types.ActivityStats, error)
+}
+
+// isTrimmed reports whether the new activity is older than the activities kept by its source (see Config.SourceRetentionCap),
+// so that the trimmed activities aren't stored (and summarized) again when the source returns them on the next poll.
+func (r *Registry) isTrimmed(ctx context.Context, act types.Activity) (bool, error) {
+	sourceUIDs := act.SourceUIDs()
+	// The activities shared with other sources aren't trimmed.
+	if len(sourceUIDs) != 1 {
+		return false, nil
+	}
+
+	keep := retentionCap(r.config, r.retentionCaps, sourceUIDs[0].Type())
+	if keep <= 0 {
+		return false, nil
+	}
+
+	oldestKeptAt, err := r.activityRepo.OldestKeptAt(ctx, sourceUIDs[0].String(), keep)
+	if err != nil {
+		return false, fmt.Errorf("oldest kept activity: %w", err)
+	}
+
+	return !oldestKeptAt.IsZero() && act.CreatedAt().Before(oldestKeptAt), nil
 }
 
 type CreateRequest struct {
@@ -56,6 +142,10 @@ type CreateRequest struct {
 	ForceReprocessEmbedding bool
 	// Upsert updates the existing record.
 	Upsert bool
+	// EmbeddingDimension recomputes the existing embeddings of a different dimension (e.g. after an embedding model change),
+	// and fails if the computed embedding doesn't match it (e.g. a misconfigured model). Zero skips the validation.
+	// If EmbeddingDimension is set, Upsert must also be true.
+	EmbeddingDimension int
 }
 
 // Create processes a single activity and stores it in the database.
@@ -67,6 +157,9 @@ func (r *Registry) Create(ctx context.Context, req CreateRequest) (bool, error)
 	if req.ForceReprocessEmbedding && !req.Upsert {
 		return false, fmt.Errorf("reprocess embedding without upsert is not allowed")
 	}
+	if req.EmbeddingDimension > 0 && !req.Upsert {
+		return false, fmt.Errorf("embedding dimension without upsert is not allowed")
+	}
 
 	// Race conditions can occur if multiple goroutines process the same activity concurrently.
 	lockKey := req.Activity.UID().String()
@@ -88,6 +181,16 @@ func (r *Registry) Create(ctx context.Context, req CreateRequest) (bool, error)
 		return false, nil
 	}
 
+	if existing == nil {
+		trimmed, err := r.isTrimmed(ctx, req.Activity)
+		if err != nil {
+			return false, fmt.Errorf("check retention cap: %w", err)
+		}
+		if trimmed {
+			return false, nil
+		}
+	}
+
 	var summary *types.ActivitySummary
 	var embedding []float32
 
@@ -96,24 +199,48 @@ func (r *Registry) Create(ctx context.Context, req CreateRequest) (bool, error)
 		embedding = existing.Embedding
 	}
 
-	if req.ForceReprocessSummary || existing == nil || existing.Summary.FullSummary == "" || existing.Summary.ShortSummary == "" {
-		summary, err = r.summarizer.SummarizeActivity(ctx, req.Activity)
+	hash := ContentHash(req.Activity)
+	// Activities stored before the content hashes were tracked have no hash, and aren't considered changed.
+	contentChanged := r.config.ReprocessChangedContent && existing != nil && existing.ContentHash != "" && existing.ContentHash != hash
+	if contentChanged {
+		r.logger.Debug().
+			Str("activity_id", req.Activity.UID().String()).
+			Msg("Activity content changed, reprocessing summary and embedding")
+	}
+
+	if req.ForceReprocessSummary || contentChanged || existing == nil || existing.Summary.FullSummary == "" || existing.Summary.ShortSummary == "" {
+		summary, err = r.summarize(ctx, req.Activity)
 		if err != nil {
 			return false, fmt.Errorf("summarize activity: %w", err)
 		}
 	}
 
-	if req.ForceReprocessEmbedding || existing == nil || len(existing.Embedding) == 0 {
+	var embeddingModel string
+	if existing != nil {
+		embeddingModel = existing.EmbeddingModel
+	}
+
+	outdatedDimension := req.EmbeddingDimension > 0 && existing != nil && len(existing.Embedding) != req.EmbeddingDimension
+	needsEmbedding := req.ForceReprocessEmbedding || contentChanged || outdatedDimension || existing == nil || len(existing.Embedding) == 0
+	if needsEmbedding && !r.config.KeywordSearchOnly {
 		embedding, err = r.embedder.EmbedActivity(ctx, req.Activity, summary)
 		if err != nil {
 			return false, fmt.Errorf("compute embedding: %w", err)
 		}
+		embeddingModel = r.embedder.Model()
+	}
+
+	if req.EmbeddingDimension > 0 && !r.config.KeywordSearchOnly && len(embedding) != req.EmbeddingDimension {
+		return false, fmt.Errorf("embedding dimension is %d, expected %d (check the embedding model configuration)", len(embedding), req.EmbeddingDimension)
 	}
 
 	err = r.activityRepo.Upsert(ctx, &types.DecoratedActivity{
-		Activity:  req.Activity,
-		Summary:   summary,
-		Embedding: embedding,
+		Activity:       req.Activity,
+		Summary:        summary,
+		Embedding:      embedding,
+		EmbeddingModel: embeddingModel,
+		ContentHash:    hash,
+		QualityScore:   qualityScore(req.Activity, summary),
 	})
 	if err != nil {
 		return false, fmt.Errorf("upsert activity: %w", err)
@@ -122,6 +249,74 @@ func (r *Registry) Create(ctx context.Context, req CreateRequest) (bool, error)
 	return true, nil
 }
 
+// CountBySource returns the number of stored activities per source UID.
+// Counts are cached for the configured TTL.
+func (r *Registry) CountBySource(ctx context.Context) (map[string]int, error) {
+	const cacheKey = "count_by_source"
+	if cached, found := r.countCache.Get(cacheKey); found {
+		if counts, ok := cached.(map[string]int); ok {
+			return counts, nil
+		}
+	}
+
+	counts, err := r.activityRepo.CountBySource(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count by source: %w", err)
+	}
+
+	r.countCache.Set(cacheKey, counts)
+
+	return counts, nil
+}
+
+// Stats summarizes the stored activities of the given sources.
+// Unlike the counts, the stats aren't cached, since they're used to detect the changed activities.
+func (r *Registry) Stats(ctx context.Context, sourceUIDs []types.TypedUID) (types.ActivityStats, error) {
+	uids := make([]string, len(sourceUIDs))
+	for i, uid := range sourceUIDs {
+		uids[i] = uid.String()
+	}
+
+	stats, err := r.activityRepo.Stats(ctx, uids)
+	if err != nil {
+		return types.ActivityStats{}, fmt.Errorf("activity stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// ContentHash returns the hash of the activity content that the summary and embedding are computed from.
+func ContentHash(act types.Activity) string {
+	hash := sha256.Sum256([]byte(act.Title() + "\n" + act.Body()))
+	return hex.EncodeToString(hash[:])
+}
+
+// summarize skips the LLM summarization for low-content activities (e.g. releases, micro-posts),
+// since the summary wouldn't be much shorter (or better) than the original content.
+func (r *Registry) summarize(ctx context.Context, act types.Activity) (*types.ActivitySummary, error) {
+	body := strings.TrimSpace(act.Body())
+	if len(strings.Fields(body)) >= r.config.MinSummaryBodyWords {
+		return r.summarizer.SummarizeActivity(ctx, act)
+	}
+
+	title := strings.TrimSpace(act.Title())
+	if body == "" {
+		body = title
+	}
+	if title == "" {
+		title = body
+	}
+
+	r.logger.Debug().
+		Str("activity_id", act.UID().String()).
+		Msg("Skipping summarization for short activity body")
+
+	return &types.ActivitySummary{
+		ShortSummary: title,
+		FullSummary:  body,
+	}, nil
+}
+
 func (r *Registry) findOne(ctx context.Context, uid types.TypedUID) (*types.DecoratedActivity, error) {
 	res, err := r.activityRepo.Search(ctx, types.SearchRequest{
 		ActivityUIDs: []types.TypedUID{uid},
@@ -136,44 +331,329 @@ func (r *Registry) findOne(ctx context.Context, uid types.TypedUID) (*types.Deco
 	return res.Activities[0], nil
 }
 
+// QueryPooling is the strategy used to combine multiple query embeddings into one.
+type QueryPooling string
+
+const (
+	// QueryPoolingMean averages the query embeddings into a centroid.
+	QueryPoolingMean QueryPooling = "mean"
+	// QueryPoolingMax takes the max value of each dimension across the query embeddings.
+	QueryPoolingMax QueryPooling = "max"
+)
+
 type SearchRequest struct {
-	Query         string
+	Query string
+	// Queries are combined into a single query embedding using QueryPooling.
+	// Ignored if Query is set.
+	Queries       []string
+	QueryPooling  QueryPooling
 	ActivityUIDs  []types.TypedUID
 	SourceUIDs    []types.TypedUID
+	SourceTypes   []string
 	MinSimilarity float32
-	Limit         int
-	Cursor        string
-	SortBy        types.SortBy
-	Period        types.Period
+	// MinQualityScore excludes activities with a lower quality score (see qualityScore).
+	MinQualityScore float64
+	Limit           int
+	Cursor          string
+	SortBy          types.SortBy
+	Period          types.Period
+	// Calendar computes the period boundaries. Nil uses the DefaultCalendar.
+	Calendar *types.Calendar
+	// Feedback adjusts the query embedding with the relevance feedback of the user. Nil disables it.
+	Feedback *RelevanceFeedback
+	// Weights overrides the weighted score weights (e.g. to experiment with the ranking). Nil uses the defaults.
+	Weights *SortWeights
+	// SourceFilters see types.SearchRequest.SourceFilters.
+	SourceFilters map[string]types.KeywordFilter
 }
 
-func (r *Registry) Search(ctx context.Context, req SearchRequest) (*types.SearchResult, error) {
-	var queryEmbedding []float32
-	if req.Query != "" {
-		embedding, err := r.embedder.EmbedActivityQuery(ctx, req.Query)
+// SortWeights are the weights of the weighted score sort. Nil weights keep the defaults.
+// The weights are relative, since they're normalized to sum to 1 (see types.SearchRequest.NormalizedWeights).
+type SortWeights struct {
+	Similarity  *float64
+	SocialScore *float64
+	Recency     *float64
+}
+
+// Validate returns ErrInvalidSortWeights if any of the weights is negative.
+func (w SortWeights) Validate() error {
+	weights := []struct {
+		name   string
+		weight *float64
+	}{
+		{"similarity", w.Similarity},
+		{"social score", w.SocialScore},
+		{"recency", w.Recency},
+	}
+	for _, w := range weights {
+		if w.weight != nil && *w.weight < 0 {
+			return fmt.Errorf("%w: %s weight must not be negative", ErrInvalidSortWeights, w.name)
+		}
+	}
+	return nil
+}
+
+// RelevanceFeedback is the embeddings of the activities the user liked ("more like this") or disliked ("less like this").
+type RelevanceFeedback struct {
+	Liked    [][]float32
+	Disliked [][]float32
+}
+
+// applyFeedback moves the query embedding towards the centroid of the liked embeddings
+// and away from the centroid of the disliked ones (Rocchio).
+// Embeddings of a different dimension than the query (e.g. from an older embedding model) are ignored.
+func applyFeedback(query []float32, feedback *RelevanceFeedback, likedWeight, dislikedWeight float64) []float32 {
+	out := slices.Clone(query)
+
+	adjust := func(embeddings [][]float32, weight float64) {
+		embeddings = slices.DeleteFunc(slices.Clone(embeddings), func(e []float32) bool {
+			return len(e) != len(query)
+		})
+		if len(embeddings) == 0 || weight == 0 {
+			return
+		}
+		centroid, err := poolEmbeddings(embeddings, QueryPoolingMean)
 		if err != nil {
-			return nil, fmt.Errorf("compute query embedding: %w", err)
+			return
+		}
+		for i, v := range centroid {
+			out[i] += float32(weight) * v
+		}
+	}
+	adjust(feedback.Liked, likedWeight)
+	adjust(feedback.Disliked, -dislikedWeight)
+
+	return out
+}
+
+// poolEmbeddings combines the embeddings into a single vector.
+// The vectors aren't normalized, since the similarity search uses the cosine distance.
+func poolEmbeddings(embeddings [][]float32, pooling QueryPooling) ([]float32, error) {
+	if len(embeddings) == 0 {
+		return nil, fmt.Errorf("no embeddings")
+	}
+
+	dim := len(embeddings[0])
+	for _, embedding := range embeddings {
+		if len(embedding) != dim {
+			return nil, fmt.Errorf("embedding dimensions differ: %d and %d", dim, len(embedding))
 		}
-		queryEmbedding = embedding
 	}
 
-	recencyWeight := 0.0
-	// We only care about recency when viewing today's activities.
-	if req.Period == types.PeriodDay {
-		recencyWeight = 1
+	out := make([]float32, dim)
+	switch pooling {
+	case QueryPoolingMax:
+		copy(out, embeddings[0])
+		for _, embedding := range embeddings[1:] {
+			for i, v := range embedding {
+				out[i] = max(out[i], v)
+			}
+		}
+	case QueryPoolingMean, "":
+		for _, embedding := range embeddings {
+			for i, v := range embedding {
+				out[i] += v
+			}
+		}
+		for i := range out {
+			out[i] /= float32(len(embeddings))
+		}
+	default:
+		return nil, fmt.Errorf("unknown query pooling: %s", pooling)
 	}
 
+	return out, nil
+}
+
+func (r *Registry) Search(ctx context.Context, req SearchRequest) (*types.SearchResult, error) {
+	queryEmbedding, err := r.queryEmbedding(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryEmbedding) > 0 && req.Feedback != nil {
+		queryEmbedding = applyFeedback(queryEmbedding, req.Feedback, r.config.FeedbackLikedWeight, r.config.FeedbackDislikedWeight)
+	}
+
+	sortBy := req.SortBy
+	var keywords []string
+	if len(queryEmbedding) == 0 && (req.Query != "" || len(req.Queries) > 0) {
+		// Degrade to a keyword search instead of failing the whole feed request (e.g. the embedding provider is down).
+		keywords = queryKeywords(append([]string{req.Query}, req.Queries...))
+		if sortBy == types.SortBySimilarity {
+			sortBy = types.SortBySocialScore
+		}
+	}
+	if len(keywords) > 0 && !r.config.KeywordSearchOnly {
+		r.logger.Warn().
+			Str("sort_by", string(sortBy)).
+			Strs("keywords", keywords).
+			Msg("query embedding unavailable, falling back to keyword search")
+	}
+
+	var embeddingModel string
+	if len(queryEmbedding) > 0 {
+		embeddingModel = r.embedder.Model()
+	}
+
+	simWeight, socialWeight, recencyWeight := r.sortWeights(req)
+
 	return r.activityRepo.Search(ctx, types.SearchRequest{
-		SourceUIDs:        req.SourceUIDs,
-		ActivityUIDs:      req.ActivityUIDs,
-		MinSimilarity:     req.MinSimilarity,
-		Limit:             req.Limit,
-		Cursor:            req.Cursor,
-		SortBy:            req.SortBy,
-		Period:            req.Period,
-		QueryEmbedding:    queryEmbedding,
-		SocialScoreWeight: 2,
-		SimilarityWeight:  4,
-		RecencyWeight:     recencyWeight,
+		SourceUIDs:         req.SourceUIDs,
+		ActivityUIDs:       req.ActivityUIDs,
+		SourceTypes:        req.SourceTypes,
+		MinSimilarity:      req.MinSimilarity,
+		MinQualityScore:    req.MinQualityScore,
+		Limit:              req.Limit,
+		Cursor:             req.Cursor,
+		SortBy:             sortBy,
+		Period:             req.Period,
+		Calendar:           req.Calendar,
+		QueryEmbedding:     queryEmbedding,
+		Keywords:           keywords,
+		SourceFilters:      req.SourceFilters,
+		EmbeddingDimension: r.config.EmbeddingDimension,
+		EmbeddingModel:     embeddingModel,
+		SocialScoreWeight:  socialWeight,
+		SimilarityWeight:   simWeight,
+		RecencyWeight:      recencyWeight,
+		// Reference sources (e.g. GitHub topics) shouldn't be penalized for their age as much as news-like sources.
+		RecencyWeightBySourceType: r.recencyWeightBySourceType(recencyWeight),
 	})
 }
+
+// sortWeights returns the weighted score weights of the search, with the defaults for the ones not overridden.
+func (r *Registry) sortWeights(req SearchRequest) (similarity, socialScore, recency float64) {
+	similarity, socialScore, recency = similarityWeight, socialScoreWeight, r.config.RecencyWeight(req.Period)
+	if req.Weights == nil {
+		return similarity, socialScore, recency
+	}
+	if req.Weights.Similarity != nil {
+		similarity = *req.Weights.Similarity
+	}
+	if req.Weights.SocialScore != nil {
+		socialScore = *req.Weights.SocialScore
+	}
+	if req.Weights.Recency != nil {
+		recency = *req.Weights.Recency
+	}
+	return similarity, socialScore, recency
+}
+
+// Related returns the activities from the given sources that are the most similar to the activity, by its stored embedding.
+// Returns no activities if the activity has no embedding of the indexed dimension (e.g. not processed yet, or keyword search only).
+func (r *Registry) Related(
+	ctx context.Context,
+	act *types.DecoratedActivity,
+	sourceUIDs []types.TypedUID,
+	limit int,
+) ([]*types.DecoratedActivity, error) {
+	if r.config.KeywordSearchOnly || len(act.Embedding) == 0 || len(sourceUIDs) == 0 {
+		return nil, nil
+	}
+	if r.config.EmbeddingDimension > 0 && len(act.Embedding) != r.config.EmbeddingDimension {
+		return nil, nil
+	}
+
+	res, err := r.activityRepo.Search(ctx, types.SearchRequest{
+		SourceUIDs:     sourceUIDs,
+		QueryEmbedding: act.Embedding,
+		EmbeddingModel: act.EmbeddingModel,
+		// The activity itself is the most similar one, so it's searched and dropped.
+		Limit:              limit + 1,
+		SortBy:             types.SortBySimilarity,
+		Period:             types.PeriodAll,
+		EmbeddingDimension: r.config.EmbeddingDimension,
+		SimilarityWeight:   similarityWeight,
+		SocialScoreWeight:  socialScoreWeight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search similar activities: %w", err)
+	}
+
+	related := slices.DeleteFunc(res.Activities, func(other *types.DecoratedActivity) bool {
+		return other.Activity.UID().String() == act.Activity.UID().String()
+	})
+	if len(related) > limit {
+		related = related[:limit]
+	}
+
+	return related, nil
+}
+
+// recencyWeightBySourceType scales the recency weight by the configured source type factors.
+func (r *Registry) recencyWeightBySourceType(weight float64) map[string]float64 {
+	if len(r.recencyWeightFactors) == 0 {
+		return nil
+	}
+
+	weights := make(map[string]float64, len(r.recencyWeightFactors))
+	for sourceType, factor := range r.recencyWeightFactors {
+		weights[sourceType] = weight * factor
+	}
+	return weights
+}
+
+// queryEmbedding computes the embedding of the search queries.
+// Returns an empty embedding if it can't be computed, so that the search can fall back to keywords.
+func (r *Registry) queryEmbedding(ctx context.Context, req SearchRequest) ([]float32, error) {
+	if r.config.KeywordSearchOnly {
+		return nil, nil
+	}
+
+	var (
+		embedding []float32
+		err       error
+	)
+	if req.Query != "" {
+		embedding, err = r.embedder.EmbedActivityQuery(ctx, req.Query)
+		if err != nil {
+			err = fmt.Errorf("compute query embedding: %w", err)
+		}
+	} else if len(req.Queries) > 0 {
+		var embeddings [][]float32
+		embeddings, err = r.embedder.EmbedActivityQueries(ctx, req.Queries)
+		if err != nil {
+			err = fmt.Errorf("compute query embeddings: %w", err)
+		} else {
+			embedding, err = poolEmbeddings(embeddings, req.QueryPooling)
+			if err != nil {
+				err = fmt.Errorf("pool query embeddings: %w", err)
+			}
+		}
+	}
+
+	if err != nil {
+		// Canceled requests shouldn't fall back to a (pointless) keyword search.
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		r.logger.Error().Err(err).Msg("failed to compute query embedding")
+		return nil, nil
+	}
+
+	return embedding, nil
+}
+
+// maxQueryKeywords is the max number of keywords used by the fallback keyword search.
+const maxQueryKeywords = 10
+
+// queryKeywords extracts the distinct words (of at least 3 characters) from the queries.
+func queryKeywords(queries []string) []string {
+	seen := make(map[string]bool)
+	keywords := make([]string, 0)
+	for _, query := range queries {
+		for _, word := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}) {
+			if utf8.RuneCountInString(word) < 3 || seen[word] {
+				continue
+			}
+			seen[word] = true
+			keywords = append(keywords, word)
+			if len(keywords) == maxQueryKeywords {
+				return keywords
+			}
+		}
+	}
+	return keywords
+}
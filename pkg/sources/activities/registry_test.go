@@ -0,0 +1,558 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+func TestRecencyWeight_FreshActivityOvertakesOld(t *testing.T) {
+	config := &Config{
+		RecencyWeightDay:   1,
+		RecencyWeightWeek:  0.5,
+		RecencyWeightMonth: 0.25,
+		RecencyWeightAll:   0,
+	}
+
+	// Mirrors the weighted score computed by the activity repository.
+	score := func(period types.Period, similarity, socialScore float64, age time.Duration) float64 {
+		req := types.SearchRequest{
+			SimilarityWeight:  similarityWeight,
+			SocialScoreWeight: socialScoreWeight,
+			RecencyWeight:     config.RecencyWeight(period),
+		}
+		simWeight, socialWeight, recencyWeight := req.NormalizedWeights()
+		return similarity*simWeight + socialScore*socialWeight + types.RecencyScore(age)*recencyWeight
+	}
+
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		period      types.Period
+		oldAge      time.Duration
+		freshWins   bool
+		oldSocial   float64
+		freshSocial float64
+	}{
+		{name: "week period", period: types.PeriodWeek, oldAge: 6 * day, oldSocial: 0.9, freshSocial: 0.8, freshWins: true},
+		{name: "month period", period: types.PeriodMonth, oldAge: 25 * day, oldSocial: 0.9, freshSocial: 0.8, freshWins: true},
+		{name: "all period ignores recency", period: types.PeriodAll, oldAge: 25 * day, oldSocial: 0.9, freshSocial: 0.8, freshWins: false},
+		{name: "much more popular old activity still wins", period: types.PeriodWeek, oldAge: 6 * day, oldSocial: 0.95, freshSocial: 0.3, freshWins: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := score(tt.period, 0.5, tt.oldSocial, tt.oldAge)
+			fresh := score(tt.period, 0.5, tt.freshSocial, time.Hour)
+
+			if got := fresh > old; got != tt.freshWins {
+				t.Errorf("fresh activity wins = %v (fresh=%.4f, old=%.4f), want %v", got, fresh, old, tt.freshWins)
+			}
+		})
+	}
+}
+
+func TestRecencyWeight_Periods(t *testing.T) {
+	config := &Config{RecencyWeightDay: 1, RecencyWeightWeek: 0.5, RecencyWeightMonth: 0.25, RecencyWeightAll: 0.1}
+
+	tests := []struct {
+		period types.Period
+		want   float64
+	}{
+		{types.PeriodDay, 1},
+		{types.PeriodWeek, 0.5},
+		{types.PeriodMonth, 0.25},
+		{types.PeriodAll, 0.1},
+		{"", 0.1},
+	}
+
+	for _, tt := range tests {
+		if got := config.RecencyWeight(tt.period); got != tt.want {
+			t.Errorf("RecencyWeight(%q) = %v, want %v", tt.period, got, tt.want)
+		}
+	}
+}
+
+func TestSortWeights(t *testing.T) {
+	config := &Config{RecencyWeightWeek: 0.5}
+	logger := zerolog.Nop()
+	registry := NewRegistry(&logger, nil, nil, nil, config)
+
+	similarity, socialScore, recency := registry.sortWeights(SearchRequest{Period: types.PeriodWeek})
+	if similarity != similarityWeight || socialScore != socialScoreWeight || recency != 0.5 {
+		t.Errorf("expected default weights, got %v, %v, %v", similarity, socialScore, recency)
+	}
+
+	override := 0.0
+	similarity, socialScore, recency = registry.sortWeights(SearchRequest{
+		Period:  types.PeriodWeek,
+		Weights: &SortWeights{SocialScore: &override},
+	})
+	if similarity != similarityWeight || socialScore != 0 || recency != 0.5 {
+		t.Errorf("expected only social score weight overridden, got %v, %v, %v", similarity, socialScore, recency)
+	}
+
+	negative := -1.0
+	if err := (SortWeights{Recency: &negative}).Validate(); !errors.Is(err, ErrInvalidSortWeights) {
+		t.Errorf("expected negative weight to be invalid, got %v", err)
+	}
+	if err := (SortWeights{Similarity: &override}).Validate(); err != nil {
+		t.Errorf("expected zero weight to be valid, got %v", err)
+	}
+}
+
+func TestRecencyWeight_SourceTypes(t *testing.T) {
+	config := &Config{
+		RecencyWeightDay:         1,
+		RecencyWeightWeek:        0.5,
+		RecencyWeightSourceTypes: "githubtopic=0, hackernewsposts=1.5",
+	}
+	logger := zerolog.Nop()
+	registry := NewRegistry(&logger, nil, nil, nil, config)
+
+	weights := registry.recencyWeightBySourceType(config.RecencyWeight(types.PeriodWeek))
+	if weights["githubtopic"] != 0 || weights["hackernewsposts"] != 0.75 || len(weights) != 2 {
+		t.Fatalf("unexpected source type recency weights: %v", weights)
+	}
+
+	req := types.SearchRequest{
+		SimilarityWeight:          similarityWeight,
+		SocialScoreWeight:         socialScoreWeight,
+		RecencyWeight:             config.RecencyWeight(types.PeriodWeek),
+		RecencyWeightBySourceType: weights,
+	}
+	_, _, recencyWeight := req.NormalizedWeights()
+	normalized := req.NormalizedSourceTypeRecencyWeights()
+	if normalized["githubtopic"] != 0 || normalized["hackernewsposts"] != 1.5*recencyWeight {
+		t.Errorf("expected source type weights scaled like the default recency weight, got %v", normalized)
+	}
+
+	for _, invalid := range []string{"githubtopic", "=1", "githubtopic=-1", "githubtopic=high"} {
+		config := &Config{RecencyWeightSourceTypes: invalid}
+		if _, err := config.RecencyWeightFactors(); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+// failingEmbedder fails to compute any embedding (e.g. the embedding provider is down).
+type failingEmbedder struct{}
+
+func (failingEmbedder) EmbedActivity(context.Context, types.Activity, *types.ActivitySummary) ([]float32, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func (failingEmbedder) EmbedActivityQuery(context.Context, string) ([]float32, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func (failingEmbedder) Model() string { return "test" }
+
+func (failingEmbedder) EmbedActivityQueries(context.Context, []string) ([][]float32, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func TestSearch_EmbeddingFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          SearchRequest
+		wantSortBy   types.SortBy
+		wantKeywords []string
+	}{
+		{
+			name:         "similarity sort falls back to social score",
+			req:          SearchRequest{Query: "Rust async runtimes, in Rust", SortBy: types.SortBySimilarity},
+			wantSortBy:   types.SortBySocialScore,
+			wantKeywords: []string{"rust", "async", "runtimes"},
+		},
+		{
+			name:         "date sort is kept",
+			req:          SearchRequest{Queries: []string{"go generics", "go iterators"}, SortBy: types.SortByDate},
+			wantSortBy:   types.SortByDate,
+			wantKeywords: []string{"generics", "iterators"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			store := &fakeActivityStore{}
+			registry := NewRegistry(&logger, store, nil, failingEmbedder{}, &Config{})
+
+			if _, err := registry.Search(context.Background(), tt.req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if store.req.SortBy != tt.wantSortBy {
+				t.Errorf("expected sort by %s, got %s", tt.wantSortBy, store.req.SortBy)
+			}
+			if !slices.Equal(store.req.Keywords, tt.wantKeywords) {
+				t.Errorf("expected keywords %v, got %v", tt.wantKeywords, store.req.Keywords)
+			}
+			if len(store.req.QueryEmbedding) != 0 {
+				t.Errorf("expected no query embedding, got %d dimensions", len(store.req.QueryEmbedding))
+			}
+		})
+	}
+}
+
+func TestSearch_EmbeddingCanceled(t *testing.T) {
+	logger := zerolog.Nop()
+	registry := NewRegistry(&logger, &fakeActivityStore{}, nil, failingEmbedder{}, &Config{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := registry.Search(ctx, SearchRequest{Query: "rust", SortBy: types.SortBySimilarity}); err == nil {
+		t.Error("expected an error for a canceled request")
+	}
+}
+
+// testActivity is a minimal activity with editable content.
+type testActivity struct {
+	title     string
+	body      string
+	createdAt time.Time
+}
+
+func (a *testActivity) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+func (a *testActivity) UnmarshalJSON([]byte) error   { return nil }
+func (a *testActivity) UID() types.TypedUID          { return lib.NewTypedUID("test", "1") }
+func (a *testActivity) SourceUIDs() []types.TypedUID {
+	return []types.TypedUID{lib.NewTypedUID("test", "source")}
+}
+func (a *testActivity) Title() string           { return a.title }
+func (a *testActivity) Body() string            { return a.body }
+func (a *testActivity) URL() string             { return "" }
+func (a *testActivity) ImageURL() string        { return "" }
+func (a *testActivity) CreatedAt() time.Time    { return a.createdAt }
+func (a *testActivity) UpvotesCount() int       { return -1 }
+func (a *testActivity) DownvotesCount() int     { return -1 }
+func (a *testActivity) CommentsCount() int      { return -1 }
+func (a *testActivity) AmplificationCount() int { return -1 }
+func (a *testActivity) SocialScore() float64    { return -1 }
+
+// countingProcessor counts the summaries and embeddings computed.
+type countingProcessor struct {
+	summaries  int
+	embeddings int
+}
+
+func (p *countingProcessor) SummarizeActivity(_ context.Context, act types.Activity) (*types.ActivitySummary, error) {
+	p.summaries++
+	return &types.ActivitySummary{ShortSummary: act.Title(), FullSummary: act.Body()}, nil
+}
+
+func (p *countingProcessor) EmbedActivity(context.Context, types.Activity, *types.ActivitySummary) ([]float32, error) {
+	p.embeddings++
+	return []float32{1, 0}, nil
+}
+
+func (p *countingProcessor) EmbedActivityQuery(context.Context, string) ([]float32, error) {
+	return []float32{1, 0}, nil
+}
+
+func (p *countingProcessor) Model() string { return "test" }
+
+func (p *countingProcessor) EmbedActivityQueries(_ context.Context, queries []string) ([][]float32, error) {
+	return make([][]float32, len(queries)), nil
+}
+
+func TestCreate_ContentChanged(t *testing.T) {
+	tests := []struct {
+		name          string
+		reprocess     bool
+		existingHash  func(act types.Activity) string
+		editedBody    string
+		wantSummaries int
+	}{
+		{name: "unchanged content", reprocess: true, existingHash: ContentHash, editedBody: "body", wantSummaries: 0},
+		{name: "changed content", reprocess: true, existingHash: ContentHash, editedBody: "edited body", wantSummaries: 1},
+		{name: "changed content with reprocessing disabled", reprocess: false, existingHash: ContentHash, editedBody: "edited body", wantSummaries: 0},
+		{name: "legacy activity without hash", reprocess: true, existingHash: func(types.Activity) string { return "" }, editedBody: "edited body", wantSummaries: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			processor := &countingProcessor{}
+			original := &testActivity{title: "title", body: "body"}
+			store := &fakeActivityStore{stored: &types.DecoratedActivity{
+				Activity:    original,
+				Summary:     &types.ActivitySummary{ShortSummary: "short", FullSummary: "full"},
+				Embedding:   []float32{1, 0},
+				ContentHash: tt.existingHash(original),
+			}}
+			registry := NewRegistry(&logger, store, processor, processor, &Config{ReprocessChangedContent: tt.reprocess})
+
+			edited := &testActivity{title: "title", body: tt.editedBody}
+			if _, err := registry.Create(context.Background(), CreateRequest{Activity: edited, Upsert: true}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if processor.summaries != tt.wantSummaries {
+				t.Errorf("expected %d summaries, got %d", tt.wantSummaries, processor.summaries)
+			}
+			if store.stored.ContentHash != ContentHash(edited) {
+				t.Errorf("expected the stored content hash to be updated")
+			}
+		})
+	}
+}
+
+func TestCreate_ShortBodySummary(t *testing.T) {
+	tests := []struct {
+		name          string
+		title         string
+		body          string
+		wantSummaries int
+		wantSummary   types.ActivitySummary
+	}{
+		{name: "long body", title: "title", body: "one two three four five", wantSummaries: 1, wantSummary: types.ActivitySummary{ShortSummary: "title", FullSummary: "one two three four five"}},
+		{name: "short body", title: "title", body: " one two ", wantSummary: types.ActivitySummary{ShortSummary: "title", FullSummary: "one two"}},
+		{name: "empty body", title: "title", wantSummary: types.ActivitySummary{ShortSummary: "title", FullSummary: "title"}},
+		{name: "empty title", body: "one two", wantSummary: types.ActivitySummary{ShortSummary: "one two", FullSummary: "one two"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			processor := &countingProcessor{}
+			store := &fakeActivityStore{}
+			registry := NewRegistry(&logger, store, processor, processor, &Config{MinSummaryBodyWords: 5})
+
+			if _, err := registry.Create(context.Background(), CreateRequest{Activity: &testActivity{title: tt.title, body: tt.body}}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if processor.summaries != tt.wantSummaries {
+				t.Errorf("expected %d LLM summaries, got %d", tt.wantSummaries, processor.summaries)
+			}
+			if store.stored == nil || store.stored.Summary == nil {
+				t.Fatal("expected the activity to be stored with a summary")
+			}
+			if *store.stored.Summary != tt.wantSummary {
+				t.Errorf("expected summary %+v, got %+v", tt.wantSummary, *store.stored.Summary)
+			}
+		})
+	}
+}
+
+func TestCreate_TrimmedActivity(t *testing.T) {
+	oldestKeptAt := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name       string
+		cap        int
+		createdAt  time.Time
+		wantStored bool
+	}{
+		{name: "newer than the kept activities", cap: 10, createdAt: oldestKeptAt.Add(time.Minute), wantStored: true},
+		{name: "older than the kept activities", cap: 10, createdAt: oldestKeptAt.Add(-time.Minute), wantStored: false},
+		{name: "source without retention cap", cap: 0, createdAt: oldestKeptAt.Add(-time.Minute), wantStored: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			store := &fakeActivityStore{oldestKeptAt: oldestKeptAt}
+			registry := NewRegistry(&logger, store, &countingProcessor{}, &countingProcessor{}, &Config{SourceRetentionCap: tt.cap})
+
+			_, err := registry.Create(context.Background(), CreateRequest{
+				Activity: &testActivity{title: "title", body: "body", createdAt: tt.createdAt},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if stored := store.stored != nil; stored != tt.wantStored {
+				t.Errorf("expected stored=%v, got %v", tt.wantStored, stored)
+			}
+		})
+	}
+}
+
+func TestQualityScore(t *testing.T) {
+	article := strings.Repeat("The release improves the compiler performance. ", 30)
+	summary := &types.ActivitySummary{ShortSummary: "Compiler is faster", FullSummary: "The compiler is faster."}
+
+	substantial := qualityScore(&testActivity{title: "Release", body: article}, summary)
+	linkOnly := qualityScore(&testActivity{title: "Link", body: "https://example.com/post"}, &types.ActivitySummary{
+		ShortSummary: "Link",
+		FullSummary:  "https://example.com/post",
+	})
+	empty := qualityScore(&testActivity{title: "Empty"}, &types.ActivitySummary{ShortSummary: "Empty", FullSummary: "Empty"})
+
+	if substantial < 0.9 {
+		t.Errorf("substantial activity score = %f, want >= 0.9", substantial)
+	}
+	if linkOnly > 0.2 {
+		t.Errorf("link-only activity score = %f, want <= 0.2", linkOnly)
+	}
+	if empty > 0.1 {
+		t.Errorf("empty activity score = %f, want <= 0.1", empty)
+	}
+	if linkOnly >= substantial || empty >= substantial {
+		t.Errorf("expected low-substance activities to score lower than %f, got link-only %f, empty %f", substantial, linkOnly, empty)
+	}
+}
+
+func TestEmbeddingModel(t *testing.T) {
+	logger := zerolog.Nop()
+	processor := &countingProcessor{}
+
+	store := &fakeActivityStore{}
+	registry := NewRegistry(&logger, store, processor, processor, &Config{})
+	if _, err := registry.Create(context.Background(), CreateRequest{Activity: &testActivity{title: "title", body: "body"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.stored.EmbeddingModel != processor.Model() {
+		t.Errorf("expected stored embedding model %q, got %q", processor.Model(), store.stored.EmbeddingModel)
+	}
+
+	recorder := &fakeActivityStore{}
+	registry = NewRegistry(&logger, recorder, processor, processor, &Config{})
+	if _, err := registry.Search(context.Background(), SearchRequest{Query: "rust", SortBy: types.SortBySimilarity}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recorder.req.EmbeddingModel != processor.Model() {
+		t.Errorf("expected search embedding model %q, got %q", processor.Model(), recorder.req.EmbeddingModel)
+	}
+}
+
+func TestCreate_EmbeddingDimension(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       []float32
+		dimension      int
+		wantEmbeddings int
+		wantErr        bool
+	}{
+		{name: "target dimension", existing: []float32{1, 0}, dimension: 2, wantEmbeddings: 0},
+		{name: "outdated dimension", existing: []float32{1, 0, 0}, dimension: 2, wantEmbeddings: 1},
+		{name: "mismatched model", existing: []float32{1, 0}, dimension: 3, wantEmbeddings: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			processor := &countingProcessor{}
+			act := &testActivity{title: "title", body: "body"}
+			store := &fakeActivityStore{stored: &types.DecoratedActivity{
+				Activity:    act,
+				Summary:     &types.ActivitySummary{ShortSummary: "short", FullSummary: "full"},
+				Embedding:   tt.existing,
+				ContentHash: ContentHash(act),
+			}}
+			registry := NewRegistry(&logger, store, processor, processor, &Config{})
+
+			_, err := registry.Create(context.Background(), CreateRequest{Activity: act, Upsert: true, EmbeddingDimension: tt.dimension})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if processor.embeddings != tt.wantEmbeddings {
+				t.Errorf("expected %d embeddings, got %d", tt.wantEmbeddings, processor.embeddings)
+			}
+		})
+	}
+}
+
+func TestKeywordSearchOnly(t *testing.T) {
+	logger := zerolog.Nop()
+	processor := &countingProcessor{}
+	store := &fakeActivityStore{}
+	registry := NewRegistry(&logger, store, processor, processor, &Config{KeywordSearchOnly: true})
+
+	act := &testActivity{title: "title", body: "body"}
+	if _, err := registry.Create(context.Background(), CreateRequest{Activity: act}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if processor.embeddings != 0 || len(store.stored.Embedding) != 0 {
+		t.Errorf("expected no embeddings, got %d computed", processor.embeddings)
+	}
+
+	recorder := &fakeActivityStore{}
+	registry = NewRegistry(&logger, recorder, processor, processor, &Config{KeywordSearchOnly: true})
+	if _, err := registry.Search(context.Background(), SearchRequest{Query: "rust compilers", SortBy: types.SortBySimilarity}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(recorder.req.QueryEmbedding) != 0 {
+		t.Errorf("expected no query embedding, got %d dimensions", len(recorder.req.QueryEmbedding))
+	}
+	if !slices.Equal(recorder.req.Keywords, []string{"rust", "compilers"}) {
+		t.Errorf("expected keyword search, got %v", recorder.req.Keywords)
+	}
+}
+
+func TestApplyFeedback(t *testing.T) {
+	query := []float32{1, 1}
+	feedback := &RelevanceFeedback{
+		Liked:    [][]float32{{1, 0}, {1, 0, 0}},
+		Disliked: [][]float32{{0, 1}},
+	}
+
+	got := applyFeedback(query, feedback, 0.5, 0.25)
+	if want := []float32{1.5, 0.75}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if !slices.Equal(query, []float32{1, 1}) {
+		t.Errorf("expected the query embedding to be unchanged, got %v", query)
+	}
+}
+
+// relatedActivity is a test activity with a custom UID.
+type relatedActivity struct {
+	testActivity
+	id string
+}
+
+func (a *relatedActivity) UID() types.TypedUID { return lib.NewTypedUID("test", a.id) }
+
+func TestRelated(t *testing.T) {
+	logger := zerolog.Nop()
+	decorated := func(id string) *types.DecoratedActivity {
+		return &types.DecoratedActivity{
+			Activity:       &relatedActivity{id: id},
+			Embedding:      []float32{1, 0, 0},
+			EmbeddingModel: "test",
+		}
+	}
+	act := decorated("a")
+	similar := []*types.DecoratedActivity{act, decorated("b"), decorated("c")}
+	// Returns the activities in the (similarity) order above.
+	store := &fakeActivityStore{search: func(types.SearchRequest) (*types.SearchResult, error) {
+		return &types.SearchResult{Activities: slices.Clone(similar)}, nil
+	}}
+	registry := NewRegistry(&logger, store, nil, failingEmbedder{}, &Config{EmbeddingDimension: 3})
+	sourceUIDs := []types.TypedUID{lib.NewTypedUID("test", "source")}
+
+	related, err := registry.Related(context.Background(), act, sourceUIDs, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(related) != 1 || related[0].Activity.UID().String() != "test:b" {
+		t.Errorf("expected the most similar other activity, got %d activities", len(related))
+	}
+	if store.req.SortBy != types.SortBySimilarity || store.req.Limit != 2 {
+		t.Errorf("expected a similarity search including the activity itself, got sort %s and limit %d", store.req.SortBy, store.req.Limit)
+	}
+	if !slices.Equal(store.req.QueryEmbedding, act.Embedding) {
+		t.Errorf("expected the stored embedding to be used as the query")
+	}
+
+	store.req = types.SearchRequest{}
+	unprocessed := &types.DecoratedActivity{Activity: &relatedActivity{id: "d"}}
+	if related, err := registry.Related(context.Background(), unprocessed, sourceUIDs, 1); err != nil || len(related) != 0 {
+		t.Errorf("expected no related activities without an embedding, got %d (err %v)", len(related), err)
+	}
+	if store.req.Limit != 0 {
+		t.Error("expected no search without an embedding")
+	}
+}
@@ -0,0 +1,213 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/alitto/pond/v2"
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// ErrReprocessJobRunning is used when a reprocess job is started while another one is still running.
+var ErrReprocessJobRunning = errors.New("reprocess job is already running")
+
+// ReprocessRequest selects the activities to reprocess (e.g. after prompt or embedding model changes).
+type ReprocessRequest struct {
+	SourceUIDs   []types.TypedUID
+	ActivityUIDs []types.TypedUID
+	SourceTypes  []string
+	Period       types.Period
+	// BatchSize is the number of activities searched at once.
+	BatchSize int
+	// MaxActivities stops the reprocessing after the given number of searched activities. Set to 0 to disable.
+	MaxActivities int
+	// MaxConcurrency is the max number of activities reprocessed at once.
+	MaxConcurrency int
+	// DryRun only searches the activities, without reprocessing them.
+	DryRun                  bool
+	ForceReprocessSummary   bool
+	ForceReprocessEmbedding bool
+	ForceUpsert             bool
+	// TargetEmbeddingDim recomputes the embeddings of a different dimension (see CreateRequest.EmbeddingDimension).
+	TargetEmbeddingDim int
+}
+
+// ReprocessProgress counts the reprocessed activities.
+type ReprocessProgress struct {
+	Fetched   atomic.Int32
+	Processed atomic.Int32
+	// Skipped counts the activities that weren't updated (e.g. already up to date, or failed).
+	Skipped atomic.Int32
+	Errored atomic.Int32
+}
+
+// Reprocess recomputes the summaries and embeddings of the matching activities, and updates the progress as it goes.
+func (r *Registry) Reprocess(ctx context.Context, req ReprocessRequest, progress *ReprocessProgress) error {
+	searchReq := SearchRequest{
+		ActivityUIDs: req.ActivityUIDs,
+		SourceUIDs:   req.SourceUIDs,
+		SourceTypes:  req.SourceTypes,
+		Limit:        req.BatchSize,
+		SortBy:       types.SortByDate,
+		Period:       req.Period,
+	}
+
+	pool := pond.NewPool(req.MaxConcurrency, pond.WithContext(ctx))
+	defer pool.StopAndWait()
+
+	for {
+		result, err := r.Search(ctx, searchReq)
+		if err != nil {
+			return fmt.Errorf("search activities: %w", err)
+		}
+		searchReq.Cursor = result.NextCursor
+		fetched := progress.Fetched.Add(int32(len(result.Activities)))
+
+		r.logger.Info().
+			Int("activities_count", len(result.Activities)).
+			Str("next_cursor", result.NextCursor).
+			Bool("has_more", result.HasMore).
+			Msg("Processing batch")
+
+		if req.MaxActivities > 0 && int(fetched) > req.MaxActivities {
+			return nil
+		}
+
+		if !req.DryRun {
+			for _, act := range result.Activities {
+				pool.Submit(func() {
+					r.reprocessOne(ctx, req, act, progress)
+				})
+			}
+		}
+
+		if !result.HasMore {
+			return nil
+		}
+	}
+}
+
+func (r *Registry) reprocessOne(ctx context.Context, req ReprocessRequest, act *types.DecoratedActivity, progress *ReprocessProgress) {
+	defer progress.Processed.Add(1)
+
+	isUpserted, err := r.Create(ctx, CreateRequest{
+		Activity:                act.Activity,
+		ForceReprocessSummary:   req.ForceReprocessSummary,
+		ForceReprocessEmbedding: req.ForceReprocessEmbedding,
+		// Activities with the target dimension are skipped, so that interrupted migrations can be resumed.
+		Upsert:             req.ForceUpsert || req.TargetEmbeddingDim > 0,
+		EmbeddingDimension: req.TargetEmbeddingDim,
+	})
+	if err != nil {
+		r.logger.Error().
+			Err(err).
+			Str("activity_id", act.Activity.UID().String()).
+			Msg("Error reprocessing activity")
+		progress.Errored.Add(1)
+	}
+	if !isUpserted {
+		progress.Skipped.Add(1)
+	}
+
+	r.logger.Debug().
+		Str("activity_id", act.Activity.UID().String()).
+		Bool("is_added", isUpserted).
+		Msg("Processed activity")
+}
+
+// ReprocessJob is a reprocessing run in the background.
+type ReprocessJob struct {
+	ID        string
+	Request   ReprocessRequest
+	Progress  ReprocessProgress
+	StartedAt time.Time
+
+	mu         sync.Mutex
+	finishedAt time.Time
+	err        error
+}
+
+// Result returns the finish time (zero while running) and the error of the job.
+func (j *ReprocessJob) Result() (time.Time, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.finishedAt, j.err
+}
+
+func (j *ReprocessJob) finish(err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finishedAt = time.Now()
+	j.err = err
+}
+
+// ReprocessJobs runs the reprocessing in the background, one job at a time.
+// Jobs are only kept in memory, so they're lost on restarts.
+type ReprocessJobs struct {
+	registry *Registry
+	logger   *zerolog.Logger
+	mu       sync.Mutex
+	jobs     map[string]*ReprocessJob
+	running  *ReprocessJob
+}
+
+func NewReprocessJobs(registry *Registry, logger *zerolog.Logger) *ReprocessJobs {
+	return &ReprocessJobs{
+		registry: registry,
+		logger:   logger,
+		jobs:     make(map[string]*ReprocessJob),
+	}
+}
+
+// Start runs the reprocessing in the background, detached from the ctx cancellation.
+func (j *ReprocessJobs) Start(ctx context.Context, req ReprocessRequest) (*ReprocessJob, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running != nil {
+		return nil, fmt.Errorf("%w: %s", ErrReprocessJobRunning, j.running.ID)
+	}
+
+	job := &ReprocessJob{
+		ID:        uuid.New().String(),
+		Request:   req,
+		StartedAt: time.Now(),
+	}
+	j.jobs[job.ID] = job
+	j.running = job
+
+	go func() {
+		err := j.registry.Reprocess(context.WithoutCancel(ctx), req, &job.Progress)
+		job.finish(err)
+
+		logger := j.logger.With().Str("job_id", job.ID).Logger()
+		if err != nil {
+			logger.Error().Err(err).Msg("Reprocess job failed")
+		} else {
+			logger.Info().
+				Int32("processed", job.Progress.Processed.Load()).
+				Int32("skipped", job.Progress.Skipped.Load()).
+				Int32("errored", job.Progress.Errored.Load()).
+				Msg("Reprocess job completed")
+		}
+
+		j.mu.Lock()
+		j.running = nil
+		j.mu.Unlock()
+	}()
+
+	return job, nil
+}
+
+// Get returns the job with the given ID, or nil if it doesn't exist.
+func (j *ReprocessJobs) Get(id string) *ReprocessJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.jobs[id]
+}
@@ -0,0 +1,70 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+func TestReprocessJobs(t *testing.T) {
+	logger := zerolog.Nop()
+	processor := &countingProcessor{}
+	act := &testActivity{title: "title", body: "body"}
+	store := &fakeActivityStore{stored: &types.DecoratedActivity{
+		Activity:    act,
+		Summary:     &types.ActivitySummary{ShortSummary: "short", FullSummary: "full"},
+		Embedding:   []float32{1, 0},
+		ContentHash: ContentHash(act),
+	}}
+	registry := NewRegistry(&logger, store, processor, processor, &Config{})
+	jobs := NewReprocessJobs(registry, &logger)
+
+	job, err := jobs.Start(context.Background(), ReprocessRequest{
+		Period:                types.PeriodAll,
+		BatchSize:             10,
+		MaxConcurrency:        1,
+		ForceReprocessSummary: true,
+		ForceUpsert:           true,
+	})
+	if err != nil {
+		t.Fatalf("start job: %v", err)
+	}
+	if _, err := jobs.Start(context.Background(), ReprocessRequest{}); !errors.Is(err, ErrReprocessJobRunning) {
+		// The job may have already finished, which is fine.
+		if finishedAt, _ := job.Result(); finishedAt.IsZero() {
+			t.Errorf("expected running job error, got %v", err)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		finishedAt, err := job.Result()
+		if !finishedAt.IsZero() {
+			if err != nil {
+				t.Fatalf("unexpected job error: %v", err)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("job didn't finish in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if jobs.Get(job.ID) != job {
+		t.Error("expected job to be found by ID")
+	}
+	if got := job.Progress.Processed.Load(); got != 1 {
+		t.Errorf("expected 1 processed activity, got %d", got)
+	}
+	if got := job.Progress.Skipped.Load() + job.Progress.Errored.Load(); got != 0 {
+		t.Errorf("expected no skipped or errored activities, got %d", got)
+	}
+	if processor.summaries != 1 {
+		t.Errorf("expected the summary to be reprocessed, got %d summaries", processor.summaries)
+	}
+}
@@ -0,0 +1,165 @@
+package activities
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/rs/zerolog"
+)
+
+type sourceTrimStore interface {
+	CountBySource(ctx context.Context) (map[string]int, error)
+	// TrimSource deletes the oldest activities of the source above the given count.
+	// Returns the number of deleted activities.
+	TrimSource(ctx context.Context, sourceUID string, keep int) (int, error)
+}
+
+// SourceTrimmer enforces the per-source retention caps (see Config.SourceRetentionCap),
+// so that a single high-volume source can't accumulate most of the stored activities.
+type SourceTrimmer struct {
+	store  sourceTrimStore
+	logger *zerolog.Logger
+	config *Config
+	// caps overrides the retention cap per source type, see Config.SourceRetentionCapSourceTypes.
+	caps map[string]int
+}
+
+func NewSourceTrimmer(logger *zerolog.Logger, store sourceTrimStore, config *Config) *SourceTrimmer {
+	caps, err := config.SourceRetentionCaps()
+	if err != nil {
+		// The caps are validated when the config is loaded.
+		logger.Error().Err(err).Msg("Ignoring invalid source type retention caps")
+	}
+
+	return &SourceTrimmer{
+		store:  store,
+		logger: logger,
+		config: config,
+		caps:   caps,
+	}
+}
+
+// SourceCount is the number of stored activities of a source.
+type SourceCount struct {
+	SourceUID     string
+	ActivityCount int
+	// RetentionCap is the max number of kept activities, or 0 if the source isn't trimmed.
+	RetentionCap int
+}
+
+// RetentionCap returns the max number of kept activities of the source type, or 0 if it isn't trimmed.
+func (t *SourceTrimmer) RetentionCap(sourceType string) int {
+	return retentionCap(t.config, t.caps, sourceType)
+}
+
+// retentionCap returns the cap of the source type from the per-type overrides, or the default cap.
+func retentionCap(config *Config, caps map[string]int, sourceType string) int {
+	if limit, ok := caps[sourceType]; ok {
+		return limit
+	}
+	return config.SourceRetentionCap
+}
+
+// Counts returns the stored activity counts of all sources, largest first, to help identifying the noisy sources.
+func (t *SourceTrimmer) Counts(ctx context.Context) ([]SourceCount, error) {
+	counts, err := t.store.CountBySource(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count by source: %w", err)
+	}
+
+	out := make([]SourceCount, 0, len(counts))
+	for uid, count := range counts {
+		out = append(out, SourceCount{
+			SourceUID:     uid,
+			ActivityCount: count,
+			RetentionCap:  t.sourceRetentionCap(uid),
+		})
+	}
+
+	slices.SortFunc(out, func(a, b SourceCount) int {
+		return cmp.Or(cmp.Compare(b.ActivityCount, a.ActivityCount), cmp.Compare(a.SourceUID, b.SourceUID))
+	})
+
+	return out, nil
+}
+
+// Trim deletes the oldest activities of the sources above their retention cap.
+// The activities with user feedback, and the ones shared with other sources are kept. Returns the number of deleted activities.
+func (t *SourceTrimmer) Trim(ctx context.Context) (int, error) {
+	counts, err := t.Counts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	trimmed := 0
+	for _, count := range counts {
+		if count.RetentionCap <= 0 || count.ActivityCount <= count.RetentionCap {
+			continue
+		}
+
+		deleted, err := t.store.TrimSource(ctx, count.SourceUID, count.RetentionCap)
+		if err != nil {
+			return trimmed, fmt.Errorf("trim source %s: %w", count.SourceUID, err)
+		}
+		trimmed += deleted
+
+		if deleted > 0 {
+			t.logger.Info().
+				Str("source_uid", count.SourceUID).
+				Int("activity_count", count.ActivityCount).
+				Int("retention_cap", count.RetentionCap).
+				Int("deleted", deleted).
+				Msg("Trimmed source activities")
+		}
+	}
+
+	return trimmed, nil
+}
+
+// Start trims the sources above their retention cap right away and then periodically, until the ctx is canceled.
+func (t *SourceTrimmer) Start(ctx context.Context) {
+	if t.config.SourceRetentionInterval <= 0 || !t.enabled() {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(t.config.SourceRetentionInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := t.Trim(ctx); err != nil {
+				t.logger.Error().Err(err).Msg("Failed to trim source activities")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (t *SourceTrimmer) enabled() bool {
+	if t.config.SourceRetentionCap > 0 {
+		return true
+	}
+	for _, limit := range t.caps {
+		if limit > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *SourceTrimmer) sourceRetentionCap(sourceUID string) int {
+	uid, err := lib.NewTypedUIDFromString(sourceUID)
+	if err != nil {
+		return t.config.SourceRetentionCap
+	}
+	return t.RetentionCap(uid.Type())
+}
@@ -0,0 +1,83 @@
+package activities
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// trimStore records the trimmed sources.
+type trimStore struct {
+	counts  map[string]int
+	trimmed map[string]int
+}
+
+func (s *trimStore) CountBySource(context.Context) (map[string]int, error) {
+	return s.counts, nil
+}
+
+func (s *trimStore) TrimSource(_ context.Context, sourceUID string, keep int) (int, error) {
+	s.trimmed[sourceUID] = keep
+	return s.counts[sourceUID] - keep, nil
+}
+
+func TestSourceTrimmer_Trim(t *testing.T) {
+	logger := zerolog.Nop()
+	store := &trimStore{
+		counts: map[string]int{
+			"redditsubreddit:golang": 500,
+			"rssfeed:blog":           150,
+			"rssfeed:small":          50,
+			"githubtopic:go":         1000,
+		},
+		trimmed: make(map[string]int),
+	}
+	trimmer := NewSourceTrimmer(&logger, store, &Config{
+		SourceRetentionCap:            100,
+		SourceRetentionCapSourceTypes: "redditsubreddit=200,githubtopic=0",
+	})
+
+	deleted, err := trimmer.Trim(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]int{"redditsubreddit:golang": 200, "rssfeed:blog": 100}
+	if len(store.trimmed) != len(want) {
+		t.Errorf("expected %d trimmed sources, got %v", len(want), store.trimmed)
+	}
+	for uid, keep := range want {
+		if store.trimmed[uid] != keep {
+			t.Errorf("expected %s to be trimmed to %d, got %d", uid, keep, store.trimmed[uid])
+		}
+	}
+	if deleted != 350 {
+		t.Errorf("expected 350 deleted activities, got %d", deleted)
+	}
+}
+
+func TestSourceTrimmer_Counts(t *testing.T) {
+	logger := zerolog.Nop()
+	store := &trimStore{counts: map[string]int{
+		"rssfeed:a":              10,
+		"redditsubreddit:golang": 30,
+		"rssfeed:b":              10,
+	}}
+	trimmer := NewSourceTrimmer(&logger, store, &Config{SourceRetentionCapSourceTypes: "redditsubreddit=20"})
+
+	counts, err := trimmer.Counts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantUIDs := []string{"redditsubreddit:golang", "rssfeed:a", "rssfeed:b"}
+	for i, count := range counts {
+		if count.SourceUID != wantUIDs[i] {
+			t.Errorf("expected %s at position %d, got %s", wantUIDs[i], i, count.SourceUID)
+		}
+	}
+	if counts[0].RetentionCap != 20 || counts[1].RetentionCap != 0 {
+		t.Errorf("unexpected retention caps: %d, %d", counts[0].RetentionCap, counts[1].RetentionCap)
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+import "time"
+
+// FailedActivity is an activity that failed processing (e.g. due to transient LLM or DB errors).
+type FailedActivity struct {
+	Activity Activity
+	// Error is the last processing error message.
+	Error        string
+	AttemptCount int
+	NextRetryAt  time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
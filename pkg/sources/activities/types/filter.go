@@ -0,0 +1,62 @@
+package types
+
+import (
+	"slices"
+	"strings"
+)
+
+// KeywordFilter drops recurring noise (e.g. daily discussion threads) from otherwise relevant sources of a feed.
+// Keywords are matched case-insensitively against the activity title and body.
+// Exclude keywords take precedence over the include keywords.
+type KeywordFilter struct {
+	// IncludeKeywords only keeps activities containing at least one of the keywords.
+	IncludeKeywords []string `json:"includeKeywords,omitempty"`
+	// ExcludeKeywords drops activities containing any of the keywords.
+	ExcludeKeywords []string `json:"excludeKeywords,omitempty"`
+}
+
+// Normalized returns the filter with the keywords trimmed and lowercased, without the blank and duplicate keywords.
+func (f KeywordFilter) Normalized() KeywordFilter {
+	return KeywordFilter{
+		IncludeKeywords: normalizeKeywords(f.IncludeKeywords),
+		ExcludeKeywords: normalizeKeywords(f.ExcludeKeywords),
+	}
+}
+
+// IsEmpty returns true if the filter keeps all activities.
+func (f KeywordFilter) IsEmpty() bool {
+	return len(f.IncludeKeywords) == 0 && len(f.ExcludeKeywords) == 0
+}
+
+// Keeps reports whether the activity matches the filter.
+// Mirrors the search predicate of the activity store, so that the activities are filtered the same way when processed.
+func (f KeywordFilter) Keeps(activity Activity) bool {
+	f = f.Normalized()
+	if f.IsEmpty() {
+		return true
+	}
+
+	text := strings.ToLower(activity.Title() + "\n" + activity.Body())
+	for _, keyword := range f.ExcludeKeywords {
+		if strings.Contains(text, keyword) {
+			return false
+		}
+	}
+	if len(f.IncludeKeywords) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(f.IncludeKeywords, func(keyword string) bool {
+		return strings.Contains(text, keyword)
+	})
+}
+
+func normalizeKeywords(keywords []string) []string {
+	var out []string
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword != "" && !slices.Contains(out, keyword) {
+			out = append(out, keyword)
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeywordFilter_Normalized(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    KeywordFilter
+		want      KeywordFilter
+		wantEmpty bool
+	}{
+		{
+			name:      "no keywords",
+			filter:    KeywordFilter{},
+			want:      KeywordFilter{},
+			wantEmpty: true,
+		},
+		{
+			name:   "lowercased and trimmed",
+			filter: KeywordFilter{IncludeKeywords: []string{" RELEASED "}, ExcludeKeywords: []string{"Daily Discussion"}},
+			want:   KeywordFilter{IncludeKeywords: []string{"released"}, ExcludeKeywords: []string{"daily discussion"}},
+		},
+		{
+			name:   "duplicates are removed",
+			filter: KeywordFilter{IncludeKeywords: []string{"rust", "Rust", "green tea"}},
+			want:   KeywordFilter{IncludeKeywords: []string{"rust", "green tea"}},
+		},
+		{
+			name:      "blank keywords are ignored",
+			filter:    KeywordFilter{IncludeKeywords: []string{" "}, ExcludeKeywords: []string{""}},
+			want:      KeywordFilter{},
+			wantEmpty: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.Normalized()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalized() = %+v, want %+v", got, tt.want)
+			}
+			if got.IsEmpty() != tt.wantEmpty {
+				t.Errorf("IsEmpty() = %v, want %v", got.IsEmpty(), tt.wantEmpty)
+			}
+		})
+	}
+}
+
+// textActivity only supports the title and body.
+type textActivity struct {
+	Activity
+	title, body string
+}
+
+func (a textActivity) Title() string { return a.title }
+func (a textActivity) Body() string  { return a.body }
+
+func TestKeywordFilter_Keeps(t *testing.T) {
+	activity := textActivity{title: "Daily Discussion Thread", body: "Rust 1.80 released"}
+
+	tests := []struct {
+		name   string
+		filter KeywordFilter
+		want   bool
+	}{
+		{name: "no keywords", filter: KeywordFilter{}, want: true},
+		{name: "excluded title", filter: KeywordFilter{ExcludeKeywords: []string{"daily discussion"}}, want: false},
+		{name: "included body", filter: KeywordFilter{IncludeKeywords: []string{" RELEASED "}}, want: true},
+		{name: "not included", filter: KeywordFilter{IncludeKeywords: []string{"go"}}, want: false},
+		{name: "exclude takes precedence", filter: KeywordFilter{IncludeKeywords: []string{"rust"}, ExcludeKeywords: []string{"thread"}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Keeps(activity); got != tt.want {
+				t.Errorf("Keeps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,18 +1,87 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrEmbeddingDimensionMismatch is used when the query embedding doesn't match the dimension of the indexed activities.
+var ErrEmbeddingDimensionMismatch = errors.New("query embedding dimension doesn't match the indexed activities")
+
 // SearchRequest represents a search query for activities
 type SearchRequest struct {
-	SourceUIDs        []TypedUID
-	ActivityUIDs      []TypedUID
-	MinSimilarity     float32
-	Limit             int
-	Cursor            string
-	SortBy            SortBy
-	Period            Period
-	QueryEmbedding    []float32
+	SourceUIDs   []TypedUID
+	ActivityUIDs []TypedUID
+	// SourceTypes only includes activities from the given source types (e.g. "githubissues").
+	SourceTypes   []string
+	MinSimilarity float32
+	// MinQualityScore excludes activities with a lower quality score. Activities without a score are kept.
+	MinQualityScore float64
+	Limit           int
+	Cursor          string
+	SortBy          SortBy
+	Period          Period
+	// Calendar computes the period boundaries. Nil uses the DefaultCalendar.
+	Calendar       *Calendar
+	QueryEmbedding []float32
+	// Keywords only includes activities with any of the keywords in the title or body (case-insensitive).
+	// Used as a fallback when the query embedding can't be computed.
+	Keywords []string
+	// SourceFilters filters the activities of the sources (keyed by source UID) by keywords.
+	// Activities shared with other sources are filtered too.
+	SourceFilters map[string]KeywordFilter
+	// EmbeddingDimension is the expected dimension of the query embedding (i.e. of the indexed activities).
+	// Zero skips the validation.
+	EmbeddingDimension int
+	// EmbeddingModel only compares the query embedding against the activity embeddings of the same model.
+	// Empty skips the filter.
+	EmbeddingModel    string
 	SimilarityWeight  float64
 	SocialScoreWeight float64
 	RecencyWeight     float64
+	// RecencyWeightBySourceType overrides the RecencyWeight for the activities of the given source types.
+	RecencyWeightBySourceType map[string]float64
+}
+
+// RecencyDecayRate controls how fast the recency score decays: score = e^(-rate * days_old).
+// 0.1 means ~0.74 score after 3 days, ~0.37 after 10 days, ~0.05 after 30 days.
+const RecencyDecayRate = 0.1
+
+// RecencyScore returns the recency score of an activity of the given age, between 0 and 1.
+func RecencyScore(age time.Duration) float64 {
+	if age < 0 {
+		age = 0
+	}
+	return math.Exp(-RecencyDecayRate * age.Hours() / 24)
+}
+
+// NormalizedWeights returns the weighted score weights scaled to sum to 1.
+// If all weights are zero, only the similarity is taken into account.
+func (r SearchRequest) NormalizedWeights() (similarity, socialScore, recency float64) {
+	similarity, socialScore, recency = r.SimilarityWeight, r.SocialScoreWeight, r.RecencyWeight
+	if similarity == 0 && socialScore == 0 && recency == 0 {
+		return 1, 0, 0
+	}
+
+	total := similarity + socialScore + recency
+	return similarity / total, socialScore / total, recency / total
+}
+
+// NormalizedSourceTypeRecencyWeights returns the RecencyWeightBySourceType scaled by the same total as NormalizedWeights,
+// so that the weighted scores of the activities from different source types remain comparable.
+func (r SearchRequest) NormalizedSourceTypeRecencyWeights() map[string]float64 {
+	total := r.SimilarityWeight + r.SocialScoreWeight + r.RecencyWeight
+	if total == 0 {
+		total = 1
+	}
+
+	weights := make(map[string]float64, len(r.RecencyWeightBySourceType))
+	for sourceType, weight := range r.RecencyWeightBySourceType {
+		weights[sourceType] = weight / total
+	}
+	return weights
 }
 
 // SearchResult represents paginated search results
@@ -43,3 +112,49 @@ const (
 	PeriodWeek  Period = "week"
 	PeriodDay   Period = "day"
 )
+
+// Calendar defines how the period boundaries are computed (e.g. in the user's time zone).
+type Calendar struct {
+	// Location is the time zone of the period boundaries. Nil means the server time zone.
+	Location *time.Location
+	// WeekStart is the first day of the week (e.g. Sunday in the US, Monday in most of Europe).
+	WeekStart time.Weekday
+}
+
+// DefaultCalendar computes the period boundaries in the server time zone, with weeks starting on Monday.
+func DefaultCalendar() Calendar {
+	return Calendar{Location: time.Local, WeekStart: time.Monday}
+}
+
+func (c Calendar) location() *time.Location {
+	if c.Location == nil {
+		return time.Local
+	}
+	return c.Location
+}
+
+// String identifies the calendar (e.g. in cache keys).
+func (c Calendar) String() string {
+	return fmt.Sprintf("%s:%s", c.location(), c.WeekStart)
+}
+
+// Since returns the start of the period at the given time, in the calendar time zone.
+// 'month' starts on the first day of last month, 'week' on the first day of last week and 'day' at midnight today.
+// Returns the zero time for PeriodAll.
+func (p Period) Since(now time.Time, calendar Calendar) time.Time {
+	loc := calendar.location()
+	now = now.In(loc)
+
+	// Dates are normalized by time.Date, so the boundaries stay at local midnight across DST changes.
+	switch p {
+	case PeriodMonth:
+		return time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, loc)
+	case PeriodWeek:
+		daysSinceWeekStart := (int(now.Weekday()) - int(calendar.WeekStart) + 7) % 7
+		return time.Date(now.Year(), now.Month(), now.Day()-daysSinceWeekStart-7, 0, 0, 0, 0, loc)
+	case PeriodDay:
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	default:
+		return time.Time{}
+	}
+}
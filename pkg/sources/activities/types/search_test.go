@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodSince(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	losAngeles := mustLoadLocation(t, "America/Los_Angeles")
+
+	tests := []struct {
+		name     string
+		period   Period
+		now      time.Time
+		calendar Calendar
+		want     time.Time
+	}{
+		{
+			name:     "all",
+			period:   PeriodAll,
+			now:      time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC),
+			calendar: Calendar{Location: time.UTC, WeekStart: time.Monday},
+			want:     time.Time{},
+		},
+		{
+			name:     "day on DST start",
+			period:   PeriodDay,
+			now:      time.Date(2025, 3, 9, 12, 0, 0, 0, newYork),
+			calendar: Calendar{Location: newYork, WeekStart: time.Monday},
+			want:     time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day is already tomorrow in the user time zone",
+			period:   PeriodDay,
+			now:      time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC),
+			calendar: Calendar{Location: tokyo, WeekStart: time.Monday},
+			want:     time.Date(2025, 1, 15, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week across DST end",
+			period:   PeriodWeek,
+			now:      time.Date(2025, 10, 29, 10, 0, 0, 0, berlin),
+			calendar: Calendar{Location: berlin, WeekStart: time.Monday},
+			want:     time.Date(2025, 10, 19, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week on the first day of the week",
+			period:   PeriodWeek,
+			now:      time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC),
+			calendar: Calendar{Location: time.UTC, WeekStart: time.Monday},
+			want:     time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week starting on sunday",
+			period:   PeriodWeek,
+			now:      time.Date(2025, 6, 15, 9, 0, 0, 0, losAngeles),
+			calendar: Calendar{Location: losAngeles, WeekStart: time.Sunday},
+			want:     time.Date(2025, 6, 8, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "month across year boundary",
+			period:   PeriodMonth,
+			now:      time.Date(2025, 1, 10, 9, 0, 0, 0, berlin),
+			calendar: Calendar{Location: berlin, WeekStart: time.Monday},
+			want:     time.Date(2024, 11, 30, 23, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.period.Since(tt.now, tt.calendar)
+			if !got.Equal(tt.want) {
+				t.Errorf("Since() = %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	return loc
+}
@@ -33,6 +33,25 @@ type Activity interface {
 	SocialScore() float64
 }
 
+// ContentFlag marks content that may be inappropriate for some audiences.
+type ContentFlag string
+
+const (
+	ContentFlagNSFW    ContentFlag = "nsfw"
+	ContentFlagSpoiler ContentFlag = "spoiler"
+)
+
+// FlaggedActivity is implemented by activities whose provider reports content flags (e.g. Reddit NSFW posts).
+type FlaggedActivity interface {
+	ContentFlags() []ContentFlag
+}
+
+// UpdatedActivity is implemented by activities whose provider reports edits (e.g. Atom entries with an updated date).
+type UpdatedActivity interface {
+	// UpdatedAt is the time of the last edit. Zero if the activity wasn't edited after it was published.
+	UpdatedAt() time.Time
+}
+
 // TypedUID is a semi-structured ID format for easy resource type extraction.
 type TypedUID interface {
 	json.Marshaler
@@ -41,14 +60,32 @@ type TypedUID interface {
 	String() string
 }
 
+// ActivityStats summarizes the stored activities of a set of sources.
+type ActivityStats struct {
+	Count int
+	// LatestCreatedAt is the creation time of the newest activity. Zero if there are no activities.
+	LatestCreatedAt time.Time
+	// UpdateCount is the total number of the activity upserts,
+	// so that it changes when the existing activities are updated (e.g. their social stats).
+	UpdateCount int
+}
+
 type ActivitySummary struct {
 	ShortSummary string
 	FullSummary  string
 }
 
 type DecoratedActivity struct {
-	Activity   Activity
-	Summary    *ActivitySummary
-	Embedding  []float32
-	Similarity float32
+	Activity  Activity
+	Summary   *ActivitySummary
+	Embedding []float32
+	// EmbeddingModel is the name of the model the embedding was computed with.
+	EmbeddingModel string
+	Similarity     float32
+	// ContentHash is the hash of the content that the summary and embedding were computed from.
+	// Empty for activities stored before the content hashes were tracked.
+	ContentHash string
+	// QualityScore measures the content substance (0-1), independent of the social engagement.
+	// -1 for activities stored before the quality scores were computed.
+	QualityScore float64
 }
@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
@@ -33,6 +34,21 @@ func (c *CachedRegistry) Initialize() error {
 	return c.registry.Initialize()
 }
 
+// Validate validates the source config without caching
+func (c *CachedRegistry) Validate(ctx context.Context, sourceType string, raw []byte) error {
+	return c.registry.Validate(ctx, sourceType, raw)
+}
+
+// ParseWebhook parses the webhook payload without caching
+func (c *CachedRegistry) ParseWebhook(source types.Source, ownerIDs []string, header http.Header, payload []byte) ([]activitytypes.Activity, error) {
+	return c.registry.ParseWebhook(source, ownerIDs, header, payload)
+}
+
+// WebhookSecret derives the webhook secret without caching
+func (c *CachedRegistry) WebhookSecret(sourceUID activitytypes.TypedUID, ownerID string) (string, error) {
+	return c.registry.WebhookSecret(sourceUID, ownerID)
+}
+
 // FindByUID finds a source by UID with caching
 func (c *CachedRegistry) FindByUID(ctx context.Context, uid activitytypes.TypedUID) (types.Source, error) {
 	cacheKey := c.generateSourceCacheKey(uid)
@@ -74,18 +90,20 @@ func (c *CachedRegistry) Search(ctx context.Context, params SearchRequest) ([]ty
 		}
 	}
 
-	results, err := c.registry.Search(ctx, params)
+	results, partial, err := c.registry.search(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	// Cache the final results
-	c.searchCache.Set(cacheKey, results)
-	c.logger.Trace().
-		Str("query", params.Query).
-		Int("topics", len(params.Topics)).
-		Int("count", len(results)).
-		Msg("cached search results")
+	// Don't cache partial results, so that the skipped fetchers are retried on the next search.
+	if !partial {
+		c.searchCache.Set(cacheKey, results)
+		c.logger.Trace().
+			Str("query", params.Query).
+			Int("topics", len(params.Topics)).
+			Int("count", len(results)).
+			Msg("cached search results")
+	}
 
 	// Also populate the source cache with individual sources
 	for _, source := range results {
@@ -1,5 +1,74 @@
 package sources
 
+import (
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+)
+
 type Config struct {
 	MaxActivityProcessorConcurrency int `env:"MAX_ACTIVITY_PROCESSOR_CONCURRENCY,default=10"`
+	// FailedActivityRetryInterval controls how often the failed activities are checked for retries.
+	FailedActivityRetryInterval time.Duration `env:"FAILED_ACTIVITY_RETRY_INTERVAL,default=5m"`
+	// FailedActivityRetryBackoff is the base delay before retrying a failed activity.
+	// The delay is doubled with each failed attempt, up to FailedActivityMaxRetryBackoff.
+	FailedActivityRetryBackoff time.Duration `env:"FAILED_ACTIVITY_RETRY_BACKOFF,default=10m"`
+	// FailedActivityMaxRetryBackoff caps the delay before retrying a failed activity. Set to 0 to disable the cap.
+	FailedActivityMaxRetryBackoff time.Duration `env:"FAILED_ACTIVITY_MAX_RETRY_BACKOFF,default=24h" validate:"gte=0"`
+	// FailedActivityMaxAttempts is the max number of processing attempts, after which the activity isn't retried anymore.
+	FailedActivityMaxAttempts int `env:"FAILED_ACTIVITY_MAX_ATTEMPTS,default=5" validate:"gte=1"`
+	// ReconcileInterval controls how often active sources that aren't used by any feed are removed.
+	// Set to 0 to disable the reconciliation.
+	ReconcileInterval time.Duration `env:"SOURCE_RECONCILE_INTERVAL,default=1h"`
+	// StaleSourceThreshold flags the sources without new activities for the given duration as stale (e.g. dead RSS feeds,
+	// deleted subreddits). Checked at the reconciliation interval. Set to 0 to disable.
+	StaleSourceThreshold time.Duration `env:"STALE_SOURCE_THRESHOLD,default=720h"`
+	// StaleSourceAutoRemove stops polling the sources that stayed stale for the removal grace period.
+	// The removed sources are still referenced by their feeds, and are polled again once re-added (e.g. on a feed update).
+	StaleSourceAutoRemove bool `env:"STALE_SOURCE_AUTO_REMOVE,default=false"`
+	// StaleSourceRemovalGrace is how long the sources are flagged as stale before they're removed,
+	// so that the feed owners can notice and replace them.
+	StaleSourceRemovalGrace time.Duration `env:"STALE_SOURCE_REMOVAL_GRACE,default=168h"`
+	// StaleSourceWebhookURL receives a JSON notification (see StaleSourceNotification) with the feed owners,
+	// when a source is flagged as stale and when it's removed. Empty disables the notifications.
+	StaleSourceWebhookURL string `env:"STALE_SOURCE_WEBHOOK_URL,default=" validate:"omitempty,url"`
+	// CacheLastActivity keeps the last activity of each source in memory,
+	// instead of searching the DB for the polling starting point on every cycle.
+	// The cache is updated as new activities are processed, so it should be disabled
+	// if other processes (e.g. reprocess command) write activities of the scheduled sources.
+	CacheLastActivity bool `env:"SOURCE_CACHE_LAST_ACTIVITY,default=true"`
+	// SeenActivityCacheSize is the max number of recently processed activities kept in memory,
+	// so that the activities re-emitted on every poll (e.g. RSS feeds, HN top stories) aren't upserted again.
+	// Set to 0 to disable.
+	SeenActivityCacheSize int `env:"SEEN_ACTIVITY_CACHE_SIZE,default=10000" validate:"gte=0"`
+	// SeenActivityCacheTTL is how long the processed activities are skipped. The social stats (e.g. upvotes)
+	// of the re-emitted activities are only updated after it expires, so it's kept short compared to the poll intervals.
+	// Set to 0 to skip them until evicted.
+	SeenActivityCacheTTL time.Duration `env:"SEEN_ACTIVITY_CACHE_TTL,default=30m" validate:"gte=0"`
+	// ExternalContentAllowedDomains is a comma-separated list of domains that external content (e.g. linked articles) can be fetched from.
+	// Prefix with "*." to match the subdomains (e.g. "*.example.com"). Empty allows all domains.
+	ExternalContentAllowedDomains string `env:"EXTERNAL_CONTENT_ALLOWED_DOMAINS,default="`
+	// ExternalContentDeniedDomains is a comma-separated list of domains that external content is never fetched from.
+	// Takes precedence over the allowed domains.
+	ExternalContentDeniedDomains string `env:"EXTERNAL_CONTENT_DENIED_DOMAINS,default="`
+}
+
+// StaleSourceNotifier returns the notifier of the stale sources, or nil if it's disabled.
+func (c *Config) StaleSourceNotifier() StaleSourceNotifier {
+	if c.StaleSourceWebhookURL == "" {
+		return nil
+	}
+	return NewWebhookNotifier(c.StaleSourceWebhookURL)
+}
+
+// DomainPolicy returns the policy of the external content domains, or nil if all domains are allowed.
+func (c *Config) DomainPolicy() *lib.DomainPolicy {
+	if c.ExternalContentAllowedDomains == "" && c.ExternalContentDeniedDomains == "" {
+		return nil
+	}
+	return lib.NewDomainPolicy(
+		strings.Split(c.ExternalContentAllowedDomains, ","),
+		strings.Split(c.ExternalContentDeniedDomains, ","),
+	)
 }
@@ -0,0 +1,33 @@
+package sources
+
+import (
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+)
+
+// contentPolicy excludes flagged content (e.g. NSFW) consistently across all providers.
+type contentPolicy struct {
+	excludedFlags map[activitytypes.ContentFlag]bool
+}
+
+func newContentPolicy(config *sourcetypes.ProviderConfig) *contentPolicy {
+	return &contentPolicy{
+		excludedFlags: config.ExcludedContentFlags(),
+	}
+}
+
+// IsFiltered returns the first flag of the activity that is excluded by the policy.
+func (p *contentPolicy) IsFiltered(activity activitytypes.Activity) (activitytypes.ContentFlag, bool) {
+	flagged, ok := activity.(activitytypes.FlaggedActivity)
+	if !ok {
+		return "", false
+	}
+
+	for _, flag := range flagged.ContentFlags() {
+		if p.excludedFlags[flag] {
+			return flag, true
+		}
+	}
+
+	return "", false
+}
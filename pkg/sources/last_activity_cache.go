@@ -0,0 +1,64 @@
+package sources
+
+import (
+	"sync"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// lastActivityCache keeps the last activity of each source in memory,
+// so that polling doesn't search the DB for the "since" activity on every cycle.
+type lastActivityCache struct {
+	mu sync.Mutex
+	// bySourceID stores nil for the sources without any activities.
+	bySourceID map[string]activitytypes.Activity
+}
+
+func newLastActivityCache() *lastActivityCache {
+	return &lastActivityCache{
+		bySourceID: make(map[string]activitytypes.Activity),
+	}
+}
+
+// Get returns the last activity of the source.
+// Found is false if the source wasn't loaded into the cache yet.
+func (c *lastActivityCache) Get(sourceUID string) (_ activitytypes.Activity, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	activity, found := c.bySourceID[sourceUID]
+	return activity, found
+}
+
+// Set stores the last activity of the source (nil if the source has no activities).
+func (c *lastActivityCache) Set(sourceUID string, activity activitytypes.Activity) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bySourceID[sourceUID] = activity
+}
+
+// Update replaces the last activity of the sources the activity is from, if it is newer.
+// Sources that weren't loaded into the cache yet are skipped, since the activity may not be their last one.
+func (c *lastActivityCache) Update(activity activitytypes.Activity) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sourceUID := range activity.SourceUIDs() {
+		last, found := c.bySourceID[sourceUID.String()]
+		if !found {
+			continue
+		}
+		if last == nil || activity.CreatedAt().After(last.CreatedAt()) {
+			c.bySourceID[sourceUID.String()] = activity
+		}
+	}
+}
+
+// Delete removes the source from the cache.
+func (c *lastActivityCache) Delete(sourceUID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.bySourceID, sourceUID)
+}
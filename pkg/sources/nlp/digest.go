@@ -0,0 +1,134 @@
+package nlp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/defeedco/defeed/pkg/lib/tracing"
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/outputparser"
+	"github.com/tmc/langchaingo/prompts"
+)
+
+// DigestHighlight is a single paragraph of the digest narrative.
+type DigestHighlight struct {
+	Content     string   `json:"content" describe:"One paragraph of the digest narrative (1-3 sentences, plain text)"`
+	ActivityIDs []string `json:"activity_ids" describe:"IDs of the input activities referenced in the paragraph"`
+}
+
+type digestActivityInput struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	ShortSummary string `json:"short_summary"`
+}
+
+// SummarizeDigest generates a narrative digest of the period's activities,
+// with references to the activities each highlight is based on.
+func (s *Summarizer) SummarizeDigest(ctx context.Context, period types.Period, activities []*types.DecoratedActivity) (_ []*DigestHighlight, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.SummarizeDigest")
+	defer tracing.End(span, &err)
+
+	if len(activities) == 0 {
+		return nil, nil
+	}
+
+	template := prompts.NewPromptTemplate(`You are an expert at analyzing and summarizing online activity information.
+Given the top activities of the period, write a digest that tells the reader what happened in a few highlights.
+
+Guidelines:
+1. Write 3-7 highlights, ordered from the most to the least important
+2. Each highlight is a paragraph of 1-3 sentences that connects the related activities into a narrative
+3. Reference the IDs of the activities each highlight is based on, only use the IDs from the input
+4. Be faithful to the input, do NOT add new information
+5. Output plain text, no Markdown or formatting
+
+## Output format
+
+{{.output_format_instructions}}
+
+## Input
+
+Period: {{.period}}
+Activities: {{.activities}}
+
+## Output
+`, []string{
+		"output_format_instructions",
+		"period",
+		"activities",
+	})
+
+	type digestResponse struct {
+		// Note: fields should not be pointers, or the format instructions won't include them
+		Highlights []DigestHighlight `json:"highlights" describe:"List of digest highlights, ordered by importance"`
+	}
+
+	parser, err := outputparser.NewDefined(digestResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("creating parser: %w", err)
+	}
+
+	inputs := make([]digestActivityInput, 0, len(activities))
+	knownIDs := make(map[string]bool, len(activities))
+	for _, activity := range activities {
+		id := activity.Activity.UID().String()
+		knownIDs[id] = true
+
+		input := digestActivityInput{
+			ID:    id,
+			Title: activity.Activity.Title(),
+		}
+		if activity.Summary != nil {
+			input.ShortSummary = activity.Summary.ShortSummary
+		}
+		inputs = append(inputs, input)
+	}
+
+	activitiesJSON, err := json.MarshalIndent(inputs, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal activities: %w", err)
+	}
+
+	prompt, err := template.Format(map[string]any{
+		"output_format_instructions": parser.GetFormatInstructions(),
+		"period":                     string(period),
+		"activities":                 string(activitiesJSON),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("format prompt: %w", err)
+	}
+
+	out, err := s.model.Call(
+		ctx,
+		prompt,
+		// Note: Fixed temperature of 1 must be applied for gpt-5-mini
+		llms.WithTemperature(1.0),
+	)
+	if err != nil {
+		logGenerateCompletionError(s.logger, err, prompt, out, "Error generating digest completion")
+		return nil, fmt.Errorf("generate digest completion: %w", err)
+	}
+
+	response, err := parseResponse(parser, out)
+	if err != nil {
+		logGenerateCompletionError(s.logger, err, prompt, out, "Error parsing digest response")
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+
+	highlights := make([]*DigestHighlight, 0, len(response.Highlights))
+	for _, highlight := range response.Highlights {
+		// The model can hallucinate the references, so only keep the input activities.
+		ids := make([]string, 0, len(highlight.ActivityIDs))
+		for _, id := range highlight.ActivityIDs {
+			if knownIDs[id] {
+				ids = append(ids, id)
+			}
+		}
+		highlight.ActivityIDs = ids
+		highlights = append(highlights, &highlight)
+	}
+
+	return highlights, nil
+}
@@ -4,28 +4,112 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/lib/tracing"
 	"github.com/tmc/langchaingo/embeddings"
 
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
 )
 
 type ActivityEmbedder struct {
-	embedder embeddings.Embedder
+	embedder  embeddings.Embedder
+	modelName string
+	// queryExpander is optional, see WithQueryExpander.
+	queryExpander *QueryExpander
+	// sourceTypeInputs overrides the embedding input per source type, see WithSourceTypeInputs.
+	sourceTypeInputs map[string]EmbeddingInput
+	// maxBodyChars limits the body embedded with EmbeddingInputBody. Zero embeds the whole body.
+	maxBodyChars int
+	// queryCache is optional, see WithQueryCache.
+	queryCache  *lib.Cache
+	queryHits   atomic.Int64
+	queryMisses atomic.Int64
 }
 
 type embedderModel interface {
 	CreateEmbedding(ctx context.Context, texts []string) ([][]float32, error)
 }
 
-func NewActivityEmbedder(model embedderModel) *ActivityEmbedder {
+func NewActivityEmbedder(model embedderModel, modelName string) *ActivityEmbedder {
 	embedder, _ := embeddings.NewEmbedder(model)
 	return &ActivityEmbedder{
-		embedder: embedder,
+		embedder:  embedder,
+		modelName: modelName,
 	}
 }
 
-func (e *ActivityEmbedder) EmbedActivity(ctx context.Context, act types.Activity, summary *types.ActivitySummary) ([]float32, error) {
+// WithQueryExpander embeds the single search queries expanded into pseudo activities, to match the activity embeddings.
+// Note: the batched queries (see EmbedActivityQueries) aren't expanded, since they're already rewritten by the QueryRewriter.
+func (e *ActivityEmbedder) WithQueryExpander(expander *QueryExpander) *ActivityEmbedder {
+	e.queryExpander = expander
+	return e
+}
+
+// WithSourceTypeInputs overrides the embedding input strategy of the activities of the given source types
+// (EmbeddingInputSummary by default). The body embedded with EmbeddingInputBody is truncated to maxBodyChars.
+// Note: the existing activities keep their embeddings until they're reprocessed (e.g. `reprocess -source-type`).
+func (e *ActivityEmbedder) WithSourceTypeInputs(inputs map[string]EmbeddingInput, maxBodyChars int) *ActivityEmbedder {
+	e.sourceTypeInputs = inputs
+	e.maxBodyChars = maxBodyChars
+	return e
+}
+
+// WithQueryCache caches the query embeddings keyed by the model and the original query (before the expansion),
+// since the same feed queries are embedded on each feed view.
+func (e *ActivityEmbedder) WithQueryCache(cache *lib.Cache) *ActivityEmbedder {
+	e.queryCache = cache
+	return e
+}
+
+// QueryCacheStats returns the hits of the query embedding cache since the start, or false if the cache is disabled.
+func (e *ActivityEmbedder) QueryCacheStats() (_ lib.CacheStats, found bool) {
+	if e.queryCache == nil {
+		return lib.CacheStats{}, false
+	}
+	return lib.CacheStats{
+		Hits:   e.queryHits.Load(),
+		Misses: e.queryMisses.Load(),
+	}, true
+}
+
+// cachedQueryEmbedding returns the cached embedding of the query, and counts the lookup.
+// The expanded queries are embedded from a different input, so they're cached separately.
+func (e *ActivityEmbedder) cachedQueryEmbedding(query string, expanded bool) ([]float32, bool) {
+	if e.queryCache == nil {
+		return nil, false
+	}
+	if cached, found := e.queryCache.Get(e.queryCacheKey(query, expanded)); found {
+		if embedding, ok := cached.([]float32); ok {
+			e.queryHits.Add(1)
+			return embedding, true
+		}
+	}
+	e.queryMisses.Add(1)
+	return nil, false
+}
+
+func (e *ActivityEmbedder) cacheQueryEmbedding(query string, expanded bool, embedding []float32) {
+	if e.queryCache != nil {
+		e.queryCache.Set(e.queryCacheKey(query, expanded), embedding)
+	}
+}
+
+func (e *ActivityEmbedder) queryCacheKey(query string, expanded bool) string {
+	return fmt.Sprintf("query_embedding:%s", lib.HashParams(e.modelName, fmt.Sprint(expanded), query))
+}
+
+// Model returns the name of the model the embeddings are computed with.
+// Embeddings of different models can't be compared, even if they have the same dimension.
+func (e *ActivityEmbedder) Model() string {
+	return e.modelName
+}
+
+func (e *ActivityEmbedder) EmbedActivity(ctx context.Context, act types.Activity, summary *types.ActivitySummary) (_ []float32, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.EmbedActivity")
+	defer tracing.End(span, &err)
+
 	sourceUIDs := act.SourceUIDs()
 	sourceUIDsStr := make([]string, len(sourceUIDs))
 	for i, sourceUID := range sourceUIDs {
@@ -33,7 +117,17 @@ func (e *ActivityEmbedder) EmbedActivity(ctx context.Context, act types.Activity
 	}
 	sourceStr := strings.Join(sourceUIDsStr, ", ")
 
-	out, err := e.embedder.EmbedQuery(ctx, fmt.Sprintf("Title: %s\nSources: %s\nSummary: %s", act.Title(), sourceStr, summary.ShortSummary))
+	var input string
+	switch e.activityInput(act) {
+	case EmbeddingInputFullSummary:
+		input = activityEmbeddingInput(act.Title(), sourceStr, summary.FullSummary)
+	case EmbeddingInputBody:
+		input = activityEmbeddingInput(act.Title(), sourceStr, summary.ShortSummary) + "\nBody: " + e.truncatedBody(act)
+	default:
+		input = activityEmbeddingInput(act.Title(), sourceStr, summary.ShortSummary)
+	}
+
+	out, err := e.embedder.EmbedQuery(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("embed activity: %w", err)
 	}
@@ -41,11 +135,77 @@ func (e *ActivityEmbedder) EmbedActivity(ctx context.Context, act types.Activity
 	return out, nil
 }
 
-func (e *ActivityEmbedder) EmbedActivityQuery(ctx context.Context, query string) ([]float32, error) {
-	out, err := e.embedder.EmbedQuery(ctx, query)
+// activityEmbeddingInput formats the text the activities are embedded from.
+func activityEmbeddingInput(title, sources, summary string) string {
+	return fmt.Sprintf("Title: %s\nSources: %s\nSummary: %s", title, sources, summary)
+}
+
+func (e *ActivityEmbedder) EmbedActivityQuery(ctx context.Context, query string) (_ []float32, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.EmbedActivityQuery")
+	defer tracing.End(span, &err)
+
+	expand := e.queryExpander != nil
+	if cached, found := e.cachedQueryEmbedding(query, expand); found {
+		return cached, nil
+	}
+
+	input := query
+	if expand {
+		expanded, err := e.queryExpander.ExpandQuery(ctx, query)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			// The original query still works, just with a lower similarity.
+			// It's not cached as expanded, so that the expansion is retried on the next request.
+			e.queryExpander.logger.Error().Err(err).Str("query", query).Msg("Error expanding query")
+			expand = false
+		} else {
+			input = expanded
+		}
+	}
+
+	out, err := e.embedder.EmbedQuery(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("embed activity query: %w", err)
 	}
+	e.cacheQueryEmbedding(query, expand, out)
+
+	return out, nil
+}
+
+// EmbedActivityQueries computes the embeddings of multiple queries in a single batched request.
+func (e *ActivityEmbedder) EmbedActivityQueries(ctx context.Context, queries []string) (_ [][]float32, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.EmbedActivityQueries")
+	defer tracing.End(span, &err)
+
+	out := make([][]float32, len(queries))
+	var uncachedIndices []int
+	var uncachedQueries []string
+	for i, query := range queries {
+		if cached, found := e.cachedQueryEmbedding(query, false); found {
+			out[i] = cached
+			continue
+		}
+		uncachedIndices = append(uncachedIndices, i)
+		uncachedQueries = append(uncachedQueries, query)
+	}
+	if len(uncachedQueries) == 0 {
+		return out, nil
+	}
+
+	embeddings, err := e.embedder.EmbedDocuments(ctx, uncachedQueries)
+	if err != nil {
+		return nil, fmt.Errorf("embed activity queries: %w", err)
+	}
+	if len(embeddings) != len(uncachedQueries) {
+		return nil, fmt.Errorf("embed activity queries: expected %d embeddings, got %d", len(uncachedQueries), len(embeddings))
+	}
+
+	for i, embedding := range embeddings {
+		out[uncachedIndices[i]] = embedding
+		e.cacheQueryEmbedding(uncachedQueries[i], false, embedding)
+	}
 
 	return out, nil
 }
@@ -0,0 +1,81 @@
+package nlp
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/rs/zerolog"
+)
+
+// countingModel counts the texts embedded by the wrapped model.
+type countingModel struct {
+	bagOfWordsModel
+	texts []string
+}
+
+func (m *countingModel) CreateEmbedding(ctx context.Context, texts []string) ([][]float32, error) {
+	m.texts = append(m.texts, texts...)
+	return m.bagOfWordsModel.CreateEmbedding(ctx, texts)
+}
+
+func TestActivityEmbedder_QueryCache(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	model := &countingModel{}
+	embedder := NewActivityEmbedder(model, "test").WithQueryCache(lib.NewCache(time.Hour, &logger))
+
+	first, err := embedder.EmbedActivityQuery(ctx, "kubernetes")
+	if err != nil {
+		t.Fatalf("embed query: %v", err)
+	}
+	second, err := embedder.EmbedActivityQuery(ctx, "kubernetes")
+	if err != nil {
+		t.Fatalf("embed query: %v", err)
+	}
+	if !slices.Equal(first, second) {
+		t.Error("expected the cached embedding to match")
+	}
+
+	batch, err := embedder.EmbedActivityQueries(ctx, []string{"kubernetes", "golang"})
+	if err != nil {
+		t.Fatalf("embed queries: %v", err)
+	}
+	if len(batch) != 2 || !slices.Equal(batch[0], first) {
+		t.Errorf("expected the batched queries in the input order, got %d embeddings", len(batch))
+	}
+
+	if want := []string{"kubernetes", "golang"}; !slices.Equal(model.texts, want) {
+		t.Errorf("expected only the uncached queries %v to be embedded, got %v", want, model.texts)
+	}
+
+	stats, found := embedder.QueryCacheStats()
+	if !found {
+		t.Fatal("expected the cache stats")
+	}
+	if stats.Hits != 2 || stats.Misses != 2 {
+		t.Errorf("expected 2 hits and 2 misses, got %+v", stats)
+	}
+
+	if _, found := NewActivityEmbedder(model, "test").QueryCacheStats(); found {
+		t.Error("expected no cache stats with the cache disabled")
+	}
+}
+
+func TestActivityEmbedder_QueryCacheKeyedByModel(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	model := &countingModel{}
+	cache := lib.NewCache(time.Hour, &logger)
+
+	for _, modelName := range []string{"small", "large"} {
+		if _, err := NewActivityEmbedder(model, modelName).WithQueryCache(cache).EmbedActivityQuery(ctx, "kubernetes"); err != nil {
+			t.Fatalf("embed query: %v", err)
+		}
+	}
+	if len(model.texts) != 2 {
+		t.Errorf("expected the query to be embedded once per model, got %d", len(model.texts))
+	}
+}
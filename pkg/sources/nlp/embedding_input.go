@@ -0,0 +1,73 @@
+package nlp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// EmbeddingInput is the strategy of composing the text the activities are embedded from.
+type EmbeddingInput string
+
+const (
+	// EmbeddingInputSummary embeds the title and the short summary (default),
+	// which works best for the low-signal bodies (e.g. release notes with changelog bullets).
+	EmbeddingInputSummary EmbeddingInput = "summary"
+	// EmbeddingInputFullSummary embeds the title and the full summary.
+	EmbeddingInputFullSummary EmbeddingInput = "full_summary"
+	// EmbeddingInputBody embeds the title, the short summary and the start of the body,
+	// which helps the recall of the long-form content (e.g. blog posts).
+	EmbeddingInputBody EmbeddingInput = "body"
+)
+
+// ParseEmbeddingInputs parses the comma-separated type=strategy pairs (e.g. "rssfeed=body,githubreleases=summary")
+// into a map of source type to embedding input strategy.
+func ParseEmbeddingInputs(in string) (map[string]EmbeddingInput, error) {
+	inputs := make(map[string]EmbeddingInput)
+
+	for pair := range strings.SplitSeq(in, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		sourceType, value, ok := strings.Cut(pair, "=")
+		sourceType = strings.TrimSpace(sourceType)
+		if !ok || sourceType == "" {
+			return nil, fmt.Errorf("invalid source type embedding input: %s", pair)
+		}
+
+		input := EmbeddingInput(strings.TrimSpace(value))
+		switch input {
+		case EmbeddingInputSummary, EmbeddingInputFullSummary, EmbeddingInputBody:
+		default:
+			return nil, fmt.Errorf("invalid embedding input for %s: %s", sourceType, value)
+		}
+
+		inputs[sourceType] = input
+	}
+
+	return inputs, nil
+}
+
+// activityInput returns the embedding input strategy of the activity, by the type of its (first) source.
+func (e *ActivityEmbedder) activityInput(act types.Activity) EmbeddingInput {
+	sourceUIDs := act.SourceUIDs()
+	if len(sourceUIDs) == 0 {
+		return EmbeddingInputSummary
+	}
+	if input, ok := e.sourceTypeInputs[sourceUIDs[0].Type()]; ok {
+		return input
+	}
+	return EmbeddingInputSummary
+}
+
+// truncatedBody returns the start of the activity body, up to the configured max number of characters.
+func (e *ActivityEmbedder) truncatedBody(act types.Activity) string {
+	body := act.Body()
+	if bodyRunes := []rune(body); e.maxBodyChars > 0 && len(bodyRunes) > e.maxBodyChars {
+		body = string(bodyRunes[:e.maxBodyChars])
+	}
+	return body
+}
@@ -0,0 +1,89 @@
+package nlp
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// inputRecordingModel records the embedded texts.
+type inputRecordingModel struct {
+	texts []string
+}
+
+func (m *inputRecordingModel) CreateEmbedding(_ context.Context, texts []string) ([][]float32, error) {
+	m.texts = append(m.texts, texts...)
+	out := make([][]float32, len(texts))
+	for i := range texts {
+		out[i] = []float32{1}
+	}
+	return out, nil
+}
+
+// sourceTypeActivity is a minimal activity of the given source type.
+type sourceTypeActivity struct {
+	sourceType string
+	body       string
+}
+
+func (a *sourceTypeActivity) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+func (a *sourceTypeActivity) UnmarshalJSON([]byte) error   { return nil }
+func (a *sourceTypeActivity) UID() types.TypedUID          { return lib.NewTypedUID(a.sourceType, "1") }
+func (a *sourceTypeActivity) SourceUIDs() []types.TypedUID {
+	return []types.TypedUID{lib.NewTypedUID(a.sourceType, "source")}
+}
+func (a *sourceTypeActivity) Title() string           { return "Title" }
+func (a *sourceTypeActivity) Body() string            { return a.body }
+func (a *sourceTypeActivity) URL() string             { return "" }
+func (a *sourceTypeActivity) ImageURL() string        { return "" }
+func (a *sourceTypeActivity) CreatedAt() time.Time    { return time.Time{} }
+func (a *sourceTypeActivity) UpvotesCount() int       { return -1 }
+func (a *sourceTypeActivity) DownvotesCount() int     { return -1 }
+func (a *sourceTypeActivity) CommentsCount() int      { return -1 }
+func (a *sourceTypeActivity) AmplificationCount() int { return -1 }
+func (a *sourceTypeActivity) SocialScore() float64    { return -1 }
+
+func TestEmbedActivity_SourceTypeInputs(t *testing.T) {
+	inputs, err := ParseEmbeddingInputs("rssfeed=body, githubreleases=full_summary")
+	if err != nil {
+		t.Fatalf("parse embedding inputs: %v", err)
+	}
+
+	model := &inputRecordingModel{}
+	embedder := NewActivityEmbedder(model, "test").WithSourceTypeInputs(inputs, 10)
+	summary := &types.ActivitySummary{ShortSummary: "short summary", FullSummary: "full summary"}
+	body := strings.Repeat("body ", 10)
+
+	tests := []struct {
+		sourceType string
+		want       string
+	}{
+		{"rssfeed", activityEmbeddingInput("Title", "rssfeed:source", "short summary") + "\nBody: body body "},
+		{"githubreleases", activityEmbeddingInput("Title", "githubreleases:source", "full summary")},
+		{"redditsubreddit", activityEmbeddingInput("Title", "redditsubreddit:source", "short summary")},
+	}
+
+	for _, tt := range tests {
+		model.texts = nil
+		_, err := embedder.EmbedActivity(context.Background(), &sourceTypeActivity{sourceType: tt.sourceType, body: body}, summary)
+		if err != nil {
+			t.Fatalf("embed activity: %v", err)
+		}
+		// The newlines are stripped by the embedder.
+		if want := strings.ReplaceAll(tt.want, "\n", " "); len(model.texts) != 1 || model.texts[0] != want {
+			t.Errorf("%s: expected input %q, got %q", tt.sourceType, tt.want, model.texts)
+		}
+	}
+}
+
+func TestParseEmbeddingInputs_Invalid(t *testing.T) {
+	for _, in := range []string{"rssfeed", "rssfeed=everything", "=body"} {
+		if _, err := ParseEmbeddingInputs(in); err == nil {
+			t.Errorf("expected an error for %q", in)
+		}
+	}
+}
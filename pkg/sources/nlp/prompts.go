@@ -0,0 +1,173 @@
+package nlp
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	llmconfig "github.com/defeedco/defeed/pkg/llms"
+)
+
+// promptFilePrefix marks a prompt config value as a path to the template file (e.g. "file:./prompts/full.tmpl").
+const promptFilePrefix = "file:"
+
+const defaultFullSummaryPrompt = `You are a summarizer.
+
+Rules:
+- Be faithful to the input.
+- Do NOT add new information.
+- Use Markdown exactly as shown.
+- Output ONLY the Markdown.
+- Keep it under {{.MaxWords}} words.
+
+Summarize the input in Markdown using EXACTLY these document sections:
+
+<document>
+### Context
+(1-3 sentences)
+
+### Key Points
+- point 1
+- point 2
+- point 3
+
+### Why it matters
+(1-2 sentences)
+</document>
+
+Input:
+{{.Input}}
+
+Output:
+`
+
+const defaultShortSummaryPrompt = `You are a summarizer.
+
+Write ONE sentence of MAX {{.MaxWords}} WORDS about the input.
+
+Rules:
+- {{.MaxWords}} words or fewer.
+- Plain text only.
+- No explanations.
+- If unsure, make it shorter.
+
+Input:
+{{.Input}}
+
+Output:
+`
+
+const defaultTopicSummaryPrompt = `You are an expert at analyzing and summarizing online activity information. 
+Given a list of activities, generate the summary of key insights that are relevant for the given topic.
+
+Guidelines:
+1. Summaries should be 1-3 sentences that capture the main high-level themes
+2. Focus on the most important insights that are shared by the activities 
+3. Be direct and informative in your summaries
+4. Output plain text, no Markdown or formatting.
+
+Topic name: {{.TopicName}}
+Topic activities: {{.Input}}
+
+Activity summary:`
+
+// promptData are the values available to the prompt templates.
+// Inputs are passed as data (not template text), so they can't inject template actions.
+type promptData struct {
+	// Input is the JSON-formatted activity (or topic activities).
+	Input string
+	// MaxWords is the summary word limit.
+	MaxWords int
+	// TopicName is only set for topic summaries.
+	TopicName string
+}
+
+type summarizerPrompts struct {
+	full  *template.Template
+	short *template.Template
+	topic *template.Template
+}
+
+// loadSummarizerPrompts loads the configured prompt templates, falling back to the built-in defaults.
+func loadSummarizerPrompts(config *llmconfig.Config) (*summarizerPrompts, error) {
+	full, err := loadPromptTemplate("full", config.SummarizerFullPrompt, defaultFullSummaryPrompt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	short, err := loadPromptTemplate("short", config.SummarizerShortPrompt, defaultShortSummaryPrompt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := loadPromptTemplate("topic", config.SummarizerTopicPrompt, defaultTopicSummaryPrompt, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &summarizerPrompts{
+		full:  full,
+		short: short,
+		topic: topic,
+	}, nil
+}
+
+func loadPromptTemplate(name string, value string, fallback string, requireTopic bool) (*template.Template, error) {
+	text := fallback
+	if path, ok := strings.CutPrefix(value, promptFilePrefix); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s prompt file: %w", name, err)
+		}
+		text = string(data)
+	} else if value != "" {
+		text = value
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s prompt: %w", name, err)
+	}
+
+	if err := validatePromptTemplate(tmpl, requireTopic); err != nil {
+		return nil, fmt.Errorf("validate %s prompt: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// validatePromptTemplate checks that the template renders and includes the required placeholders.
+func validatePromptTemplate(tmpl *template.Template, requireTopic bool) error {
+	const (
+		inputSentinel = "__input__"
+		topicSentinel = "__topic__"
+	)
+
+	out, err := renderPrompt(tmpl, promptData{
+		Input:     inputSentinel,
+		MaxWords:  1,
+		TopicName: topicSentinel,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !strings.Contains(out, inputSentinel) {
+		return errors.New("missing {{.Input}} placeholder")
+	}
+	if requireTopic && !strings.Contains(out, topicSentinel) {
+		return errors.New("missing {{.TopicName}} placeholder")
+	}
+
+	return nil
+}
+
+func renderPrompt(tmpl *template.Template, data promptData) (string, error) {
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("render prompt: %w", err)
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,61 @@
+package nlp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	llmconfig "github.com/defeedco/defeed/pkg/llms"
+)
+
+func TestLoadSummarizerPrompts(t *testing.T) {
+	promptFile := filepath.Join(t.TempDir(), "short.tmpl")
+	if err := os.WriteFile(promptFile, []byte("Summarize in {{.MaxWords}} words: {{.Input}}"), 0o600); err != nil {
+		t.Fatalf("write prompt file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		config  llmconfig.Config
+		wantErr bool
+	}{
+		{name: "defaults", config: llmconfig.Config{}},
+		{name: "inline", config: llmconfig.Config{SummarizerFullPrompt: "Bullet points only: {{.Input}}"}},
+		{name: "file", config: llmconfig.Config{SummarizerShortPrompt: "file:" + promptFile}},
+		{name: "missing file", config: llmconfig.Config{SummarizerShortPrompt: "file:" + filepath.Join(t.TempDir(), "missing.tmpl")}, wantErr: true},
+		{name: "missing input placeholder", config: llmconfig.Config{SummarizerFullPrompt: "Summarize in {{.MaxWords}} words"}, wantErr: true},
+		{name: "missing topic placeholder", config: llmconfig.Config{SummarizerTopicPrompt: "Summarize: {{.Input}}"}, wantErr: true},
+		{name: "unknown placeholder", config: llmconfig.Config{SummarizerFullPrompt: "{{.Language}} {{.Input}}"}, wantErr: true},
+		{name: "invalid syntax", config: llmconfig.Config{SummarizerFullPrompt: "{{.Input"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadSummarizerPrompts(&tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRenderPrompt_InputIsNotEvaluated(t *testing.T) {
+	prompts, err := loadSummarizerPrompts(&llmconfig.Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	input := `{"title": "{{.MaxWords}}"}`
+	out, err := renderPrompt(prompts.short, promptData{Input: input, MaxWords: shortSummaryMaxWords})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(out, input) {
+		t.Errorf("expected the input to be included verbatim, got %q", out)
+	}
+	if !strings.Contains(out, "MAX 20 WORDS") {
+		t.Errorf("expected the word limit to be substituted, got %q", out)
+	}
+}
@@ -0,0 +1,80 @@
+package nlp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/lib/tracing"
+	"github.com/rs/zerolog"
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/prompts"
+)
+
+// QueryExpander expands the search queries into pseudo activities (the query as the title, and a hypothetical matching summary),
+// since the activities are embedded from their summaries, and short keyword queries land far from them in the vector space.
+// The expansions are cached, so that polling clients don't rerun the completion on each request.
+type QueryExpander struct {
+	model  completionModel
+	cache  *lib.Cache
+	logger *zerolog.Logger
+}
+
+func NewQueryExpander(model completionModel, cache *lib.Cache, logger *zerolog.Logger) *QueryExpander {
+	return &QueryExpander{model: model, cache: cache, logger: logger}
+}
+
+// ExpandQuery returns the query formatted as the embedding input of an activity (see ActivityEmbedder.EmbedActivity).
+func (e *QueryExpander) ExpandQuery(ctx context.Context, query string) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.ExpandQuery")
+	defer tracing.End(span, &err)
+
+	cacheKey := fmt.Sprintf("query_expansion:%s", lib.HashParams(query))
+	if cached, found := e.cache.Get(cacheKey); found {
+		if expanded, ok := cached.(string); ok {
+			return expanded, nil
+		}
+	}
+
+	template := prompts.NewPromptTemplate(`You are an AI assistant that helps to search online activities (e.g. posts, articles, releases) by their summaries.
+## Task
+Write the summary of a hypothetical activity that perfectly matches the search query.
+
+Guidelines:
+1. Write at most {{.max_words}} words, in the style of a concise activity summary
+2. Expand the abbreviations, and mention the closely related terms, technologies and projects
+3. Write in the language of the search query
+4. Output only the summary, without any introduction or formatting
+
+## Input
+
+Search query: "{{.query}}"
+
+## Output
+`, []string{"query", "max_words"})
+
+	prompt, err := template.Format(map[string]any{
+		"query":     query,
+		"max_words": shortSummaryMaxWords,
+	})
+	if err != nil {
+		return "", fmt.Errorf("format prompt: %w", err)
+	}
+
+	out, err := e.model.Call(ctx, prompt, llms.WithTemperature(0.0))
+	if err != nil {
+		return "", fmt.Errorf("generate completion: %w", err)
+	}
+
+	summary := strings.TrimSpace(out)
+	if summary == "" {
+		return "", errors.New("empty query expansion")
+	}
+
+	expanded := activityEmbeddingInput(query, "", summary)
+	e.cache.Set(cacheKey, expanded)
+
+	return expanded, nil
+}
@@ -0,0 +1,196 @@
+package nlp
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"hash/fnv"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/rs/zerolog"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// bagOfWordsModel embeds the texts as hashed word counts, so that the texts sharing words are similar.
+type bagOfWordsModel struct{}
+
+func (bagOfWordsModel) CreateEmbedding(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	for i, text := range texts {
+		embedding := make([]float32, 256)
+		for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			// The embedding input labels are shared by all the texts.
+			if word == "title" || word == "sources" || word == "summary" {
+				continue
+			}
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(word))
+			embedding[h.Sum32()%uint32(len(embedding))]++
+		}
+		out[i] = embedding
+	}
+	return out, nil
+}
+
+// expansionModel returns the expansion of the query in the prompt.
+type expansionModel struct {
+	expansions map[string]string
+	calls      int
+	err        error
+}
+
+func (m *expansionModel) Call(_ context.Context, prompt string, _ ...llms.CallOption) (string, error) {
+	m.calls++
+	if m.err != nil {
+		return "", m.err
+	}
+	for query, expansion := range m.expansions {
+		if strings.Contains(prompt, `Search query: "`+query+`"`) {
+			return expansion, nil
+		}
+	}
+	return "", nil
+}
+
+// TestQueryExpansion_Recall evaluates the recall of the keyword queries, with and without the expansion.
+func TestQueryExpansion_Recall(t *testing.T) {
+	corpus := []struct{ title, summary string }{
+		{"Kubernetes 1.31 released", "Kubernetes release adds cluster autoscaling and container scheduling improvements"},
+		{"Scaling k8s clusters at work", "Lessons learned operating large Kubernetes clusters and container orchestration"},
+		{"Rust 2024 edition", "Rust language edition stabilizes async closures"},
+		{"PostgreSQL 17", "Postgres database release improves vacuum and query performance"},
+		{"Introducing Helm 4", "Helm package manager for Kubernetes charts gets a major release"},
+		{"React compiler beta", "React team ships a compiler for automatic memoization"},
+	}
+	queries := []struct {
+		query     string
+		expansion string
+		relevant  []int
+	}{
+		{
+			query:     "k8s",
+			expansion: "Kubernetes container orchestration news, cluster autoscaling and Helm charts",
+			relevant:  []int{0, 1, 4},
+		},
+		{
+			query:     "pg",
+			expansion: "PostgreSQL (Postgres) database release with query performance improvements",
+			relevant:  []int{3},
+		},
+	}
+
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	model := &expansionModel{expansions: make(map[string]string)}
+	for _, q := range queries {
+		model.expansions[q.query] = q.expansion
+	}
+	plain := NewActivityEmbedder(bagOfWordsModel{}, "test")
+	expanding := NewActivityEmbedder(bagOfWordsModel{}, "test").
+		WithQueryExpander(NewQueryExpander(model, lib.NewCache(time.Hour, &logger), &logger))
+
+	corpusEmbeddings := make([][]float32, len(corpus))
+	for i, act := range corpus {
+		embedding, err := plain.embedder.EmbedQuery(ctx, activityEmbeddingInput(act.title, "test:source", act.summary))
+		if err != nil {
+			t.Fatalf("embed corpus: %v", err)
+		}
+		corpusEmbeddings[i] = embedding
+	}
+
+	recall := func(embedder *ActivityEmbedder) float64 {
+		var total float64
+		for _, q := range queries {
+			queryEmbedding, err := embedder.EmbedActivityQuery(ctx, q.query)
+			if err != nil {
+				t.Fatalf("embed query %q: %v", q.query, err)
+			}
+
+			ranked := make([]int, len(corpus))
+			for i := range ranked {
+				ranked[i] = i
+			}
+			slices.SortStableFunc(ranked, func(a, b int) int {
+				return cmp.Compare(cosine(queryEmbedding, corpusEmbeddings[b]), cosine(queryEmbedding, corpusEmbeddings[a]))
+			})
+
+			var found int
+			for _, i := range ranked[:len(q.relevant)] {
+				if slices.Contains(q.relevant, i) && cosine(queryEmbedding, corpusEmbeddings[i]) > 0 {
+					found++
+				}
+			}
+			total += float64(found) / float64(len(q.relevant))
+		}
+		return total / float64(len(queries))
+	}
+
+	plainRecall := recall(plain)
+	expandedRecall := recall(expanding)
+	t.Logf("recall without expansion: %.2f, with expansion: %.2f", plainRecall, expandedRecall)
+
+	if expandedRecall <= plainRecall {
+		t.Errorf("expected the expansion to improve the recall, got %.2f (plain %.2f)", expandedRecall, plainRecall)
+	}
+	if expandedRecall < 1 {
+		t.Errorf("expected all the relevant activities to be found with the expansion, got recall %.2f", expandedRecall)
+	}
+}
+
+func TestQueryExpander_Cache(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	model := &expansionModel{expansions: map[string]string{"k8s": "Kubernetes news"}}
+	expander := NewQueryExpander(model, lib.NewCache(time.Hour, &logger), &logger)
+
+	for range 2 {
+		expanded, err := expander.ExpandQuery(ctx, "k8s")
+		if err != nil {
+			t.Fatalf("expand query: %v", err)
+		}
+		if expanded != activityEmbeddingInput("k8s", "", "Kubernetes news") {
+			t.Errorf("unexpected expansion: %q", expanded)
+		}
+	}
+	if model.calls != 1 {
+		t.Errorf("expected a single completion, got %d", model.calls)
+	}
+}
+
+func TestQueryExpander_FallbackToQuery(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.Nop()
+	model := &expansionModel{err: errors.New("provider unavailable")}
+	embedder := NewActivityEmbedder(bagOfWordsModel{}, "test").
+		WithQueryExpander(NewQueryExpander(model, lib.NewCache(time.Hour, &logger), &logger))
+
+	got, err := embedder.EmbedActivityQuery(ctx, "k8s")
+	if err != nil {
+		t.Fatalf("expected the original query to be embedded, got error: %v", err)
+	}
+	want, _ := bagOfWordsModel{}.CreateEmbedding(ctx, []string{"k8s"})
+	if !slices.Equal(got, want[0]) {
+		t.Errorf("expected the embedding of the original query")
+	}
+}
+
+func cosine(a, b []float32) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i] * b[i])
+		normA += float64(a[i] * a[i])
+		normB += float64(b[i] * b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/defeedco/defeed/pkg/lib/tracing"
 	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
 	"github.com/rs/zerolog"
 	"github.com/tmc/langchaingo/prompts"
@@ -28,24 +29,53 @@ type TopicQueryGroup struct {
 	Queries []string `json:"queries" describe:"Re-written sub-queries for the topic (max 1-3)"`
 }
 
+// defaultMaxTopics is the max number of rewritten topics, if not set in the request.
+const defaultMaxTopics = 5
+
+// maxQueriesPerTopic is the max number of queries of a single topic, since each query is embedded and searched.
+const maxQueriesPerTopic = 3
+
+// defaultLanguage is the language of the rewritten topics, if not set in the request.
+const defaultLanguage = "en"
+
 type RewriteRequest struct {
 	Query   string
 	Sources []sourcetypes.Source
+	// MaxTopics is the max number of topics to rewrite the query into. Defaults to 5.
+	MaxTopics int
+	// Language is the BCP 47 tag of the language the topics are written in (e.g. "de"). Defaults to English.
+	Language string
 }
 
-func (qr *QueryRewriter) RewriteToTopics(ctx context.Context, req RewriteRequest) ([]*TopicQueryGroup, error) {
+func (qr *QueryRewriter) RewriteToTopics(ctx context.Context, req RewriteRequest) (_ []*TopicQueryGroup, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.RewriteToTopics")
+	defer tracing.End(span, &err)
+
+	maxTopics := req.MaxTopics
+	if maxTopics <= 0 {
+		maxTopics = defaultMaxTopics
+	}
+	minTopics := min(2, maxTopics)
+
+	language := req.Language
+	if language == "" {
+		language = defaultLanguage
+	}
+
 	template := prompts.NewPromptTemplate(`You are an AI assistant tasked with reformulating user queries to improve retrieval in a RAG system. The system searches embeddings of online activity summaries.
 ## Task
 Given the original query, rewrite it into multiple topic-based queries that are more specific, detailed, and likely to retrieve relevant information from the provided sources.
 
 Guidelines:
-1. Break down the original query into 2-5 distinct and diverse topics
+1. Break down the original query into {{.min_topics}}-{{.max_topics}} distinct and diverse topics, ordered from the most to the least relevant
 2. Each topic should have a clear, descriptive name
-3. Each topic should have 1-3 specific queries as an array
+3. Each topic should have 1-{{.max_queries_per_topic}} specific queries as an array
 	1.1. Make queries more specific than the original to get better retrieval results
 	1.2 Focus on different aspects or angles of the original query
 	1.3 The queries should be plain text, optimised for RAG retrieval of the full activity summary embeddings
-4. Each topic should include representative emoji
+4. Each topic should include representative emoji that doesn't depend on the language (e.g. no flags or text symbols)
+5. Write the topic names and queries in the language with the BCP 47 tag "{{.language}}"
+	5.1 Keep the technical terms, product and project names untranslated, since they're used as is in the activities of any language
 
 ## Output format
 
@@ -61,11 +91,15 @@ Sources of activity data: {{.sources}}
 		"output_format_instructions",
 		"original_query",
 		"sources",
+		"min_topics",
+		"max_topics",
+		"max_queries_per_topic",
+		"language",
 	})
 
 	type queryRewriteResponse struct {
 		// Note: fields should not be pointers, or the format instructions won't include them
-		Topics []TopicQueryGroup `json:"topics" describe:"List of topic-based queries, maximum 5 topics"`
+		Topics []TopicQueryGroup `json:"topics" describe:"List of topic-based queries, ordered by relevance"`
 	}
 
 	parser, err := outputparser.NewDefined(queryRewriteResponse{})
@@ -82,6 +116,10 @@ Sources of activity data: {{.sources}}
 		"output_format_instructions": parser.GetFormatInstructions(),
 		"original_query":             req.Query,
 		"sources":                    sourcesJSON,
+		"min_topics":                 minTopics,
+		"max_topics":                 maxTopics,
+		"max_queries_per_topic":      maxQueriesPerTopic,
+		"language":                   language,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("format prompt: %w", err)
@@ -108,8 +146,17 @@ Sources of activity data: {{.sources}}
 		return nil, fmt.Errorf("parse response: %w", err)
 	}
 
+	// The model doesn't always respect the max number of topics,
+	// so drop the least relevant ones (ordered last).
+	if len(response.Topics) > maxTopics {
+		response.Topics = response.Topics[:maxTopics]
+	}
+
 	topics := make([]*TopicQueryGroup, len(response.Topics))
 	for i, topic := range response.Topics {
+		if len(topic.Queries) > maxQueriesPerTopic {
+			topic.Queries = topic.Queries[:maxQueriesPerTopic]
+		}
 		topics[i] = &topic
 	}
 
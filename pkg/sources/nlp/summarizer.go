@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"unicode"
 
+	"github.com/defeedco/defeed/pkg/lib/tracing"
+	llmconfig "github.com/defeedco/defeed/pkg/llms"
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
 	"github.com/rs/zerolog"
 
@@ -20,15 +23,29 @@ const (
 )
 
 type Summarizer struct {
-	model  completionModel
-	logger *zerolog.Logger
+	model   completionModel
+	config  *llmconfig.Config
+	prompts *summarizerPrompts
+	logger  *zerolog.Logger
 }
 
-func NewSummarizer(model completionModel, logger *zerolog.Logger) *Summarizer {
-	return &Summarizer{
-		model:  model,
-		logger: logger,
+func NewSummarizer(model completionModel, config *llmconfig.Config, logger *zerolog.Logger) (*Summarizer, error) {
+	prompts, err := loadSummarizerPrompts(config)
+	if err != nil {
+		return nil, fmt.Errorf("load prompts: %w", err)
 	}
+
+	// The completions may still succeed (e.g. if the provider ignores the parameter), so this isn't fatal.
+	if err := config.ValidateSummarizerParams(); err != nil {
+		logger.Warn().Err(err).Msg("Summarizer parameters may not be supported by the completion model")
+	}
+
+	return &Summarizer{
+		model:   model,
+		config:  config,
+		prompts: prompts,
+		logger:  logger,
+	}, nil
 }
 
 type completionModel interface {
@@ -44,7 +61,10 @@ type summarizeActivityInput struct {
 func (s *Summarizer) SummarizeActivity(
 	ctx context.Context,
 	activity types.Activity,
-) (*types.ActivitySummary, error) {
+) (_ *types.ActivitySummary, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.SummarizeActivity")
+	defer tracing.End(span, &err)
+
 	// Preprocess input to reduce token count
 	processedInput := s.activityToInput(activity)
 
@@ -90,8 +110,11 @@ func (s *Summarizer) summarizeWithRetry(
 	maxWords int,
 ) (string, error) {
 
+	// Models can't reliably hit an exact word limit, so allow some slack to avoid wasting completions.
+	allowedWords := maxWords + int(float64(maxWords)*s.config.SummarizerWordTolerance)
+
 	out := s.formatActivityInput(input)
-	for range 3 {
+	for range s.config.SummarizerMaxAttempts {
 		curr, err := summarizer(ctx, out)
 		if err != nil {
 			return "", fmt.Errorf("summarizer: %w", err)
@@ -100,7 +123,7 @@ func (s *Summarizer) summarizeWithRetry(
 		prevWords := wordCount(out)
 		currWords := wordCount(curr)
 
-		if currWords <= maxWords {
+		if currWords <= allowedWords {
 			return curr, nil
 		}
 
@@ -108,6 +131,7 @@ func (s *Summarizer) summarizeWithRetry(
 			Int("previous_words", prevWords).
 			Int("current_words", currWords).
 			Int("max_words", maxWords).
+			Int("allowed_words", allowedWords).
 			Msg("summary too long, retrying")
 
 		if currWords < prevWords {
@@ -119,46 +143,49 @@ func (s *Summarizer) summarizeWithRetry(
 	return out, nil
 }
 
-func wordCount(s string) int {
-	return len(strings.Split(s, " "))
-}
-
-func (s *Summarizer) generateFullSummary(ctx context.Context, input string) (string, error) {
-	prompt := fmt.Sprintf(`You are a summarizer.
-
-Rules:
-- Be faithful to the input.
-- Do NOT add new information.
-- Use Markdown exactly as shown.
-- Output ONLY the Markdown.
-- Keep it under %d words.
-
-Summarize the input in Markdown using EXACTLY these document sections:
+// cjkCharsPerWord is the approximate number of CJK characters that make up a single (English) word.
+const cjkCharsPerWord = 2
 
-<document>
-### Context
-(1-3 sentences)
-
-### Key Points
-- point 1
-- point 2
-- point 3
+// wordCount estimates the number of words in s.
+// Languages that don't delimit words with spaces (Chinese, Japanese) are measured by the number of characters,
+// otherwise a whole sentence would be counted as a single word.
+func wordCount(s string) int {
+	words := 0
+	cjkChars := 0
+	inWord := false
+
+	for _, r := range s {
+		switch {
+		case isCJK(r):
+			cjkChars++
+			inWord = false
+		case unicode.IsSpace(r):
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+				inWord = true
+			}
+		}
+	}
 
-### Why it matters
-(1-2 sentences)
-</document>
+	return words + (cjkChars+cjkCharsPerWord-1)/cjkCharsPerWord
+}
 
-Input:
-%s
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
 
-Output:
-`, longSummaryMaxWords, input)
+func (s *Summarizer) generateFullSummary(ctx context.Context, input string) (string, error) {
+	prompt, err := renderPrompt(s.prompts.full, promptData{Input: input, MaxWords: longSummaryMaxWords})
+	if err != nil {
+		return "", err
+	}
 
 	out, err := s.model.Call(
 		ctx,
 		prompt,
-		// Note: Fixed temperature of 1 must be applied for gpt-5-mini
-		llms.WithTemperature(1.0),
+		s.config.FullSummaryParams().CallOptions()...,
 	)
 	if err != nil {
 		logGenerateCompletionError(s.logger, err, prompt, out, "Error generating full summary completion")
@@ -176,27 +203,15 @@ func trimMarkdown(s string) string {
 }
 
 func (s *Summarizer) generateShortSummary(ctx context.Context, input string) (string, error) {
-	prompt := fmt.Sprintf(`You are a summarizer.
-
-Write ONE sentence of MAX %d WORDS about the input.
-
-Rules:
-- %d words or fewer.
-- Plain text only.
-- No explanations.
-- If unsure, make it shorter.
-
-Input:
-%s
-
-Output:
-`, shortSummaryMaxWords, shortSummaryMaxWords, input)
+	prompt, err := renderPrompt(s.prompts.short, promptData{Input: input, MaxWords: shortSummaryMaxWords})
+	if err != nil {
+		return "", err
+	}
 
 	out, err := s.model.Call(
 		ctx,
 		prompt,
-		// Note: Fixed temperature of 1 must be applied for gpt-5-mini
-		llms.WithTemperature(0.0),
+		s.config.ShortSummaryParams().CallOptions()...,
 	)
 	if err != nil {
 		logGenerateCompletionError(s.logger, err, prompt, out, "Error generating short summary completion")
@@ -216,9 +231,22 @@ func (s *Summarizer) formatActivityInput(input summarizeActivityInput) string {
 }
 
 func (s *Summarizer) activityToInput(activity types.Activity) summarizeActivityInput {
+	body := activity.Body()
+
+	// Only the body is truncated, since the title is usually short and carries the most signal.
+	maxChars := s.config.SummarizerMaxInputChars
+	if bodyRunes := []rune(body); maxChars > 0 && len(bodyRunes) > maxChars {
+		s.logger.Debug().
+			Str("activity_id", activity.UID().String()).
+			Int("body_chars", len(bodyRunes)).
+			Int("max_chars", maxChars).
+			Msg("Truncating activity body before summarization")
+		body = string(bodyRunes[:maxChars])
+	}
+
 	return summarizeActivityInput{
 		Title: activity.Title(),
-		Body:  activity.Body(),
+		Body:  body,
 		URL:   activity.URL(),
 	}
 }
@@ -233,7 +261,10 @@ type topicSummaryActivitiesInput struct {
 	Activities []topicSummaryActivityInput `json:"activities"`
 }
 
-func (s *Summarizer) SummarizeTopic(ctx context.Context, topic *TopicQueryGroup, activities []*types.DecoratedActivity) (string, error) {
+func (s *Summarizer) SummarizeTopic(ctx context.Context, topic *TopicQueryGroup, activities []*types.DecoratedActivity) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "nlp.SummarizeTopic")
+	defer tracing.End(span, &err)
+
 	if len(activities) == 0 {
 		return "", nil
 	}
@@ -252,25 +283,15 @@ func (s *Summarizer) SummarizeTopic(ctx context.Context, topic *TopicQueryGroup,
 		return "", fmt.Errorf("marshal activities: %w", err)
 	}
 
-	prompt := fmt.Sprintf(`You are an expert at analyzing and summarizing online activity information. 
-Given a list of activities, generate the summary of key insights that are relevant for the given topic.
-
-Guidelines:
-1. Summaries should be 1-3 sentences that capture the main high-level themes
-2. Focus on the most important insights that are shared by the activities 
-3. Be direct and informative in your summaries
-4. Output plain text, no Markdown or formatting.
-
-Topic name: %s
-Topic activities: %s
-
-Activity summary:`, topic.Name, string(activitiesJSON))
+	prompt, err := renderPrompt(s.prompts.topic, promptData{Input: string(activitiesJSON), TopicName: topic.Name})
+	if err != nil {
+		return "", err
+	}
 
 	out, err := s.model.Call(
 		ctx,
 		prompt,
-		// Note: Fixed temperature of 1 must be applied for gpt-5-mini
-		llms.WithTemperature(1.0),
+		s.config.TopicSummaryParams().CallOptions()...,
 	)
 	if err != nil {
 		logGenerateCompletionError(s.logger, err, prompt, out, "Error generating topic summary completion")
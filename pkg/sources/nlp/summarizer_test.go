@@ -0,0 +1,160 @@
+package nlp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	llmconfig "github.com/defeedco/defeed/pkg/llms"
+	"github.com/rs/zerolog"
+	"github.com/tmc/langchaingo/llms"
+)
+
+func TestWordCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "empty", input: "", want: 0},
+		{name: "english", input: "The quick brown fox jumps", want: 5},
+		{name: "repeated whitespace", input: "  The quick\n\nbrown   fox ", want: 4},
+		{name: "punctuation", input: "Hello, world! It's v1.2.", want: 4},
+		// 13 characters without spaces would be a single word when splitting on spaces.
+		{name: "chinese", input: "这是一个关于开源软件的摘要", want: 7},
+		{name: "japanese", input: "これは要約です", want: 4},
+		{name: "chinese with punctuation", input: "开源软件。新版本发布！", want: 5},
+		{name: "mixed", input: "Go 语言 released 新版本", want: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wordCount(tt.input); got != tt.want {
+				t.Errorf("wordCount(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeWithRetry(t *testing.T) {
+	logger := zerolog.Nop()
+
+	tests := []struct {
+		name         string
+		outputs      []string
+		maxWords     int
+		maxAttempts  int
+		tolerance    float64
+		want         string
+		wantAttempts int
+	}{
+		{
+			name:         "cjk summary within limit",
+			outputs:      []string{strings.Repeat("开源", 10)},
+			maxWords:     20,
+			maxAttempts:  3,
+			want:         strings.Repeat("开源", 10),
+			wantAttempts: 1,
+		},
+		{
+			name:         "cjk summary over limit is retried",
+			outputs:      []string{strings.Repeat("开源", 30), strings.Repeat("开源", 15)},
+			maxWords:     20,
+			maxAttempts:  3,
+			want:         strings.Repeat("开源", 15),
+			wantAttempts: 2,
+		},
+		{
+			name:         "within tolerance",
+			outputs:      []string{strings.Repeat("word ", 22)},
+			maxWords:     20,
+			maxAttempts:  3,
+			tolerance:    0.1,
+			want:         strings.Repeat("word ", 22),
+			wantAttempts: 1,
+		},
+		{
+			name:         "over tolerance",
+			outputs:      []string{strings.Repeat("word ", 23), strings.Repeat("word ", 10)},
+			maxWords:     20,
+			maxAttempts:  3,
+			tolerance:    0.1,
+			want:         strings.Repeat("word ", 10),
+			wantAttempts: 2,
+		},
+		{
+			name:         "stops after max attempts",
+			outputs:      []string{strings.Repeat("word ", 30), strings.Repeat("word ", 29), strings.Repeat("word ", 28)},
+			maxWords:     20,
+			maxAttempts:  2,
+			want:         strings.Repeat("word ", 29),
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summarizer{
+				config: &llmconfig.Config{
+					SummarizerMaxAttempts:   tt.maxAttempts,
+					SummarizerWordTolerance: tt.tolerance,
+				},
+				logger: &logger,
+			}
+
+			attempts := 0
+			summarizer := func(_ context.Context, _ string) (string, error) {
+				out := tt.outputs[min(attempts, len(tt.outputs)-1)]
+				attempts++
+				return out, nil
+			}
+
+			input := summarizeActivityInput{Title: "Title", Body: strings.Repeat("body ", 100)}
+			got, err := s.summarizeWithRetry(context.Background(), input, summarizer, tt.maxWords)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+// optionsModel records the call options of the last completion.
+type optionsModel struct {
+	options llms.CallOptions
+}
+
+func (m *optionsModel) Call(_ context.Context, _ string, options ...llms.CallOption) (string, error) {
+	m.options = llms.CallOptions{}
+	for _, opt := range options {
+		opt(&m.options)
+	}
+	return "summary", nil
+}
+
+func TestGenerateShortSummary_Params(t *testing.T) {
+	logger := zerolog.Nop()
+	config := &llmconfig.Config{
+		SummarizerShortTemperature: 0.3,
+		SummarizerShortTopP:        0.9,
+		SummarizerShortMaxTokens:   100,
+	}
+	model := &optionsModel{}
+	s, err := NewSummarizer(model, config, &logger)
+	if err != nil {
+		t.Fatalf("create summarizer: %v", err)
+	}
+
+	if _, err := s.generateShortSummary(context.Background(), "input"); err != nil {
+		t.Fatalf("generate short summary: %v", err)
+	}
+	if model.options.Temperature != 0.3 || model.options.TopP != 0.9 || model.options.MaxTokens != 100 {
+		t.Errorf("expected configured params, got temperature %g, top-p %g, max tokens %d",
+			model.options.Temperature, model.options.TopP, model.options.MaxTokens)
+	}
+}
@@ -0,0 +1,38 @@
+package custom
+
+import (
+	"context"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+// Fetcher resolves the custom sources. Any ID is a valid custom source of the user,
+// which receives the activities pushed with its UID.
+type Fetcher struct {
+	Logger *zerolog.Logger
+}
+
+func NewFetcher(logger *zerolog.Logger) *Fetcher {
+	return &Fetcher{
+		Logger: logger,
+	}
+}
+
+func (f *Fetcher) SourceType() string {
+	return TypeCustom
+}
+
+func (f *Fetcher) FindByID(ctx context.Context, id activitytypes.TypedUID, config *types.ProviderConfig) (types.Source, error) {
+	ownerID, sourceID, err := parseSourceUID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &SourceCustom{OwnerID: ownerID, ID: sourceID}, nil
+}
+
+func (f *Fetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
+	// Custom sources are private to the systems pushing the activities, so they aren't discoverable.
+	return nil, nil
+}
@@ -0,0 +1,263 @@
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+const TypeCustom = "custom"
+
+// ErrNotSourceOwner is used when the user pushes to (or subscribes to) a custom source of another user.
+var ErrNotSourceOwner = errors.New("custom source belongs to another user")
+
+// SourceCustom is a push-only source for activities ingested by external systems (e.g. an internal event bus),
+// that can't be polled by defeed.
+// The sources are scoped to the user, so that other users can't push to (or read) them.
+type SourceCustom struct {
+	OwnerID string `json:"owner_id" validate:"required"`
+	ID      string `json:"id" validate:"required"`
+	logger  *zerolog.Logger
+}
+
+func NewSourceCustom() *SourceCustom {
+	return &SourceCustom{}
+}
+
+// NewSourceUID returns the UID of the user's custom source (e.g. custom:user_123:deployments).
+func NewSourceUID(ownerID, id string) activitytypes.TypedUID {
+	return lib.NewTypedUID(TypeCustom, ownerID, id)
+}
+
+// parseSourceUID returns the owner and the ID of the custom source UID.
+func parseSourceUID(uid activitytypes.TypedUID) (ownerID, id string, err error) {
+	rest, ok := strings.CutPrefix(uid.String(), TypeCustom+":")
+	if !ok {
+		return "", "", fmt.Errorf("source %s is not a %s source", uid, TypeCustom)
+	}
+	ownerID, id, ok = strings.Cut(rest, ":")
+	if !ok || ownerID == "" || id == "" {
+		return "", "", fmt.Errorf("invalid custom source UID %s, expected %s:<user id>:<id>", uid, TypeCustom)
+	}
+	return ownerID, id, nil
+}
+
+// ValidateOwner returns ErrNotSourceOwner if the source is a custom source of another user.
+// The other source types are shared by all users.
+func ValidateOwner(uid activitytypes.TypedUID, userID string) error {
+	if uid.Type() != TypeCustom {
+		return nil
+	}
+	ownerID, _, err := parseSourceUID(uid)
+	if err != nil {
+		return err
+	}
+	if ownerID != userID {
+		return fmt.Errorf("%w: %s", ErrNotSourceOwner, uid)
+	}
+	return nil
+}
+
+func (s *SourceCustom) UID() activitytypes.TypedUID {
+	return NewSourceUID(s.OwnerID, s.ID)
+}
+
+func (s *SourceCustom) Name() string {
+	return s.ID
+}
+
+func (s *SourceCustom) Description() string {
+	return fmt.Sprintf("Activities pushed to the %s custom source", s.ID)
+}
+
+func (s *SourceCustom) URL() string {
+	return ""
+}
+
+func (s *SourceCustom) Icon() string {
+	return ""
+}
+
+func (s *SourceCustom) Topics() []sourcetypes.TopicTag {
+	return []sourcetypes.TopicTag{}
+}
+
+func (s *SourceCustom) Initialize(logger *zerolog.Logger, config *sourcetypes.ProviderConfig) error {
+	if err := lib.ValidateStruct(s); err != nil {
+		return err
+	}
+
+	s.logger = logger
+
+	return nil
+}
+
+// Stream doesn't fetch anything, since the activities are pushed via the ingest API.
+func (s *SourceCustom) Stream(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+}
+
+func (s *SourceCustom) MarshalJSON() ([]byte, error) {
+	type Alias SourceCustom
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  TypeCustom,
+	})
+}
+
+func (s *SourceCustom) UnmarshalJSON(data []byte) error {
+	type Alias SourceCustom
+	aux := &struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Payload is the activity data pushed by the external system.
+type Payload struct {
+	// ID is unique within the source. It's used to deduplicate the pushed activities.
+	ID        string    `json:"id" validate:"required"`
+	Title     string    `json:"title" validate:"required"`
+	Body      string    `json:"body"`
+	URL       string    `json:"url" validate:"omitempty,url"`
+	ImageURL  string    `json:"image_url" validate:"omitempty,url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Item is a generic activity pushed to a custom source.
+type Item struct {
+	Payload   *Payload                 `json:"payload"`
+	SourceIDs []activitytypes.TypedUID `json:"source_ids"`
+}
+
+func NewItem() *Item {
+	return &Item{}
+}
+
+// NewItemFromPayload creates a validated activity pushed by the user to their custom source.
+// Returns ErrNotSourceOwner if the source belongs to another user.
+func NewItemFromPayload(userID string, sourceUID activitytypes.TypedUID, payload *Payload) (*Item, error) {
+	if sourceUID.Type() != TypeCustom {
+		return nil, fmt.Errorf("source %s is not a %s source", sourceUID, TypeCustom)
+	}
+	if err := ValidateOwner(sourceUID, userID); err != nil {
+		return nil, err
+	}
+	if err := lib.ValidateStruct(payload); err != nil {
+		return nil, err
+	}
+	if payload.CreatedAt.IsZero() {
+		payload.CreatedAt = time.Now()
+	}
+
+	return &Item{
+		Payload:   payload,
+		SourceIDs: []activitytypes.TypedUID{sourceUID},
+	}, nil
+}
+
+func (i *Item) MarshalJSON() ([]byte, error) {
+	type Alias Item
+	return json.Marshal(&struct {
+		*Alias
+	}{
+		Alias: (*Alias)(i),
+	})
+}
+
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type Alias Item
+	aux := &struct {
+		*Alias
+		SourceIDs []*lib.TypedUID `json:"source_ids"`
+	}{
+		Alias: (*Alias)(i),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.SourceIDs) == 0 {
+		return fmt.Errorf("source_ids is required")
+	}
+
+	i.SourceIDs = make([]activitytypes.TypedUID, len(aux.SourceIDs))
+	for j, uid := range aux.SourceIDs {
+		i.SourceIDs[j] = uid
+	}
+
+	return nil
+}
+
+// UID is scoped by the source (including its owner), since the payload IDs are only unique within the source.
+func (i *Item) UID() activitytypes.TypedUID {
+	return lib.NewTypedUID(TypeCustom, i.sourceID(), i.Payload.ID)
+}
+
+func (i *Item) sourceID() string {
+	if len(i.SourceIDs) == 0 {
+		return ""
+	}
+	sourceID, _ := strings.CutPrefix(i.SourceIDs[0].String(), TypeCustom+":")
+	return sourceID
+}
+
+func (i *Item) SourceUIDs() []activitytypes.TypedUID {
+	return i.SourceIDs
+}
+
+func (i *Item) Title() string {
+	return i.Payload.Title
+}
+
+func (i *Item) Body() string {
+	return i.Payload.Body
+}
+
+func (i *Item) URL() string {
+	return i.Payload.URL
+}
+
+func (i *Item) ImageURL() string {
+	return i.Payload.ImageURL
+}
+
+func (i *Item) CreatedAt() time.Time {
+	return i.Payload.CreatedAt
+}
+
+func (i *Item) UpvotesCount() int {
+	return -1
+}
+
+func (i *Item) DownvotesCount() int {
+	return -1
+}
+
+func (i *Item) CommentsCount() int {
+	return -1
+}
+
+func (i *Item) AmplificationCount() int {
+	return -1
+}
+
+func (i *Item) SocialScore() float64 {
+	return -1
+}
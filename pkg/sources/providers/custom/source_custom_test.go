@@ -0,0 +1,105 @@
+package custom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+)
+
+func TestNewItemFromPayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		sourceUID string
+		payload   *Payload
+		wantUID   string
+		wantErr   bool
+	}{
+		{
+			name:      "valid payload",
+			sourceUID: "custom:user_1:deploys",
+			payload:   &Payload{ID: "42", Title: "Deployed v1.2"},
+			wantUID:   "custom:user_1:deploys:42",
+		},
+		{
+			name:      "source of another user",
+			sourceUID: "custom:user_2:deploys",
+			payload:   &Payload{ID: "42", Title: "Deployed v1.2"},
+			wantErr:   true,
+		},
+		{
+			name:      "source without owner",
+			sourceUID: "custom:deploys",
+			payload:   &Payload{ID: "42", Title: "Deployed v1.2"},
+			wantErr:   true,
+		},
+		{
+			name:      "not a custom source",
+			sourceUID: "rssfeed:example.com",
+			payload:   &Payload{ID: "42", Title: "Deployed v1.2"},
+			wantErr:   true,
+		},
+		{
+			name:      "missing title",
+			sourceUID: "custom:user_1:deploys",
+			payload:   &Payload{ID: "42"},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid url",
+			sourceUID: "custom:user_1:deploys",
+			payload:   &Payload{ID: "42", Title: "Deployed v1.2", URL: "not a url"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourceUID, err := lib.NewTypedUIDFromString(tt.sourceUID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			item, err := NewItemFromPayload("user_1", sourceUID, tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewItemFromPayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := item.UID().String(); got != tt.wantUID {
+				t.Errorf("UID() = %q, want %q", got, tt.wantUID)
+			}
+			if item.CreatedAt().IsZero() {
+				t.Error("CreatedAt() should default to the ingestion time")
+			}
+		})
+	}
+}
+
+func TestValidateOwner(t *testing.T) {
+	tests := []struct {
+		name      string
+		sourceUID string
+		wantErr   error
+	}{
+		{name: "own custom source", sourceUID: "custom:user_1:deploys"},
+		{name: "custom source of another user", sourceUID: "custom:user_2:deploys", wantErr: ErrNotSourceOwner},
+		{name: "shared source type", sourceUID: "rssfeed:example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourceUID, err := lib.NewTypedUIDFromString(tt.sourceUID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			err = ValidateOwner(sourceUID, "user_1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateOwner() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
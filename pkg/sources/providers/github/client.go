@@ -0,0 +1,28 @@
+package github
+
+import (
+	"sync"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/google/go-github/v72/github"
+	"github.com/rs/zerolog"
+)
+
+// Provider identifies the GitHub API requests for the rate limiter (see lib.NewProviderHTTPClient).
+const Provider = "github"
+
+var warnUnauthenticatedOnce sync.Once
+
+// newClient returns a client authenticated with the token, if set.
+// Unauthenticated clients have much lower rate limits (60 requests/hour, 10 search requests/minute),
+// so a warning is logged once to explain the poll errors.
+func newClient(token string, logger *zerolog.Logger) *github.Client {
+	client := github.NewClient(lib.NewProviderHTTPClient(Provider, 0))
+	if token == "" {
+		warnUnauthenticatedOnce.Do(func() {
+			logger.Warn().Msg("GITHUB_API_KEY is not set, GitHub sources are limited to the unauthenticated rate limits")
+		})
+		return client
+	}
+	return client.WithAuthToken(token)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/defeedco/defeed/pkg/lib"
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 
 	"github.com/defeedco/defeed/pkg/sources/types"
@@ -30,9 +31,9 @@ func (f *IssuesFetcher) SourceType() string {
 func (f *IssuesFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID, config *types.ProviderConfig) (types.Source, error) {
 	var client *github.Client
 	if config.GithubAPIKey != "" {
-		client = github.NewClient(nil).WithAuthToken(config.GithubAPIKey)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0)).WithAuthToken(config.GithubAPIKey)
 	} else {
-		client = github.NewClient(nil)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0))
 	}
 
 	ghUID, ok := id.(*TypedUID)
@@ -45,18 +46,27 @@ func (f *IssuesFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID,
 		return nil, fmt.Errorf("get repository: %w", err)
 	}
 
-	return &SourceIssues{
-		Owner: *repo.Owner.Login,
-		Repo:  *repo.Name,
-	}, nil
+	source := NewIssuesSource()
+	source.Owner = *repo.Owner.Login
+	source.Repo = *repo.Name
+	if err := source.applyFilter(ghUID.Filter); err != nil {
+		return nil, fmt.Errorf("apply filter: %w", err)
+	}
+
+	return source, nil
 }
 
-func (f *IssuesFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *IssuesFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
+	// All repository sources have the same topics, so skip the API call if they won't match anyway.
+	if len(types.FilterByTopics([]types.Source{&SourceIssues{}}, topicsHint)) == 0 {
+		return []types.Source{}, nil
+	}
+
 	var client *github.Client
 	if config.GithubAPIKey != "" {
-		client = github.NewClient(nil).WithAuthToken(config.GithubAPIKey)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0)).WithAuthToken(config.GithubAPIKey)
 	} else {
-		client = github.NewClient(nil)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0))
 	}
 
 	var searchQuery string
@@ -81,10 +91,9 @@ func (f *IssuesFetcher) Search(ctx context.Context, query string, config *types.
 			continue
 		}
 
-		source := &SourceIssues{
-			Owner: *repo.Owner.Login,
-			Repo:  *repo.Name,
-		}
+		source := NewIssuesSource()
+		source.Owner = *repo.Owner.Login
+		source.Repo = *repo.Name
 		sources = append(sources, source)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/defeedco/defeed/pkg/lib"
 	types2 "github.com/defeedco/defeed/pkg/sources/activities/types"
 
 	"github.com/defeedco/defeed/pkg/sources/types"
@@ -30,9 +31,9 @@ func (f *ReleasesFetcher) SourceType() string {
 func (f *ReleasesFetcher) FindByID(ctx context.Context, id types2.TypedUID, config *types.ProviderConfig) (types.Source, error) {
 	var client *github.Client
 	if config.GithubAPIKey != "" {
-		client = github.NewClient(nil).WithAuthToken(config.GithubAPIKey)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0)).WithAuthToken(config.GithubAPIKey)
 	} else {
-		client = github.NewClient(nil)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0))
 	}
 
 	ghUID, ok := id.(*TypedUID)
@@ -52,13 +53,18 @@ func (f *ReleasesFetcher) FindByID(ctx context.Context, id types2.TypedUID, conf
 	}, nil
 }
 
-func (f *ReleasesFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *ReleasesFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
+	// All repository sources have the same topics, so skip the API call if they won't match anyway.
+	if len(types.FilterByTopics([]types.Source{&SourceRelease{}}, topicsHint)) == 0 {
+		return []types.Source{}, nil
+	}
+
 	token := config.GithubAPIKey
 	var client *github.Client
 	if token != "" {
-		client = github.NewClient(nil).WithAuthToken(token)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0)).WithAuthToken(token)
 	} else {
-		client = github.NewClient(nil)
+		client = github.NewClient(lib.NewProviderHTTPClient(Provider, 0))
 	}
 
 	var searchQuery string
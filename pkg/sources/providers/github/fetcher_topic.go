@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 
@@ -34,12 +35,18 @@ func (f *TopicFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID,
 	}
 
 	// See: SourceTopic.UID
-	return &SourceTopic{
+	source := &SourceTopic{
 		Topic: typedUID.Identifiers[0],
-	}, nil
+	}
+	if len(typedUID.Identifiers) > 1 {
+		if err := source.applyOptions(typedUID.Identifiers[1]); err != nil {
+			return nil, err
+		}
+	}
+	return source, nil
 }
 
-func (f *TopicFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *TopicFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	query = strings.TrimSpace(query)
 	if query == "" {
 		// Cannot enumerate all topics; return empty
@@ -99,7 +106,7 @@ func (f *TopicFetcher) searchTopics(ctx context.Context, query string, config *t
 		Items      []topicItem `json:"items"`
 	}
 
-	resp, err := lib.DecodeJSONFromRequest[topicResponse](lib.DefaultHTTPClient, req)
+	resp, err := lib.DecodeJSONFromRequest[topicResponse](lib.NewProviderHTTPClient(Provider, 5*time.Second), req)
 	if err != nil {
 		return nil, err
 	}
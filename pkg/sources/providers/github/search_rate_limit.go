@@ -0,0 +1,82 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v72/github"
+)
+
+// sharedSearchRateLimit is the search quota shared by all topic sources, since the GitHub limits apply per token.
+var sharedSearchRateLimit = &searchRateLimit{}
+
+// searchRateLimit tracks until when the GitHub search quota is exhausted,
+// so that the sources don't keep calling the API (and erroring) until the quota resets.
+type searchRateLimit struct {
+	mu      sync.Mutex
+	resetAt time.Time
+}
+
+// update records the quota from the response rate limit headers (X-RateLimit-Remaining and X-RateLimit-Reset).
+func (l *searchRateLimit) update(rate github.Rate) {
+	if rate.Remaining > 0 || rate.Reset.IsZero() {
+		return
+	}
+	l.block(rate.Reset.Time)
+}
+
+// updateFromError records the quota from the rate limit errors. Returns false if the error isn't rate limit related.
+func (l *searchRateLimit) updateFromError(err error) bool {
+	var rateLimitErr *github.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		l.block(rateLimitErr.Rate.Reset.Time)
+		return true
+	}
+
+	// Secondary rate limits (e.g. too many concurrent requests) come with a Retry-After header.
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		retryAfter := time.Minute
+		if abuseErr.RetryAfter != nil {
+			retryAfter = *abuseErr.RetryAfter
+		}
+		l.block(time.Now().Add(retryAfter))
+		return true
+	}
+
+	return false
+}
+
+func (l *searchRateLimit) block(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.resetAt) {
+		l.resetAt = until
+	}
+}
+
+// wait blocks until the quota resets, if it's within maxWait.
+// Returns false if the quota resets later, and the search should be aborted.
+func (l *searchRateLimit) wait(ctx context.Context, maxWait time.Duration) (bool, error) {
+	l.mu.Lock()
+	delay := time.Until(l.resetAt)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return true, nil
+	}
+	if delay > maxWait {
+		return false, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-timer.C:
+		return true, nil
+	}
+}
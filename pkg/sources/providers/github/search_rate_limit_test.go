@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/google/go-github/v72/github"
+	"github.com/rs/zerolog"
+)
+
+func TestSourceTopic_rateLimited(t *testing.T) {
+	reset := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+
+	tests := []struct {
+		name      string
+		handler   http.HandlerFunc
+		wantRepos int
+		wantErr   bool
+		wantCalls int32
+	}{
+		{
+			name: "quota exhausted after the first page",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-RateLimit-Limit", "30")
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", reset)
+				_, _ = fmt.Fprint(w, `{"total_count": 200, "items": [{"name": "repo", "owner": {"login": "owner"}}]}`)
+			},
+			wantRepos: 1,
+			wantCalls: 1,
+		},
+		{
+			name: "quota exhausted before the first page",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-RateLimit-Limit", "30")
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", reset)
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = fmt.Fprint(w, `{"message": "API rate limit exceeded"}`)
+			},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				tt.handler(w, r)
+			}))
+			defer server.Close()
+
+			client := github.NewClient(nil)
+			client.BaseURL, _ = url.Parse(server.URL + "/")
+
+			logger := zerolog.Nop()
+			source := &SourceTopic{
+				Topic:       "golang",
+				PageLimit:   3,
+				client:      client,
+				logger:      &logger,
+				rateLimit:   &searchRateLimit{},
+				maxRateWait: time.Second,
+			}
+
+			feed := make(chan activitytypes.Activity, 10)
+			errs := make(chan error, 10)
+			source.fetchTopicRepositories(context.Background(), nil, feed, errs)
+			close(feed)
+			close(errs)
+
+			if len(feed) != tt.wantRepos {
+				t.Errorf("expected %d repositories, got %d", tt.wantRepos, len(feed))
+			}
+			if gotErr := len(errs) > 0; gotErr != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, <-errs)
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("expected %d requests, got %d", tt.wantCalls, calls.Load())
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/defeedco/defeed/pkg/lib"
@@ -16,31 +19,118 @@ import (
 
 const TypeGithubIssues = "githubissues"
 
+const (
+	issueStateAll    = "all"
+	issueStateOpen   = "open"
+	issueStateClosed = "closed"
+)
+
 type SourceIssues struct {
-	Owner  string `json:"owner" validate:"required"`
-	Repo   string `json:"repo" validate:"required"`
-	client *github.Client
-	logger *zerolog.Logger
+	Owner string `json:"owner" validate:"required"`
+	Repo  string `json:"repo" validate:"required"`
+	// Labels only includes issues with all the given labels.
+	Labels []string `json:"labels"`
+	// State is one of "open", "closed" or "all" (default).
+	State string `json:"state" validate:"omitempty,oneof=open closed all"`
+	// IncludePRs also includes pull requests, since GitHub treats them as issues.
+	IncludePRs      bool `json:"includePRs"`
+	client          *github.Client
+	logger          *zerolog.Logger
+	initialBackfill time.Duration
 }
 
 func NewIssuesSource() *SourceIssues {
-	return &SourceIssues{}
+	return &SourceIssues{
+		State:      issueStateAll,
+		IncludePRs: true,
+	}
 }
 
 func (s *SourceIssues) UID() activitytypes.TypedUID {
 	return &TypedUID{
-		Typ:   TypeGithubIssues,
-		Owner: s.Owner,
-		Repo:  s.Repo,
+		Typ:    TypeGithubIssues,
+		Owner:  s.Owner,
+		Repo:   s.Repo,
+		Filter: s.filter(),
+	}
+}
+
+// filter encodes the non-default filters, so that the UID of unfiltered sources stays the same.
+func (s *SourceIssues) filter() string {
+	values := url.Values{}
+	if len(s.Labels) > 0 {
+		labels := slices.Clone(s.Labels)
+		slices.Sort(labels)
+		values.Set("labels", strings.Join(labels, ","))
+	}
+	if s.state() != issueStateAll {
+		values.Set("state", s.state())
+	}
+	if !s.IncludePRs {
+		values.Set("prs", "false")
+	}
+	return values.Encode()
+}
+
+// applyFilter is the inverse of filter.
+func (s *SourceIssues) applyFilter(filter string) error {
+	values, err := url.ParseQuery(filter)
+	if err != nil {
+		return fmt.Errorf("parse filter: %w", err)
+	}
+
+	s.Labels = nil
+	if labels := values.Get("labels"); labels != "" {
+		s.Labels = strings.Split(labels, ",")
+	}
+	s.State = issueStateAll
+	if state := values.Get("state"); state != "" {
+		s.State = state
+	}
+	s.IncludePRs = values.Get("prs") != "false"
+
+	return nil
+}
+
+func (s *SourceIssues) state() string {
+	if s.State == "" {
+		return issueStateAll
 	}
+	return s.State
 }
 
 func (s *SourceIssues) Name() string {
-	return fmt.Sprintf("Issues on %s/%s", s.Owner, s.Repo)
+	var kind string
+	switch s.state() {
+	case issueStateOpen:
+		kind = "Open issues"
+	case issueStateClosed:
+		kind = "Closed issues"
+	default:
+		kind = "Issues"
+	}
+	if s.IncludePRs {
+		kind += " & PRs"
+	}
+
+	if len(s.Labels) > 0 {
+		return fmt.Sprintf("%s labeled %s on %s/%s", kind, strings.Join(s.Labels, ", "), s.Owner, s.Repo)
+	}
+	return fmt.Sprintf("%s on %s/%s", kind, s.Owner, s.Repo)
 }
 
 func (s *SourceIssues) Description() string {
-	return fmt.Sprintf("Recent issue activity from %s/%s", s.Owner, s.Repo)
+	description := fmt.Sprintf("Recent issue activity from %s/%s", s.Owner, s.Repo)
+	if s.state() != issueStateAll {
+		description += fmt.Sprintf(", %s only", s.state())
+	}
+	if len(s.Labels) > 0 {
+		description += fmt.Sprintf(", labeled %s", strings.Join(s.Labels, ", "))
+	}
+	if !s.IncludePRs {
+		description += ", excluding pull requests"
+	}
+	return description
 }
 
 func (s *SourceIssues) URL() string {
@@ -197,13 +287,10 @@ func (s *SourceIssues) Initialize(logger *zerolog.Logger, config *sourcetypes.Pr
 		return err
 	}
 
-	if config.GithubAPIKey != "" {
-		s.client = github.NewClient(nil).WithAuthToken(config.GithubAPIKey)
-	} else {
-		s.client = github.NewClient(nil)
-	}
+	s.client = newClient(config.GithubAPIKey, logger)
 
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -213,14 +300,11 @@ func (s *SourceIssues) Stream(ctx context.Context, since activitytypes.Activity,
 }
 
 func (s *SourceIssues) fetchIssueActivities(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
-	var sinceTime time.Time
-	if since != nil {
-		sinceTime = since.CreatedAt()
-	}
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
 
-	// TODO: When since is non-empty, it always fetches the one last issue we've already seen
 	issues, _, err := s.client.Issues.ListByRepo(ctx, s.Owner, s.Repo, &github.IssueListByRepoOptions{
-		State:     "all",
+		State:     s.state(),
+		Labels:    s.Labels,
 		Sort:      "updated",
 		Direction: "desc",
 		Since:     sinceTime,
@@ -237,6 +321,14 @@ func (s *SourceIssues) fetchIssueActivities(ctx context.Context, since activityt
 		Msg("Fetched issues")
 
 	for _, issue := range issues {
+		if !s.IncludePRs && issue.IsPullRequest() {
+			continue
+		}
+		// GitHub's since filter is inclusive, so the last seen issue would be returned again.
+		if !issue.GetUpdatedAt().After(sinceTime) {
+			continue
+		}
+
 		activity := &Issue{
 			Issue:     issue,
 			SourceIDs: []*TypedUID{s.UID().(*TypedUID)},
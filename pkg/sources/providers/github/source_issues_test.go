@@ -0,0 +1,67 @@
+package github
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSourceIssues_UID(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *SourceIssues
+		want   string
+	}{
+		{
+			name:   "defaults keep the unfiltered UID",
+			source: &SourceIssues{Owner: "golang", Repo: "go", State: "all", IncludePRs: true},
+			want:   "githubissues:golang:go",
+		},
+		{
+			name:   "labels are sorted",
+			source: &SourceIssues{Owner: "golang", Repo: "go", Labels: []string{"help wanted", "bug"}, IncludePRs: true},
+			want:   "githubissues:golang:go:labels=bug%2Chelp+wanted",
+		},
+		{
+			name:   "state and prs",
+			source: &SourceIssues{Owner: "golang", Repo: "go", State: "open", IncludePRs: false},
+			want:   "githubissues:golang:go:prs=false&state=open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.source.UID().String(); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSourceIssues_applyFilter(t *testing.T) {
+	source := &SourceIssues{Owner: "golang", Repo: "go", Labels: []string{"area/api", "bug"}, State: "closed"}
+
+	uid, err := NewTypedUIDFromString(source.UID().String())
+	if err != nil {
+		t.Fatalf("parse UID: %v", err)
+	}
+
+	parsed := NewIssuesSource()
+	parsed.Owner = "golang"
+	parsed.Repo = "go"
+	if err := parsed.applyFilter(uid.(*TypedUID).Filter); err != nil {
+		t.Fatalf("apply filter: %v", err)
+	}
+
+	if !slices.Equal(parsed.Labels, source.Labels) {
+		t.Errorf("expected labels %v, got %v", source.Labels, parsed.Labels)
+	}
+	if parsed.State != source.State {
+		t.Errorf("expected state %s, got %s", source.State, parsed.State)
+	}
+	if parsed.IncludePRs != source.IncludePRs {
+		t.Errorf("expected includePRs %v, got %v", source.IncludePRs, parsed.IncludePRs)
+	}
+	if parsed.UID().String() != source.UID().String() {
+		t.Errorf("expected UID %s, got %s", source.UID(), parsed.UID())
+	}
+}
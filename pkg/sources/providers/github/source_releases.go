@@ -18,10 +18,11 @@ const TypeGithubReleases = "githubreleases"
 type SourceRelease struct {
 	Owner            string `json:"owner" validate:"required"`
 	Repo             string `json:"repo" validate:"required"`
-	Token            string `json:"token"`
+	Token            string `json:"token" secret:"true"`
 	IncludePreleases bool   `json:"includePrereleases"`
 	client           *github.Client
 	logger           *zerolog.Logger
+	initialBackfill  time.Duration
 }
 
 func NewReleaseSource() *SourceRelease {
@@ -75,13 +76,10 @@ func (s *SourceRelease) Initialize(logger *zerolog.Logger, config *sourcetypes.P
 		token = config.GithubAPIKey
 	}
 
-	if token != "" {
-		s.client = github.NewClient(nil).WithAuthToken(token)
-	} else {
-		s.client = github.NewClient(nil)
-	}
+	s.client = newClient(token, logger)
 
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -214,10 +212,7 @@ func (r *Release) SocialScore() float64 {
 }
 
 func (s *SourceRelease) fetchGithubReleases(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
-	sinceTime := time.Now().Add(-1 * time.Hour)
-	if since != nil {
-		sinceTime = since.CreatedAt()
-	}
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
 
 	page := 1
 outer:
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,13 +19,32 @@ import (
 
 const TypeGithubTopic = "githubtopic"
 
+const (
+	defaultTopicMinStars  = 200
+	defaultTopicPageLimit = 2
+	defaultTopicSort      = "updated"
+	// maxTrendingStars is set to prevent returning the top starred repos
+	maxTrendingStars = 20000
+	// maxSearchPerPage is the max page size supported by the GitHub search API.
+	maxSearchPerPage = 100
+)
+
 // SourceTopic fetches repositories for a single GitHub topic (tag)
 // It can return either trending repositories (by stars) or newly created repositories.
 type SourceTopic struct {
 	Topic string `json:"topic" validate:"required"`
-
-	client *github.Client
-	logger *zerolog.Logger
+	// MinStars is the min number of stars for a repository to be considered trending.
+	// Niche topics can lower the threshold, while popular topics can raise it.
+	MinStars int `json:"minStars,omitempty" validate:"omitempty,gte=0,lt=20000"`
+	// PageLimit is the max number of search result pages fetched on each poll.
+	PageLimit int `json:"pageLimit,omitempty" validate:"omitempty,gte=1,lte=10"`
+	// Sort is the search results sort field.
+	Sort string `json:"sort,omitempty" validate:"omitempty,oneof=stars created updated"`
+
+	client      *github.Client
+	logger      *zerolog.Logger
+	rateLimit   *searchRateLimit
+	maxRateWait time.Duration
 }
 
 func (s *SourceTopic) Topics() []types.TopicTag {
@@ -51,9 +72,50 @@ func NewSourceTopic() *SourceTopic {
 }
 
 func (s *SourceTopic) UID() activitytypes.TypedUID {
+	if options := s.options(); options != "" {
+		return lib.NewTypedUID(TypeGithubTopic, s.Topic, options)
+	}
 	return lib.NewTypedUID(TypeGithubTopic, s.Topic)
 }
 
+// options encodes the non-default search settings, so that the UID of the sources with default settings stays the same.
+func (s *SourceTopic) options() string {
+	values := url.Values{}
+	if s.minStars() != defaultTopicMinStars {
+		values.Set("minStars", strconv.Itoa(s.minStars()))
+	}
+	if s.pageLimit() != defaultTopicPageLimit {
+		values.Set("pageLimit", strconv.Itoa(s.pageLimit()))
+	}
+	if s.Sort != "" && s.Sort != defaultTopicSort {
+		values.Set("sort", s.Sort)
+	}
+	return values.Encode()
+}
+
+// applyOptions is the inverse of options.
+func (s *SourceTopic) applyOptions(options string) error {
+	values, err := url.ParseQuery(options)
+	if err != nil {
+		return fmt.Errorf("parse options: %w", err)
+	}
+
+	s.MinStars = 0
+	if minStars := values.Get("minStars"); minStars != "" {
+		if s.MinStars, err = strconv.Atoi(minStars); err != nil {
+			return fmt.Errorf("parse min stars: %w", err)
+		}
+	}
+	s.PageLimit = 0
+	if pageLimit := values.Get("pageLimit"); pageLimit != "" {
+		if s.PageLimit, err = strconv.Atoi(pageLimit); err != nil {
+			return fmt.Errorf("parse page limit: %w", err)
+		}
+	}
+	s.Sort = values.Get("sort")
+	return nil
+}
+
 func (s *SourceTopic) Name() string {
 	return fmt.Sprintf("Topic #%s", s.Topic)
 }
@@ -75,11 +137,9 @@ func (s *SourceTopic) Initialize(logger *zerolog.Logger, config *types.ProviderC
 		return err
 	}
 
-	if config.GithubAPIKey != "" {
-		s.client = github.NewClient(nil).WithAuthToken(config.GithubAPIKey)
-	} else {
-		s.client = github.NewClient(nil)
-	}
+	s.client = newClient(config.GithubAPIKey, logger)
+	s.rateLimit = sharedSearchRateLimit
+	s.maxRateWait = config.GithubSearchMaxWait
 
 	s.logger = logger
 	return nil
@@ -90,14 +150,9 @@ func (s *SourceTopic) Stream(ctx context.Context, since activitytypes.Activity,
 }
 
 func (s *SourceTopic) fetchTopicRepositories(ctx context.Context, _ activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
-	// minTrendingStars could be set based on the popularity of the topic (more popular topics => higher popularity thresholds)
-	minTrendingStars := 200
-	// maxTrendingStars is set to prevent returning the top starred repos
-	maxTrendingStars := 20000
-	perPage := 200
-	pageLimit := 2
 	// Note: Do not filter by creation date, since popular repositories can be arbitrary old, but only recently gain popularity.
-	query := fmt.Sprintf("topic:%s stars:%d..%d", s.Topic, minTrendingStars, maxTrendingStars)
+	query := fmt.Sprintf("topic:%s stars:%d..%d", s.Topic, s.minStars(), maxTrendingStars)
+	pageLimit := s.pageLimit()
 
 	s.logger.Debug().
 		Str("topic", s.Topic).
@@ -105,25 +160,43 @@ func (s *SourceTopic) fetchTopicRepositories(ctx context.Context, _ activitytype
 		Msg("Searching GitHub repositories by topic")
 
 	page := 1
+	fetched := 0
+	retried := false
 	for {
-		// Docs: https://docs.github.com/en/rest/search/search?apiVersion=2022-11-28#search-repositories
-		result, _, err := s.client.Search.Repositories(ctx, query, &github.SearchOptions{
-			ListOptions: github.ListOptions{
-				PerPage: perPage,
-				Page:    page,
-			},
-			Order: "desc",
-			Sort:  "updated",
-		})
+		ok, err := s.rateLimit.wait(ctx, s.maxRateWait)
 		if err != nil {
-			errs <- fmt.Errorf("search repositories: %w", err)
+			errs <- fmt.Errorf("wait for search rate limit: %w", err)
+			return
+		}
+		if !ok {
+			s.abortRateLimited(fetched, page, errs)
 			return
 		}
 
+		// Docs: https://docs.github.com/en/rest/search/search?apiVersion=2022-11-28#search-repositories
+		result, resp, err := s.client.Search.Repositories(ctx, query, s.searchOptions(page))
+		if err != nil {
+			if !s.rateLimit.updateFromError(err) {
+				errs <- fmt.Errorf("search repositories: %w", err)
+				return
+			}
+			if retried {
+				s.abortRateLimited(fetched, page, errs)
+				return
+			}
+			// Retry the page once the quota resets, if it's soon enough (see the wait above).
+			retried = true
+			continue
+		}
+		retried = false
+		s.rateLimit.update(resp.Rate)
+
 		s.logger.Debug().
 			Int("count", len(result.Repositories)).
 			Int("page", page).
 			Str("query", query).
+			Int("rate_limit_remaining", resp.Rate.Remaining).
+			Time("rate_limit_reset", resp.Rate.Reset.Time).
 			Msg("Fetched repositories for topic")
 
 		for _, repo := range result.Repositories {
@@ -136,6 +209,7 @@ func (s *SourceTopic) fetchTopicRepositories(ctx context.Context, _ activitytype
 				SourceIDs:  []activitytypes.TypedUID{s.UID()},
 			}
 			feed <- activity
+			fetched++
 		}
 
 		if len(result.Repositories) == 0 || page >= pageLimit {
@@ -146,6 +220,50 @@ func (s *SourceTopic) fetchTopicRepositories(ctx context.Context, _ activitytype
 	}
 }
 
+// abortRateLimited stops the poll once the search quota is exhausted.
+// The repositories fetched so far are kept, and the poll only errors if nothing was fetched.
+func (s *SourceTopic) abortRateLimited(fetched int, page int, errs chan<- error) {
+	if fetched == 0 {
+		errs <- fmt.Errorf("search repositories: rate limit exceeded")
+		return
+	}
+	s.logger.Warn().
+		Str("topic", s.Topic).
+		Int("page", page).
+		Int("fetched", fetched).
+		Msg("GitHub search rate limit exceeded, stopping the poll early")
+}
+
+func (s *SourceTopic) minStars() int {
+	if s.MinStars > 0 {
+		return s.MinStars
+	}
+	return defaultTopicMinStars
+}
+
+func (s *SourceTopic) pageLimit() int {
+	if s.PageLimit > 0 {
+		return s.PageLimit
+	}
+	return defaultTopicPageLimit
+}
+
+func (s *SourceTopic) searchOptions(page int) *github.SearchOptions {
+	sort := s.Sort
+	if sort == "" {
+		sort = defaultTopicSort
+	}
+
+	return &github.SearchOptions{
+		ListOptions: github.ListOptions{
+			PerPage: maxSearchPerPage,
+			Page:    page,
+		},
+		Order: "desc",
+		Sort:  sort,
+	}
+}
+
 func (s *SourceTopic) MarshalJSON() ([]byte, error) {
 	type Alias SourceTopic
 	return json.Marshal(&struct {
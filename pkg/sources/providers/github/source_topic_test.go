@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+)
+
+func TestSourceTopic_searchOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       *SourceTopic
+		expectedSort string
+	}{
+		{
+			name:         "defaults",
+			source:       &SourceTopic{Topic: "golang"},
+			expectedSort: "updated",
+		},
+		{
+			name:         "custom sort",
+			source:       &SourceTopic{Topic: "golang", Sort: "stars"},
+			expectedSort: "stars",
+		},
+		{
+			name:         "max page limit",
+			source:       &SourceTopic{Topic: "golang", PageLimit: 10, Sort: "created"},
+			expectedSort: "created",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for page := 1; page <= tt.source.pageLimit(); page++ {
+				opts := tt.source.searchOptions(page)
+
+				if opts.PerPage > 100 {
+					t.Errorf("searchOptions(%d).PerPage = %d, should not exceed 100", page, opts.PerPage)
+				}
+				if opts.Page != page {
+					t.Errorf("searchOptions(%d).Page = %d, want %d", page, opts.Page, page)
+				}
+				if opts.Sort != tt.expectedSort {
+					t.Errorf("searchOptions(%d).Sort = %s, want %s", page, opts.Sort, tt.expectedSort)
+				}
+			}
+		})
+	}
+}
+
+func TestSourceTopic_validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  *SourceTopic
+		wantErr bool
+	}{
+		{
+			name:    "defaults",
+			source:  &SourceTopic{Topic: "golang"},
+			wantErr: false,
+		},
+		{
+			name:    "valid custom fields",
+			source:  &SourceTopic{Topic: "golang", MinStars: 50, PageLimit: 5, Sort: "created"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid sort",
+			source:  &SourceTopic{Topic: "golang", Sort: "forks"},
+			wantErr: true,
+		},
+		{
+			name:    "page limit too high",
+			source:  &SourceTopic{Topic: "golang", PageLimit: 11},
+			wantErr: true,
+		},
+		{
+			name:    "negative min stars",
+			source:  &SourceTopic{Topic: "golang", MinStars: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := lib.ValidateStruct(tt.source)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSourceTopic_UID(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *SourceTopic
+		want   string
+	}{
+		{
+			name:   "defaults keep the topic UID",
+			source: &SourceTopic{Topic: "golang"},
+			want:   "githubtopic:golang",
+		},
+		{
+			name:   "explicit defaults keep the topic UID",
+			source: &SourceTopic{Topic: "golang", MinStars: defaultTopicMinStars, PageLimit: defaultTopicPageLimit, Sort: defaultTopicSort},
+			want:   "githubtopic:golang",
+		},
+		{
+			name:   "custom settings",
+			source: &SourceTopic{Topic: "golang", MinStars: 50, PageLimit: 5, Sort: "stars"},
+			want:   "githubtopic:golang:minStars=50&pageLimit=5&sort=stars",
+		},
+	}
+
+	fetcher := NewTopicFetcher(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid := tt.source.UID()
+			if uid.String() != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, uid)
+			}
+
+			parsed, err := lib.NewTypedUIDFromString(uid.String())
+			if err != nil {
+				t.Fatalf("parse UID: %v", err)
+			}
+			found, err := fetcher.FindByID(context.Background(), parsed, nil)
+			if err != nil {
+				t.Fatalf("find by ID: %v", err)
+			}
+			if found.UID().String() != tt.want {
+				t.Errorf("expected the found source UID %s, got %s", tt.want, found.UID())
+			}
+		})
+	}
+}
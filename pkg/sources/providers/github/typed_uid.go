@@ -14,6 +14,8 @@ type TypedUID struct {
 	Typ   string
 	Owner string
 	Repo  string
+	// Filter is an optional source-specific filter (e.g. issue labels), omitted from the UID if empty.
+	Filter string
 }
 
 func (s TypedUID) Type() string {
@@ -38,17 +40,24 @@ func (s *TypedUID) UnmarshalJSON(data []byte) error {
 }
 
 func (s TypedUID) String() string {
+	if s.Filter != "" {
+		return fmt.Sprintf("%s:%s:%s:%s", s.Typ, s.Owner, s.Repo, s.Filter)
+	}
 	return fmt.Sprintf("%s:%s:%s", s.Typ, s.Owner, s.Repo)
 }
 
 func NewTypedUIDFromString(s string) (types.TypedUID, error) {
-	parts := strings.SplitN(s, ":", 3)
-	if len(parts) != 3 {
+	parts := strings.SplitN(s, ":", 4)
+	if len(parts) < 3 {
 		return nil, fmt.Errorf("invalid GitHub typed UID: %s", s)
 	}
-	return &TypedUID{
+	uid := &TypedUID{
 		Typ:   parts[0],
 		Owner: parts[1],
 		Repo:  parts[2],
-	}, nil
+	}
+	if len(parts) == 4 {
+		uid.Filter = parts[3]
+	}
+	return uid, nil
 }
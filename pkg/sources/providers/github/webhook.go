@@ -0,0 +1,178 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/google/go-github/v72/github"
+	"github.com/rs/zerolog"
+)
+
+// ReleasesWebhookParser parses the "release" webhook events of the release sources.
+type ReleasesWebhookParser struct {
+	Logger *zerolog.Logger
+}
+
+func NewReleasesWebhookParser(logger *zerolog.Logger) *ReleasesWebhookParser {
+	return &ReleasesWebhookParser{
+		Logger: logger,
+	}
+}
+
+func (p *ReleasesWebhookParser) SourceType() string {
+	return TypeGithubReleases
+}
+
+func (p *ReleasesWebhookParser) ParseWebhook(source types.Source, header http.Header, payload []byte, secrets []string) ([]activitytypes.Activity, error) {
+	s, ok := source.(*SourceRelease)
+	if !ok {
+		return nil, fmt.Errorf("unexpected source type: %T", source)
+	}
+
+	event, err := parseWebhook(header, payload, secrets, s.Owner, s.Repo)
+	if err != nil || event == nil {
+		return nil, err
+	}
+
+	releaseEvent, ok := event.(*github.ReleaseEvent)
+	if !ok {
+		return nil, nil
+	}
+
+	release := releaseEvent.GetRelease()
+	switch {
+	case release == nil || release.GetDraft():
+		return nil, nil
+	case releaseEvent.GetAction() != "published" && releaseEvent.GetAction() != "edited":
+		return nil, nil
+	case !s.IncludePreleases && release.GetPrerelease():
+		return nil, nil
+	}
+
+	return []activitytypes.Activity{&Release{
+		Release:   release,
+		Owner:     s.Owner,
+		Repo:      s.Repo,
+		SourceIDs: []*TypedUID{s.UID().(*TypedUID)},
+	}}, nil
+}
+
+// IssuesWebhookParser parses the "issues" webhook events of the issue sources.
+// GitHub sends the pull request changes as separate events, so they aren't ingested.
+type IssuesWebhookParser struct {
+	Logger *zerolog.Logger
+}
+
+func NewIssuesWebhookParser(logger *zerolog.Logger) *IssuesWebhookParser {
+	return &IssuesWebhookParser{
+		Logger: logger,
+	}
+}
+
+func (p *IssuesWebhookParser) SourceType() string {
+	return TypeGithubIssues
+}
+
+func (p *IssuesWebhookParser) ParseWebhook(source types.Source, header http.Header, payload []byte, secrets []string) ([]activitytypes.Activity, error) {
+	s, ok := source.(*SourceIssues)
+	if !ok {
+		return nil, fmt.Errorf("unexpected source type: %T", source)
+	}
+
+	event, err := parseWebhook(header, payload, secrets, s.Owner, s.Repo)
+	if err != nil || event == nil {
+		return nil, err
+	}
+
+	issuesEvent, ok := event.(*github.IssuesEvent)
+	if !ok {
+		return nil, nil
+	}
+
+	issue := issuesEvent.GetIssue()
+	switch {
+	case issue == nil:
+		return nil, nil
+	case issuesEvent.GetAction() == "deleted" || issuesEvent.GetAction() == "transferred":
+		return nil, nil
+	case !s.matchesIssue(issue):
+		return nil, nil
+	}
+
+	return []activitytypes.Activity{&Issue{
+		Issue:     issue,
+		SourceIDs: []*TypedUID{s.UID().(*TypedUID)},
+		Owner:     s.Owner,
+		Repo:      s.Repo,
+	}}, nil
+}
+
+// matchesIssue applies the source filters that the issues API applies when polling.
+func (s *SourceIssues) matchesIssue(issue *github.Issue) bool {
+	if s.state() != issueStateAll && issue.GetState() != s.state() {
+		return false
+	}
+	if !s.IncludePRs && issue.IsPullRequest() {
+		return false
+	}
+
+	labels := make([]string, len(issue.Labels))
+	for i, label := range issue.Labels {
+		labels[i] = label.GetName()
+	}
+	for _, label := range s.Labels {
+		if !slices.Contains(labels, label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseWebhook validates the signature of the webhook payload with any of the secrets and parses the event.
+// Returns a nil event for pings and the events of other repositories.
+func parseWebhook(header http.Header, payload []byte, secrets []string, owner string, repo string) (any, error) {
+	if err := validateSignature(header, payload, secrets); err != nil {
+		return nil, err
+	}
+
+	eventType := header.Get(github.EventTypeHeader)
+	if eventType == "ping" {
+		return nil, nil
+	}
+
+	event, err := github.ParseWebHook(eventType, payload)
+	if err != nil {
+		return nil, fmt.Errorf("parse webhook: %w", err)
+	}
+
+	// The webhooks of other repositories may be (mis)configured with the same secret.
+	repoEvent, ok := event.(interface{ GetRepo() *github.Repository })
+	if !ok || !strings.EqualFold(repoEvent.GetRepo().GetFullName(), owner+"/"+repo) {
+		return nil, nil
+	}
+
+	return event, nil
+}
+
+// validateSignature returns nil if the payload is signed with any of the (non-empty) secrets.
+func validateSignature(header http.Header, payload []byte, secrets []string) error {
+	signature := header.Get(github.SHA256SignatureHeader)
+	var err error
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		if err = github.ValidateSignature(signature, payload, []byte(secret)); err == nil {
+			return nil
+		}
+	}
+	if err == nil {
+		return fmt.Errorf("%w: webhooks aren't enabled for the source", types.ErrInvalidWebhookSignature)
+	}
+	return fmt.Errorf("%w: %w", types.ErrInvalidWebhookSignature, err)
+}
@@ -0,0 +1,118 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+func signedWebhookHeader(event string, payload []byte, secret string) http.Header {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	header := http.Header{}
+	header.Set("X-GitHub-Event", event)
+	header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return header
+}
+
+func TestReleasesWebhookParser(t *testing.T) {
+	logger := zerolog.Nop()
+	parser := NewReleasesWebhookParser(&logger)
+	source := &SourceRelease{Owner: "golang", Repo: "go"}
+	secrets := []string{"owner-secret", "other-owner-secret"}
+
+	releasePayload := func(repo string, prerelease bool) []byte {
+		pre := "false"
+		if prerelease {
+			pre = "true"
+		}
+		return []byte(`{"action": "published", "repository": {"full_name": "` + repo + `"},` +
+			`"release": {"id": 1, "tag_name": "v1.0.0", "name": "v1.0.0", "prerelease": ` + pre + `}}`)
+	}
+
+	tests := []struct {
+		name      string
+		event     string
+		payload   []byte
+		secret    string
+		wantCount int
+		wantErr   error
+	}{
+		{name: "published release", event: "release", payload: releasePayload("golang/go", false), secret: "owner-secret", wantCount: 1},
+		{name: "secret of another owner", event: "release", payload: releasePayload("golang/go", false), secret: "other-owner-secret", wantCount: 1},
+		{name: "prerelease is filtered", event: "release", payload: releasePayload("golang/go", true), secret: "owner-secret"},
+		{name: "other repository is ignored", event: "release", payload: releasePayload("golang/tools", false), secret: "owner-secret"},
+		{name: "ping is ignored", event: "ping", payload: []byte(`{"zen": "Keep it simple."}`), secret: "owner-secret"},
+		{name: "invalid signature", event: "release", payload: releasePayload("golang/go", false), secret: "wrong", wantErr: types.ErrInvalidWebhookSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acts, err := parser.ParseWebhook(source, signedWebhookHeader(tt.event, tt.payload, tt.secret), tt.payload, secrets)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if len(acts) != tt.wantCount {
+				t.Fatalf("expected %d activities, got %d", tt.wantCount, len(acts))
+			}
+			if tt.wantCount > 0 && acts[0].SourceUIDs()[0].String() != source.UID().String() {
+				t.Errorf("expected activity of source %s, got %s", source.UID(), acts[0].SourceUIDs()[0])
+			}
+		})
+	}
+}
+
+func TestIssuesWebhookParser(t *testing.T) {
+	logger := zerolog.Nop()
+	parser := NewIssuesWebhookParser(&logger)
+	source := &SourceIssues{Owner: "golang", Repo: "go", Labels: []string{"bug"}, State: issueStateOpen}
+	secrets := []string{"owner-secret"}
+
+	tests := []struct {
+		name      string
+		payload   string
+		wantCount int
+	}{
+		{
+			name:      "matching issue",
+			payload:   `{"action": "opened", "repository": {"full_name": "golang/go"}, "issue": {"number": 1, "state": "open", "labels": [{"name": "bug"}, {"name": "runtime"}]}}`,
+			wantCount: 1,
+		},
+		{
+			name:    "missing label",
+			payload: `{"action": "opened", "repository": {"full_name": "golang/go"}, "issue": {"number": 1, "state": "open", "labels": [{"name": "runtime"}]}}`,
+		},
+		{
+			name:    "closed issue",
+			payload: `{"action": "closed", "repository": {"full_name": "golang/go"}, "issue": {"number": 1, "state": "closed", "labels": [{"name": "bug"}]}}`,
+		},
+		{
+			name:    "deleted issue",
+			payload: `{"action": "deleted", "repository": {"full_name": "golang/go"}, "issue": {"number": 1, "state": "open", "labels": [{"name": "bug"}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := []byte(tt.payload)
+			acts, err := parser.ParseWebhook(source, signedWebhookHeader("issues", payload, "owner-secret"), payload, secrets)
+			if err != nil {
+				t.Fatalf("parse webhook: %v", err)
+			}
+			if len(acts) != tt.wantCount {
+				t.Errorf("expected %d activities, got %d", tt.wantCount, len(acts))
+			}
+		})
+	}
+
+	if _, err := parser.ParseWebhook(source, http.Header{}, []byte(`{}`), nil); !errors.Is(err, types.ErrInvalidWebhookSignature) {
+		t.Errorf("expected webhooks to be rejected without an owner secret, got %v", err)
+	}
+}
@@ -58,7 +58,7 @@ func (f *PostsFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID,
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *PostsFetcher) Search(_ context.Context, _ string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *PostsFetcher) Search(_ context.Context, _ string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	// Ignore the query, since the set of all available sources is small
-	return feedSources, nil
+	return types.FilterByTopics(feedSources, topicsHint), nil
 }
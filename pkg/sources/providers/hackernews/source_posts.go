@@ -3,6 +3,7 @@ package hackernews
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -18,10 +19,17 @@ import (
 
 const TypeHackerNewsPosts = "hackernewsposts"
 
+// Provider identifies the Hacker News API requests for the rate limiter (see lib.NewProviderHTTPClient).
+const Provider = "hackernews"
+
 type SourcePosts struct {
-	FeedName string `json:"feedName" validate:"required,oneof=top new best ask show job"`
-	client   *gohn.Client
-	logger   *zerolog.Logger
+	FeedName      string `json:"feedName" validate:"required,oneof=top new best ask show job"`
+	client        *gohn.Client
+	logger        *zerolog.Logger
+	textFallbacks lib.TextFallbacks
+	concurrency   int
+	// initialBackfill is how far back the stories are processed on the first fetch.
+	initialBackfill time.Duration
 }
 
 func NewSourcePosts() *SourcePosts {
@@ -216,12 +224,15 @@ func (p *Post) CreatedAt() time.Time {
 
 func (s *SourcePosts) Initialize(logger *zerolog.Logger, config *sourcetypes.ProviderConfig) error {
 	var err error
-	s.client, err = gohn.NewClient(nil)
+	s.client, err = gohn.NewClient(lib.NewProviderHTTPClient(Provider, 0))
 	if err != nil {
 		return fmt.Errorf("init client: %v", err)
 	}
 
 	s.logger = logger
+	s.textFallbacks = config.ArticleTextFallbacks()
+	s.concurrency = config.HackerNewsFetchConcurrency
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -231,7 +242,7 @@ func (s *SourcePosts) Stream(ctx context.Context, since activitytypes.Activity,
 	s.fetchHackerNewsPosts(ctx, since, feed, errs)
 }
 
-func (s *SourcePosts) fetchHackerNewsPosts(ctx context.Context, _ activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+func (s *SourcePosts) fetchHackerNewsPosts(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
 	storyIDs, err := s.fetchStoryIDs(ctx)
 
 	if err != nil {
@@ -248,8 +259,14 @@ func (s *SourcePosts) fetchHackerNewsPosts(ctx context.Context, _ activitytypes.
 	// because the stories are chronologically ordered only on the "new" feed timeline.
 	// The order on "best" or "top" is not chronological and can change over time.
 	// So for now just fetch all stories, the scheduler will skip the already processed ones.
+	// The story lists are capped by the API (max 500), so on the first fetch only the stories within the initial backfill period are kept.
+	var initialSince time.Time
+	if since == nil {
+		initialSince = sourcetypes.InitialSince(ctx, s.initialBackfill)
+	}
 
-	pool := pond.NewPool(20)
+	// The external fetches of the stories are additionally bounded instance-wide (see lib.SetMaxConcurrentFetches).
+	pool := pond.NewPool(max(s.concurrency, 1))
 
 	for _, id := range storyIDs {
 		if id == nil {
@@ -274,6 +291,11 @@ func (s *SourcePosts) fetchHackerNewsPosts(ctx context.Context, _ activitytypes.
 				return
 			}
 
+			if story.Time != nil && time.Unix(int64(*story.Time), 0).Before(initialSince) {
+				storyLogger.Debug().Msg("Skipping story older than the initial backfill period")
+				return
+			}
+
 			post := &Post{
 				Post:                story,
 				ArticleTextBody:     "",
@@ -283,34 +305,8 @@ func (s *SourcePosts) fetchHackerNewsPosts(ctx context.Context, _ activitytypes.
 			}
 
 			if story.URL != nil {
-				resp, err := lib.FetchURL(ctx, s.logger, *story.URL)
-				if err != nil {
-					storyLogger.Error().Err(err).Msg("Failed to fetch external article")
-					return
-				}
-
-				defer resp.Body.Close()
-
-				faviconURL, err := lib.FaviconFromHTTPResponse(ctx, s.logger, resp)
-				if err == nil {
-					post.ArticleFaviconURL = faviconURL
-				} else {
-					storyLogger.Error().Err(err).Msg("Failed to get article favicon")
-				}
-
-				thumbnailURL, err := lib.ThumbnailURLFromHTTPResponse(ctx, s.logger, resp)
-				if err == nil {
-					post.ArticleThumbnailURL = thumbnailURL
-				} else {
-					storyLogger.Error().Err(err).Msg("Failed to get article thumbnail")
-				}
-
-				content, err := lib.TextFromHTTPResponse(ctx, s.logger, resp)
-				if err == nil {
-					post.ArticleTextBody = content
-				} else {
-					storyLogger.Error().Err(err).Msg("Failed to get article text")
-				}
+				// The post is kept without the article details if the article can't be fetched.
+				s.fetchArticle(ctx, &storyLogger, post, *story.URL)
 			}
 
 			feed <- post
@@ -320,6 +316,49 @@ func (s *SourcePosts) fetchHackerNewsPosts(ctx context.Context, _ activitytypes.
 	pool.StopAndWait()
 }
 
+// fetchArticle sets the favicon, thumbnail and text of the article linked by the post.
+func (s *SourcePosts) fetchArticle(ctx context.Context, logger *zerolog.Logger, post *Post, url string) {
+	resp, err := lib.FetchURL(ctx, s.logger, url)
+	if err != nil {
+		if errors.Is(err, lib.ErrDisallowedDomain) {
+			logger.Debug().Err(err).Msg("Skipping external article")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch external article")
+		}
+		return
+	}
+
+	defer resp.Body.Close()
+
+	// The page is parsed for the favicon, thumbnail and text, so it's read only once.
+	page, err := lib.BufferResponse(resp)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read external article")
+		return
+	}
+
+	faviconURL, err := lib.FaviconFromHTTPResponse(ctx, s.logger, page())
+	if err == nil {
+		post.ArticleFaviconURL = faviconURL
+	} else {
+		logger.Error().Err(err).Msg("Failed to get article favicon")
+	}
+
+	thumbnailURL, err := lib.ThumbnailURLFromHTTPResponse(ctx, s.logger, page())
+	if err == nil {
+		post.ArticleThumbnailURL = thumbnailURL
+	} else {
+		logger.Error().Err(err).Msg("Failed to get article thumbnail")
+	}
+
+	content, err := lib.CachedTextFromHTTPResponse(ctx, s.logger, url, page(), s.textFallbacks)
+	if err == nil {
+		post.ArticleTextBody = content
+	} else if !errors.Is(err, lib.ErrUnsupportedContentType) {
+		logger.Error().Err(err).Msg("Failed to get article text")
+	}
+}
+
 func (s *SourcePosts) fetchStoryIDs(ctx context.Context) ([]*int, error) {
 	var storyIDs []*int
 	var err error
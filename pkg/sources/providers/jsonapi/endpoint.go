@@ -0,0 +1,138 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+)
+
+// Endpoint is a JSON API (e.g. a REST or GraphQL endpoint of an internal service) polled for activities.
+// The endpoints are defined by the operator (see ProviderConfig.JSONAPIEndpoints), since they may require credentials.
+type Endpoint struct {
+	// Name identifies the source of the endpoint, so it can't be changed without losing the feeds using it.
+	Name        string `json:"name" validate:"required,excludesall=:/ "`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url" validate:"required,url"`
+	// Method is GET by default. Use POST with the body for the GraphQL queries.
+	Method string `json:"method" validate:"omitempty,oneof=GET POST"`
+	Body   string `json:"body"`
+	// Headers are sent with each request (e.g. the Authorization header).
+	// Values can reference environment variables as ${NAME}, so that the credentials aren't stored in the file.
+	Headers map[string]string `json:"headers"`
+	// SinceParam is the query parameter the time of the last seen activity (RFC 3339) is sent as,
+	// for the APIs that can filter the items. Empty fetches all the items on every poll.
+	SinceParam string                 `json:"sinceParam"`
+	Mapping    Mapping                `json:"mapping" validate:"required"`
+	Topics     []sourcetypes.TopicTag `json:"topics"`
+	Icon       string                 `json:"icon" validate:"omitempty,url"`
+}
+
+// Mapping selects the activity fields from the response with JSONPath-style paths (see parsePath).
+type Mapping struct {
+	// Items is the path of the items array. Empty if the response itself is the array.
+	Items string `json:"items"`
+	// ID is the path of the item ID, unique within the endpoint.
+	ID        string `json:"id" validate:"required"`
+	Title     string `json:"title" validate:"required"`
+	Body      string `json:"body"`
+	URL       string `json:"url"`
+	ImageURL  string `json:"imageUrl"`
+	CreatedAt string `json:"createdAt"`
+	// UpdatedAt is the path of the item modification time. The items updated after the last seen activity are polled again.
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// mappingPath is a named mapping field, for validation.
+type mappingPath struct {
+	field string
+	path  string
+}
+
+func (m Mapping) paths() []mappingPath {
+	return []mappingPath{
+		{"items", m.Items},
+		{"id", m.ID},
+		{"title", m.Title},
+		{"body", m.Body},
+		{"url", m.URL},
+		{"imageUrl", m.ImageURL},
+		{"createdAt", m.CreatedAt},
+		{"updatedAt", m.UpdatedAt},
+	}
+}
+
+// Validate checks the required fields and the syntax of the mapping paths.
+func (e *Endpoint) Validate() error {
+	if err := lib.ValidateStruct(e); err != nil {
+		return fmt.Errorf("endpoint %q: %w", e.Name, err)
+	}
+	for _, p := range e.Mapping.paths() {
+		if _, err := parsePath(p.path); err != nil {
+			return fmt.Errorf("endpoint %q: mapping %s: %w", e.Name, p.field, err)
+		}
+	}
+	return nil
+}
+
+func (e *Endpoint) method() string {
+	if e.Method == "" {
+		return http.MethodGet
+	}
+	return e.Method
+}
+
+// header returns the request headers with the environment variables expanded.
+func (e *Endpoint) header() http.Header {
+	header := make(http.Header, len(e.Headers))
+	for name, value := range e.Headers {
+		header.Set(name, os.ExpandEnv(value))
+	}
+	return header
+}
+
+// LoadEndpoints reads the endpoints from a JSON file with an array of endpoints. An empty path loads no endpoints.
+func LoadEndpoints(path string) ([]*Endpoint, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read endpoints: %w", err)
+	}
+
+	return ParseEndpoints(data)
+}
+
+// ParseEndpoints parses and validates the JSON array of endpoints.
+func ParseEndpoints(data []byte) ([]*Endpoint, error) {
+	var endpoints []*Endpoint
+	if err := json.Unmarshal(data, &endpoints); err != nil {
+		return nil, fmt.Errorf("parse endpoints: %w", err)
+	}
+
+	names := make(map[string]bool, len(endpoints))
+	var errs []error
+	for _, endpoint := range endpoints {
+		if err := endpoint.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if names[endpoint.Name] {
+			errs = append(errs, fmt.Errorf("duplicate endpoint name: %s", endpoint.Name))
+		}
+		names[endpoint.Name] = true
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
@@ -0,0 +1,52 @@
+package jsonapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+// EndpointFetcher resolves the sources of the JSON API endpoints defined by the operator.
+type EndpointFetcher struct {
+	Endpoints []*Endpoint
+	Logger    *zerolog.Logger
+}
+
+func NewEndpointFetcher(logger *zerolog.Logger, config *types.ProviderConfig) *EndpointFetcher {
+	// The endpoints are validated when the config is loaded.
+	endpoints, err := LoadEndpoints(config.JSONAPIEndpoints)
+	if err != nil {
+		logger.Error().Err(err).Msg("load JSON API endpoints")
+	}
+
+	return &EndpointFetcher{
+		Endpoints: endpoints,
+		Logger:    logger,
+	}
+}
+
+func (f *EndpointFetcher) SourceType() string {
+	return TypeJSONAPIEndpoint
+}
+
+func (f *EndpointFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID, config *types.ProviderConfig) (types.Source, error) {
+	name, _ := strings.CutPrefix(id.String(), TypeJSONAPIEndpoint+":")
+	endpoint := findEndpoint(f.Endpoints, name)
+	if endpoint == nil {
+		return nil, fmt.Errorf("source not found")
+	}
+	return newSourceEndpoint(endpoint), nil
+}
+
+func (f *EndpointFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
+	// Ignore the query, since there are only a few endpoints defined by the operator.
+	sources := make([]types.Source, len(f.Endpoints))
+	for i, endpoint := range f.Endpoints {
+		sources[i] = newSourceEndpoint(endpoint)
+	}
+	return sources, nil
+}
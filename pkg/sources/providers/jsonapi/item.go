@@ -0,0 +1,131 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// Payload is the activity data mapped from the endpoint response item.
+type Payload struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	URL       string    `json:"url"`
+	ImageURL  string    `json:"image_url"`
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is zero if the endpoint doesn't map the modification time.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// changedAt is the last time the item was created or updated.
+func (p *Payload) changedAt() time.Time {
+	if p.UpdatedAt.After(p.CreatedAt) {
+		return p.UpdatedAt
+	}
+	return p.CreatedAt
+}
+
+// Item is an activity polled from a JSON API endpoint.
+type Item struct {
+	Payload   *Payload                 `json:"payload"`
+	SourceIDs []activitytypes.TypedUID `json:"source_ids"`
+}
+
+func NewItem() *Item {
+	return &Item{}
+}
+
+func (i *Item) MarshalJSON() ([]byte, error) {
+	type Alias Item
+	return json.Marshal(&struct {
+		*Alias
+	}{
+		Alias: (*Alias)(i),
+	})
+}
+
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type Alias Item
+	aux := &struct {
+		*Alias
+		SourceIDs []*lib.TypedUID `json:"source_ids"`
+	}{
+		Alias: (*Alias)(i),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.SourceIDs) == 0 {
+		return fmt.Errorf("source_ids is required")
+	}
+
+	i.SourceIDs = make([]activitytypes.TypedUID, len(aux.SourceIDs))
+	for j, uid := range aux.SourceIDs {
+		i.SourceIDs[j] = uid
+	}
+
+	return nil
+}
+
+// UID is scoped by the endpoint, since the item IDs are only unique within the endpoint.
+func (i *Item) UID() activitytypes.TypedUID {
+	return lib.NewTypedUID(TypeJSONAPIEndpoint, i.endpointName(), i.Payload.ID)
+}
+
+func (i *Item) endpointName() string {
+	if len(i.SourceIDs) == 0 {
+		return ""
+	}
+	name, _ := strings.CutPrefix(i.SourceIDs[0].String(), TypeJSONAPIEndpoint+":")
+	return name
+}
+
+func (i *Item) SourceUIDs() []activitytypes.TypedUID {
+	return i.SourceIDs
+}
+
+func (i *Item) Title() string {
+	return i.Payload.Title
+}
+
+func (i *Item) Body() string {
+	return i.Payload.Body
+}
+
+func (i *Item) URL() string {
+	return i.Payload.URL
+}
+
+func (i *Item) ImageURL() string {
+	return i.Payload.ImageURL
+}
+
+func (i *Item) CreatedAt() time.Time {
+	return i.Payload.CreatedAt
+}
+
+func (i *Item) UpvotesCount() int {
+	return -1
+}
+
+func (i *Item) DownvotesCount() int {
+	return -1
+}
+
+func (i *Item) CommentsCount() int {
+	return -1
+}
+
+func (i *Item) AmplificationCount() int {
+	return -1
+}
+
+func (i *Item) SocialScore() float64 {
+	return -1
+}
@@ -0,0 +1,124 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pathSegment is either an object key or an array index.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath parses a JSONPath-style field path, e.g. "$.data.items", "items[0].title" or "author.name".
+// Only the dot-notation keys and the array indexes are supported. An empty path (or "$") selects the root.
+func parsePath(path string) ([]pathSegment, error) {
+	rest := strings.TrimPrefix(strings.TrimSpace(path), "$")
+
+	var segments []pathSegment
+	for rest != "" {
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed index in path %q", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index %q in path %q", rest[1:end], path)
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			// The leading dot is optional for the first key (e.g. "data.items").
+			if rest[0] == '.' {
+				rest = rest[1:]
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty key in path %q", path)
+			}
+			segments = append(segments, pathSegment{key: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+
+	return segments, nil
+}
+
+// lookup returns the value at the path, or false if any of the segments doesn't exist.
+func lookup(value any, segments []pathSegment) (any, bool) {
+	for _, segment := range segments {
+		if segment.isIndex {
+			array, ok := value.([]any)
+			if !ok || segment.index >= len(array) {
+				return nil, false
+			}
+			value = array[segment.index]
+			continue
+		}
+
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[segment.key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// stringValue formats the scalar values (e.g. numeric IDs) as strings. Objects, arrays and nulls are empty.
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+// timeLayouts are the supported formats of the string timestamps.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.DateTime,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// timeValue parses the timestamps formatted as strings (e.g. RFC 3339), or as Unix seconds or milliseconds.
+func timeValue(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		seconds, err := v.Float64()
+		if err != nil || seconds <= 0 {
+			return time.Time{}, false
+		}
+		// Millisecond timestamps (e.g. from JavaScript backends) are way in the future as seconds.
+		if seconds > 1e11 {
+			return time.UnixMilli(int64(seconds)), true
+		}
+		return time.Unix(int64(seconds), 0), true
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
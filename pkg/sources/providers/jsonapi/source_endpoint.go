@@ -0,0 +1,279 @@
+package jsonapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+const TypeJSONAPIEndpoint = "jsonapiendpoint"
+
+// maxResponseBytes caps the size of the endpoint responses.
+const maxResponseBytes = 10 << 20
+
+// SourceEndpoint polls a JSON API endpoint defined by the operator (see Endpoint).
+// Only the endpoint name is stored, the definition (with the credentials) is loaded from the config on initialization.
+type SourceEndpoint struct {
+	EndpointName    string `json:"endpoint" validate:"required"`
+	endpoint        *Endpoint
+	client          *http.Client
+	logger          *zerolog.Logger
+	initialBackfill time.Duration
+}
+
+func NewSourceEndpoint() *SourceEndpoint {
+	return &SourceEndpoint{}
+}
+
+func newSourceEndpoint(endpoint *Endpoint) *SourceEndpoint {
+	return &SourceEndpoint{
+		EndpointName: endpoint.Name,
+		endpoint:     endpoint,
+	}
+}
+
+func (s *SourceEndpoint) UID() activitytypes.TypedUID {
+	return lib.NewTypedUID(TypeJSONAPIEndpoint, s.EndpointName)
+}
+
+func (s *SourceEndpoint) Name() string {
+	if s.endpoint != nil && s.endpoint.Title != "" {
+		return s.endpoint.Title
+	}
+	return s.EndpointName
+}
+
+func (s *SourceEndpoint) Description() string {
+	if s.endpoint != nil && s.endpoint.Description != "" {
+		return s.endpoint.Description
+	}
+	return fmt.Sprintf("Items from the %s JSON API", s.EndpointName)
+}
+
+func (s *SourceEndpoint) URL() string {
+	// The API URL isn't exposed, since it may point to internal services.
+	return ""
+}
+
+func (s *SourceEndpoint) Icon() string {
+	if s.endpoint == nil {
+		return ""
+	}
+	return s.endpoint.Icon
+}
+
+func (s *SourceEndpoint) Topics() []sourcetypes.TopicTag {
+	if s.endpoint == nil {
+		return []sourcetypes.TopicTag{}
+	}
+	return s.endpoint.Topics
+}
+
+func (s *SourceEndpoint) Initialize(logger *zerolog.Logger, config *sourcetypes.ProviderConfig) error {
+	if err := lib.ValidateStruct(s); err != nil {
+		return err
+	}
+
+	endpoints, err := LoadEndpoints(config.JSONAPIEndpoints)
+	if err != nil {
+		return fmt.Errorf("load endpoints: %w", err)
+	}
+	s.endpoint = findEndpoint(endpoints, s.EndpointName)
+	if s.endpoint == nil {
+		return fmt.Errorf("endpoint not found: %s", s.EndpointName)
+	}
+
+	s.client = lib.NewHTTPClient(30 * time.Second)
+	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
+
+	return nil
+}
+
+func (s *SourceEndpoint) Stream(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
+
+	response, err := s.fetch(ctx, sinceTime)
+	if err != nil {
+		errs <- err
+		return
+	}
+
+	items, err := s.mapItems(response)
+	if err != nil {
+		errs <- err
+		return
+	}
+
+	for _, item := range items {
+		// The updated items are sent again, so that their content is refreshed.
+		if item.Payload.changedAt().After(sinceTime) {
+			feed <- item
+		}
+	}
+}
+
+func (s *SourceEndpoint) fetch(ctx context.Context, sinceTime time.Time) (any, error) {
+	url := s.endpoint.URL
+	if s.endpoint.SinceParam != "" && !sinceTime.IsZero() {
+		parsed, err := neturl.Parse(url)
+		if err != nil {
+			return nil, fmt.Errorf("parse url: %w", err)
+		}
+		query := parsed.Query()
+		query.Set(s.endpoint.SinceParam, sinceTime.UTC().Format(time.RFC3339))
+		parsed.RawQuery = query.Encode()
+		url = parsed.String()
+	}
+
+	var body io.Reader
+	if s.endpoint.Body != "" {
+		body = bytes.NewReader([]byte(s.endpoint.Body))
+	}
+	req, err := http.NewRequestWithContext(ctx, s.endpoint.method(), url, body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header = s.endpoint.header()
+	req.Header.Set("Accept", "application/json")
+	if body != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", lib.DefeedUserAgentString)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch endpoint: http status %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes))
+	// Keep the large numeric IDs and timestamps exact.
+	decoder.UseNumber()
+	var response any
+	if err := decoder.Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	return response, nil
+}
+
+// mapItems maps the response items to activities. The items without an ID or a title are skipped.
+func (s *SourceEndpoint) mapItems(response any) ([]*Item, error) {
+	mapping := s.endpoint.Mapping
+
+	itemsPath, err := parsePath(mapping.Items)
+	if err != nil {
+		return nil, fmt.Errorf("parse items path: %w", err)
+	}
+	value, ok := lookup(response, itemsPath)
+	if !ok {
+		return nil, fmt.Errorf("items not found at %q", mapping.Items)
+	}
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("items at %q are not an array", mapping.Items)
+	}
+
+	field := func(value any, path string) any {
+		if path == "" {
+			return nil
+		}
+		// The paths are validated when the endpoints are loaded.
+		segments, _ := parsePath(path)
+		out, _ := lookup(value, segments)
+		return out
+	}
+
+	now := time.Now()
+	items := make([]*Item, 0, len(values))
+	skipped := 0
+	for _, value := range values {
+		payload := &Payload{
+			ID:       stringValue(field(value, mapping.ID)),
+			Title:    stringValue(field(value, mapping.Title)),
+			Body:     stringValue(field(value, mapping.Body)),
+			URL:      stringValue(field(value, mapping.URL)),
+			ImageURL: stringValue(field(value, mapping.ImageURL)),
+		}
+		if payload.ID == "" || payload.Title == "" {
+			skipped++
+			continue
+		}
+
+		payload.UpdatedAt, _ = timeValue(field(value, mapping.UpdatedAt))
+		createdAt, ok := timeValue(field(value, mapping.CreatedAt))
+		switch {
+		case ok:
+			payload.CreatedAt = createdAt
+		case !payload.UpdatedAt.IsZero():
+			payload.CreatedAt = payload.UpdatedAt
+		default:
+			payload.CreatedAt = now
+		}
+
+		items = append(items, &Item{
+			Payload:   payload,
+			SourceIDs: []activitytypes.TypedUID{s.UID()},
+		})
+	}
+
+	if skipped > 0 {
+		s.logger.Warn().
+			Str("endpoint", s.EndpointName).
+			Int("skipped", skipped).
+			Msg("Skipped JSON API items without an ID or title")
+	}
+
+	return items, nil
+}
+
+func (s *SourceEndpoint) MarshalJSON() ([]byte, error) {
+	type Alias SourceEndpoint
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  TypeJSONAPIEndpoint,
+	})
+}
+
+func (s *SourceEndpoint) UnmarshalJSON(data []byte) error {
+	type Alias SourceEndpoint
+	aux := &struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return nil
+}
+
+func findEndpoint(endpoints []*Endpoint, name string) *Endpoint {
+	for _, endpoint := range endpoints {
+		if endpoint.Name == name {
+			return endpoint
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package jsonapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    int
+		wantErr bool
+	}{
+		{path: "", want: 0},
+		{path: "$", want: 0},
+		{path: "$.data.items", want: 2},
+		{path: "items[0].title", want: 3},
+		{path: "[1]", want: 1},
+		{path: "data..items", wantErr: true},
+		{path: "items[x]", wantErr: true},
+		{path: "items[0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		segments, err := parsePath(tt.path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parsePath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && len(segments) != tt.want {
+			t.Errorf("parsePath(%q) = %d segments, want %d", tt.path, len(segments), tt.want)
+		}
+	}
+}
+
+func TestParseEndpoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: `[{"name": "deploys", "url": "https://example.com/api", "mapping": {"id": "id", "title": "name"}}]`,
+		},
+		{
+			name:    "missing title mapping",
+			data:    `[{"name": "deploys", "url": "https://example.com/api", "mapping": {"id": "id"}}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid path",
+			data:    `[{"name": "deploys", "url": "https://example.com/api", "mapping": {"id": "id", "title": "a..b"}}]`,
+			wantErr: true,
+		},
+		{
+			name:    "name with separator",
+			data:    `[{"name": "internal:deploys", "url": "https://example.com/api", "mapping": {"id": "id", "title": "name"}}]`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate names",
+			data: `[{"name": "deploys", "url": "https://example.com/a", "mapping": {"id": "id", "title": "name"}},
+				{"name": "deploys", "url": "https://example.com/b", "mapping": {"id": "id", "title": "name"}}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEndpoints([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseEndpoints() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSourceEndpoint_Stream(t *testing.T) {
+	var gotAuth, gotSince string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotSince = r.URL.Query().Get("since")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"items": [
+			{"id": 12345678901234567, "name": "New", "meta": {"link": "https://example.com/new"}, "created": "2025-01-03T00:00:00Z"},
+			{"id": 2, "name": "Updated", "created": 1735689600, "updated": "2025-01-03T00:00:00Z"},
+			{"id": 3, "name": "Old", "created": 1735689600000},
+			{"id": 4, "created": "2025-01-03T00:00:00Z"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("JSONAPI_TEST_TOKEN", "secret")
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	endpoints := `[{
+		"name": "deploys",
+		"url": "` + server.URL + `",
+		"headers": {"Authorization": "Bearer ${JSONAPI_TEST_TOKEN}"},
+		"sinceParam": "since",
+		"mapping": {"items": "$.data.items", "id": "id", "title": "name", "url": "meta.link", "createdAt": "created", "updatedAt": "updated"}
+	}]`
+	if err := os.WriteFile(path, []byte(endpoints), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := zerolog.Nop()
+	source := NewSourceEndpoint()
+	source.EndpointName = "deploys"
+	if err := source.Initialize(&logger, &sourcetypes.ProviderConfig{JSONAPIEndpoints: path}); err != nil {
+		t.Fatalf("initialize source: %v", err)
+	}
+
+	since := &Item{
+		Payload:   &Payload{ID: "0", CreatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		SourceIDs: []activitytypes.TypedUID{source.UID()},
+	}
+	feed := make(chan activitytypes.Activity, 10)
+	errs := make(chan error, 10)
+	source.Stream(context.Background(), since, feed, errs)
+	close(feed)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Errorf("expected the expanded auth header, got %q", gotAuth)
+	}
+	if gotSince != "2025-01-02T00:00:00Z" {
+		t.Errorf("expected the since query param, got %q", gotSince)
+	}
+
+	var uids []string
+	for activity := range feed {
+		uids = append(uids, activity.UID().String())
+	}
+	want := []string{"jsonapiendpoint:deploys:12345678901234567", "jsonapiendpoint:deploys:2"}
+	if len(uids) != len(want) || uids[0] != want[0] || uids[1] != want[1] {
+		t.Fatalf("expected activities %v, got %v", want, uids)
+	}
+}
+
+func TestSourceEndpoint_Stream_InitialBackfill(t *testing.T) {
+	now := time.Now().UTC()
+	var gotSince string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSince = r.URL.Query().Get("since")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": 1, "name": "Recent", "created": "` + now.Add(-time.Hour).Format(time.RFC3339) + `"},
+			{"id": 2, "name": "Old", "created": "` + now.Add(-30*24*time.Hour).Format(time.RFC3339) + `"}
+		]`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	endpoints := `[{
+		"name": "deploys",
+		"url": "` + server.URL + `",
+		"sinceParam": "since",
+		"mapping": {"items": "$", "id": "id", "title": "name", "createdAt": "created"}
+	}]`
+	if err := os.WriteFile(path, []byte(endpoints), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := zerolog.Nop()
+	source := NewSourceEndpoint()
+	source.EndpointName = "deploys"
+	config := &sourcetypes.ProviderConfig{JSONAPIEndpoints: path, InitialBackfillPeriod: 7 * 24 * time.Hour}
+	if err := source.Initialize(&logger, config); err != nil {
+		t.Fatalf("initialize source: %v", err)
+	}
+
+	feed := make(chan activitytypes.Activity, 10)
+	errs := make(chan error, 10)
+	source.Stream(context.Background(), nil, feed, errs)
+	close(feed)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotSince == "" {
+		t.Error("expected the start of the initial backfill period as the since query param")
+	}
+
+	var uids []string
+	for activity := range feed {
+		uids = append(uids, activity.UID().String())
+	}
+	if len(uids) != 1 || uids[0] != "jsonapiendpoint:deploys:1" {
+		t.Fatalf("expected only the activity within the initial backfill period, got %v", uids)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/defeedco/defeed/pkg/lib"
 )
 
+// Provider identifies the Lobsters requests for the rate limiter (see lib.NewProviderHTTPClient).
+const Provider = "lobsters"
+
 // There is no official REST API, but each page can be fetched as JSON.
 // See: https://lobste.rs/s/r9oskz/is_there_api_documentation_for_lobsters
 type LobstersClient struct {
@@ -24,7 +27,7 @@ func NewLobstersClient(baseURL string) *LobstersClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 
 	return &LobstersClient{
-		httpClient: lib.DefaultHTTPClient,
+		httpClient: lib.NewProviderHTTPClient(Provider, 5*time.Second),
 		baseURL:    baseURL,
 	}
 }
@@ -43,6 +46,42 @@ type Story struct {
 	Tags        []string `json:"tags"`
 }
 
+// StoryComment is a comment in the story discussion.
+type StoryComment struct {
+	ID            string    `json:"short_id"`
+	ShortIDURL    string    `json:"short_id_url"`
+	URL           string    `json:"url"`
+	CreatedAt     time.Time `json:"created_at"`
+	Score         int       `json:"score"`
+	IsDeleted     bool      `json:"is_deleted"`
+	IsModerated   bool      `json:"is_moderated"`
+	CommentPlain  string    `json:"comment_plain"`
+	Depth         int       `json:"depth"`
+	ParentComment *string   `json:"parent_comment"`
+}
+
+type StoryWithComments struct {
+	Story
+	Comments []*StoryComment `json:"comments"`
+}
+
+// GetStory returns the story with its discussion.
+func (c *LobstersClient) GetStory(ctx context.Context, id string) (*StoryWithComments, error) {
+	url := fmt.Sprintf("%s/s/%s.json", c.baseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %v", err)
+	}
+
+	story, err := lib.DecodeJSONFromRequest[*StoryWithComments](c.httpClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching story: %v", err)
+	}
+
+	return story, nil
+}
+
 func (c *LobstersClient) GetStoriesByFeed(ctx context.Context, feed string) ([]*Story, error) {
 	url := fmt.Sprintf("%s/%s.json", c.baseURL, feed)
 	return c.fetchStories(ctx, url)
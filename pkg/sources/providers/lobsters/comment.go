@@ -0,0 +1,112 @@
+package lobsters
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/providers"
+)
+
+type Comment struct {
+	Comment   *StoryComment    `json:"comment"`
+	Story     *Story           `json:"story"`
+	SourceIDs []types.TypedUID `json:"source_ids"`
+	SourceTyp string           `json:"source_type"`
+}
+
+func NewComment() *Comment {
+	return &Comment{}
+}
+
+func (c *Comment) SourceType() string {
+	return c.SourceTyp
+}
+
+func (c *Comment) MarshalJSON() ([]byte, error) {
+	type Alias Comment
+	return json.Marshal(&struct {
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	})
+}
+
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type Alias Comment
+	aux := &struct {
+		*Alias
+		SourceIDs []*lib.TypedUID `json:"source_ids"`
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.SourceIDs) == 0 {
+		return fmt.Errorf("source_ids is required")
+	}
+
+	c.SourceIDs = make([]types.TypedUID, len(aux.SourceIDs))
+	for i, uid := range aux.SourceIDs {
+		c.SourceIDs[i] = uid
+	}
+
+	return nil
+}
+
+func (c *Comment) UID() types.TypedUID {
+	return lib.NewTypedUID(c.SourceTyp, c.Comment.ID)
+}
+
+func (c *Comment) SourceUIDs() []types.TypedUID {
+	return c.SourceIDs
+}
+
+func (c *Comment) Title() string {
+	return fmt.Sprintf("Comment on: %s", c.Story.Title)
+}
+
+func (c *Comment) Body() string {
+	return fmt.Sprintf("Story: %s\n\nComment:\n%s", c.Story.Title, c.Comment.CommentPlain)
+}
+
+func (c *Comment) URL() string {
+	if c.Comment.ShortIDURL != "" {
+		return c.Comment.ShortIDURL
+	}
+	return c.Comment.URL
+}
+
+func (c *Comment) ImageURL() string {
+	return ""
+}
+
+func (c *Comment) CreatedAt() time.Time {
+	return c.Comment.CreatedAt
+}
+
+func (c *Comment) UpvotesCount() int {
+	return c.Comment.Score
+}
+
+func (c *Comment) DownvotesCount() int {
+	return -1
+}
+
+func (c *Comment) CommentsCount() int {
+	return -1
+}
+
+func (c *Comment) AmplificationCount() int {
+	return -1
+}
+
+func (c *Comment) SocialScore() float64 {
+	// Comments get far fewer upvotes than stories.
+	maxUpvotes := 50.0
+	return providers.NormSocialScore(float64(c.UpvotesCount()), maxUpvotes)
+}
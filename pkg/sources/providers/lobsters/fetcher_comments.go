@@ -0,0 +1,69 @@
+package lobsters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/types"
+
+	"github.com/rs/zerolog"
+)
+
+// CommentsFetcher implements search functionality for Lobsters story discussions
+type CommentsFetcher struct {
+	Logger *zerolog.Logger
+}
+
+func NewCommentsFetcher(logger *zerolog.Logger) *CommentsFetcher {
+	return &CommentsFetcher{
+		Logger: logger,
+	}
+}
+
+func (f *CommentsFetcher) SourceType() string {
+	return TypeLobstersComments
+}
+
+var storyURLRegex = regexp.MustCompile(`^(https?://[^/]+)/s/([a-z0-9]+)`)
+
+// FindByID reconstructs the source from its UID (e.g. "lobsterscomments:lobste.rs:story:<id>").
+func (f *CommentsFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID, config *types.ProviderConfig) (types.Source, error) {
+	uid, ok := id.(*lib.TypedUID)
+	if !ok || len(uid.Identifiers) != 3 {
+		return nil, fmt.Errorf("source not found")
+	}
+
+	source := &SourceComments{
+		InstanceURL: fmt.Sprintf("https://%s", uid.Identifiers[0]),
+	}
+
+	switch uid.Identifiers[1] {
+	case "story":
+		source.StoryID = uid.Identifiers[2]
+	case "tag":
+		source.Tag = uid.Identifiers[2]
+	default:
+		return nil, fmt.Errorf("source not found")
+	}
+
+	return source, nil
+}
+
+// Search only returns a source when the query is a story URL, since discussions can't be searched.
+func (f *CommentsFetcher) Search(_ context.Context, query string, _ []types.TopicTag, _ *types.ProviderConfig) ([]types.Source, error) {
+	matches := storyURLRegex.FindStringSubmatch(query)
+	if matches == nil {
+		return []types.Source{}, nil
+	}
+
+	return []types.Source{
+		&SourceComments{
+			InstanceURL: matches[1],
+			StoryID:     matches[2],
+		},
+	}, nil
+}
@@ -47,7 +47,7 @@ func (f *FeedFetcher) FindByID(ctx context.Context, id types2.TypedUID, config *
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *FeedFetcher) Search(_ context.Context, _ string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *FeedFetcher) Search(_ context.Context, _ string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	// Ignore the query, since the set of all available sources is small
-	return feedSources, nil
+	return types.FilterByTopics(feedSources, topicsHint), nil
 }
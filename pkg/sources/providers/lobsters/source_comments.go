@@ -0,0 +1,196 @@
+package lobsters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+const TypeLobstersComments = "lobsterscomments"
+
+const (
+	// topCommentsPerStory is the max number of (highest scoring) comments emitted per story.
+	topCommentsPerStory = 5
+	// maxStoriesPerTag is the max number of (most discussed) stories checked when following a tag.
+	maxStoriesPerTag = 10
+)
+
+// SourceComments emits the top comments of a single story or of the stories with the given tag.
+type SourceComments struct {
+	InstanceURL     string `json:"instanceUrl" validate:"required,url"`
+	StoryID         string `json:"storyId" validate:"required_without=Tag,excluded_with=Tag"`
+	Tag             string `json:"tag" validate:"required_without=StoryID"`
+	client          *LobstersClient
+	logger          *zerolog.Logger
+	initialBackfill time.Duration
+}
+
+func NewSourceComments() *SourceComments {
+	return &SourceComments{
+		InstanceURL: "https://lobste.rs",
+	}
+}
+
+func (s *SourceComments) UID() activitytypes.TypedUID {
+	if s.StoryID != "" {
+		return lib.NewTypedUID(TypeLobstersComments, lib.StripURL(s.InstanceURL), "story", s.StoryID)
+	}
+	return lib.NewTypedUID(TypeLobstersComments, lib.StripURL(s.InstanceURL), "tag", s.Tag)
+}
+
+func (s *SourceComments) Name() string {
+	if s.StoryID != "" {
+		return fmt.Sprintf("Lobsters discussion %s", s.StoryID)
+	}
+	return fmt.Sprintf("Lobsters #%s discussions", s.Tag)
+}
+
+func (s *SourceComments) Description() string {
+	instanceName, err := lib.StripURLHost(s.InstanceURL)
+	if err != nil {
+		instanceName = s.InstanceURL
+	}
+
+	if s.StoryID != "" {
+		return fmt.Sprintf("Top comments on story %s from %s", s.StoryID, instanceName)
+	}
+	return fmt.Sprintf("Top comments on stories tagged with #%s from %s", s.Tag, instanceName)
+}
+
+func (s *SourceComments) URL() string {
+	if s.StoryID != "" {
+		return fmt.Sprintf("%s/s/%s", s.InstanceURL, s.StoryID)
+	}
+	return fmt.Sprintf("%s/t/%s", s.InstanceURL, s.Tag)
+}
+
+func (s *SourceComments) Icon() string {
+	return "https://lobste.rs/favicon.ico"
+}
+
+func (s *SourceComments) Topics() []sourcetypes.TopicTag {
+	if s.Tag != "" {
+		return (&SourceTag{Tag: s.Tag}).Topics()
+	}
+	return []sourcetypes.TopicTag{sourcetypes.TopicDevTools, sourcetypes.TopicOpenSource}
+}
+
+func (s *SourceComments) Stream(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+	storyIDs, err := s.storyIDs(ctx)
+	if err != nil {
+		errs <- err
+		return
+	}
+
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
+
+	for _, storyID := range storyIDs {
+		story, err := s.client.GetStory(ctx, storyID)
+		if err != nil {
+			errs <- fmt.Errorf("get story %s: %w", storyID, err)
+			continue
+		}
+
+		for _, comment := range topComments(story.Comments, topCommentsPerStory) {
+			if !comment.CreatedAt.After(sinceTime) {
+				continue
+			}
+
+			feed <- &Comment{
+				Comment:   comment,
+				Story:     &story.Story,
+				SourceTyp: TypeLobstersComments,
+				SourceIDs: []activitytypes.TypedUID{s.UID()},
+			}
+		}
+	}
+}
+
+func (s *SourceComments) storyIDs(ctx context.Context) ([]string, error) {
+	if s.StoryID != "" {
+		return []string{s.StoryID}, nil
+	}
+
+	stories, err := s.client.GetStoriesByTag(ctx, s.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(stories, func(i, j int) bool {
+		return stories[i].CommentCount > stories[j].CommentCount
+	})
+
+	var ids []string
+	for _, story := range stories {
+		if story.CommentCount == 0 || len(ids) >= maxStoriesPerTag {
+			break
+		}
+		ids = append(ids, story.ID)
+	}
+
+	return ids, nil
+}
+
+// topComments returns up to limit highest scoring comments, skipping deleted and moderated ones.
+func topComments(comments []*StoryComment, limit int) []*StoryComment {
+	var out []*StoryComment
+	for _, comment := range comments {
+		if comment.IsDeleted || comment.IsModerated || comment.CommentPlain == "" {
+			continue
+		}
+		out = append(out, comment)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Score > out[j].Score
+	})
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+
+	return out
+}
+
+func (s *SourceComments) Initialize(logger *zerolog.Logger, config *sourcetypes.ProviderConfig) error {
+	if err := lib.ValidateStruct(s); err != nil {
+		return err
+	}
+
+	s.client = NewLobstersClient(s.InstanceURL)
+	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
+	return nil
+}
+
+func (s *SourceComments) MarshalJSON() ([]byte, error) {
+	type Alias SourceComments
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  TypeLobstersComments,
+	})
+}
+
+func (s *SourceComments) UnmarshalJSON(data []byte) error {
+	type Alias SourceComments
+	aux := &struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package lobsters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/types"
+)
+
+func TestTopComments(t *testing.T) {
+	comments := []*StoryComment{
+		{ID: "a", Score: 3, CommentPlain: "a"},
+		{ID: "b", Score: 10, CommentPlain: "b"},
+		{ID: "c", Score: 50, CommentPlain: "c", IsDeleted: true},
+		{ID: "d", Score: 7, CommentPlain: "d"},
+		{ID: "e", Score: 20, CommentPlain: "e", IsModerated: true},
+		{ID: "f", Score: 1, CommentPlain: "f"},
+	}
+
+	got := topComments(comments, 3)
+
+	want := []string{"b", "d", "a"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d comments, got %d", len(want), len(got))
+	}
+	for i, comment := range got {
+		if comment.ID != want[i] {
+			t.Errorf("expected comment %d to be %s, got %s", i, want[i], comment.ID)
+		}
+	}
+}
+
+func TestCommentsFetcher_FindByID(t *testing.T) {
+	fetcher := NewCommentsFetcher(nil)
+
+	sources := []*SourceComments{
+		{InstanceURL: "https://lobste.rs", StoryID: "abc123"},
+		{InstanceURL: "https://lobste.rs", Tag: "go"},
+	}
+
+	for _, source := range sources {
+		uid, err := lib.NewTypedUIDFromString(source.UID().String())
+		if err != nil {
+			t.Fatalf("parse UID: %v", err)
+		}
+
+		found, err := fetcher.FindByID(context.Background(), uid, &types.ProviderConfig{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !lib.Equals(found.UID(), source.UID()) {
+			t.Errorf("expected %s, got %s", source.UID(), found.UID())
+		}
+	}
+}
+
+func TestCommentsFetcher_Search(t *testing.T) {
+	fetcher := NewCommentsFetcher(nil)
+
+	found, err := fetcher.Search(context.Background(), "https://lobste.rs/s/abc123/some_story", nil, &types.ProviderConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(found) != 1 || found[0].(*SourceComments).StoryID != "abc123" {
+		t.Errorf("expected story comments source, got %v", found)
+	}
+
+	found, err = fetcher.Search(context.Background(), "rust", nil, &types.ProviderConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("expected no sources, got %d", len(found))
+	}
+}
+
+func TestSourceComments_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  *SourceComments
+		wantErr bool
+	}{
+		{name: "story", source: &SourceComments{InstanceURL: "https://lobste.rs", StoryID: "abc123"}},
+		{name: "tag", source: &SourceComments{InstanceURL: "https://lobste.rs", Tag: "go"}},
+		{name: "neither", source: &SourceComments{InstanceURL: "https://lobste.rs"}, wantErr: true},
+		{name: "both", source: &SourceComments{InstanceURL: "https://lobste.rs", StoryID: "abc123", Tag: "go"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := lib.ValidateStruct(tt.source)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
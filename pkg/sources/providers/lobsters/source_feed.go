@@ -16,10 +16,12 @@ import (
 const TypeLobstersFeed = "lobstersfeed"
 
 type SourceFeed struct {
-	InstanceURL string `json:"instanceUrl" validate:"required,url"`
-	FeedName    string `json:"feed" validate:"required,oneof=hottest newest"`
-	client      *LobstersClient
-	logger      *zerolog.Logger
+	InstanceURL     string `json:"instanceUrl" validate:"required,url"`
+	FeedName        string `json:"feed" validate:"required,oneof=hottest newest"`
+	client          *LobstersClient
+	logger          *zerolog.Logger
+	initialBackfill time.Duration
+	textFallbacks   lib.TextFallbacks
 }
 
 func NewSourceFeed() *SourceFeed {
@@ -75,6 +77,8 @@ func (s *SourceFeed) Initialize(logger *zerolog.Logger, config *sourcetypes.Prov
 
 	s.client = NewLobstersClient(s.InstanceURL)
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
+	s.textFallbacks = config.ArticleTextFallbacks()
 	return nil
 }
 
@@ -89,28 +93,27 @@ func (s *SourceFeed) fetchAndSendNewStories(ctx context.Context, since activityt
 		return
 	}
 
-	var sinceTime time.Time
-	if since != nil {
-		sinceTime = since.CreatedAt()
-	}
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
 
 	for _, story := range stories {
+		// Skip the older stories before fetching their external content.
+		if !story.CreatedAt.After(sinceTime) {
+			continue
+		}
 		post, err := s.buildPost(ctx, story)
 		if err != nil {
 			errs <- err
 			return
 		}
-		if since == nil || post.CreatedAt().After(sinceTime) {
-			feed <- post
-		}
+		feed <- post
 	}
 }
 
 func (s *SourceFeed) buildPost(ctx context.Context, story *Story) (*Post, error) {
 	post := &Post{Post: story, SourceTyp: TypeLobstersFeed, SourceIDs: []activitytypes.TypedUID{s.UID()}}
 	if story.URL != "" {
-		externalContent, err := lib.FetchTextFromURL(ctx, s.logger, story.URL)
-		if err != nil && !errors.Is(err, lib.ErrUnsupportedContentType) {
+		externalContent, err := lib.FetchTextFromURL(ctx, s.logger, story.URL, s.textFallbacks)
+		if err != nil && !errors.Is(err, lib.ErrUnsupportedContentType) && !errors.Is(err, lib.ErrDisallowedDomain) {
 			return nil, fmt.Errorf("fetch external content: %w", err)
 		}
 		post.ExternalContent = externalContent
@@ -0,0 +1,61 @@
+package lobsters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+func TestSourceFeed_InitialBackfill(t *testing.T) {
+	var articleFetches atomic.Int32
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		articleFetches.Add(1)
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body><p>Article</p></body></html>"))
+	})
+	mux.HandleFunc("/hottest.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]*Story{
+			{ID: "recent", Title: "Recent", CreatedAt: time.Now().Add(-time.Hour)},
+			{ID: "old", Title: "Old", URL: server.URL + "/article", CreatedAt: time.Now().Add(-30 * 24 * time.Hour)},
+		})
+	})
+
+	logger := zerolog.Nop()
+	source := &SourceFeed{
+		FeedName:        "hottest",
+		client:          NewLobstersClient(server.URL),
+		logger:          &logger,
+		initialBackfill: 7 * 24 * time.Hour,
+	}
+
+	feed := make(chan activitytypes.Activity, 10)
+	errs := make(chan error, 10)
+	source.Stream(context.Background(), nil, feed, errs)
+	close(feed)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(feed) != 1 {
+		t.Fatalf("expected only the story within the initial backfill period, got %d", len(feed))
+	}
+	if post := <-feed; post.(*Post).Post.ID != "recent" {
+		t.Errorf("expected the recent story, got %s", post.(*Post).Post.ID)
+	}
+	if articleFetches.Load() != 0 {
+		t.Errorf("expected the content of the skipped story not to be fetched, got %d fetches", articleFetches.Load())
+	}
+}
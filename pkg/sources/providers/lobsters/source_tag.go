@@ -15,11 +15,12 @@ import (
 const TypeLobstersTag = "lobsterstag"
 
 type SourceTag struct {
-	InstanceURL    string `json:"instanceUrl" validate:"required,url"`
-	Tag            string `json:"tag" validate:"required"`
-	TagDescription string `json:"tagDescription"`
-	client         *LobstersClient
-	logger         *zerolog.Logger
+	InstanceURL     string `json:"instanceUrl" validate:"required,url"`
+	Tag             string `json:"tag" validate:"required"`
+	TagDescription  string `json:"tagDescription"`
+	client          *LobstersClient
+	logger          *zerolog.Logger
+	initialBackfill time.Duration
 }
 
 func NewSourceTag() *SourceTag {
@@ -87,10 +88,7 @@ func (s *SourceTag) fetchAndSendNewStories(ctx context.Context, since activityty
 		return
 	}
 
-	var sinceTime time.Time
-	if since != nil {
-		sinceTime = since.CreatedAt()
-	}
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
 
 	for _, story := range stories {
 		post := &Post{
@@ -98,7 +96,7 @@ func (s *SourceTag) fetchAndSendNewStories(ctx context.Context, since activityty
 			SourceTyp: TypeLobstersTag,
 			SourceIDs: []activitytypes.TypedUID{s.UID()},
 		}
-		if since == nil || post.CreatedAt().After(sinceTime) {
+		if post.CreatedAt().After(sinceTime) {
 			feed <- post
 		}
 	}
@@ -111,6 +109,7 @@ func (s *SourceTag) Initialize(logger *zerolog.Logger, config *sourcetypes.Provi
 
 	s.client = NewLobstersClient(s.InstanceURL)
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
 	return nil
 }
 
@@ -0,0 +1,119 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/mattn/go-mastodon"
+)
+
+// Provider identifies the Mastodon API requests for the rate limiter (see lib.NewProviderHTTPClient).
+// Each instance is limited separately.
+const Provider = "mastodon"
+
+// newClient creates a client for the instance.
+// The source access token takes precedence over the instance token from the config.
+// Without an access token, only the public API endpoints are accessible.
+func newClient(instanceURL string, accessToken string, config *sourcetypes.ProviderConfig) *mastodon.Client {
+	if accessToken == "" {
+		accessToken = instanceAccessToken(config.MastodonAccessTokens, instanceURL)
+	}
+
+	client := mastodon.NewClient(&mastodon.Config{
+		Server:       instanceURL,
+		ClientID:     config.MastodonClientID,
+		ClientSecret: config.MastodonClientSecret,
+		AccessToken:  accessToken,
+	})
+	// Route the requests through the outbound proxy and the rate limiter, like the other provider clients.
+	client.Client = *lib.NewProviderHTTPClient(Provider, 0)
+
+	return client
+}
+
+// instanceAccessToken finds the instance token in comma-separated host=token pairs.
+func instanceAccessToken(tokens string, instanceURL string) string {
+	if tokens == "" {
+		return ""
+	}
+
+	host, err := lib.StripURLHost(instanceURL)
+	if err != nil {
+		return ""
+	}
+
+	for pair := range strings.SplitSeq(tokens, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		if strings.EqualFold(strings.TrimSpace(parts[0]), host) {
+			return strings.TrimSpace(parts[1])
+		}
+	}
+
+	return ""
+}
+
+// getTrendingStatuses fetches the statuses trending on the instance, ordered by the instance's trending score.
+// The trends API isn't supported by the mastodon client library.
+func getTrendingStatuses(ctx context.Context, client *mastodon.Client, limit int) ([]*mastodon.Status, error) {
+	u, err := url.JoinPath(client.Config.Server, "/api/v1/trends/statuses")
+	if err != nil {
+		return nil, fmt.Errorf("build url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?limit=%d", u, limit), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("User-Agent", lib.DefeedUserAgentString)
+	if client.Config.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+client.Config.AccessToken)
+	}
+
+	return lib.DecodeJSONFromRequest[[]*mastodon.Status](&client.Client, req)
+}
+
+// initialBackfillMaxPages bounds the timeline pages fetched on the first fetch.
+const initialBackfillMaxPages = 10
+
+// fetchInitialStatuses pages back through a timeline (ordered newest first),
+// and returns the statuses created within the initial backfill period.
+// Only the latest page is fetched if the period is disabled.
+func fetchInitialStatuses(ctx context.Context, initialBackfill time.Duration, fetchPage func(ctx context.Context, pg *mastodon.Pagination) ([]*mastodon.Status, error)) ([]*mastodon.Status, error) {
+	initialSince := sourcetypes.InitialSince(ctx, initialBackfill)
+
+	var out []*mastodon.Status
+	var maxID mastodon.ID
+	for page := 0; page < initialBackfillMaxPages; page++ {
+		statuses, err := fetchPage(ctx, &mastodon.Pagination{
+			Limit: 40,
+			MaxID: maxID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range statuses {
+			if status.CreatedAt.Before(initialSince) {
+				return out, nil
+			}
+			out = append(out, status)
+		}
+
+		if len(statuses) == 0 || initialSince.IsZero() {
+			break
+		}
+		maxID = statuses[len(statuses)-1].ID
+	}
+
+	return out, nil
+}
@@ -0,0 +1,55 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-mastodon"
+)
+
+func TestFetchInitialStatuses(t *testing.T) {
+	now := time.Now()
+	// The timeline has a status per day, newest first.
+	timeline := make([]*mastodon.Status, 100)
+	for i := range timeline {
+		timeline[i] = &mastodon.Status{
+			ID:        mastodon.ID(fmt.Sprint(len(timeline) - i)),
+			CreatedAt: now.Add(-time.Duration(i)*24*time.Hour - time.Hour),
+		}
+	}
+
+	pages := 0
+	fetchPage := func(_ context.Context, pg *mastodon.Pagination) ([]*mastodon.Status, error) {
+		pages++
+		start := 0
+		for i, status := range timeline {
+			if pg.MaxID != "" && status.ID == pg.MaxID {
+				start = i + 1
+			}
+		}
+		end := min(start+5, len(timeline))
+		return timeline[start:end], nil
+	}
+
+	statuses, err := fetchInitialStatuses(context.Background(), 7*24*time.Hour, fetchPage)
+	if err != nil {
+		t.Fatalf("fetch initial statuses: %v", err)
+	}
+	if len(statuses) != 7 {
+		t.Errorf("expected the 7 statuses within the initial backfill period, got %d", len(statuses))
+	}
+	if pages != 2 {
+		t.Errorf("expected to stop paging at the first status older than the period, got %d pages", pages)
+	}
+
+	pages = 0
+	statuses, err = fetchInitialStatuses(context.Background(), 0, fetchPage)
+	if err != nil {
+		t.Fatalf("fetch initial statuses: %v", err)
+	}
+	if len(statuses) != 5 || pages != 1 {
+		t.Errorf("expected only the latest page with the period disabled, got %d statuses in %d pages", len(statuses), pages)
+	}
+}
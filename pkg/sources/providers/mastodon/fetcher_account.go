@@ -80,8 +80,8 @@ func (f *AccountFetcher) FindByID(ctx context.Context, id types2.TypedUID, confi
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *AccountFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *AccountFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	// TODO(sources): Support searching custom accounts
 	// Ignore the query, since the set of all available sources is small
-	return popularTechAccountSources, nil
+	return types.FilterByTopics(popularTechAccountSources, topicsHint), nil
 }
@@ -89,8 +89,8 @@ func (f *TagFetcher) FindByID(ctx context.Context, id types2.TypedUID, config *t
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *TagFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *TagFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	// TODO(sources): Support searching custom tags
 	// Ignore the query, since the set of all available sources is small
-	return popularTagSources, nil
+	return types.FilterByTopics(popularTagSources, topicsHint), nil
 }
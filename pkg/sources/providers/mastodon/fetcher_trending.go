@@ -0,0 +1,57 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+
+	types2 "github.com/defeedco/defeed/pkg/sources/activities/types"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/types"
+
+	"github.com/rs/zerolog"
+)
+
+// TrendingFetcher implements preset search functionality for trending statuses on Mastodon instances
+type TrendingFetcher struct {
+	Logger *zerolog.Logger
+}
+
+func NewTrendingFetcher(logger *zerolog.Logger) *TrendingFetcher {
+	return &TrendingFetcher{
+		Logger: logger,
+	}
+}
+
+func (f *TrendingFetcher) SourceType() string {
+	return TypeMastodonTrending
+}
+
+var popularTrendingSources = []types.Source{
+	&SourceTrending{
+		InstanceURL: defaultInstanceURL,
+	},
+	&SourceTrending{
+		InstanceURL: "https://fosstodon.org",
+	},
+	&SourceTrending{
+		InstanceURL: "https://hachyderm.io",
+	},
+	&SourceTrending{
+		InstanceURL: "https://infosec.exchange",
+	},
+}
+
+func (f *TrendingFetcher) FindByID(ctx context.Context, id types2.TypedUID, config *types.ProviderConfig) (types.Source, error) {
+	for _, source := range popularTrendingSources {
+		if lib.Equals(source.UID(), id) {
+			return source, nil
+		}
+	}
+	return nil, fmt.Errorf("source not found")
+}
+
+func (f *TrendingFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
+	// Ignore the query, since the set of all available sources is small
+	return types.FilterByTopics(popularTrendingSources, topicsHint), nil
+}
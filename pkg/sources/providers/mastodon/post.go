@@ -124,6 +124,18 @@ func (p *Post) AmplificationCount() int {
 	return int(p.Status.ReblogsCount)
 }
 
+func (p *Post) ContentFlags() []types.ContentFlag {
+	var flags []types.ContentFlag
+	if p.Status.Sensitive {
+		flags = append(flags, types.ContentFlagNSFW)
+	}
+	// Spoiler text is used as a content warning.
+	if p.Status.SpoilerText != "" {
+		flags = append(flags, types.ContentFlagSpoiler)
+	}
+	return flags
+}
+
 func (p *Post) SocialScore() float64 {
 	favorites := float64(p.UpvotesCount())
 	reblogs := float64(p.AmplificationCount())
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/defeedco/defeed/pkg/lib"
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
@@ -18,8 +19,13 @@ type SourceAccount struct {
 	InstanceURL string `json:"instanceUrl" validate:"required,url"`
 	Account     string `json:"account" validate:"required"`
 	AccountBio  string `json:"accountBio"`
+	// AccessToken is optional and only required for private (followers-only) accounts
+	// or instances that restrict anonymous access.
+	AccessToken string `json:"accessToken,omitempty" secret:"true"`
 	client      *mastodon.Client
 	logger      *zerolog.Logger
+	// initialBackfill is how far back the statuses are fetched on the first fetch.
+	initialBackfill time.Duration
 }
 
 func NewSourceAccount() *SourceAccount {
@@ -70,13 +76,10 @@ func (s *SourceAccount) Initialize(logger *zerolog.Logger, config *sourcetypes.P
 		return err
 	}
 
-	s.client = mastodon.NewClient(&mastodon.Config{
-		Server:       s.InstanceURL,
-		ClientID:     config.MastodonClientID,
-		ClientSecret: config.MastodonClientSecret,
-	})
+	s.client = newClient(s.InstanceURL, s.AccessToken, config)
 
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -108,7 +111,7 @@ func (s *SourceAccount) fetchAccountPosts(ctx context.Context, accountID mastodo
 		sinceID = sincePost.Status.ID
 	} else {
 		// If this is the first time we're fetching posts,
-		// only fetch the last few posts to avoid retrieving all historic posts.
+		// only fetch the posts within the initial backfill period to avoid retrieving all historic posts.
 		s.fetchLatestPosts(ctx, accountID, feed, errs)
 		return
 	}
@@ -157,8 +160,8 @@ func (s *SourceAccount) fetchLatestPosts(ctx context.Context, accountID mastodon
 
 	accLogger.Debug().Msg("Fetching latest post from account timeline")
 
-	statuses, err := s.client.GetAccountStatuses(ctx, accountID, &mastodon.Pagination{
-		Limit: 10,
+	statuses, err := fetchInitialStatuses(ctx, s.initialBackfill, func(ctx context.Context, pg *mastodon.Pagination) ([]*mastodon.Status, error) {
+		return s.client.GetAccountStatuses(ctx, accountID, pg)
 	})
 	if err != nil {
 		errs <- fmt.Errorf("fetch account statuses: %w", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/defeedco/defeed/pkg/lib"
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
@@ -18,8 +19,12 @@ type SourceTag struct {
 	InstanceURL string `json:"instanceUrl" validate:"required,url"`
 	Tag         string `json:"tag" validate:"required"`
 	TagSummary  string `json:"tagSummary"`
+	// AccessToken is optional and only required for instances that restrict anonymous access.
+	AccessToken string `json:"accessToken,omitempty" secret:"true"`
 	client      *mastodon.Client
 	logger      *zerolog.Logger
+	// initialBackfill is how far back the statuses are fetched on the first fetch.
+	initialBackfill time.Duration
 }
 
 func NewSourceTag() *SourceTag {
@@ -70,13 +75,10 @@ func (s *SourceTag) Initialize(logger *zerolog.Logger, config *sourcetypes.Provi
 		return err
 	}
 
-	s.client = mastodon.NewClient(&mastodon.Config{
-		Server:       s.InstanceURL,
-		ClientID:     config.MastodonClientID,
-		ClientSecret: config.MastodonClientSecret,
-	})
+	s.client = newClient(s.InstanceURL, s.AccessToken, config)
 
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -92,7 +94,7 @@ func (s *SourceTag) fetchHashtagPosts(ctx context.Context, since activitytypes.A
 		sinceID = sincePost.Status.ID
 	} else {
 		// If this is the first time we're fetching posts,
-		// only fetch the last few posts to avoid retrieving all historic posts.
+		// only fetch the posts within the initial backfill period to avoid retrieving all historic posts.
 		s.fetchLatestPosts(ctx, feed, errs)
 		return
 	}
@@ -140,8 +142,8 @@ func (s *SourceTag) fetchLatestPosts(ctx context.Context, feed chan<- activityty
 
 	tagLogger.Debug().Msg("Fetching latest post from hashtag timeline")
 
-	statuses, err := s.client.GetTimelineHashtag(ctx, s.Tag, false, &mastodon.Pagination{
-		Limit: 10,
+	statuses, err := fetchInitialStatuses(ctx, s.initialBackfill, func(ctx context.Context, pg *mastodon.Pagination) ([]*mastodon.Status, error) {
+		return s.client.GetTimelineHashtag(ctx, s.Tag, false, pg)
 	})
 	if err != nil {
 		errs <- fmt.Errorf("get hashtag timeline: %w", err)
@@ -0,0 +1,144 @@
+package mastodon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/mattn/go-mastodon"
+	"github.com/rs/zerolog"
+)
+
+const TypeMastodonTrending = "mastodontrending"
+
+// trendingStatusesLimit is the number of trending statuses fetched per poll (max 40).
+const trendingStatusesLimit = 20
+
+// SourceTrending surfaces the statuses trending on an instance, without following a specific tag or account.
+type SourceTrending struct {
+	InstanceURL string `json:"instanceUrl" validate:"required,url"`
+	// AccessToken is optional and only required for instances that restrict anonymous access.
+	AccessToken string `json:"accessToken,omitempty" secret:"true"`
+	client      *mastodon.Client
+	logger      *zerolog.Logger
+	// initialBackfill is how far back the statuses are kept on the first fetch.
+	initialBackfill time.Duration
+}
+
+func NewSourceTrending() *SourceTrending {
+	return &SourceTrending{
+		InstanceURL: "https://mastodon.social",
+	}
+}
+
+func (s *SourceTrending) UID() activitytypes.TypedUID {
+	return lib.NewTypedUID(TypeMastodonTrending, lib.StripURL(s.InstanceURL))
+}
+
+func (s *SourceTrending) Name() string {
+	instanceName, err := lib.StripURLHost(s.InstanceURL)
+	if err != nil {
+		return "Mastodon Trending"
+	}
+	return fmt.Sprintf("Trending on %s", instanceName)
+}
+
+func (s *SourceTrending) Description() string {
+	instanceName, err := lib.StripURLHost(s.InstanceURL)
+	if err != nil {
+		return "Trending posts from Mastodon"
+	}
+	return fmt.Sprintf("Trending posts from %s", instanceName)
+}
+
+func (s *SourceTrending) URL() string {
+	return fmt.Sprintf("%s/explore", s.InstanceURL)
+}
+
+func (s *SourceTrending) Icon() string {
+	// Note: might not work for all instances
+	return fmt.Sprintf("%s/packs/assets/favicon-48x48-DMnduFKh.png", s.InstanceURL)
+}
+
+func (s *SourceTrending) Topics() []sourcetypes.TopicTag {
+	return []sourcetypes.TopicTag{sourcetypes.TopicOpenSource}
+}
+
+func (s *SourceTrending) Initialize(logger *zerolog.Logger, config *sourcetypes.ProviderConfig) error {
+	if err := lib.ValidateStruct(s); err != nil {
+		return err
+	}
+
+	s.client = newClient(s.InstanceURL, s.AccessToken, config)
+
+	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
+
+	return nil
+}
+
+func (s *SourceTrending) Stream(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+	trendingLogger := s.logger.With().
+		Str("instance_url", s.InstanceURL).
+		Logger()
+
+	trendingLogger.Debug().Msg("Fetching trending statuses")
+
+	statuses, err := getTrendingStatuses(ctx, s.client, trendingStatusesLimit)
+	if err != nil {
+		errs <- fmt.Errorf("get trending statuses: %w", err)
+		return
+	}
+
+	// The trends API doesn't support since_id (trending statuses aren't ordered chronologically),
+	// so filter out the statuses created before the last processed status (or the initial backfill period) instead.
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
+
+	count := 0
+	for _, status := range statuses {
+		if !status.CreatedAt.After(sinceTime) {
+			continue
+		}
+
+		feed <- &Post{
+			Status:    status,
+			SourceTyp: TypeMastodonTrending,
+			SourceIDs: []activitytypes.TypedUID{s.UID()},
+		}
+		count++
+	}
+
+	trendingLogger.Debug().
+		Int("count", count).
+		Int("fetched_count", len(statuses)).
+		Msg("Fetched trending statuses")
+}
+
+func (s *SourceTrending) MarshalJSON() ([]byte, error) {
+	type Alias SourceTrending
+	return json.Marshal(&struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+		Type:  TypeMastodonTrending,
+	})
+}
+
+func (s *SourceTrending) UnmarshalJSON(data []byte) error {
+	type Alias SourceTrending
+	aux := &struct {
+		*Alias
+		Type string `json:"type"`
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return nil
+}
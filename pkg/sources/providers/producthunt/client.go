@@ -9,40 +9,63 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/defeedco/defeed/pkg/lib"
 	"github.com/rs/zerolog"
 )
 
+// Provider identifies the Product Hunt API requests for the rate limiter (see lib.NewProviderHTTPClient).
+const Provider = "producthunt"
+
+const graphQLURL = "https://api.producthunt.com/v2/api/graphql"
+
 type Client struct {
 	httpClient *http.Client
+	apiURL     string
 	apiToken   string
 	logger     *zerolog.Logger
 }
 
 func NewClient(apiToken string, logger *zerolog.Logger) *Client {
 	return &Client{
-		httpClient: &http.Client{
-			// ProductHunt API can take some more time to respond
-			Timeout: 60 * time.Second,
-		},
-		apiToken: apiToken,
-		logger:   logger,
+		// ProductHunt API can take some more time to respond
+		httpClient: lib.NewProviderHTTPClient(Provider, 60*time.Second),
+		apiURL:     graphQLURL,
+		apiToken:   apiToken,
+		logger:     logger,
 	}
 }
 
-func (c *Client) FetchPosts(ctx context.Context, order PostOrder, limit int, timePeriod TimePeriod) ([]*PostNode, error) {
-	query := c.buildPostsQuery(order, limit, timePeriod)
+// PostsRequest selects a page of posts.
+type PostsRequest struct {
+	Order      PostOrder
+	Limit      int
+	TimePeriod TimePeriod
+	// PostedAfter extends the time period further back (e.g. to catch up after downtime). Zero is ignored.
+	PostedAfter time.Time
+	// After is the cursor of the previous page (PostsPage.EndCursor). Empty fetches the first page.
+	After string
+}
+
+type PostsPage struct {
+	Posts       []*PostNode
+	EndCursor   string
+	HasNextPage bool
+}
+
+func (c *Client) FetchPosts(ctx context.Context, req PostsRequest) (*PostsPage, error) {
+	query := c.buildPostsQuery(req)
 	return c.executeGraphQLQuery(ctx, query)
 }
 
-func (c *Client) buildPostsQuery(order PostOrder, limit int, timePeriod TimePeriod) string {
+func (c *Client) buildPostsQuery(req PostsRequest) string {
 	orderStr := "VOTES"
-	if order == PostOrderNewest {
+	if req.Order == PostOrderNewest {
 		orderStr = "NEWEST"
 	}
 
-	dateFilter := ""
-	if timePeriod != TimePeriodAll {
-		dateFilter = c.buildDateFilter(timePeriod)
+	filters := c.buildDateFilter(req.TimePeriod, req.PostedAfter)
+	if req.After != "" {
+		filters += fmt.Sprintf(`, after: %q`, req.After)
 	}
 
 	// Docs: http://api-v2-docs.producthunt.com.s3-website-us-east-1.amazonaws.com/object/post
@@ -50,6 +73,10 @@ func (c *Client) buildPostsQuery(order PostOrder, limit int, timePeriod TimePeri
 	return fmt.Sprintf(`
 		query {
 			posts(order: %s, first: %d%s) {
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
 				edges {
 					node {
 						id
@@ -96,10 +123,10 @@ func (c *Client) buildPostsQuery(order PostOrder, limit int, timePeriod TimePeri
 				}
 			}
 		}
-	`, orderStr, limit, dateFilter)
+	`, orderStr, req.Limit, filters)
 }
 
-func (c *Client) executeGraphQLQuery(ctx context.Context, query string) ([]*PostNode, error) {
+func (c *Client) executeGraphQLQuery(ctx context.Context, query string) (*PostsPage, error) {
 	reqBody := map[string]string{
 		"query": query,
 	}
@@ -109,7 +136,7 @@ func (c *Client) executeGraphQLQuery(ctx context.Context, query string) ([]*Post
 		return nil, fmt.Errorf("marshal request body: %v", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.producthunt.com/v2/api/graphql", bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL, bytes.NewBuffer(jsonBody))
 	if err != nil {
 		return nil, fmt.Errorf("create request: %v", err)
 	}
@@ -144,7 +171,11 @@ func (c *Client) executeGraphQLQuery(ctx context.Context, query string) ([]*Post
 		}
 	}
 
-	return products, nil
+	return &PostsPage{
+		Posts:       products,
+		EndCursor:   result.Data.Posts.PageInfo.EndCursor,
+		HasNextPage: result.Data.Posts.PageInfo.HasNextPage,
+	}, nil
 }
 
 type PostOrder int
@@ -174,6 +205,22 @@ const (
 	TimePeriodAll
 )
 
+// ParseTimePeriod parses the time period name (see TimePeriod.String). Empty defaults to today.
+func ParseTimePeriod(s string) (TimePeriod, error) {
+	switch s {
+	case "", "today":
+		return TimePeriodToday, nil
+	case "week":
+		return TimePeriodWeek, nil
+	case "month":
+		return TimePeriodMonth, nil
+	case "all":
+		return TimePeriodAll, nil
+	default:
+		return TimePeriodToday, fmt.Errorf("unknown time period: %s", s)
+	}
+}
+
 func (tp TimePeriod) String() string {
 	switch tp {
 	case TimePeriodToday:
@@ -189,7 +236,7 @@ func (tp TimePeriod) String() string {
 	}
 }
 
-func (c *Client) buildDateFilter(timePeriod TimePeriod) string {
+func (c *Client) buildDateFilter(timePeriod TimePeriod, postedAfter time.Time) string {
 	now := time.Now()
 	var startDate time.Time
 
@@ -204,6 +251,10 @@ func (c *Client) buildDateFilter(timePeriod TimePeriod) string {
 		return ""
 	}
 
+	if !postedAfter.IsZero() && postedAfter.Before(startDate) {
+		startDate = postedAfter
+	}
+
 	// Format as ISO 8601 date string
 	dateStr := startDate.Format("2006-01-02")
 	return fmt.Sprintf(`, postedAfter: "%s"`, dateStr)
@@ -223,7 +274,13 @@ type Data struct {
 }
 
 type PostConnection struct {
-	Edges []PostEdge `json:"edges"`
+	PageInfo PageInfo   `json:"pageInfo"`
+	Edges    []PostEdge `json:"edges"`
+}
+
+type PageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
 }
 
 type PostEdge struct {
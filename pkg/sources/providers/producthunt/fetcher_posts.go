@@ -3,6 +3,7 @@ package producthunt
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/defeedco/defeed/pkg/lib"
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
@@ -39,9 +40,25 @@ func (f *PostsFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID,
 			return source, nil
 		}
 	}
+
+	// Sources of other periods or limits than the presets are identified by the feed name, period and limit (see SourcePosts.UID).
+	if uid, ok := id.(*lib.TypedUID); ok && (len(uid.Identifiers) == 2 || len(uid.Identifiers) == 3) {
+		source := &SourcePosts{FeedName: uid.Identifiers[0], TimePeriod: uid.Identifiers[1]}
+		if len(uid.Identifiers) == 3 {
+			limit, err := strconv.Atoi(uid.Identifiers[2])
+			if err != nil {
+				return nil, fmt.Errorf("parse limit: %w", err)
+			}
+			source.Limit = limit
+		}
+		if err := source.Validate(); err == nil {
+			return source, nil
+		}
+	}
+
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *PostsFetcher) Search(_ context.Context, _ string, config *types.ProviderConfig) ([]types.Source, error) {
-	return feedSources, nil
+func (f *PostsFetcher) Search(_ context.Context, _ string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
+	return types.FilterByTopics(feedSources, topicsHint), nil
 }
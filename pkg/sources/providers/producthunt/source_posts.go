@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/defeedco/defeed/pkg/lib"
@@ -17,16 +18,44 @@ const TypeProductHuntPosts = "producthuntposts"
 
 type SourcePosts struct {
 	FeedName string `json:"feedName" validate:"required,oneof=new top"`
-	client   *Client
-	logger   *zerolog.Logger
+	// TimePeriod is the period of the fetched posts. Defaults to today.
+	TimePeriod string `json:"timePeriod,omitempty" validate:"omitempty,oneof=today week month all"`
+	// Limit is the max number of posts fetched per poll, across the pages. Defaults to 50.
+	Limit           int `json:"limit,omitempty" validate:"omitempty,gte=1,lte=500"`
+	client          *Client
+	logger          *zerolog.Logger
+	initialBackfill time.Duration
 }
 
+const (
+	defaultPostsLimit = 50
+	// postsPageSize is the number of posts fetched per GraphQL request.
+	// Larger pages are rejected by the Product Hunt API for exceeding the query complexity.
+	postsPageSize = 50
+)
+
 func NewSourcePosts() *SourcePosts {
 	return &SourcePosts{}
 }
 
 func (s *SourcePosts) UID() activitytypes.TypedUID {
-	return lib.NewTypedUID(TypeProductHuntPosts, s.FeedName)
+	// The default period and limit are omitted, so that the UIDs of the existing sources don't change.
+	customPeriod := s.TimePeriod != "" && s.TimePeriod != TimePeriodToday.String()
+	customLimit := s.Limit > 0 && s.Limit != defaultPostsLimit
+
+	parts := []string{s.FeedName}
+	if customPeriod || customLimit {
+		timePeriod := s.TimePeriod
+		if timePeriod == "" {
+			timePeriod = TimePeriodToday.String()
+		}
+		parts = append(parts, timePeriod)
+	}
+	if customLimit {
+		parts = append(parts, strconv.Itoa(s.Limit))
+	}
+
+	return lib.NewTypedUID(TypeProductHuntPosts, parts...)
 }
 
 func (s *SourcePosts) Name() string {
@@ -176,8 +205,13 @@ func (p *Post) CreatedAt() time.Time {
 }
 
 func (s *SourcePosts) Initialize(logger *zerolog.Logger, config *sourcetypes.ProviderConfig) error {
+	if config.ProductHuntAPIToken == "" {
+		return fmt.Errorf("%w: set PRODUCTHUNT_API_TOKEN to enable Product Hunt sources", sourcetypes.ErrMissingCredentials)
+	}
+
 	s.client = NewClient(config.ProductHuntAPIToken, logger)
 	s.logger = logger
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -186,38 +220,67 @@ func (s *SourcePosts) Stream(ctx context.Context, since activitytypes.Activity,
 	s.fetchProductHuntPosts(ctx, since, feed, errs)
 }
 
-func (s *SourcePosts) fetchProductHuntPosts(ctx context.Context, _ activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+func (s *SourcePosts) fetchProductHuntPosts(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
 	order := PostOrderVotes
 	if s.FeedName == "new" {
 		order = PostOrderNewest
 	}
 
-	timePeriod := TimePeriodToday
-	limit := 50
-	products, err := s.client.FetchPosts(ctx, order, limit, timePeriod)
+	timePeriod, err := ParseTimePeriod(s.TimePeriod)
 	if err != nil {
-		errs <- fmt.Errorf("fetch posts: %v", err)
+		errs <- err
 		return
 	}
 
-	s.logger.Debug().
-		Int("products_count", len(products)).
-		Str("order", order.String()).
-		Str("time_period", timePeriod.String()).
-		Str("feed_name", s.FeedName).
-		Int("limit", limit).
-		Msg("Fetched products")
+	limit := s.Limit
+	if limit <= 0 {
+		limit = defaultPostsLimit
+	}
 
-	if len(products) == 0 {
-		return
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
+	req := PostsRequest{
+		Order:      order,
+		TimePeriod: timePeriod,
+		// Widen the period to the last seen post, so that the top posts are caught up after downtime.
+		PostedAfter: sinceTime,
 	}
 
-	for _, product := range products {
-		post := &Post{
-			Product:   product,
-			SourceIDs: []activitytypes.TypedUID{s.UID()},
+	fetched := 0
+	for fetched < limit {
+		req.Limit = min(postsPageSize, limit-fetched)
+		page, err := s.client.FetchPosts(ctx, req)
+		if err != nil {
+			errs <- fmt.Errorf("fetch posts: %v", err)
+			return
+		}
+		fetched += len(page.Posts)
+
+		s.logger.Debug().
+			Int("products_count", len(page.Posts)).
+			Str("order", order.String()).
+			Str("time_period", timePeriod.String()).
+			Str("feed_name", s.FeedName).
+			Int("limit", limit).
+			Bool("has_next_page", page.HasNextPage).
+			Msg("Fetched products")
+
+		reachedSince := false
+		for _, product := range page.Posts {
+			// The newest posts are ordered by the creation time, so the rest of the pages were already seen.
+			if order == PostOrderNewest && !product.CreatedAt.After(sinceTime) {
+				reachedSince = true
+				break
+			}
+			feed <- &Post{
+				Product:   product,
+				SourceIDs: []activitytypes.TypedUID{s.UID()},
+			}
+		}
+
+		if reachedSince || !page.HasNextPage || page.EndCursor == "" || len(page.Posts) == 0 {
+			return
 		}
-		feed <- post
+		req.After = page.EndCursor
 	}
 }
 
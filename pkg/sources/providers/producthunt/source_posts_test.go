@@ -0,0 +1,163 @@
+package producthunt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+)
+
+// newTestPostsServer serves the pages of posts by cursor ("" is the first page), and records the requested cursors.
+func newTestPostsServer(t *testing.T, pages map[string]PostsPage) (*httptest.Server, *[]string) {
+	cursors := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+
+		cursor := ""
+		if _, after, ok := strings.Cut(body.Query, `after: "`); ok {
+			cursor, _, _ = strings.Cut(after, `"`)
+		}
+		cursors = append(cursors, cursor)
+
+		page := pages[cursor]
+		edges := make([]map[string]any, 0, len(page.Posts))
+		for _, post := range page.Posts {
+			edges = append(edges, map[string]any{"node": post})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"posts": map[string]any{
+					"pageInfo": map[string]any{"endCursor": page.EndCursor, "hasNextPage": page.HasNextPage},
+					"edges":    edges,
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &cursors
+}
+
+func newTestSourcePosts(server *httptest.Server, feedName string, limit int) *SourcePosts {
+	logger := zerolog.Nop()
+	return &SourcePosts{
+		FeedName: feedName,
+		Limit:    limit,
+		client:   &Client{httpClient: server.Client(), apiURL: server.URL, logger: &logger},
+		logger:   &logger,
+	}
+}
+
+func testPosts(createdAt ...time.Time) []*PostNode {
+	posts := make([]*PostNode, len(createdAt))
+	for i, t := range createdAt {
+		posts[i] = &PostNode{ID: fmt.Sprintf("%d", t.Unix()), CreatedAt: t}
+	}
+	return posts
+}
+
+func streamPosts(s *SourcePosts, since activitytypes.Activity) ([]activitytypes.Activity, []error) {
+	feed := make(chan activitytypes.Activity, 100)
+	errs := make(chan error, 10)
+	s.Stream(context.Background(), since, feed, errs)
+	close(feed)
+	close(errs)
+
+	var acts []activitytypes.Activity
+	for act := range feed {
+		acts = append(acts, act)
+	}
+	var errList []error
+	for err := range errs {
+		errList = append(errList, err)
+	}
+	return acts, errList
+}
+
+func TestSourcePosts_Pagination(t *testing.T) {
+	now := time.Now()
+	server, cursors := newTestPostsServer(t, map[string]PostsPage{
+		"":      {Posts: testPosts(now, now.Add(-time.Minute)), EndCursor: "page2", HasNextPage: true},
+		"page2": {Posts: testPosts(now.Add(-2*time.Minute), now.Add(-3*time.Minute)), EndCursor: "page3", HasNextPage: true},
+		"page3": {Posts: testPosts(now.Add(-4 * time.Minute))},
+	})
+
+	acts, errs := streamPosts(newTestSourcePosts(server, "top", 3), nil)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	// The limit stops the paging before the last page.
+	if len(acts) != 4 {
+		t.Errorf("expected 4 posts from the first 2 pages, got %d", len(acts))
+	}
+	if strings.Join(*cursors, ",") != ",page2" {
+		t.Errorf("expected the pages to be requested by cursor, got %q", *cursors)
+	}
+}
+
+func TestSourcePosts_SinceCutoff(t *testing.T) {
+	now := time.Now()
+	server, cursors := newTestPostsServer(t, map[string]PostsPage{
+		"":      {Posts: testPosts(now, now.Add(-time.Hour), now.Add(-3*time.Hour)), EndCursor: "page2", HasNextPage: true},
+		"page2": {Posts: testPosts(now.Add(-4 * time.Hour))},
+	})
+
+	since := &Post{Product: &PostNode{CreatedAt: now.Add(-2 * time.Hour)}}
+	acts, errs := streamPosts(newTestSourcePosts(server, "new", 0), since)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	if len(acts) != 2 {
+		t.Errorf("expected the 2 posts created after since, got %d", len(acts))
+	}
+	if len(*cursors) != 1 {
+		t.Errorf("expected no requests after reaching since, got %q", *cursors)
+	}
+}
+
+func TestSourcePosts_UID(t *testing.T) {
+	tests := []struct {
+		name   string
+		source SourcePosts
+		want   string
+	}{
+		{name: "defaults", source: SourcePosts{FeedName: "top"}, want: "producthuntposts:top"},
+		{name: "default limit", source: SourcePosts{FeedName: "top", TimePeriod: "today", Limit: defaultPostsLimit}, want: "producthuntposts:top"},
+		{name: "period", source: SourcePosts{FeedName: "top", TimePeriod: "week"}, want: "producthuntposts:top:week"},
+		{name: "limit", source: SourcePosts{FeedName: "top", Limit: 200}, want: "producthuntposts:top:today:200"},
+	}
+
+	fetcher := NewPostsFetcher(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid := tt.source.UID()
+			if uid.String() != tt.want {
+				t.Fatalf("expected UID %s, got %s", tt.want, uid)
+			}
+
+			parsed, err := lib.NewTypedUIDFromString(uid.String())
+			if err != nil {
+				t.Fatalf("parse UID: %v", err)
+			}
+			found, err := fetcher.FindByID(context.Background(), parsed, nil)
+			if err != nil || found.UID().String() != tt.want {
+				t.Errorf("expected the source to be found by UID, got %v (%v)", found, err)
+			}
+		})
+	}
+}
@@ -183,8 +183,8 @@ func (f *SubredditFetcher) FindByID(ctx context.Context, id types2.TypedUID, con
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *SubredditFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *SubredditFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	// TODO(sources): Support searching custom subreddits
 	// Ignore the query, since the set of all available sources is small
-	return popularSubredditSources, nil
+	return types.FilterByTopics(popularSubredditSources, topicsHint), nil
 }
@@ -0,0 +1,97 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/sync/singleflight"
+)
+
+// subredditRSSCacheTTL is how long the fetched subreddit RSS feeds are reused.
+// Much shorter than the polling interval, so that the posts are still fresh.
+const subredditRSSCacheTTL = 15 * time.Minute
+
+// subredditRSSFetchTimeout bounds the shared fetch, since it isn't canceled with the context of the first caller.
+const subredditRSSFetchTimeout = time.Minute
+
+// subredditRSSFeeds is shared by all the subreddit sources, since the RSS feed doesn't depend on the sort and period,
+// i.e. the sort variants of the same subreddit (e.g. hot and new r/golang) fetch exactly the same posts.
+var subredditRSSFeeds = newSubredditRSSCache("https://www.reddit.com")
+
+type subredditRSSEntry struct {
+	feed      *gofeed.Feed
+	fetchedAt time.Time
+}
+
+// subredditRSSCache deduplicates the RSS feed fetches of the sources covering the same subreddit.
+type subredditRSSCache struct {
+	baseURL string
+	mu      sync.Mutex
+	entries map[string]subredditRSSEntry
+	group   singleflight.Group
+}
+
+func newSubredditRSSCache(baseURL string) *subredditRSSCache {
+	return &subredditRSSCache{
+		baseURL: baseURL,
+		entries: make(map[string]subredditRSSEntry),
+	}
+}
+
+// Get returns the recently fetched RSS feed of the subreddit, or fetches it once for all the concurrent callers.
+// The returned feed is shared, and must not be modified.
+func (c *subredditRSSCache) Get(ctx context.Context, subreddit string) (*gofeed.Feed, error) {
+	key := strings.ToLower(subreddit)
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && time.Since(entry.fetchedAt) < subredditRSSCacheTTL {
+		return entry.feed, nil
+	}
+
+	// The fetch is shared by the concurrent callers, so it must not fail when the first caller is canceled (e.g. its source was removed).
+	// Each caller stops waiting for it once its own context is canceled.
+	result := c.group.DoChan(key, func() (any, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), subredditRSSFetchTimeout)
+		defer cancel()
+
+		parser := gofeed.NewParser()
+		parser.UserAgent = lib.DefeedUserAgentString
+		parser.Client = lib.NewProviderHTTPClient(Provider, 0)
+
+		feed, err := parser.ParseURLWithContext(fmt.Sprintf("%s/r/%s.rss", c.baseURL, subreddit), fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		if feed == nil {
+			return nil, fmt.Errorf("feed is nil")
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for k, e := range c.entries {
+			if time.Since(e.fetchedAt) >= subredditRSSCacheTTL {
+				delete(c.entries, k)
+			}
+		}
+		c.entries[key] = subredditRSSEntry{feed: feed, fetchedAt: time.Now()}
+
+		return feed, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*gofeed.Feed), nil
+	}
+}
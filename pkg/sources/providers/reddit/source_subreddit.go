@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"html"
+	"regexp"
 	"strings"
 	"time"
 
@@ -13,13 +14,15 @@ import (
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 	"github.com/defeedco/defeed/pkg/sources/providers"
 	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
-	"github.com/mmcdole/gofeed"
 	"github.com/rs/zerolog"
 	"github.com/vartanbeno/go-reddit/v2/reddit"
 )
 
 const TypeRedditSubreddit = "redditsubreddit"
 
+// Provider identifies the Reddit API requests for the rate limiter (see lib.NewProviderHTTPClient).
+const Provider = "reddit"
+
 type SourceSubreddit struct {
 	Subreddit        string `json:"subreddit" validate:"required"`
 	SubredditSummary string `json:"subredditSummary"`
@@ -28,6 +31,10 @@ type SourceSubreddit struct {
 	Search           string `json:"search"`
 	client           *reddit.Client
 	logger           *zerolog.Logger
+	textFallbacks    lib.TextFallbacks
+	excludedFlags    map[activitytypes.ContentFlag]bool
+	// initialBackfill is how far back the posts are fetched on the first fetch.
+	initialBackfill time.Duration
 }
 
 func NewSourceSubreddit() *SourceSubreddit {
@@ -147,6 +154,8 @@ func (p *Post) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UID doesn't depend on the source sort and period, so the posts of the overlapping sources (e.g. hot and new r/golang)
+// are stored once, and their source UIDs are merged on upsert.
 func (p *Post) UID() activitytypes.TypedUID {
 	return lib.NewTypedUID(p.SourceTyp, p.Post.ID)
 }
@@ -198,6 +207,17 @@ func (p *Post) AmplificationCount() int {
 	return -1
 }
 
+func (p *Post) ContentFlags() []activitytypes.ContentFlag {
+	var flags []activitytypes.ContentFlag
+	if p.Post.NSFW {
+		flags = append(flags, activitytypes.ContentFlagNSFW)
+	}
+	if p.Post.Spoiler {
+		flags = append(flags, activitytypes.ContentFlagSpoiler)
+	}
+	return flags
+}
+
 func (p *Post) SocialScore() float64 {
 	score := float64(p.UpvotesCount())
 	comments := float64(p.CommentsCount())
@@ -220,9 +240,9 @@ func (s *SourceSubreddit) Initialize(logger *zerolog.Logger, config *sourcetypes
 		client, err = reddit.NewClient(reddit.Credentials{
 			ID:     config.RedditClientID,
 			Secret: config.RedditClientSecret,
-		})
+		}, reddit.WithHTTPClient(lib.NewProviderHTTPClient(Provider, 0)))
 	} else {
-		client, err = reddit.NewReadonlyClient()
+		client, err = reddit.NewReadonlyClient(reddit.WithHTTPClient(lib.NewProviderHTTPClient(Provider, 0)))
 	}
 
 	if err != nil {
@@ -232,6 +252,9 @@ func (s *SourceSubreddit) Initialize(logger *zerolog.Logger, config *sourcetypes
 	s.client = client
 
 	s.logger = logger
+	s.textFallbacks = config.ArticleTextFallbacks()
+	s.excludedFlags = config.ExcludedContentFlags()
+	s.initialBackfill = config.InitialBackfillPeriod
 
 	return nil
 }
@@ -246,23 +269,21 @@ func (s *SourceSubreddit) Stream(ctx context.Context, since activitytypes.Activi
 	}
 }
 
-func (s *SourceSubreddit) fetchSubredditPostsWithRSS(ctx context.Context, _ activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
-	parser := gofeed.NewParser()
-	parser.UserAgent = lib.DefeedUserAgentString
-
-	rssFeed, err := parser.ParseURLWithContext(fmt.Sprintf("https://www.reddit.com/r/%s.rss", s.Subreddit), ctx)
+func (s *SourceSubreddit) fetchSubredditPostsWithRSS(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
+	rssFeed, err := subredditRSSFeeds.Get(ctx, s.Subreddit)
 	if err != nil {
 		errs <- fmt.Errorf("fetch rss feed: %w", err)
 		return
 	}
 
-	if rssFeed == nil {
-		errs <- fmt.Errorf("feed is nil")
+	if len(rssFeed.Items) == 0 {
 		return
 	}
 
-	if len(rssFeed.Items) == 0 {
-		return
+	// The RSS feed only has the latest page of posts, so the initial backfill period can only narrow it down.
+	var initialSince time.Time
+	if since == nil {
+		initialSince = sourcetypes.InitialSince(ctx, s.initialBackfill)
 	}
 
 	for _, item := range rssFeed.Items {
@@ -270,8 +291,14 @@ func (s *SourceSubreddit) fetchSubredditPostsWithRSS(ctx context.Context, _ acti
 			s.logger.Warn().Msgf("skipping item with no published date: %+v", item)
 			continue
 		}
+		if item.PublishedParsed.Before(initialSince) {
+			continue
+		}
 
 		postID := strings.TrimPrefix(item.GUID, "t3_")
+		if postID == "" {
+			postID = postIDFromURL(item.Link)
+		}
 
 		feedItem := &Post{
 			Post: &reddit.Post{
@@ -295,6 +322,17 @@ func (s *SourceSubreddit) fetchSubredditPostsWithRSS(ctx context.Context, _ acti
 	}
 }
 
+var postIDPattern = regexp.MustCompile(`/comments/([a-z0-9]+)`)
+
+// postIDFromURL extracts the post ID from the post permalink.
+// Falls back to the canonical URL, so that the URL variants of the same post result in the same ID.
+func postIDFromURL(url string) string {
+	if match := postIDPattern.FindStringSubmatch(url); match != nil {
+		return match[1]
+	}
+	return lib.CanonicalURL(url)
+}
+
 func (s *SourceSubreddit) fetchSubredditPosts(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
 	event := s.logger.With().
 		Str("subreddit", s.Subreddit).
@@ -309,7 +347,7 @@ func (s *SourceSubreddit) fetchSubredditPosts(ctx context.Context, since activit
 	} else {
 		event.Debug().Msg("Fetching recent posts")
 		// If this is the first time we're fetching posts,
-		// only fetch the last few posts to avoid retrieving all historic posts.
+		// only fetch the posts within the initial backfill period to avoid retrieving all historic posts.
 		s.fetchRecentPosts(ctx, feed, errs)
 		return
 	}
@@ -334,11 +372,7 @@ outer:
 
 		for _, post := range redditPosts {
 			// Skip pineed posts
-			if post.Stickied {
-				continue
-			}
-			// Skip NSFW posts to avoid missuse or legal issues
-			if post.NSFW {
+			if post.Stickied || s.isExcluded(post) {
 				continue
 			}
 			builtPost, err := s.buildPost(ctx, post)
@@ -353,23 +387,76 @@ outer:
 	}
 }
 
+const (
+	// initialBackfillMaxPages bounds the pages fetched on the first fetch,
+	// since the hot, top and rising listings aren't ordered chronologically.
+	initialBackfillMaxPages = 4
+	// initialBackfillMaxPosts bounds the posts emitted on the first fetch,
+	// so that adding an active subreddit doesn't flood the feed (and the summarizer).
+	initialBackfillMaxPosts = 50
+)
+
 func (s *SourceSubreddit) fetchRecentPosts(ctx context.Context, feed chan<- activitytypes.Activity, errs chan<- error) {
-	redditPosts, _, err := s.fetchByCurrentTimeline(ctx, &reddit.ListOptions{
-		Limit: 10,
-	})
-	if err != nil {
-		errs <- fmt.Errorf("fetch posts: %v", err)
-		return
-	}
+	initialSince := sourcetypes.InitialSince(ctx, s.initialBackfill)
 
-	for _, post := range redditPosts {
-		builtPost, err := s.buildPost(ctx, post)
+	after := ""
+	emitted := 0
+	for page := 0; page < initialBackfillMaxPages; page++ {
+		redditPosts, _, err := s.fetchByCurrentTimeline(ctx, &reddit.ListOptions{
+			Limit: 25,
+			After: after,
+		})
 		if err != nil {
-			errs <- fmt.Errorf("build post: %v", err)
+			errs <- fmt.Errorf("fetch posts: %v", err)
 			return
 		}
-		feed <- builtPost
+
+		if len(redditPosts) == 0 {
+			return
+		}
+
+		inPeriod := 0
+		for _, post := range redditPosts {
+			if post.Stickied || (post.Created != nil && post.Created.Before(initialSince)) {
+				continue
+			}
+			inPeriod++
+			if s.isExcluded(post) {
+				continue
+			}
+
+			builtPost, err := s.buildPost(ctx, post)
+			if err != nil {
+				errs <- fmt.Errorf("build post: %v", err)
+				return
+			}
+			feed <- builtPost
+
+			emitted++
+			if emitted >= initialBackfillMaxPosts {
+				return
+			}
+		}
+
+		// Stop once a whole page is older than the initial backfill period,
+		// or right away if the period is disabled (only the latest page is fetched).
+		if inPeriod == 0 || initialSince.IsZero() {
+			return
+		}
+
+		after = redditPosts[len(redditPosts)-1].FullID
+	}
+}
+
+// isExcluded reports whether the post is excluded by the content policy,
+// so that its external content isn't fetched only to be dropped by the scheduler.
+func (s *SourceSubreddit) isExcluded(post *reddit.Post) bool {
+	for _, flag := range (&Post{Post: post}).ContentFlags() {
+		if s.excludedFlags[flag] {
+			return true
+		}
 	}
+	return false
 }
 
 func (s *SourceSubreddit) buildPost(ctx context.Context, post *reddit.Post) (*Post, error) {
@@ -377,10 +464,10 @@ func (s *SourceSubreddit) buildPost(ctx context.Context, post *reddit.Post) (*Po
 
 	// Note: self post is a post that doesn't link outside of reddit.com
 	if post.URL != "" && !post.IsSelfPost {
-		content, err := lib.FetchTextFromURL(ctx, s.logger, post.URL)
+		content, err := lib.FetchTextFromURL(ctx, s.logger, post.URL, s.textFallbacks)
 
-		// It's okay to skip unsupported content types (e.g. images)
-		if err != nil && !errors.Is(err, lib.ErrUnsupportedContentType) {
+		// It's okay to skip unsupported content types (e.g. images) and domains excluded by the operator
+		if err != nil && !errors.Is(err, lib.ErrUnsupportedContentType) && !errors.Is(err, lib.ErrDisallowedDomain) {
 			return nil, fmt.Errorf("fetch external content: %w", err)
 		}
 
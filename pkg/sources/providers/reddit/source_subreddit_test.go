@@ -0,0 +1,257 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/rs/zerolog"
+	"github.com/vartanbeno/go-reddit/v2/reddit"
+)
+
+const subredditRSSTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+<channel>
+<title>r/golang</title>
+<item>
+<title>Go 1.30 is released</title>
+<link>https://www.reddit.com/r/golang/comments/abc123/go_130_is_released/</link>
+<guid>t3_abc123</guid>
+<pubDate>%s</pubDate>
+</item>
+</channel>
+</rss>`
+
+func TestSourceSubreddit_SortVariantsShareActivities(t *testing.T) {
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, subredditRSSTemplate, time.Now().Add(-time.Hour).Format(time.RFC1123Z))
+	}))
+	defer server.Close()
+
+	original := subredditRSSFeeds
+	subredditRSSFeeds = newSubredditRSSCache(server.URL)
+	t.Cleanup(func() { subredditRSSFeeds = original })
+
+	logger := zerolog.Nop()
+	hot := &SourceSubreddit{Subreddit: "golang", SortBy: "hot", TopPeriod: "day", logger: &logger}
+	latest := &SourceSubreddit{Subreddit: "golang", SortBy: "new", TopPeriod: "day", logger: &logger}
+
+	hotPosts := streamPosts(t, hot)
+	newPosts := streamPosts(t, latest)
+
+	if fetches.Load() != 1 {
+		t.Errorf("expected the sort variants to share a single fetch, got %d", fetches.Load())
+	}
+	if len(hotPosts) != 1 || len(newPosts) != 1 {
+		t.Fatalf("expected a post from each source, got %d and %d", len(hotPosts), len(newPosts))
+	}
+
+	// The same activity is emitted by both sources, so it's stored once with both source UIDs (see ActivityRepository.Upsert).
+	if hotPosts[0].UID().String() != newPosts[0].UID().String() {
+		t.Errorf("expected the same activity UID, got %s and %s", hotPosts[0].UID(), newPosts[0].UID())
+	}
+	if hotPosts[0].UID().String() != "redditsubreddit:abc123" {
+		t.Errorf("expected sort independent activity UID, got %s", hotPosts[0].UID())
+	}
+	if hotPosts[0].SourceUIDs()[0].String() == newPosts[0].SourceUIDs()[0].String() {
+		t.Errorf("expected each activity to reference its own source, got %s", hotPosts[0].SourceUIDs()[0])
+	}
+}
+
+func TestSubredditRSSCache_CanceledCaller(t *testing.T) {
+	var fetches atomic.Int32
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		started <- struct{}{}
+		<-release
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, subredditRSSTemplate, time.Now().Format(time.RFC1123Z))
+	}))
+	defer server.Close()
+	cache := newSubredditRSSCache(server.URL)
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error, 1)
+	go func() {
+		_, err := cache.Get(canceledCtx, "golang")
+		canceled <- err
+	}()
+
+	// Wait for the first caller to start the shared fetch, then join it with a second caller.
+	<-started
+	joined := make(chan error, 1)
+	go func() {
+		_, err := cache.Get(context.Background(), "golang")
+		joined <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	if err := <-canceled; err == nil {
+		t.Error("expected the canceled caller to stop waiting")
+	}
+
+	close(release)
+	if err := <-joined; err != nil {
+		t.Errorf("expected the shared fetch to outlive the canceled caller, got %v", err)
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("expected a single shared fetch, got %d", fetches.Load())
+	}
+}
+
+func streamPosts(t *testing.T, source *SourceSubreddit) []activitytypes.Activity {
+	t.Helper()
+
+	feed := make(chan activitytypes.Activity, 10)
+	errs := make(chan error, 10)
+	source.Stream(context.Background(), nil, feed, errs)
+	close(feed)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out []activitytypes.Activity
+	for act := range feed {
+		out = append(out, act)
+	}
+	return out
+}
+
+func TestSourceSubreddit_InitialBackfillCap(t *testing.T) {
+	var pages atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := pages.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(subredditListing(int(page), 25, time.Now().Add(-time.Hour)))
+	}))
+	defer server.Close()
+
+	posts := fetchRecentPosts(t, server.URL, 7*24*time.Hour)
+
+	if len(posts) != initialBackfillMaxPosts {
+		t.Errorf("expected %d posts, got %d", initialBackfillMaxPosts, len(posts))
+	}
+	if pages.Load() != 2 {
+		t.Errorf("expected the fetch to stop once the cap is reached, got %d pages", pages.Load())
+	}
+}
+
+func TestSourceSubreddit_InitialBackfillPeriod(t *testing.T) {
+	var pages atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := pages.Add(1)
+		created := time.Now().Add(-time.Hour)
+		if page > 1 {
+			created = time.Now().Add(-30 * 24 * time.Hour)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(subredditListing(int(page), 10, created))
+	}))
+	defer server.Close()
+
+	posts := fetchRecentPosts(t, server.URL, 7*24*time.Hour)
+
+	if len(posts) != 10 {
+		t.Errorf("expected only the posts within the period, got %d", len(posts))
+	}
+	if pages.Load() != 2 {
+		t.Errorf("expected the fetch to stop at the first page outside the period, got %d pages", pages.Load())
+	}
+}
+
+func TestSourceSubreddit_InitialBackfillRSS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, subredditRSSTemplate, time.Now().Add(-30*24*time.Hour).Format(time.RFC1123Z))
+	}))
+	defer server.Close()
+
+	original := subredditRSSFeeds
+	subredditRSSFeeds = newSubredditRSSCache(server.URL)
+	t.Cleanup(func() { subredditRSSFeeds = original })
+
+	logger := zerolog.Nop()
+	source := &SourceSubreddit{Subreddit: "golang", SortBy: "hot", logger: &logger, initialBackfill: 7 * 24 * time.Hour}
+	if posts := streamPosts(t, source); len(posts) != 0 {
+		t.Errorf("expected the posts older than the initial backfill period to be skipped, got %d", len(posts))
+	}
+
+	source = &SourceSubreddit{Subreddit: "golang", SortBy: "new", logger: &logger}
+	if posts := streamPosts(t, source); len(posts) != 1 {
+		t.Errorf("expected the whole feed without the initial backfill period, got %d", len(posts))
+	}
+}
+
+func fetchRecentPosts(t *testing.T, baseURL string, initialBackfill time.Duration) []activitytypes.Activity {
+	t.Helper()
+
+	client, err := reddit.NewReadonlyClient(reddit.WithBaseURL(baseURL))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	logger := zerolog.Nop()
+	source := &SourceSubreddit{
+		Subreddit:       "golang",
+		SortBy:          "hot",
+		client:          client,
+		logger:          &logger,
+		initialBackfill: initialBackfill,
+	}
+
+	feed := make(chan activitytypes.Activity, 100)
+	errs := make(chan error, 10)
+	source.fetchRecentPosts(context.Background(), feed, errs)
+	close(feed)
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out []activitytypes.Activity
+	for act := range feed {
+		out = append(out, act)
+	}
+	return out
+}
+
+// subredditListing returns a page of self posts in the Reddit API listing format.
+func subredditListing(page int, count int, created time.Time) []byte {
+	children := make([]map[string]any, 0, count)
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("p%di%d", page, i)
+		children = append(children, map[string]any{
+			"kind": "t3",
+			"data": map[string]any{
+				"id":          id,
+				"name":        "t3_" + id,
+				"title":       "Post " + id,
+				"is_self":     true,
+				"created_utc": float64(created.Unix()),
+			},
+		})
+	}
+
+	body, _ := json.Marshal(map[string]any{
+		"kind": "Listing",
+		"data": map[string]any{
+			"after":    fmt.Sprintf("t3_p%di%d", page, count-1),
+			"children": children,
+		},
+	})
+	return body
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 
@@ -30,7 +35,7 @@ type FeedFetcher struct {
 	Logger *zerolog.Logger
 }
 
-func NewFeedFetcher(logger *zerolog.Logger) *FeedFetcher {
+func NewFeedFetcher(logger *zerolog.Logger, config *types.ProviderConfig) *FeedFetcher {
 	var faviconMap map[string]string
 	err := json.Unmarshal([]byte(faviconMapJSON), &faviconMap)
 	if err != nil {
@@ -38,7 +43,7 @@ func NewFeedFetcher(logger *zerolog.Logger) *FeedFetcher {
 		return nil
 	}
 
-	feeds, err := loadOPMLSources(logger, faviconMap)
+	feeds, err := loadOPMLSources(logger, config, faviconMap)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("load OPML sources")
 		return nil
@@ -63,87 +68,180 @@ func (f *FeedFetcher) FindByID(ctx context.Context, id activitytypes.TypedUID, c
 	return nil, fmt.Errorf("source not found")
 }
 
-func (f *FeedFetcher) Search(ctx context.Context, query string, config *types.ProviderConfig) ([]types.Source, error) {
+func (f *FeedFetcher) Search(ctx context.Context, query string, topicsHint []types.TopicTag, config *types.ProviderConfig) ([]types.Source, error) {
 	// TODO(sources): Support adding custom feed URL?
 	// Ignore the query, since the set of all available sources is small
-	return f.Feeds, nil
+	return types.FilterByTopics(f.Feeds, topicsHint), nil
 }
 
-func loadOPMLSources(logger *zerolog.Logger, faviconMap map[string]string) ([]types.Source, error) {
-	opml, err := lib.ParseOPML(feedsOPML)
-	if err != nil {
-		return nil, fmt.Errorf("parse OPML: %w", err)
+// loadOPMLSources loads the preset sources from the embedded OPML and the configured OPML files/URLs.
+// Sources from multiple OPML documents are merged, the first occurrence of the same feed wins.
+func loadOPMLSources(logger *zerolog.Logger, config *types.ProviderConfig, faviconMap map[string]string) ([]types.Source, error) {
+	var documents []string
+	if config.RSSPresetIncludeEmbedded {
+		documents = append(documents, feedsOPML)
 	}
 
-	opmlSources, err := opmlToRSSSources(logger, opml, faviconMap)
-	if err != nil {
-		return nil, fmt.Errorf("convert OPML to RSS sources: %w", err)
+	for _, location := range strings.Split(config.RSSPresetOPML, ",") {
+		location = strings.TrimSpace(location)
+		if location == "" {
+			continue
+		}
+
+		data, err := readOPML(logger, location)
+		if err != nil {
+			logger.Warn().Err(err).
+				Str("location", location).
+				Msg("skipping OPML presets")
+			continue
+		}
+		documents = append(documents, data)
+	}
+
+	var result []types.Source
+	seen := make(map[string]bool)
+	for _, document := range documents {
+		opml, err := lib.ParseOPML(document)
+		if err != nil {
+			logger.Warn().Err(err).Msg("skipping invalid OPML presets")
+			continue
+		}
+
+		for _, source := range opmlToRSSSources(logger, opml.Body.Outlines, faviconMap) {
+			if seen[source.UID().String()] {
+				continue
+			}
+			seen[source.UID().String()] = true
+
+			result = append(result, source)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, errors.New("no OPML presets loaded")
 	}
 
 	logger.Info().
-		Int("count", len(opmlSources)).
+		Int("count", len(result)).
+		Int("documents", len(documents)).
 		Msg("loaded OPML RSS sources")
 
-	return opmlSources, nil
+	return result, nil
+}
+
+// readOPML reads the OPML document from a local file path or a http(s) URL.
+func readOPML(logger *zerolog.Logger, location string) (string, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		data, err := os.ReadFile(location)
+		if err != nil {
+			return "", fmt.Errorf("read file: %w", err)
+		}
+		return string(data), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := lib.FetchURL(ctx, logger, location)
+	if err != nil {
+		return "", fmt.Errorf("fetch url: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read response body: %w", err)
+	}
+
+	return string(data), nil
 }
 
-func opmlToRSSSources(logger *zerolog.Logger, opml *lib.OPML, faviconMap map[string]string) ([]types.Source, error) {
+// opmlToRSSSources converts the (possibly nested) OPML outlines to RSS sources.
+// Malformed outlines are skipped, so that a single invalid entry doesn't prevent loading the rest.
+func opmlToRSSSources(logger *zerolog.Logger, outlines []lib.OPMLOutline, faviconMap map[string]string) []types.Source {
 	var result []types.Source
 
-	seen := make(map[string]bool)
-	for _, category := range opml.Body.Outlines {
-		for _, outline := range category.Outlines {
-			if outline.Type != "rss" {
-				return nil, fmt.Errorf("invalid outline type: %s", outline.Type)
+	for _, outline := range outlines {
+		// Outlines without the feed URL are categories
+		if outline.XMLUrl == "" {
+			if len(outline.Outlines) == 0 {
+				logger.Warn().
+					Str("outline", outline.Text).
+					Msg("skipping OPML outline without url")
 			}
+			result = append(result, opmlToRSSSources(logger, outline.Outlines, faviconMap)...)
+			continue
+		}
 
-			if outline.XMLUrl == "" {
-				return nil, fmt.Errorf("outline missing url: %s", outline.Text)
-			}
+		if outline.Type != "" && outline.Type != "rss" && outline.Type != "atom" {
+			logger.Warn().
+				Str("outline", outline.Text).
+				Str("type", outline.Type).
+				Msg("skipping OPML outline with invalid type")
+			continue
+		}
 
-			source := &SourceFeed{
-				title:       outline.Title,
-				FeedURL:     outline.XMLUrl,
-				description: outline.Text,
-				IconURL:     outline.FaviconUrl,
-				topics:      []types.TopicTag{},
-			}
+		source := &SourceFeed{
+			title:       outline.Title,
+			FeedURL:     outline.XMLUrl,
+			description: outline.Text,
+			IconURL:     outline.FaviconUrl,
+			topics:      []types.TopicTag{},
+		}
 
-			if source.IconURL == "" {
-				hostName, err := lib.StripURLHost(outline.XMLUrl)
-				if err != nil {
-					logger.Error().Err(err).
-						Str("url", outline.XMLUrl).
-						Msg("invalid url")
-					continue
-				}
-				if faviconURL, ok := faviconMap[hostName]; ok {
-					source.IconURL = faviconURL
-				}
-			}
+		if source.title == "" {
+			source.title = outline.Text
+		}
 
-			if outline.Topics != "" {
-				topicStrings := strings.Split(outline.Topics, ",")
-				var topicTags []types.TopicTag
-				for _, topicStr := range topicStrings {
-					tag, ok := types.WordToTopic(topicStr)
-					if !ok {
-						return nil, fmt.Errorf("invalid topic: %s", topicStr)
-					}
-
-					topicTags = append(topicTags, tag)
-				}
-				source.topics = topicTags
-			}
+		if err := lib.ValidateStruct(source); err != nil {
+			logger.Warn().Err(err).
+				Str("url", outline.XMLUrl).
+				Msg("skipping invalid OPML outline")
+			continue
+		}
 
-			if seen[source.UID().String()] {
+		if source.IconURL == "" {
+			hostName, err := lib.StripURLHost(outline.XMLUrl)
+			if err != nil {
+				logger.Warn().Err(err).
+					Str("url", outline.XMLUrl).
+					Msg("skipping OPML outline with invalid url")
 				continue
 			}
-			seen[source.UID().String()] = true
+			if faviconURL, ok := faviconMap[hostName]; ok {
+				source.IconURL = faviconURL
+			}
+		}
 
-			result = append(result, source)
+		if outline.Topics != "" {
+			topicTags, err := parseOPMLTopics(outline.Topics)
+			if err != nil {
+				logger.Warn().Err(err).
+					Str("url", outline.XMLUrl).
+					Msg("skipping OPML outline with invalid topics")
+				continue
+			}
+			source.topics = topicTags
 		}
+
+		result = append(result, source)
 	}
 
-	return result, nil
+	return result
+}
+
+func parseOPMLTopics(in string) ([]types.TopicTag, error) {
+	var out []types.TopicTag
+	for _, topicStr := range strings.Split(in, ",") {
+		tag, ok := types.WordToTopic(topicStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid topic: %s", topicStr)
+		}
+		out = append(out, tag)
+	}
+	return out, nil
 }
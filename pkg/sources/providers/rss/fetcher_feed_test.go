@@ -0,0 +1,91 @@
+package rss
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+const testOPML = `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Test</title></head>
+  <body>
+    <outline text="Category">
+      <outline type="rss" text="Valid" title="Valid" xmlUrl="https://example.com/feed.xml"/>
+      <outline type="rss" text="Duplicate" title="Duplicate" xmlUrl="https://example.com/feed.xml"/>
+      <outline type="rss" text="Invalid URL" title="Invalid URL" xmlUrl="not a url"/>
+      <outline type="rss" text="Invalid topic" title="Invalid topic" xmlUrl="https://example.org/feed.xml" topics="not-a-topic"/>
+      <outline type="link" text="Invalid type" title="Invalid type" xmlUrl="https://example.net/feed.xml"/>
+      <outline text="Empty"/>
+    </outline>
+    <outline type="rss" text="Top level" xmlUrl="https://blog.example.com/rss"/>
+  </body>
+</opml>`
+
+func TestLoadOPMLSources(t *testing.T) {
+	logger := zerolog.Nop()
+
+	path := filepath.Join(t.TempDir(), "presets.opml")
+	if err := os.WriteFile(path, []byte(testOPML), 0o600); err != nil {
+		t.Fatalf("write OPML: %v", err)
+	}
+
+	sources, err := loadOPMLSources(&logger, &types.ProviderConfig{
+		RSSPresetOPML: path + ", " + filepath.Join(t.TempDir(), "missing.opml"),
+	}, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"https://example.com/feed.xml", "https://blog.example.com/rss"}
+	if len(sources) != len(want) {
+		t.Fatalf("expected %d sources, got %d", len(want), len(sources))
+	}
+	for i, source := range sources {
+		if got := source.(*SourceFeed).FeedURL; got != want[i] {
+			t.Errorf("expected source %d to be %s, got %s", i, want[i], got)
+		}
+	}
+
+	if sources[1].Name() != "Top level" {
+		t.Errorf("expected title to fall back to outline text, got %q", sources[1].Name())
+	}
+}
+
+func TestLoadOPMLSources_MergesEmbedded(t *testing.T) {
+	logger := zerolog.Nop()
+
+	embedded, err := loadOPMLSources(&logger, &types.ProviderConfig{RSSPresetIncludeEmbedded: true}, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "presets.opml")
+	if err := os.WriteFile(path, []byte(testOPML), 0o600); err != nil {
+		t.Fatalf("write OPML: %v", err)
+	}
+
+	merged, err := loadOPMLSources(&logger, &types.ProviderConfig{
+		RSSPresetIncludeEmbedded: true,
+		RSSPresetOPML:            path,
+	}, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(merged) != len(embedded)+2 {
+		t.Errorf("expected %d merged sources, got %d", len(embedded)+2, len(merged))
+	}
+}
+
+func TestLoadOPMLSources_NoSources(t *testing.T) {
+	logger := zerolog.Nop()
+
+	_, err := loadOPMLSources(&logger, &types.ProviderConfig{}, map[string]string{})
+	if err == nil {
+		t.Error("expected error when no presets are loaded")
+	}
+}
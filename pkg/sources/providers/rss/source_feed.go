@@ -38,9 +38,15 @@ type SourceFeed struct {
 	description string
 	topics      []sourcetypes.TopicTag
 	FeedURL     string            `json:"url" validate:"required,url"`
-	Headers     map[string]string `json:"headers"`
+	Headers     map[string]string `json:"headers" secret:"true"`
 	IconURL     string            `json:"icon_url"`
 	logger      *zerolog.Logger
+	// minContentLength is the min body length of the items to be processed.
+	minContentLength int
+	// initialBackfill is how far back the items are processed on the first fetch.
+	initialBackfill time.Duration
+	// fetchThumbnails enables fetching thumbnails from the item pages if the feed doesn't provide them.
+	fetchThumbnails bool
 }
 
 func NewSourceFeed() *SourceFeed {
@@ -101,6 +107,9 @@ func (s *SourceFeed) Initialize(logger *zerolog.Logger, config *sourcetypes.Prov
 	}
 
 	s.logger = logger
+	s.minContentLength = config.RSSMinContentLength
+	s.initialBackfill = config.InitialBackfillPeriod
+	s.fetchThumbnails = config.RSSFetchThumbnails
 
 	return nil
 }
@@ -135,12 +144,13 @@ func (s *SourceFeed) Stream(ctx context.Context, since activitytypes.Activity, f
 func (s *SourceFeed) fetchAndSendNewItems(ctx context.Context, since activitytypes.Activity, feed chan<- activitytypes.Activity, errs chan<- error) {
 	parser := gofeed.NewParser()
 	parser.UserAgent = lib.DefeedUserAgentString
+	parser.Client = lib.NewHTTPClient(0)
 
 	if s.Headers != nil {
 		parser.Client = &http.Client{
 			Transport: &customTransport{
 				headers: s.Headers,
-				base:    http.DefaultTransport,
+				base:    parser.Client.Transport,
 			},
 		}
 	}
@@ -160,10 +170,7 @@ func (s *SourceFeed) fetchAndSendNewItems(ctx context.Context, since activitytyp
 		return
 	}
 
-	var sinceTime time.Time
-	if since != nil {
-		sinceTime = since.CreatedAt()
-	}
+	sinceTime := sourcetypes.SinceTime(ctx, since, s.initialBackfill)
 
 	for _, item := range rssFeed.Items {
 		if item.PublishedParsed == nil {
@@ -184,9 +191,20 @@ func (s *SourceFeed) fetchAndSendNewItems(ctx context.Context, since activitytyp
 			SourceIDs:    []activitytypes.TypedUID{s.UID()},
 		}
 
-		if item.Image != nil && item.Image.URL != "" {
-			feedItem.ThumbnailURL = item.Image.URL
-		} else {
+		if s.minContentLength > 0 {
+			if bodyLen := len([]rune(strings.TrimSpace(feedItem.Body()))); bodyLen < s.minContentLength {
+				s.logger.Debug().
+					Str("link", item.Link).
+					Int("body_length", bodyLen).
+					Int("min_content_length", s.minContentLength).
+					Msg("Skipping rss item with short content")
+				continue
+			}
+		}
+
+		if imageURL := feedImageURL(item); imageURL != "" {
+			feedItem.ThumbnailURL = imageURL
+		} else if s.fetchThumbnails {
 			thumbnailURL, err := lib.FetchThumbnailFromURL(ctx, s.logger, item.Link)
 			if err == nil {
 				feedItem.ThumbnailURL = thumbnailURL
@@ -201,6 +219,25 @@ func (s *SourceFeed) fetchAndSendNewItems(ctx context.Context, since activitytyp
 	}
 }
 
+// feedImageURL returns the image provided by the feed item (if any).
+func feedImageURL(item *gofeed.Item) string {
+	if item.Image != nil && item.Image.URL != "" {
+		return item.Image.URL
+	}
+
+	if thumbURL := findThumbnailInItemExtensions(item); thumbURL != "" {
+		return thumbURL
+	}
+
+	for _, enclosure := range item.Enclosures {
+		if enclosure != nil && enclosure.URL != "" && strings.HasPrefix(enclosure.Type, "image/") {
+			return enclosure.URL
+		}
+	}
+
+	return ""
+}
+
 type FeedItem struct {
 	Item         *gofeed.Item             `json:"item"`
 	FeedURL      string                   `json:"feed_url"`
@@ -249,7 +286,8 @@ func (e *FeedItem) UnmarshalJSON(data []byte) error {
 func (e *FeedItem) UID() activitytypes.TypedUID {
 	id := e.Item.GUID
 	if id == "" {
-		id = lib.StripURL(e.URL())
+		// URLs can vary between polls (e.g. tracking params), so make sure the same item gets the same UID.
+		id = lib.CanonicalURL(e.URL())
 	}
 	return lib.NewTypedUID(e.SourceTyp, id)
 }
@@ -321,6 +359,17 @@ func (e *FeedItem) CreatedAt() time.Time {
 	return time.Now()
 }
 
+// UpdatedAt returns the updated date of items edited after they were published.
+func (e *FeedItem) UpdatedAt() time.Time {
+	if e.Item.UpdatedParsed == nil || e.Item.PublishedParsed == nil {
+		return time.Time{}
+	}
+	if !e.Item.UpdatedParsed.After(*e.Item.PublishedParsed) {
+		return time.Time{}
+	}
+	return *e.Item.UpdatedParsed
+}
+
 func (e *FeedItem) UpvotesCount() int {
 	return -1
 }
@@ -351,6 +400,23 @@ func (e *FeedItem) Categories() []string {
 	return categories
 }
 
+// ContentFlags detects NSFW items from the "nsfw" category or the adult media rating (Media RSS).
+func (e *FeedItem) ContentFlags() []activitytypes.ContentFlag {
+	for _, category := range e.Item.Categories {
+		if strings.EqualFold(strings.TrimSpace(category), "nsfw") {
+			return []activitytypes.ContentFlag{activitytypes.ContentFlagNSFW}
+		}
+	}
+
+	for _, rating := range e.Item.Extensions["media"]["rating"] {
+		if strings.EqualFold(strings.TrimSpace(rating.Value), "adult") {
+			return []activitytypes.ContentFlag{activitytypes.ContentFlagNSFW}
+		}
+	}
+
+	return nil
+}
+
 func findThumbnailInItemExtensions(item *gofeed.Item) string {
 	media, ok := item.Extensions["media"]
 
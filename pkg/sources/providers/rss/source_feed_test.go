@@ -1,8 +1,20 @@
 package rss
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/extensions"
 	"github.com/rs/zerolog"
 )
 
@@ -54,3 +66,171 @@ func TestSourceFeed_fetchIcon(t *testing.T) {
 		})
 	}
 }
+
+func TestFeedItem_UID(t *testing.T) {
+	variants := []string{
+		"https://example.com/blog/post-1",
+		"http://www.example.com/blog/post-1/",
+		"https://EXAMPLE.com/blog/post-1?utm_source=rss&utm_medium=feed",
+		"https://example.com/blog/post-1#comments",
+	}
+
+	newItem := func(link string) *FeedItem {
+		return &FeedItem{
+			Item:      &gofeed.Item{Link: link},
+			FeedURL:   "https://example.com/feed.xml",
+			SourceTyp: TypeRSSFeed,
+		}
+	}
+
+	want := newItem(variants[0]).UID().String()
+	for _, link := range variants[1:] {
+		if got := newItem(link).UID().String(); got != want {
+			t.Errorf("expected UID %q for %q, got %q", want, link, got)
+		}
+	}
+
+	if got := newItem("https://example.com/blog/post-2").UID().String(); got == want {
+		t.Errorf("expected different UID for a different item, got %q", got)
+	}
+
+	withGUID := &FeedItem{Item: &gofeed.Item{GUID: "guid-1", Link: variants[0]}, SourceTyp: TypeRSSFeed}
+	if got := withGUID.UID().String(); got == want {
+		t.Errorf("expected GUID to take precedence over the URL, got %q", got)
+	}
+}
+
+func TestFeedItem_ContentFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		item *gofeed.Item
+		want bool
+	}{
+		{name: "no flags", item: &gofeed.Item{Categories: []string{"Go"}}, want: false},
+		{name: "nsfw category", item: &gofeed.Item{Categories: []string{"Go", " NSFW "}}, want: true},
+		{
+			name: "adult media rating",
+			item: &gofeed.Item{Extensions: ext.Extensions{"media": {"rating": {{Name: "rating", Value: "adult"}}}}},
+			want: true,
+		},
+		{
+			name: "nonadult media rating",
+			item: &gofeed.Item{Extensions: ext.Extensions{"media": {"rating": {{Name: "rating", Value: "nonadult"}}}}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := (&FeedItem{Item: tt.item}).ContentFlags()
+			got := len(flags) == 1 && flags[0] == activitytypes.ContentFlagNSFW
+			if got != tt.want {
+				t.Errorf("ContentFlags() = %v, want nsfw: %v", flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeedItem_UpdatedAt(t *testing.T) {
+	published := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := published.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		item *gofeed.Item
+		want time.Time
+	}{
+		{name: "no updated date", item: &gofeed.Item{PublishedParsed: &published}},
+		{name: "updated when published", item: &gofeed.Item{PublishedParsed: &published, UpdatedParsed: &published}},
+		{name: "updated only", item: &gofeed.Item{UpdatedParsed: &updated}},
+		{name: "edited", item: &gofeed.Item{PublishedParsed: &published, UpdatedParsed: &updated}, want: updated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&FeedItem{Item: tt.item}).UpdatedAt(); !got.Equal(tt.want) {
+				t.Errorf("UpdatedAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceFeed_InitialBackfill(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"><channel><title>Blog</title>
+<item><title>Recent</title><link>https://example.com/recent</link><pubDate>%s</pubDate></item>
+<item><title>Old</title><link>https://example.com/old</link><pubDate>%s</pubDate></item>
+</channel></rss>`,
+			time.Now().Add(-time.Hour).Format(time.RFC1123Z),
+			time.Now().Add(-30*24*time.Hour).Format(time.RFC1123Z))
+	}))
+	defer server.Close()
+
+	lastSeen := time.Now().Add(-60 * 24 * time.Hour)
+	tests := []struct {
+		name            string
+		since           activitytypes.Activity
+		initialBackfill time.Duration
+		want            int
+	}{
+		{name: "first fetch", initialBackfill: 7 * 24 * time.Hour, want: 1},
+		{name: "first fetch without backfill period", want: 2},
+		{name: "last seen activity", since: &FeedItem{Item: &gofeed.Item{PublishedParsed: &lastSeen}}, initialBackfill: 7 * 24 * time.Hour, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			source := &SourceFeed{FeedURL: server.URL, logger: &logger, initialBackfill: tt.initialBackfill}
+
+			feed := make(chan activitytypes.Activity, 10)
+			errs := make(chan error, 10)
+			source.Stream(context.Background(), tt.since, feed, errs)
+			close(feed)
+			close(errs)
+
+			for err := range errs {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(feed) != tt.want {
+				t.Errorf("expected %d items, got %d", tt.want, len(feed))
+			}
+		})
+	}
+}
+
+func TestSourceFeed_SecretHeaders(t *testing.T) {
+	cipher, err := lib.NewSecretCipher(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("create cipher: %v", err)
+	}
+
+	source := &SourceFeed{FeedURL: "https://example.com/feed.xml", Headers: map[string]string{"Authorization": "Bearer token"}}
+	sealed, err := cipher.SealSecrets(source)
+	if err != nil {
+		t.Fatalf("seal secrets: %v", err)
+	}
+	raw, err := json.Marshal(sealed)
+	if err != nil {
+		t.Fatalf("marshal source: %v", err)
+	}
+	if strings.Contains(string(raw), "Bearer token") {
+		t.Errorf("expected the headers to be encrypted, got %s", raw)
+	}
+	if source.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("expected the original headers to be kept, got %v", source.Headers)
+	}
+
+	opened := NewSourceFeed()
+	if err := opened.UnmarshalJSON(raw); err != nil {
+		t.Fatalf("unmarshal source: %v", err)
+	}
+	if err := cipher.OpenSecrets(opened); err != nil {
+		t.Fatalf("open secrets: %v", err)
+	}
+	if opened.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("expected the decrypted headers, got %v", opened.Headers)
+	}
+}
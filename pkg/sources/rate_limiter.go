@@ -0,0 +1,80 @@
+package sources
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources/providers/github"
+	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/lobsters"
+	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
+	"github.com/defeedco/defeed/pkg/sources/providers/producthunt"
+	"github.com/defeedco/defeed/pkg/sources/providers/reddit"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"golang.org/x/time/rate"
+)
+
+// ProviderRateLimiter is a token bucket rate limiter shared by all sources of the same provider.
+// Provider API limits usually apply per client/token, so independently scheduled sources
+// (e.g. many subreddits) must coordinate to not exceed the limit collectively.
+// A token is taken for each API request (see lib.NewProviderHTTPClient), since a single poll can page through many requests.
+type ProviderRateLimiter struct {
+	requestsPerMinute map[string]float64
+	// perHost providers are limited separately for each host (e.g. each Mastodon instance has its own limits).
+	perHost map[string]bool
+
+	mu           sync.Mutex
+	limiterByKey map[string]*rate.Limiter
+}
+
+func NewProviderRateLimiter(config *sourcetypes.ProviderConfig) *ProviderRateLimiter {
+	return &ProviderRateLimiter{
+		requestsPerMinute: map[string]float64{
+			github.Provider:      config.GithubRateLimit,
+			reddit.Provider:      config.RedditRateLimit,
+			mastodon.Provider:    config.MastodonRateLimit,
+			producthunt.Provider: config.ProductHuntRateLimit,
+			hackernews.Provider:  config.HackerNewsRateLimit,
+			lobsters.Provider:    config.LobstersRateLimit,
+		},
+		perHost: map[string]bool{
+			mastodon.Provider: true,
+		},
+		limiterByKey: make(map[string]*rate.Limiter),
+	}
+}
+
+// Wait blocks until a request to the provider host is allowed or the context is canceled.
+func (l *ProviderRateLimiter) Wait(ctx context.Context, provider string, host string) error {
+	limiter := l.limiter(provider, host)
+	if limiter == nil {
+		return nil
+	}
+
+	return limiter.Wait(ctx)
+}
+
+// limiter returns the bucket of the provider (or the provider host), or nil if the provider isn't limited.
+func (l *ProviderRateLimiter) limiter(provider string, host string) *rate.Limiter {
+	limit := l.requestsPerMinute[provider]
+	if limit <= 0 {
+		return nil
+	}
+
+	key := provider
+	if l.perHost[provider] {
+		key = provider + ":" + host
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiterByKey[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Every(time.Duration(float64(time.Minute)/limit)), 1)
+		l.limiterByKey[key] = limiter
+	}
+
+	return limiter
+}
@@ -0,0 +1,55 @@
+package sources
+
+import (
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/sources/providers/github"
+	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+)
+
+func TestProviderRateLimiter_Buckets(t *testing.T) {
+	limiter := NewProviderRateLimiter(&sourcetypes.ProviderConfig{
+		GithubRateLimit:     60,
+		MastodonRateLimit:   60,
+		HackerNewsRateLimit: 0,
+	})
+
+	if limiter.limiter(hackernews.Provider, "hacker-news.firebaseio.com") != nil {
+		t.Error("expected disabled provider not to be limited")
+	}
+	if limiter.limiter("unknown", "example.com") != nil {
+		t.Error("expected unknown provider not to be limited")
+	}
+
+	if limiter.limiter(github.Provider, "api.github.com") != limiter.limiter(github.Provider, "uploads.github.com") {
+		t.Error("expected a single bucket shared by all GitHub hosts")
+	}
+
+	mastodonSocial := limiter.limiter(mastodon.Provider, "mastodon.social")
+	if mastodonSocial != limiter.limiter(mastodon.Provider, "mastodon.social") {
+		t.Error("expected the same bucket for the same Mastodon instance")
+	}
+	if mastodonSocial == limiter.limiter(mastodon.Provider, "fosstodon.org") {
+		t.Error("expected separate buckets for different Mastodon instances")
+	}
+}
+
+func TestProviderRateLimiter_TokenPerRequest(t *testing.T) {
+	limiter := NewProviderRateLimiter(&sourcetypes.ProviderConfig{
+		MastodonRateLimit: 1,
+	})
+
+	// The burst allows a single request, the following requests to the same instance must wait.
+	bucket := limiter.limiter(mastodon.Provider, "mastodon.social")
+	if !bucket.Allow() {
+		t.Fatal("expected the first request to be allowed")
+	}
+	if bucket.Allow() {
+		t.Error("expected the second request to the same instance to be limited")
+	}
+	if !limiter.limiter(mastodon.Provider, "fosstodon.org").Allow() {
+		t.Error("expected a request to another instance to be allowed")
+	}
+}
@@ -5,17 +5,21 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"sort"
 
+	"github.com/defeedco/defeed/pkg/lib"
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 
 	"github.com/defeedco/defeed/pkg/sources/types"
 
 	"strings"
 
+	"github.com/defeedco/defeed/pkg/sources/providers/custom"
 	"github.com/defeedco/defeed/pkg/sources/providers/github"
 	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/jsonapi"
 	"github.com/defeedco/defeed/pkg/sources/providers/lobsters"
 	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
 	"github.com/defeedco/defeed/pkg/sources/providers/producthunt"
@@ -23,27 +27,34 @@ import (
 	"github.com/defeedco/defeed/pkg/sources/providers/rss"
 	"github.com/lithammer/fuzzysearch/fuzzy"
 	"github.com/rs/zerolog"
-	"golang.org/x/sync/errgroup"
 )
 
 // Registry manages available source configurations through fetchers.
 type Registry struct {
-	fetchers     []types.Fetcher
-	logger       *zerolog.Logger
-	sourceConfig *types.ProviderConfig
+	fetchers       []types.Fetcher
+	webhookParsers []types.WebhookParser
+	logger         *zerolog.Logger
+	sourceConfig   *types.ProviderConfig
 }
 
+// ErrWebhookNotSupported is used when the source type can't ingest webhooks.
+var ErrWebhookNotSupported = errors.New("source type doesn't support webhooks")
+
+// ErrWebhooksDisabled is used when the webhook secrets can't be derived, since no secret key is configured.
+var ErrWebhooksDisabled = errors.New("webhooks are disabled")
+
 func NewRegistry(logger *zerolog.Logger, sourceConfig *types.ProviderConfig) *Registry {
 	return &Registry{
-		fetchers:     make([]types.Fetcher, 0),
-		logger:       logger,
-		sourceConfig: sourceConfig,
+		fetchers:       make([]types.Fetcher, 0),
+		webhookParsers: make([]types.WebhookParser, 0),
+		logger:         logger,
+		sourceConfig:   sourceConfig,
 	}
 }
 
 // Initialize sets up the fetchers for each source type
 func (r *Registry) Initialize() error {
-	r.fetchers = append(r.fetchers, rss.NewFeedFetcher(r.logger))
+	r.fetchers = append(r.fetchers, rss.NewFeedFetcher(r.logger, r.sourceConfig))
 	r.fetchers = append(r.fetchers, github.NewIssuesFetcher(r.logger))
 	r.fetchers = append(r.fetchers, github.NewReleasesFetcher(r.logger))
 	r.fetchers = append(r.fetchers, github.NewTopicFetcher(r.logger))
@@ -51,17 +62,97 @@ func (r *Registry) Initialize() error {
 	r.fetchers = append(r.fetchers, hackernews.NewPostsFetcher(r.logger))
 	r.fetchers = append(r.fetchers, lobsters.NewFeedFetcher(r.logger))
 	r.fetchers = append(r.fetchers, lobsters.NewTagFetcher(r.logger))
+	r.fetchers = append(r.fetchers, lobsters.NewCommentsFetcher(r.logger))
 	r.fetchers = append(r.fetchers, mastodon.NewAccountFetcher(r.logger))
 	r.fetchers = append(r.fetchers, mastodon.NewTagFetcher(r.logger))
+	r.fetchers = append(r.fetchers, mastodon.NewTrendingFetcher(r.logger))
 	r.fetchers = append(r.fetchers, producthunt.NewPostsFetcher(r.logger))
+	r.fetchers = append(r.fetchers, custom.NewFetcher(r.logger))
+	r.fetchers = append(r.fetchers, jsonapi.NewEndpointFetcher(r.logger, r.sourceConfig))
+
+	r.webhookParsers = append(r.webhookParsers, github.NewReleasesWebhookParser(r.logger))
+	r.webhookParsers = append(r.webhookParsers, github.NewIssuesWebhookParser(r.logger))
 
 	r.logger.Info().
 		Int("count", len(r.fetchers)).
+		Int("webhook_parsers_count", len(r.webhookParsers)).
 		Msg("initialized source fetchers")
 
 	return nil
 }
 
+// ParseWebhook validates and parses the webhook payload pushed to the source by the provider.
+// The payload must be signed with the webhook secret of any of the source owners (see WebhookSecret).
+// Returns ErrWebhookNotSupported if the source type doesn't have a webhook parser.
+func (r *Registry) ParseWebhook(source types.Source, ownerIDs []string, header http.Header, payload []byte) ([]activitytypes.Activity, error) {
+	parser, err := r.webhookParser(source.UID())
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]string, 0, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		secrets = append(secrets, types.WebhookSecret(r.sourceConfig.WebhookSecretKey, source.UID(), ownerID))
+	}
+
+	return parser.ParseWebhook(source, header, payload, secrets)
+}
+
+// WebhookSecret returns the secret that the owner configures for the provider webhooks of the source.
+// Returns ErrWebhookNotSupported if the source type doesn't have a webhook parser,
+// or ErrWebhooksDisabled if no secret key is configured.
+func (r *Registry) WebhookSecret(sourceUID activitytypes.TypedUID, ownerID string) (string, error) {
+	if _, err := r.webhookParser(sourceUID); err != nil {
+		return "", err
+	}
+	if r.sourceConfig.WebhookSecretKey == "" {
+		return "", ErrWebhooksDisabled
+	}
+
+	return types.WebhookSecret(r.sourceConfig.WebhookSecretKey, sourceUID, ownerID), nil
+}
+
+func (r *Registry) webhookParser(sourceUID activitytypes.TypedUID) (types.WebhookParser, error) {
+	for _, p := range r.webhookParsers {
+		if p.SourceType() == sourceUID.Type() {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrWebhookNotSupported, sourceUID.Type())
+}
+
+// Validate checks that the raw source config can be used to create and initialize a source,
+// without fetching any activities. Initialization is bounded by the context,
+// since some providers may call external APIs when creating their clients.
+func (r *Registry) Validate(ctx context.Context, sourceType string, raw []byte) error {
+	source, err := NewSource(sourceType)
+	if err != nil {
+		return err
+	}
+
+	if err := source.UnmarshalJSON(raw); err != nil {
+		return fmt.Errorf("unmarshal source: %w", err)
+	}
+
+	// Not all sources validate their fields on initialization.
+	if err := lib.ValidateStruct(source); err != nil {
+		return err
+	}
+
+	initErr := make(chan error, 1)
+	go func() {
+		initErr <- source.Initialize(r.logger, r.sourceConfig)
+	}()
+
+	select {
+	case err := <-initErr:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("initialize source: %w", ctx.Err())
+	}
+}
+
 func (r *Registry) FindByUID(ctx context.Context, uid activitytypes.TypedUID) (types.Source, error) {
 	var fetcher types.Fetcher
 	for _, f := range r.fetchers {
@@ -90,24 +181,85 @@ type SearchRequest struct {
 
 // Search searches for sources from available fetchers
 func (r *Registry) Search(ctx context.Context, params SearchRequest) ([]types.Source, error) {
-	g, gctx := errgroup.WithContext(ctx)
+	results, _, err := r.search(ctx, params)
+	return results, err
+}
 
-	g.SetLimit(len(r.fetchers))
+// search returns the matching sources, and whether some fetchers were skipped because they timed out.
+func (r *Registry) search(ctx context.Context, params SearchRequest) (_ []types.Source, partial bool, _ error) {
+	ctx, cancel := context.WithTimeout(ctx, r.sourceConfig.SourceSearchTimeout)
+	defer cancel()
 
-	results := make([]types.Source, 0)
-	for _, f := range r.fetchers {
-		g.Go(func() error {
-			res, err := f.Search(gctx, params.Query, r.sourceConfig)
-			if err != nil {
-				return fmt.Errorf("fetcher search: %w", err)
+	type fetcherResult struct {
+		index   int
+		sources []types.Source
+		err     error
+	}
+
+	// Buffered, so that the fetchers responding after the deadline don't block.
+	resultChan := make(chan fetcherResult, len(r.fetchers))
+	slots := make(chan struct{}, r.sourceConfig.SourceSearchConcurrency)
+	for i, f := range r.fetchers {
+		go func() {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				resultChan <- fetcherResult{index: i, err: ctx.Err()}
+				return
+			}
+			defer func() { <-slots }()
+
+			fctx, cancel := context.WithTimeout(ctx, r.sourceConfig.SourceSearchFetcherTimeout)
+			defer cancel()
+
+			res, err := f.Search(fctx, params.Query, params.Topics, r.sourceConfig)
+			if err != nil && errors.Is(fctx.Err(), context.DeadlineExceeded) {
+				err = fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
 			}
-			results = append(results, res...)
-			return nil
-		})
+			resultChan <- fetcherResult{index: i, sources: res, err: err}
+		}()
 	}
 
-	if err := g.Wait(); err != nil {
-		return nil, fmt.Errorf("search sources: %w", err)
+	// Wait for the fetchers without relying on them to respect the context,
+	// so that a hung fetcher can't stall the whole search.
+	resultsByFetcher := make([][]types.Source, len(r.fetchers))
+	responded := make([]bool, len(r.fetchers))
+	pending := len(r.fetchers)
+	for pending > 0 {
+		select {
+		case res := <-resultChan:
+			pending--
+			responded[res.index] = true
+			// Only skip the fetchers that timed out, other errors (or the caller's cancellation) fail the search.
+			if res.err != nil && !errors.Is(res.err, context.DeadlineExceeded) {
+				return nil, false, fmt.Errorf("search sources: fetcher search: %w", res.err)
+			}
+			if res.err != nil {
+				responded[res.index] = false
+				continue
+			}
+			resultsByFetcher[res.index] = res.sources
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil, false, fmt.Errorf("search sources: %w", ctx.Err())
+			}
+			pending = 0
+		}
+	}
+
+	for i, ok := range responded {
+		if !ok {
+			partial = true
+			r.logger.Warn().
+				Str("source_type", r.fetchers[i].SourceType()).
+				Str("query", params.Query).
+				Msg("source search timed out, skipping fetcher")
+		}
+	}
+
+	results := make([]types.Source, 0)
+	for _, res := range resultsByFetcher {
+		results = append(results, res...)
 	}
 
 	r.logger.Debug().
@@ -115,9 +267,8 @@ func (r *Registry) Search(ctx context.Context, params SearchRequest) ([]types.So
 		Int("count", len(results)).
 		Msg("searched sources")
 
-	if len(params.Topics) > 0 {
-		results = filterByTopics(results, params.Topics)
-	}
+	// Not all fetchers pre-filter by topics hint
+	results = types.FilterByTopics(results, params.Topics)
 
 	switch {
 	case params.Query != "":
@@ -126,28 +277,7 @@ func (r *Registry) Search(ctx context.Context, params SearchRequest) ([]types.So
 		results = curatedDefaultSort(results)
 	}
 
-	return results, nil
-}
-
-func filterByTopics(input []types.Source, topics []types.TopicTag) []types.Source {
-	result := make([]types.Source, 0)
-
-	lookup := make(map[types.TopicTag]bool)
-	for _, topic := range topics {
-		lookup[topic] = true
-	}
-
-	for _, source := range input {
-	topics:
-		for _, topic := range source.Topics() {
-			if lookup[topic] {
-				result = append(result, source)
-				break topics
-			}
-		}
-	}
-
-	return result
+	return results, partial, nil
 }
 
 // sourceWithScore holds a source and its calculated relevance score
@@ -174,8 +304,11 @@ func fuzzyReRank(input []types.Source, query string) []types.Source {
 		}
 	}
 
-	// Sort by score (higher is better)
-	sort.Slice(sourcesWithScore, func(i, j int) bool {
+	// Sort by score (higher is better), with the UID as a tiebreaker for a stable order across requests.
+	sort.SliceStable(sourcesWithScore, func(i, j int) bool {
+		if sourcesWithScore[i].score == sourcesWithScore[j].score {
+			return sourcesWithScore[i].source.UID().String() < sourcesWithScore[j].source.UID().String()
+		}
 		return sourcesWithScore[i].score > sourcesWithScore[j].score
 	})
 
@@ -276,13 +409,13 @@ func curatedDefaultSort(input []types.Source) []types.Source {
 			return 95
 		case producthunt.TypeProductHuntPosts:
 			return 93
-		case lobsters.TypeLobstersTag, lobsters.TypeLobstersFeed:
+		case lobsters.TypeLobstersTag, lobsters.TypeLobstersFeed, lobsters.TypeLobstersComments:
 			return 90
 		case github.TypeGithubIssues, github.TypeGithubReleases:
 			return 80
 		case rss.TypeRSSFeed:
 			return 70
-		case mastodon.TypeMastodonAccount, mastodon.TypeMastodonTag:
+		case mastodon.TypeMastodonAccount, mastodon.TypeMastodonTag, mastodon.TypeMastodonTrending:
 			return 65
 		default:
 			return 50
@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -25,10 +26,54 @@ type Scheduler struct {
 	activeSourceRepo   sourceStore
 	activityRegistry   *activities.Registry
 	activityWorkerPool pond.Pool
-	cancelBySourceID   sync.Map
+	cancelBySourceID   sync.Map // polling tickers by source UID
+	cancelPollBySource sync.Map // in-progress polls by source UID
 	cancelByActivityID sync.Map
 	logger             *zerolog.Logger
+	config             *Config
 	sourceConfig       *sourcetypes.ProviderConfig
+	contentPolicy      *contentPolicy
+	lastActivities     *lastActivityCache
+	seenActivities     *seenActivityCache // nil if disabled
+	pollStats          sync.Map           // map[string]SourceStats
+	failedActivityRepo failedActivityStore
+	stateStore         sourceStateStore    // nil if the source states aren't persisted
+	staleNotifier      StaleSourceNotifier // nil if disabled
+	activityFilter     ActivityFilter      // nil if the activities aren't filtered by the feeds
+	cancelRetries      context.CancelFunc
+	cancelReconcile    context.CancelFunc
+	pollInterval       time.Duration
+}
+
+// SourceStats reports whether the source is alive and productive.
+type SourceStats struct {
+	// LastPolledAt is zero if the source wasn't polled since the server started (e.g. it isn't used by any feed).
+	LastPolledAt time.Time
+	// LastSucceededAt is the last time the source was polled without errors.
+	LastSucceededAt time.Time
+	// ActivityCount is the number of stored activities from the source.
+	ActivityCount int
+	// DisabledReason is set when the source isn't polled (e.g. missing credentials, or removed as stale).
+	DisabledReason string
+	// LastNewActivityAt is the creation time of the newest activity from the source,
+	// or the time the source was first polled if it had no activities yet.
+	LastNewActivityAt time.Time
+	// StaleSince is set when the source has no new activities for longer than the threshold (see Config.StaleSourceThreshold).
+	StaleSince time.Time
+}
+
+// SourceUsage reports which sources are still referenced by feeds.
+type SourceUsage interface {
+	// UsedSourceUIDs returns the subset of the given source UIDs that are used by at least one active feed.
+	UsedSourceUIDs(ctx context.Context, sourceUIDs []activitytypes.TypedUID) (map[string]bool, error)
+	// SourceOwnerIDs returns the users with feeds that use the source.
+	SourceOwnerIDs(ctx context.Context, sourceUID activitytypes.TypedUID) ([]string, error)
+}
+
+// ActivityFilter drops the activities that no feed would show, before they're summarized and embedded.
+type ActivityFilter interface {
+	// KeepActivity reports whether any feed using the activity sources keeps the activity with its keyword filters.
+	KeepActivity(ctx context.Context, activity activitytypes.Activity) (bool, error)
 }
 
 type sourceStore interface {
@@ -38,28 +83,72 @@ type sourceStore interface {
 	GetByID(uid string) (sourcetypes.Source, error)
 }
 
+type failedActivityStore interface {
+	Upsert(ctx context.Context, f *activitytypes.FailedActivity) error
+	Remove(ctx context.Context, uid string) error
+	GetByID(ctx context.Context, uid string) (*activitytypes.FailedActivity, error)
+	ListDue(ctx context.Context, before time.Time, maxAttempts int, limit int) ([]*activitytypes.FailedActivity, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// failedActivityRetryBatchSize is the max number of failed activities retried on each tick.
+const failedActivityRetryBatchSize = 100
+
 func NewScheduler(
 	logger *zerolog.Logger,
 	sourceRepo sourceStore,
+	failedActivityRepo failedActivityStore,
 	activityRegistry *activities.Registry,
 	config *Config,
 	sourceConfig *sourcetypes.ProviderConfig,
 ) *Scheduler {
 	return &Scheduler{
 		activeSourceRepo:   sourceRepo,
+		failedActivityRepo: failedActivityRepo,
 		activityRegistry:   activityRegistry,
 		logger:             logger,
 		activityWorkerPool: pond.NewPool(config.MaxActivityProcessorConcurrency),
+		config:             config,
 		sourceConfig:       sourceConfig,
+		contentPolicy:      newContentPolicy(sourceConfig),
+		lastActivities:     newLastActivityCache(),
+		seenActivities:     newSeenActivityCache(config.SeenActivityCacheSize, config.SeenActivityCacheTTL),
+		// Default to 2 hours for all sources
+		// TODO: Make this configurable per source type?
+		pollInterval: 2 * time.Hour,
 	}
 }
 
+// WithStateStore persists the staleness of the sources (see SourceState), so that it survives restarts.
+func (r *Scheduler) WithStateStore(store sourceStateStore) *Scheduler {
+	r.stateStore = store
+	return r
+}
+
+// WithActivityFilter skips processing the activities excluded by the keyword filters of all feeds using their sources.
+// The filters are still applied per feed when searching, since the feeds sharing a source can filter it differently.
+func (r *Scheduler) WithActivityFilter(filter ActivityFilter) *Scheduler {
+	r.activityFilter = filter
+	return r
+}
+
+// WithStaleSourceNotifier notifies the feed owners when their sources are flagged as stale and removed.
+func (r *Scheduler) WithStaleSourceNotifier(notifier StaleSourceNotifier) *Scheduler {
+	r.staleNotifier = notifier
+	return r
+}
+
 func (r *Scheduler) Initialize(ctx context.Context) error {
 	sources, err := r.activeSourceRepo.List()
 	if err != nil {
 		return fmt.Errorf("list sources: %w", err)
 	}
 
+	// The sources are still polled if the states can't be loaded, the staleness is then tracked from scratch.
+	if err := r.loadSourceStates(ctx); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to load source states")
+	}
+
 	r.logger.Info().Int("count", len(sources)).Msg("Initializing sources")
 
 	for _, source := range sources {
@@ -68,25 +157,17 @@ func (r *Scheduler) Initialize(ctx context.Context) error {
 			sLogger.Error().
 				Err(err).
 				Msg("Failed to initialize source")
+			r.disable(source, err)
 			continue
 		}
 
-		result, err := r.activityRegistry.Search(ctx, activities.SearchRequest{
-			SourceUIDs: []activitytypes.TypedUID{source.UID()},
-			Limit:      1,
-			SortBy:     activitytypes.SortByDate,
-		})
+		since, lookBackLimit, err := r.findSince(ctx, source)
 		if err != nil {
-			return fmt.Errorf("search existing activities: %w", err)
-		}
-
-		var since activitytypes.Activity = nil
-		if len(result.Activities) > 0 {
-			since = result.Activities[0].Activity
+			return fmt.Errorf("find last activity: %w", err)
 		}
 
 		// Do not block the initialization since the result/error reporting is async
-		go r.executeSourceOnce(source, since)
+		go r.executeSourceOnce(source, since, lookBackLimit)
 		r.scheduleSource(source)
 
 		sLogger.Info().Msg("Source initialized")
@@ -98,7 +179,7 @@ func (r *Scheduler) Initialize(ctx context.Context) error {
 
 func (r *Scheduler) scheduleSource(source sourcetypes.Source) {
 	ctx, cancel := context.WithCancel(context.Background())
-	r.cancelBySourceID.Store(source.UID(), cancel)
+	r.cancelBySourceID.Store(source.UID().String(), cancel)
 
 	go func() {
 		ticker := r.getSourceTicker(source)
@@ -109,11 +190,7 @@ func (r *Scheduler) scheduleSource(source sourcetypes.Source) {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				result, err := r.activityRegistry.Search(ctx, activities.SearchRequest{
-					SourceUIDs: []activitytypes.TypedUID{source.UID()},
-					Limit:      1,
-					SortBy:     activitytypes.SortByDate,
-				})
+				since, lookBackLimit, err := r.findSince(ctx, source)
 				if err != nil {
 					r.logger.Error().
 						Str("source_id", source.UID().String()).
@@ -122,22 +199,92 @@ func (r *Scheduler) scheduleSource(source sourcetypes.Source) {
 				}
 
 				logEvent := r.logger.Debug()
-				var since activitytypes.Activity = nil
-				if len(result.Activities) > 0 {
-					since = result.Activities[0].Activity
+				if since != nil {
 					logEvent.Str("last_activity_uid", since.UID().String())
 				}
 				logEvent.Msg("Polling source")
 
-				r.executeSourceOnce(source, since)
+				r.executeSourceOnce(source, since, lookBackLimit)
 			}
 		}
 	}()
 }
 
-func (r *Scheduler) executeSourceOnce(source sourcetypes.Source, since activitytypes.Activity) {
+// findSince returns the last activity emitted by the source, which is used as the starting point for polling.
+// Returns nil if there are no activities yet, or if the last activity is older than the max look-back window.
+// In both cases the source falls back to its initial backfill (see sourcetypes.SinceTime),
+// which is additionally bounded by the returned look-back limit for the stale activities (see sourcetypes.WithLookBackLimit).
+func (r *Scheduler) findSince(ctx context.Context, source sourcetypes.Source) (activitytypes.Activity, time.Time, error) {
+	since, err := r.lastActivity(ctx, source)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if since == nil {
+		return nil, time.Time{}, nil
+	}
+
+	if sourcetypes.IsStale(since, r.sourceConfig.MaxLookBack) {
+		r.logger.Info().
+			Str("source_id", source.UID().String()).
+			Time("last_activity_at", since.CreatedAt()).
+			Dur("max_look_back", r.sourceConfig.MaxLookBack).
+			Msg("Last activity is older than max look-back, backfilling up to the max look-back")
+		return nil, time.Now().Add(-r.sourceConfig.MaxLookBack), nil
+	}
+
+	return since, time.Time{}, nil
+}
+
+// lastActivity returns the last activity emitted by the source (nil if there are none).
+func (r *Scheduler) lastActivity(ctx context.Context, source sourcetypes.Source) (activitytypes.Activity, error) {
+	if r.config.CacheLastActivity {
+		if last, found := r.lastActivities.Get(source.UID().String()); found {
+			return last, nil
+		}
+	}
+
+	result, err := r.activityRegistry.Search(ctx, activities.SearchRequest{
+		SourceUIDs: []activitytypes.TypedUID{source.UID()},
+		Limit:      1,
+		SortBy:     activitytypes.SortByDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search activities: %w", err)
+	}
+
+	var last activitytypes.Activity
+	if len(result.Activities) > 0 {
+		last = result.Activities[0].Activity
+	}
+
+	if r.config.CacheLastActivity {
+		r.lastActivities.Set(source.UID().String(), last)
+	}
+
+	return last, nil
+}
+
+// executeSourceOnce streams the source activities since the last seen activity.
+// A non-zero look-back limit bounds the initial backfill of the sources without a (non-stale) last seen activity.
+func (r *Scheduler) executeSourceOnce(source sourcetypes.Source, since activitytypes.Activity, lookBackLimit time.Time) {
 	ctx, cancel := context.WithCancel(context.Background())
-	r.cancelBySourceID.Store(source.UID(), cancel)
+	// Stored separately from the ticker, so that removing the source stops both the ticker and the in-progress poll.
+	r.cancelPollBySource.Store(source.UID().String(), cancel)
+	defer cancel()
+	if !lookBackLimit.IsZero() {
+		ctx = sourcetypes.WithLookBackLimit(ctx, lookBackLimit)
+	}
+
+	r.updatePollStats(source, func(stats *SourceStats) {
+		stats.LastPolledAt = time.Now()
+		if stats.LastNewActivityAt.IsZero() {
+			stats.LastNewActivityAt = stats.LastPolledAt
+			if since != nil {
+				stats.LastNewActivityAt = since.CreatedAt()
+			}
+		}
+	})
 
 	activityChan := make(chan activitytypes.Activity, 100)
 	errorChan := make(chan error, 100)
@@ -148,18 +295,21 @@ func (r *Scheduler) executeSourceOnce(source sourcetypes.Source, since activityt
 		source.Stream(ctx, since, activityChan, errorChan)
 	}()
 
+	failed := false
 	for {
 		select {
 		case activity, ok := <-activityChan:
 			if !ok {
 				activityChan = nil
-			} else {
-				r.processActivity(activity)
+			} else if r.acceptActivity(activity) {
+				r.trackNewActivity(source, activity)
+				r.processActivity(activity, nil)
 			}
 		case err, ok := <-errorChan:
 			if !ok {
 				errorChan = nil
 			} else {
+				failed = true
 				r.logger.Error().
 					Err(err).
 					Str("source_id", source.UID().String()).
@@ -171,16 +321,134 @@ func (r *Scheduler) executeSourceOnce(source sourcetypes.Source, since activityt
 
 		// Exit when both channels are closed
 		if activityChan == nil && errorChan == nil {
+			if !failed {
+				r.updatePollStats(source, func(stats *SourceStats) {
+					stats.LastSucceededAt = time.Now()
+				})
+			}
 			return
 		}
 	}
 }
 
-func (r *Scheduler) processActivity(activity activitytypes.Activity) {
+// disable records why the source isn't polled.
+func (r *Scheduler) disable(source sourcetypes.Source, reason error) {
+	r.updatePollStats(source, func(stats *SourceStats) {
+		stats.DisabledReason = reason.Error()
+	})
+}
+
+func (r *Scheduler) updatePollStats(source sourcetypes.Source, update func(stats *SourceStats)) {
+	var stats SourceStats
+	if existing, ok := r.pollStats.Load(source.UID().String()); ok {
+		stats = existing.(SourceStats)
+	}
+	update(&stats)
+	r.pollStats.Store(source.UID().String(), stats)
+}
+
+// SourceStats returns the polling and activity stats by source UID.
+// Sources that weren't polled and have no activities are omitted.
+func (r *Scheduler) SourceStats(ctx context.Context) (map[string]SourceStats, error) {
+	counts, err := r.activityRegistry.CountBySource(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count activities: %w", err)
+	}
+
+	out := make(map[string]SourceStats, len(counts))
+	for uid, count := range counts {
+		out[uid] = SourceStats{ActivityCount: count}
+	}
+
+	r.pollStats.Range(func(key, value any) bool {
+		uid := key.(string)
+		polled := value.(SourceStats)
+		stats := out[uid]
+		stats.LastPolledAt = polled.LastPolledAt
+		stats.LastSucceededAt = polled.LastSucceededAt
+		stats.DisabledReason = polled.DisabledReason
+		stats.LastNewActivityAt = polled.LastNewActivityAt
+		stats.StaleSince = polled.StaleSince
+		out[uid] = stats
+		return true
+	})
+
+	return out, nil
+}
+
+// acceptActivity reports whether the activity should be processed,
+// i.e. it isn't excluded by the content policy.
+// The keyword filters of the feeds are applied when processing (see ActivityFilter).
+func (r *Scheduler) acceptActivity(activity activitytypes.Activity) bool {
+	if flag, filtered := r.contentPolicy.IsFiltered(activity); filtered {
+		r.logger.Debug().
+			Str("activity_uid", activity.UID().String()).
+			Str("content_flag", string(flag)).
+			Msg("Skipping flagged activity")
+		return false
+	}
+
+	return true
+}
+
+// Ingest schedules the processing of the activities pushed by external systems to the source.
+// The activities are processed like the polled ones (summarized, embedded and stored) and retried on failures.
+// Returns the number of accepted activities.
+func (r *Scheduler) Ingest(sourceUID activitytypes.TypedUID, acts []activitytypes.Activity) (int, error) {
+	// The active source tracks the stale stats, if it's used by any feed.
+	source, err := r.activeSourceRepo.GetByID(sourceUID.String())
+	if err != nil {
+		return 0, fmt.Errorf("get source: %w", err)
+	}
+
+	accepted := 0
+	for _, activity := range acts {
+		if r.acceptActivity(activity) {
+			r.trackNewActivity(source, activity)
+			r.processActivity(activity, nil)
+			accepted++
+		}
+	}
+
+	r.logger.Debug().
+		Str("source_id", sourceUID.String()).
+		Int("count", len(acts)).
+		Int("accepted_count", accepted).
+		Msg("Ingested activities")
+
+	return accepted, nil
+}
+
+// processActivity schedules the activity processing.
+// The previous failure should be provided when retrying a failed activity.
+func (r *Scheduler) processActivity(activity activitytypes.Activity, previous *activitytypes.FailedActivity) {
+	// The failed activities are always retried, since they weren't stored.
+	if previous == nil && r.seenActivities != nil && r.seenActivities.Seen(activity, time.Now()) {
+		return
+	}
+
+	uid := activity.UID().String()
 	ctx, cancel := context.WithCancel(context.Background())
-	r.cancelByActivityID.Store(activity.UID(), cancel)
+	// Skip the activity if it's still queued, e.g. a retry that wasn't processed since the previous tick.
+	if _, queued := r.cancelByActivityID.LoadOrStore(uid, cancel); queued {
+		cancel()
+		return
+	}
 
 	r.activityWorkerPool.Submit(func() {
+		defer func() {
+			r.cancelByActivityID.Delete(uid)
+			cancel()
+		}()
+
+		// The retried activities were already kept by the feeds.
+		if previous == nil && !r.keepActivity(ctx, activity) {
+			if r.seenActivities != nil {
+				r.seenActivities.Add(activity, time.Now())
+			}
+			return
+		}
+
 		// Do not force reprocessing or upsert if activity already exists,
 		// since some sources might return already processed activities (e.g. GitHub topic).
 		isUpserted, err := r.activityRegistry.Create(ctx, activities.CreateRequest{
@@ -192,25 +460,260 @@ func (r *Scheduler) processActivity(activity activitytypes.Activity) {
 			Upsert:                  true,
 		})
 		if err != nil {
-			// TODO: Better error handling (retry or track the failures)
 			r.logger.Error().
 				Err(err).
 				Str("activity_uid", activity.UID().String()).
 				Msg("Failed to create activity")
+			r.trackFailedActivity(ctx, activity, previous, err)
+			return
+		}
+
+		if r.config.CacheLastActivity {
+			r.lastActivities.Update(activity)
+		}
+		if r.seenActivities != nil {
+			r.seenActivities.Add(activity, time.Now())
+		}
+
+		// The activity might have failed before, even if it's not a retry (e.g. polled again by the source).
+		if err := r.failedActivityRepo.Remove(ctx, uid); err != nil {
+			r.logger.Error().
+				Err(err).
+				Str("activity_uid", uid).
+				Msg("Failed to remove failed activity")
 		}
 
 		r.logger.Debug().
 			Str("activity_uid", activity.UID().String()).
 			Bool("upserted", isUpserted).
+			Bool("retried", previous != nil).
 			Msg("Activity processed")
 	})
 
 }
 
+// keepActivity reports whether any feed keeps the activity with its keyword filters.
+// The activity is kept if the filters can't be loaded, since it can still be filtered when searching.
+func (r *Scheduler) keepActivity(ctx context.Context, activity activitytypes.Activity) bool {
+	if r.activityFilter == nil {
+		return true
+	}
+
+	keep, err := r.activityFilter.KeepActivity(ctx, activity)
+	if err != nil {
+		r.logger.Error().
+			Err(err).
+			Str("activity_uid", activity.UID().String()).
+			Msg("Failed to filter activity")
+		return true
+	}
+
+	if !keep {
+		r.logger.Debug().
+			Str("activity_uid", activity.UID().String()).
+			Msg("Skipping activity excluded by the keyword filters of all feeds")
+	}
+	return keep
+}
+
+// trackFailedActivity stores the failed activity, so that it can be retried with exponential backoff.
+func (r *Scheduler) trackFailedActivity(ctx context.Context, activity activitytypes.Activity, previous *activitytypes.FailedActivity, processErr error) {
+	if previous == nil {
+		existing, err := r.failedActivityRepo.GetByID(ctx, activity.UID().String())
+		if err != nil {
+			r.logger.Error().
+				Err(err).
+				Str("activity_uid", activity.UID().String()).
+				Msg("Failed to load failed activity")
+			return
+		}
+		previous = existing
+	}
+
+	now := time.Now()
+	failed := &activitytypes.FailedActivity{
+		Activity:     activity,
+		Error:        processErr.Error(),
+		AttemptCount: 1,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if previous != nil {
+		// Stop counting at the max attempts, since the activities that are re-emitted by the sources
+		// keep failing after the retries are exhausted.
+		failed.AttemptCount = min(previous.AttemptCount+1, max(r.config.FailedActivityMaxAttempts, 1))
+		failed.CreatedAt = previous.CreatedAt
+	}
+	failed.NextRetryAt = now.Add(retryBackoff(r.config.FailedActivityRetryBackoff, r.config.FailedActivityMaxRetryBackoff, failed.AttemptCount))
+
+	if err := r.failedActivityRepo.Upsert(ctx, failed); err != nil {
+		r.logger.Error().
+			Err(err).
+			Str("activity_uid", activity.UID().String()).
+			Msg("Failed to track failed activity")
+		return
+	}
+
+	logEvent := r.logger.Debug()
+	if failed.AttemptCount >= r.config.FailedActivityMaxAttempts {
+		logEvent = r.logger.Warn()
+	}
+	logEvent.
+		Str("activity_uid", activity.UID().String()).
+		Int("attempt_count", failed.AttemptCount).
+		Time("next_retry_at", failed.NextRetryAt).
+		Msg("Failed activity tracked")
+}
+
+// maxRetryBackoffExponent bounds the doubling of the retry backoff, so that the delay can't overflow.
+const maxRetryBackoffExponent = 10
+
+// retryBackoff returns the delay before retrying an activity that failed the given number of attempts.
+// The base delay is doubled with each attempt, up to the max delay (if set).
+func retryBackoff(base, maxDelay time.Duration, attemptCount int) time.Duration {
+	exponent := min(max(attemptCount-1, 0), maxRetryBackoffExponent)
+	delay := base * time.Duration(1<<exponent)
+	if maxDelay > 0 {
+		delay = min(delay, maxDelay)
+	}
+	return delay
+}
+
+// StartFailedActivityRetries periodically retries the activities that failed to be processed (see Config.FailedActivityRetryInterval).
+// The retries are independent of the source initialization, since the pushed activities (see Ingest) can fail too.
+func (r *Scheduler) StartFailedActivityRetries() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancelRetries = cancel
+
+	go func() {
+		ticker := time.NewTicker(r.config.FailedActivityRetryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.retryFailedActivities(ctx)
+			}
+		}
+	}()
+}
+
+func (r *Scheduler) retryFailedActivities(ctx context.Context) {
+	due, err := r.failedActivityRepo.ListDue(ctx, time.Now(), r.config.FailedActivityMaxAttempts, failedActivityRetryBatchSize)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to list failed activities for retry")
+		return
+	}
+
+	if len(due) == 0 {
+		return
+	}
+
+	r.logger.Info().Int("count", len(due)).Msg("Retrying failed activities")
+
+	for _, failed := range due {
+		r.processActivity(failed.Activity, failed)
+	}
+}
+
+// FailedActivityCount returns the number of activities that are pending a retry or exhausted all attempts.
+func (r *Scheduler) FailedActivityCount(ctx context.Context) (int, error) {
+	return r.failedActivityRepo.Count(ctx)
+}
+
+// SeenActivityCacheStats returns the hits of the recently processed activities cache (see Config.SeenActivityCacheSize).
+// Found is false if the cache is disabled.
+func (r *Scheduler) SeenActivityCacheStats() (_ SeenActivityCacheStats, found bool) {
+	if r.seenActivities == nil {
+		return SeenActivityCacheStats{}, false
+	}
+	return r.seenActivities.Stats(), true
+}
+
+// StartReconciler periodically removes active sources that aren't used by any feed.
+// This recovers from sources left behind by interrupted feed updates or deletions.
+// The stale sources are checked at the same interval (see Config.StaleSourceThreshold).
+func (r *Scheduler) StartReconciler(usage SourceUsage) {
+	if r.config.ReconcileInterval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancelReconcile = cancel
+
+	go func() {
+		ticker := time.NewTicker(r.config.ReconcileInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Reconcile(ctx, usage); err != nil {
+					r.logger.Error().Err(err).Msg("Failed to reconcile sources")
+				}
+				r.checkStaleSources(ctx, usage, time.Now())
+			}
+		}
+	}()
+}
+
+// Reconcile removes active sources that aren't used by any feed.
+func (r *Scheduler) Reconcile(ctx context.Context, usage SourceUsage) error {
+	active, err := r.activeSourceRepo.List()
+	if err != nil {
+		return fmt.Errorf("list sources: %w", err)
+	}
+
+	if len(active) == 0 {
+		return nil
+	}
+
+	sourceUIDs := make([]activitytypes.TypedUID, 0, len(active))
+	for _, source := range active {
+		sourceUIDs = append(sourceUIDs, source.UID())
+	}
+
+	used, err := usage.UsedSourceUIDs(ctx, sourceUIDs)
+	if err != nil {
+		return fmt.Errorf("find used sources: %w", err)
+	}
+
+	removed, failed := 0, 0
+	for _, uid := range sourceUIDs {
+		if used[uid.String()] {
+			continue
+		}
+
+		if err := r.Remove(uid.String()); err != nil {
+			failed++
+			r.logger.Warn().
+				Err(err).
+				Str("source_uid", uid.String()).
+				Msg("Failed to remove orphaned source")
+			continue
+		}
+
+		removed++
+		r.logger.Info().
+			Str("source_uid", uid.String()).
+			Msg("Removed orphaned source")
+	}
+
+	r.logger.Info().
+		Int("checked", len(sourceUIDs)).
+		Int("removed", removed).
+		Int("failed", failed).
+		Msg("Source reconciliation complete")
+
+	return nil
+}
+
 func (r *Scheduler) getSourceTicker(source sourcetypes.Source) *time.Ticker {
-	// Default to 2 hours for all sources
-	// TODO: Make this configurable per source type?
-	return time.NewTicker(2 * time.Hour)
+	return time.NewTicker(r.pollInterval)
 }
 
 // Add starts processing activities from the source.
@@ -222,18 +725,31 @@ func (r *Scheduler) Add(source sourcetypes.Source) error {
 		return nil
 	}
 
-	if err := source.Initialize(sourceLogger(source, r.logger), r.sourceConfig); err != nil {
-		return fmt.Errorf("initialize source: %w", err)
+	initErr := source.Initialize(sourceLogger(source, r.logger), r.sourceConfig)
+	if initErr != nil && !errors.Is(initErr, sourcetypes.ErrMissingCredentials) {
+		return fmt.Errorf("initialize source: %w", initErr)
 	}
 
 	err := r.activeSourceRepo.Add(source)
 	if err != nil {
 		return fmt.Errorf("add source: %w", err)
 	}
+	// Reset the stats of the previously removed source (e.g. stale sources re-added by a feed update).
+	r.pollStats.Delete(source.UID().String())
+	r.removeSourceState(source.UID().String())
+
+	// Keep the source, so that it's polled after a restart once the credentials are configured.
+	if initErr != nil {
+		sourceLogger(source, r.logger).Warn().
+			Err(initErr).
+			Msg("Source added but disabled")
+		r.disable(source, initErr)
+		return nil
+	}
 
 	// Set to nil since there are no previous activities for this source yet.
 	var since activitytypes.Activity = nil
-	go r.executeSourceOnce(source, since)
+	go r.executeSourceOnce(source, since, time.Time{})
 	r.scheduleSource(source)
 
 	return nil
@@ -252,17 +768,32 @@ func (r *Scheduler) Remove(uid string) error {
 		return fmt.Errorf("remove source: %w", err)
 	}
 
-	cancel, ok := r.cancelBySourceID.Load(uid)
+	r.lastActivities.Delete(uid)
+	r.pollStats.Delete(uid)
+	r.removeSourceState(uid)
+
 	// When the source wasn't registered, there is no cancel func (e.g. when SOURCE_INITIALIZATION=false).
-	if ok {
+	if cancel, ok := r.cancelBySourceID.LoadAndDelete(uid); ok {
+		cancel.(context.CancelFunc)()
+	}
+	if cancel, ok := r.cancelPollBySource.LoadAndDelete(uid); ok {
 		cancel.(context.CancelFunc)()
-		r.cancelBySourceID.Delete(uid)
 	}
 
 	return nil
 }
 
 func (r *Scheduler) Shutdown() {
+	// Cancel failed activity retries
+	if r.cancelRetries != nil {
+		r.cancelRetries()
+	}
+
+	// Cancel source reconciliation
+	if r.cancelReconcile != nil {
+		r.cancelReconcile()
+	}
+
 	// Cancel source scheduling
 	r.cancelBySourceID.Range(func(key, value interface{}) bool {
 		cancel := value.(context.CancelFunc)
@@ -270,6 +801,12 @@ func (r *Scheduler) Shutdown() {
 		return true
 	})
 	r.cancelBySourceID.Clear()
+	r.cancelPollBySource.Range(func(key, value interface{}) bool {
+		cancel := value.(context.CancelFunc)
+		cancel()
+		return true
+	})
+	r.cancelPollBySource.Clear()
 
 	// Cancel processing activities
 	r.cancelByActivityID.Range(func(key, value interface{}) bool {
@@ -280,6 +817,15 @@ func (r *Scheduler) Shutdown() {
 	r.cancelByActivityID.Clear()
 }
 
+// Get returns the active source with the given UID, or nil if it isn't used by any feed.
+func (r *Scheduler) Get(uid activitytypes.TypedUID) (sourcetypes.Source, error) {
+	source, err := r.activeSourceRepo.GetByID(uid.String())
+	if err != nil {
+		return nil, fmt.Errorf("get source: %w", err)
+	}
+	return source, nil
+}
+
 type ListRequest struct {
 	SourceUIDs []activitytypes.TypedUID
 }
@@ -0,0 +1,231 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+// createdActivity only supports the creation time.
+type createdActivity struct {
+	activitytypes.Activity
+	createdAt time.Time
+}
+
+func (a createdActivity) CreatedAt() time.Time { return a.createdAt }
+
+func TestFindSince_MaxLookBack(t *testing.T) {
+	source := &staleTestSource{id: "a"}
+	scheduler, _, _, _ := newStaleTestScheduler(&Config{CacheLastActivity: true}, source)
+	scheduler.sourceConfig.MaxLookBack = 24 * time.Hour
+
+	recent := createdActivity{createdAt: time.Now().Add(-time.Hour)}
+	scheduler.lastActivities.Set(source.UID().String(), recent)
+	since, lookBackLimit, err := scheduler.findSince(context.Background(), source)
+	if err != nil {
+		t.Fatalf("find since: %v", err)
+	}
+	if since != recent || !lookBackLimit.IsZero() {
+		t.Errorf("expected the recent last activity without a look-back limit, got %v and %v", since, lookBackLimit)
+	}
+
+	scheduler.lastActivities.Set(source.UID().String(), createdActivity{createdAt: time.Now().Add(-30 * 24 * time.Hour)})
+	since, lookBackLimit, err = scheduler.findSince(context.Background(), source)
+	if err != nil {
+		t.Fatalf("find since: %v", err)
+	}
+	if since != nil {
+		t.Errorf("expected the stale last activity to be discarded, got %v", since)
+	}
+	if want := time.Now().Add(-24 * time.Hour); lookBackLimit.Sub(want).Abs() > time.Minute {
+		t.Errorf("expected the look-back limit %v, got %v", want, lookBackLimit)
+	}
+}
+
+// pollCountingSource counts the polls without emitting any activities.
+type pollCountingSource struct {
+	staleTestSource
+	polls atomic.Int32
+}
+
+func (s *pollCountingSource) Initialize(*zerolog.Logger, *sourcetypes.ProviderConfig) error {
+	return nil
+}
+
+func (s *pollCountingSource) Stream(context.Context, activitytypes.Activity, chan<- activitytypes.Activity, chan<- error) {
+	s.polls.Add(1)
+}
+
+// waitForPolls waits until the source is polled at least n times.
+func waitForPolls(t *testing.T, source *pollCountingSource, n int32) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for source.polls.Load() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected at least %d polls, got %d", n, source.polls.Load())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRemove_StopsPolling(t *testing.T) {
+	const pollInterval = 20 * time.Millisecond
+	source := &pollCountingSource{staleTestSource: staleTestSource{id: "a"}}
+	uid := source.UID().String()
+	scheduler, sourceStore, _, _ := newStaleTestScheduler(&Config{CacheLastActivity: true}, source)
+	delete(sourceStore.sources, uid)
+	scheduler.pollInterval = pollInterval
+	defer scheduler.Shutdown()
+
+	// The source has no activities, so that the ticker doesn't search the registry.
+	scheduler.lastActivities.Set(uid, nil)
+	if err := scheduler.Add(source); err != nil {
+		t.Fatalf("add source: %v", err)
+	}
+	waitForPolls(t, source, 3)
+
+	// Pausing the feed removes its unused sources.
+	if err := scheduler.Remove(uid); err != nil {
+		t.Fatalf("remove source: %v", err)
+	}
+	time.Sleep(pollInterval)
+	paused := source.polls.Load()
+	time.Sleep(5 * pollInterval)
+	if polls := source.polls.Load(); polls != paused {
+		t.Fatalf("expected no polls after the removal, got %d", polls-paused)
+	}
+
+	// Unpausing the feed adds the sources again, which should start a single ticker.
+	scheduler.lastActivities.Set(uid, nil)
+	if err := scheduler.Add(source); err != nil {
+		t.Fatalf("add source: %v", err)
+	}
+	window := 10 * pollInterval
+	time.Sleep(window)
+	// The initial poll and one poll per tick.
+	if polls, limit := source.polls.Load()-paused, int32(window/pollInterval)+2; polls > limit {
+		t.Errorf("expected at most %d polls from a single ticker, got %d", limit, polls)
+	}
+}
+
+// usedSources reports only the given source UIDs as used.
+type usedSources map[string]bool
+
+func (u usedSources) UsedSourceUIDs(context.Context, []activitytypes.TypedUID) (map[string]bool, error) {
+	return u, nil
+}
+
+func (u usedSources) SourceOwnerIDs(context.Context, activitytypes.TypedUID) ([]string, error) {
+	return nil, nil
+}
+
+func TestReconcile_StopsOrphanedSources(t *testing.T) {
+	used := &staleTestSource{id: "used"}
+	orphaned := &staleTestSource{id: "orphaned"}
+	scheduler, sourceStore, _, _ := newStaleTestScheduler(&Config{}, used)
+	sourceStore.sources[orphaned.UID().String()] = orphaned
+
+	usedCtx, cancelUsed := context.WithCancel(context.Background())
+	defer cancelUsed()
+	scheduler.cancelBySourceID.Store(used.UID().String(), cancelUsed)
+	orphanedCtx, cancelOrphaned := context.WithCancel(context.Background())
+	defer cancelOrphaned()
+	scheduler.cancelBySourceID.Store(orphaned.UID().String(), cancelOrphaned)
+
+	if err := scheduler.Reconcile(context.Background(), usedSources{used.UID().String(): true}); err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+
+	if sourceStore.sources[orphaned.UID().String()] != nil {
+		t.Error("expected the orphaned source to be removed")
+	}
+	if orphanedCtx.Err() == nil {
+		t.Error("expected the orphaned source polling to be stopped")
+	}
+	if sourceStore.sources[used.UID().String()] == nil || usedCtx.Err() != nil {
+		t.Error("expected the used source to be kept polling")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxDelay     time.Duration
+		attemptCount int
+		want         time.Duration
+	}{
+		{name: "first attempt", attemptCount: 1, want: time.Minute},
+		{name: "doubled", attemptCount: 3, want: 4 * time.Minute},
+		{name: "no attempts", attemptCount: 0, want: time.Minute},
+		{name: "clamped exponent", attemptCount: 100, want: 1024 * time.Minute},
+		{name: "capped", maxDelay: time.Hour, attemptCount: 8, want: time.Hour},
+		{name: "below cap", maxDelay: time.Hour, attemptCount: 2, want: 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryBackoff(time.Minute, tt.maxDelay, tt.attemptCount); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// memoryFailedActivityStore stores the failed activities by UID.
+type memoryFailedActivityStore struct {
+	failed map[string]*activitytypes.FailedActivity
+}
+
+func (s *memoryFailedActivityStore) Upsert(_ context.Context, f *activitytypes.FailedActivity) error {
+	s.failed[f.Activity.UID().String()] = f
+	return nil
+}
+
+func (s *memoryFailedActivityStore) Remove(_ context.Context, uid string) error {
+	delete(s.failed, uid)
+	return nil
+}
+
+func (s *memoryFailedActivityStore) GetByID(_ context.Context, uid string) (*activitytypes.FailedActivity, error) {
+	return s.failed[uid], nil
+}
+
+func (s *memoryFailedActivityStore) ListDue(context.Context, time.Time, int, int) ([]*activitytypes.FailedActivity, error) {
+	return nil, nil
+}
+
+func (s *memoryFailedActivityStore) Count(context.Context) (int, error) {
+	return len(s.failed), nil
+}
+
+func TestTrackFailedActivity_MaxAttempts(t *testing.T) {
+	logger := zerolog.Nop()
+	store := &memoryFailedActivityStore{failed: make(map[string]*activitytypes.FailedActivity)}
+	scheduler := NewScheduler(&logger, nil, store, nil, &Config{
+		FailedActivityRetryBackoff:    time.Minute,
+		FailedActivityMaxRetryBackoff: time.Hour,
+		FailedActivityMaxAttempts:     3,
+	}, &sourcetypes.ProviderConfig{})
+
+	activity := &seenTestActivity{id: "failing"}
+	for range 5 {
+		scheduler.trackFailedActivity(context.Background(), activity, nil, errors.New("process failed"))
+	}
+
+	failed := store.failed[activity.UID().String()]
+	if failed == nil {
+		t.Fatal("expected the failed activity to be tracked")
+	}
+	if failed.AttemptCount != 3 {
+		t.Errorf("expected the attempt count to stop at the max attempts, got %d", failed.AttemptCount)
+	}
+	if delay := time.Until(failed.NextRetryAt); delay > 4*time.Minute {
+		t.Errorf("expected the retry delay of the last attempt, got %s", delay)
+	}
+}
@@ -0,0 +1,125 @@
+package sources
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// seenActivityCache remembers the recently processed activities (an LRU with expiration),
+// so that the activities re-emitted on every poll (e.g. RSS feeds, HN top stories) skip the DB upsert.
+// It's only an optimization, so it isn't persisted across restarts.
+type seenActivityCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	// order has the most recently seen keys at the front.
+	order *list.List
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type seenActivityEntry struct {
+	key    string
+	seenAt time.Time
+}
+
+// SeenActivityCacheStats reports how many of the polled activities were skipped as recently processed.
+type SeenActivityCacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// HitRate returns the fraction of the lookups that were hits, or 0 if there were no lookups yet.
+func (s SeenActivityCacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// newSeenActivityCache returns nil if the cache is disabled (zero size).
+func newSeenActivityCache(size int, ttl time.Duration) *seenActivityCache {
+	if size <= 0 {
+		return nil
+	}
+	return &seenActivityCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// seenActivityKey includes the source UIDs, so that the activities re-emitted by another source
+// are still upserted to be linked to it (see the ActivityRepository source UIDs merging).
+// It also includes the content hash, so that the activities edited in place are upserted to regenerate their summaries.
+func seenActivityKey(activity activitytypes.Activity) string {
+	var sb strings.Builder
+	sb.WriteString(activity.UID().String())
+	sb.WriteString(" ")
+	sb.WriteString(activities.ContentHash(activity))
+	for _, uid := range activity.SourceUIDs() {
+		sb.WriteString(" ")
+		sb.WriteString(uid.String())
+	}
+	return sb.String()
+}
+
+// Seen reports whether the activity was processed within the TTL.
+func (c *seenActivityCache) Seen(activity activitytypes.Activity, now time.Time) bool {
+	key := seenActivityKey(activity)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if ok && (c.ttl <= 0 || now.Sub(el.Value.(*seenActivityEntry).seenAt) < c.ttl) {
+		// The activities still re-emitted are kept over the ones that dropped out of the sources.
+		c.order.MoveToFront(el)
+		c.hits.Add(1)
+		return true
+	}
+	if ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+	c.misses.Add(1)
+	return false
+}
+
+// Add marks the activity as processed, evicting the least recently seen activities above the size.
+func (c *seenActivityCache) Add(activity activitytypes.Activity, now time.Time) {
+	key := seenActivityKey(activity)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*seenActivityEntry).seenAt = now
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&seenActivityEntry{key: key, seenAt: now})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*seenActivityEntry).key)
+	}
+}
+
+func (c *seenActivityCache) Stats() SeenActivityCacheStats {
+	return SeenActivityCacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
@@ -0,0 +1,116 @@
+package sources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// seenTestActivity is a minimal activity identified by its ID and sources.
+type seenTestActivity struct {
+	id         string
+	sourceUIDs []string
+	body       string
+}
+
+func (a *seenTestActivity) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+func (a *seenTestActivity) UnmarshalJSON([]byte) error   { return nil }
+func (a *seenTestActivity) UID() activitytypes.TypedUID  { return lib.NewTypedUID("test", a.id) }
+func (a *seenTestActivity) SourceUIDs() []activitytypes.TypedUID {
+	uids := make([]activitytypes.TypedUID, len(a.sourceUIDs))
+	for i, id := range a.sourceUIDs {
+		uids[i] = lib.NewTypedUID("test", id)
+	}
+	return uids
+}
+func (a *seenTestActivity) Title() string           { return "" }
+func (a *seenTestActivity) Body() string            { return a.body }
+func (a *seenTestActivity) URL() string             { return "" }
+func (a *seenTestActivity) ImageURL() string        { return "" }
+func (a *seenTestActivity) CreatedAt() time.Time    { return time.Time{} }
+func (a *seenTestActivity) UpvotesCount() int       { return -1 }
+func (a *seenTestActivity) DownvotesCount() int     { return -1 }
+func (a *seenTestActivity) CommentsCount() int      { return -1 }
+func (a *seenTestActivity) AmplificationCount() int { return -1 }
+func (a *seenTestActivity) SocialScore() float64    { return -1 }
+
+func TestSeenActivityCache_Eviction(t *testing.T) {
+	now := time.Now()
+	cache := newSeenActivityCache(2, time.Hour)
+	a := &seenTestActivity{id: "a", sourceUIDs: []string{"source"}}
+	b := &seenTestActivity{id: "b", sourceUIDs: []string{"source"}}
+	c := &seenTestActivity{id: "c", sourceUIDs: []string{"source"}}
+
+	cache.Add(a, now)
+	cache.Add(b, now)
+	// Seeing a again makes b the least recently seen activity.
+	if !cache.Seen(a, now) {
+		t.Fatal("expected a to be seen")
+	}
+	cache.Add(c, now)
+
+	if cache.Seen(b, now) {
+		t.Error("expected the least recently seen activity to be evicted")
+	}
+	if !cache.Seen(a, now) || !cache.Seen(c, now) {
+		t.Error("expected the recently seen activities to be kept")
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 {
+		t.Errorf("expected 3 hits and 1 miss, got %+v", stats)
+	}
+}
+
+func TestSeenActivityCache_TTL(t *testing.T) {
+	now := time.Now()
+	cache := newSeenActivityCache(10, time.Minute)
+	a := &seenTestActivity{id: "a", sourceUIDs: []string{"source"}}
+
+	cache.Add(a, now)
+	if !cache.Seen(a, now.Add(59*time.Second)) {
+		t.Error("expected the activity to be seen within the TTL")
+	}
+	if cache.Seen(a, now.Add(time.Minute)) {
+		t.Error("expected the activity to expire after the TTL")
+	}
+	if cache.Seen(a, now) {
+		t.Error("expected the expired activity to be removed")
+	}
+}
+
+func TestSeenActivityCache_SourceUIDs(t *testing.T) {
+	now := time.Now()
+	cache := newSeenActivityCache(10, time.Hour)
+
+	cache.Add(&seenTestActivity{id: "a", sourceUIDs: []string{"top"}}, now)
+
+	if cache.Seen(&seenTestActivity{id: "a", sourceUIDs: []string{"top", "best"}}, now) {
+		t.Error("expected the activity re-emitted by another source not to be skipped")
+	}
+	if !cache.Seen(&seenTestActivity{id: "a", sourceUIDs: []string{"top"}}, now) {
+		t.Error("expected the activity from the same source to be skipped")
+	}
+}
+
+func TestSeenActivityCache_Edited(t *testing.T) {
+	now := time.Now()
+	cache := newSeenActivityCache(10, time.Hour)
+
+	cache.Add(&seenTestActivity{id: "a", sourceUIDs: []string{"top"}, body: "original"}, now)
+
+	if cache.Seen(&seenTestActivity{id: "a", sourceUIDs: []string{"top"}, body: "edited"}, now) {
+		t.Error("expected the activity edited in place not to be skipped")
+	}
+	if !cache.Seen(&seenTestActivity{id: "a", sourceUIDs: []string{"top"}, body: "original"}, now) {
+		t.Error("expected the unchanged activity to be skipped")
+	}
+}
+
+func TestSeenActivityCache_Disabled(t *testing.T) {
+	if newSeenActivityCache(0, time.Hour) != nil {
+		t.Error("expected zero size to disable the cache")
+	}
+}
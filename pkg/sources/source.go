@@ -7,8 +7,10 @@ import (
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
 
 	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/sources/providers/custom"
 	"github.com/defeedco/defeed/pkg/sources/providers/github"
 	"github.com/defeedco/defeed/pkg/sources/providers/hackernews"
+	"github.com/defeedco/defeed/pkg/sources/providers/jsonapi"
 	"github.com/defeedco/defeed/pkg/sources/providers/lobsters"
 	"github.com/defeedco/defeed/pkg/sources/providers/mastodon"
 	"github.com/defeedco/defeed/pkg/sources/providers/producthunt"
@@ -35,6 +37,8 @@ func NewSource(sourceType string) (sourcestypes.Source, error) {
 		s = mastodon.NewSourceAccount()
 	case mastodon.TypeMastodonTag:
 		s = mastodon.NewSourceTag()
+	case mastodon.TypeMastodonTrending:
+		s = mastodon.NewSourceTrending()
 	case hackernews.TypeHackerNewsPosts:
 		s = hackernews.NewSourcePosts()
 	case reddit.TypeRedditSubreddit:
@@ -43,6 +47,8 @@ func NewSource(sourceType string) (sourcestypes.Source, error) {
 		s = lobsters.NewSourceTag()
 	case lobsters.TypeLobstersFeed:
 		s = lobsters.NewSourceFeed()
+	case lobsters.TypeLobstersComments:
+		s = lobsters.NewSourceComments()
 	case rss.TypeRSSFeed:
 		s = rss.NewSourceFeed()
 	case github.TypeGithubReleases:
@@ -53,6 +59,10 @@ func NewSource(sourceType string) (sourcestypes.Source, error) {
 		s = github.NewSourceTopic()
 	case producthunt.TypeProductHuntPosts:
 		s = producthunt.NewSourcePosts()
+	case custom.TypeCustom:
+		s = custom.NewSourceCustom()
+	case jsonapi.TypeJSONAPIEndpoint:
+		s = jsonapi.NewSourceEndpoint()
 	default:
 		return nil, fmt.Errorf("unknown source type: %s", sourceType)
 	}
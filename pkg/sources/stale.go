@@ -0,0 +1,254 @@
+package sources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+)
+
+const staleSourceWebhookTimeout = 10 * time.Second
+
+// SourceState is the part of the source stats that is persisted, so that the staleness survives restarts.
+type SourceState struct {
+	LastNewActivityAt time.Time
+	StaleSince        time.Time
+	// DisabledReason is set for the sources removed as stale.
+	DisabledReason string
+}
+
+type sourceStateStore interface {
+	// List returns the states by source UID.
+	List(ctx context.Context) (map[string]SourceState, error)
+	Save(ctx context.Context, uid string, state SourceState) error
+	Remove(ctx context.Context, uid string) error
+}
+
+// StaleSourceNotification is sent when a source is flagged as stale, and when it's removed.
+type StaleSourceNotification struct {
+	SourceUID string `json:"sourceUid"`
+	// OwnerIDs are the users with feeds that use the source.
+	OwnerIDs          []string  `json:"ownerIds"`
+	LastNewActivityAt time.Time `json:"lastNewActivityAt"`
+	// RemoveAt is when the source is going to be removed, or nil if the auto-removal is disabled.
+	RemoveAt *time.Time `json:"removeAt,omitempty"`
+	Removed  bool       `json:"removed"`
+}
+
+// StaleSourceNotifier notifies the feed owners about their stale sources, before they're removed.
+type StaleSourceNotifier interface {
+	NotifyStaleSource(ctx context.Context, notification StaleSourceNotification) error
+}
+
+// WebhookNotifier posts the stale source notifications as JSON to the webhook URL (see Config.StaleSourceWebhookURL).
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: lib.NewHTTPClient(staleSourceWebhookTimeout),
+	}
+}
+
+func (n *WebhookNotifier) NotifyStaleSource(ctx context.Context, notification StaleSourceNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// trackNewActivity records the creation time of the newest activity seen from the source.
+// The source is optional (e.g. for activities pushed to inactive sources).
+func (r *Scheduler) trackNewActivity(source sourcetypes.Source, activity activitytypes.Activity) {
+	if source == nil {
+		return
+	}
+	r.updatePollStats(source, func(stats *SourceStats) {
+		if activity.CreatedAt().After(stats.LastNewActivityAt) {
+			stats.LastNewActivityAt = activity.CreatedAt()
+		}
+	})
+}
+
+// loadSourceStates restores the persisted states into the source stats.
+func (r *Scheduler) loadSourceStates(ctx context.Context) error {
+	if r.stateStore == nil {
+		return nil
+	}
+
+	states, err := r.stateStore.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list source states: %w", err)
+	}
+
+	for uid, state := range states {
+		r.pollStats.Store(uid, SourceStats{
+			LastNewActivityAt: state.LastNewActivityAt,
+			StaleSince:        state.StaleSince,
+			DisabledReason:    state.DisabledReason,
+		})
+	}
+
+	return nil
+}
+
+// checkStaleSources flags the sources without new activities for longer than the threshold as stale,
+// and removes them from the scheduler if they stayed stale for the grace period (see Config.StaleSourceAutoRemove).
+// The feed owners are notified when the source is flagged and when it's removed (see WithStaleSourceNotifier).
+// The removed sources are still referenced by their feeds, and are polled again once they're re-added (e.g. on a feed update).
+func (r *Scheduler) checkStaleSources(ctx context.Context, usage SourceUsage, now time.Time) {
+	if r.config.StaleSourceThreshold <= 0 {
+		return
+	}
+
+	r.pollStats.Range(func(key, value any) bool {
+		uid := key.(string)
+		stats := value.(SourceStats)
+		// Disabled and removed sources aren't polled, so they can't recover anyway.
+		if stats.DisabledReason != "" || stats.LastNewActivityAt.IsZero() {
+			return true
+		}
+
+		source, err := r.activeSourceRepo.GetByID(uid)
+		if err != nil || source == nil {
+			return true
+		}
+		sLogger := sourceLogger(source, r.logger)
+
+		switch {
+		case now.Sub(stats.LastNewActivityAt) < r.config.StaleSourceThreshold:
+			if !stats.StaleSince.IsZero() {
+				r.updatePollStats(source, func(stats *SourceStats) {
+					stats.StaleSince = time.Time{}
+				})
+				sLogger.Info().Msg("Stale source recovered")
+			}
+		case stats.StaleSince.IsZero():
+			stats.StaleSince = now
+			r.updatePollStats(source, func(stats *SourceStats) {
+				stats.StaleSince = now
+			})
+			sLogger.Warn().
+				Time("last_new_activity_at", stats.LastNewActivityAt).
+				Bool("auto_remove", r.config.StaleSourceAutoRemove).
+				Msg("Source is stale")
+			r.notifyStaleSource(ctx, usage, source, stats)
+		case r.config.StaleSourceAutoRemove && now.Sub(stats.StaleSince) >= r.config.StaleSourceRemovalGrace:
+			r.removeStaleSource(ctx, usage, source, stats)
+			return true
+		}
+
+		r.saveSourceState(ctx, uid)
+		return true
+	})
+}
+
+func (r *Scheduler) removeStaleSource(ctx context.Context, usage SourceUsage, source sourcetypes.Source, stats SourceStats) {
+	uid := source.UID().String()
+	sLogger := sourceLogger(source, r.logger)
+
+	if err := r.Remove(uid); err != nil {
+		sLogger.Error().Err(err).Msg("Failed to remove stale source")
+		return
+	}
+
+	// Keep the stats of the removed source, so that the feed owners can see why it isn't polled anymore.
+	stats.DisabledReason = fmt.Sprintf("removed after no new activities since %s", stats.LastNewActivityAt.Format(time.DateOnly))
+	r.pollStats.Store(uid, stats)
+	r.saveSourceState(ctx, uid)
+
+	sLogger.Info().
+		Time("last_new_activity_at", stats.LastNewActivityAt).
+		Msg("Removed stale source")
+
+	r.notifyStaleSource(ctx, usage, source, stats)
+}
+
+// notifyStaleSource only logs the failures, since the staleness is also reported by the source stats.
+func (r *Scheduler) notifyStaleSource(ctx context.Context, usage SourceUsage, source sourcetypes.Source, stats SourceStats) {
+	if r.staleNotifier == nil {
+		return
+	}
+	sLogger := sourceLogger(source, r.logger)
+
+	ownerIDs, err := usage.SourceOwnerIDs(ctx, source.UID())
+	if err != nil {
+		sLogger.Error().Err(err).Msg("Failed to find the owners of the stale source")
+		return
+	}
+
+	notification := StaleSourceNotification{
+		SourceUID:         source.UID().String(),
+		OwnerIDs:          ownerIDs,
+		LastNewActivityAt: stats.LastNewActivityAt,
+		Removed:           stats.DisabledReason != "",
+	}
+	if r.config.StaleSourceAutoRemove && !notification.Removed {
+		removeAt := stats.StaleSince.Add(r.config.StaleSourceRemovalGrace)
+		notification.RemoveAt = &removeAt
+	}
+
+	if err := r.staleNotifier.NotifyStaleSource(ctx, notification); err != nil {
+		sLogger.Error().Err(err).Msg("Failed to notify the stale source owners")
+	}
+}
+
+// saveSourceState persists the current state of the source, if the state store is configured.
+func (r *Scheduler) saveSourceState(ctx context.Context, uid string) {
+	if r.stateStore == nil {
+		return
+	}
+
+	value, ok := r.pollStats.Load(uid)
+	if !ok {
+		return
+	}
+	stats := value.(SourceStats)
+
+	err := r.stateStore.Save(ctx, uid, SourceState{
+		LastNewActivityAt: stats.LastNewActivityAt,
+		StaleSince:        stats.StaleSince,
+		DisabledReason:    stats.DisabledReason,
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Str("source_uid", uid).Msg("Failed to save source state")
+	}
+}
+
+// removeSourceState removes the persisted state of the removed or re-added source.
+func (r *Scheduler) removeSourceState(uid string) {
+	if r.stateStore == nil {
+		return
+	}
+
+	if err := r.stateStore.Remove(context.Background(), uid); err != nil {
+		r.logger.Error().Err(err).Str("source_uid", uid).Msg("Failed to remove source state")
+	}
+}
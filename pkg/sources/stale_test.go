@@ -0,0 +1,212 @@
+package sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	sourcetypes "github.com/defeedco/defeed/pkg/sources/types"
+	"github.com/rs/zerolog"
+)
+
+// staleTestSource only supports the UID.
+type staleTestSource struct {
+	sourcetypes.Source
+	id string
+}
+
+func (s *staleTestSource) UID() activitytypes.TypedUID { return lib.NewTypedUID("test", s.id) }
+
+// memorySourceStore keeps the active sources in memory.
+type memorySourceStore struct {
+	sources map[string]sourcetypes.Source
+}
+
+func (s *memorySourceStore) Add(source sourcetypes.Source) error {
+	s.sources[source.UID().String()] = source
+	return nil
+}
+
+func (s *memorySourceStore) Remove(uid string) error {
+	delete(s.sources, uid)
+	return nil
+}
+
+func (s *memorySourceStore) List() ([]sourcetypes.Source, error) {
+	out := make([]sourcetypes.Source, 0, len(s.sources))
+	for _, source := range s.sources {
+		out = append(out, source)
+	}
+	return out, nil
+}
+
+func (s *memorySourceStore) GetByID(uid string) (sourcetypes.Source, error) {
+	return s.sources[uid], nil
+}
+
+type memoryStateStore struct {
+	states map[string]SourceState
+}
+
+func (s *memoryStateStore) List(context.Context) (map[string]SourceState, error) {
+	return s.states, nil
+}
+
+func (s *memoryStateStore) Save(_ context.Context, uid string, state SourceState) error {
+	s.states[uid] = state
+	return nil
+}
+
+func (s *memoryStateStore) Remove(_ context.Context, uid string) error {
+	delete(s.states, uid)
+	return nil
+}
+
+// ownerUsage reports all sources as used by the same user.
+type ownerUsage struct{}
+
+func (ownerUsage) UsedSourceUIDs(_ context.Context, sourceUIDs []activitytypes.TypedUID) (map[string]bool, error) {
+	out := make(map[string]bool, len(sourceUIDs))
+	for _, uid := range sourceUIDs {
+		out[uid.String()] = true
+	}
+	return out, nil
+}
+
+func (ownerUsage) SourceOwnerIDs(context.Context, activitytypes.TypedUID) ([]string, error) {
+	return []string{"user"}, nil
+}
+
+type recordingNotifier struct {
+	notifications []StaleSourceNotification
+}
+
+func (n *recordingNotifier) NotifyStaleSource(_ context.Context, notification StaleSourceNotification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func newStaleTestScheduler(config *Config, source sourcetypes.Source) (*Scheduler, *memorySourceStore, *memoryStateStore, *recordingNotifier) {
+	logger := zerolog.Nop()
+	sourceStore := &memorySourceStore{sources: map[string]sourcetypes.Source{source.UID().String(): source}}
+	stateStore := &memoryStateStore{states: make(map[string]SourceState)}
+	notifier := &recordingNotifier{}
+	config.MaxActivityProcessorConcurrency = 1
+	scheduler := NewScheduler(&logger, sourceStore, nil, nil, config, &sourcetypes.ProviderConfig{}).
+		WithStateStore(stateStore).
+		WithStaleSourceNotifier(notifier)
+	return scheduler, sourceStore, stateStore, notifier
+}
+
+func TestCheckStaleSources_AutoRemove(t *testing.T) {
+	source := &staleTestSource{id: "dead"}
+	uid := source.UID().String()
+	scheduler, sourceStore, stateStore, notifier := newStaleTestScheduler(&Config{
+		StaleSourceThreshold:    24 * time.Hour,
+		StaleSourceAutoRemove:   true,
+		StaleSourceRemovalGrace: time.Hour,
+	}, source)
+
+	now := time.Now()
+	lastNewActivityAt := now.Add(-48 * time.Hour)
+	scheduler.updatePollStats(source, func(stats *SourceStats) {
+		stats.LastNewActivityAt = lastNewActivityAt
+	})
+	pollCtx, cancelPolling := context.WithCancel(context.Background())
+	defer cancelPolling()
+	scheduler.cancelBySourceID.Store(uid, cancelPolling)
+
+	scheduler.checkStaleSources(context.Background(), ownerUsage{}, now)
+
+	if state := stateStore.states[uid]; !state.StaleSince.Equal(now) || !state.LastNewActivityAt.Equal(lastNewActivityAt) {
+		t.Fatalf("expected persisted stale state, got %+v", state)
+	}
+	if len(notifier.notifications) != 1 {
+		t.Fatalf("expected a stale notification, got %d", len(notifier.notifications))
+	}
+	flagged := notifier.notifications[0]
+	if flagged.Removed || flagged.RemoveAt == nil || !flagged.RemoveAt.Equal(now.Add(time.Hour)) || flagged.OwnerIDs[0] != "user" {
+		t.Errorf("unexpected stale notification: %+v", flagged)
+	}
+
+	// The source is kept within the grace period.
+	scheduler.checkStaleSources(context.Background(), ownerUsage{}, now.Add(30*time.Minute))
+	if sourceStore.sources[uid] == nil || len(notifier.notifications) != 1 {
+		t.Fatal("expected the source to be kept within the grace period")
+	}
+	if pollCtx.Err() != nil {
+		t.Fatal("expected the source to be polled within the grace period")
+	}
+
+	scheduler.checkStaleSources(context.Background(), ownerUsage{}, now.Add(time.Hour))
+	if sourceStore.sources[uid] != nil {
+		t.Fatal("expected the stale source to be removed")
+	}
+	if pollCtx.Err() == nil {
+		t.Error("expected the stale source polling to be stopped")
+	}
+	if state := stateStore.states[uid]; state.DisabledReason == "" {
+		t.Errorf("expected persisted disabled reason, got %+v", state)
+	}
+	if len(notifier.notifications) != 2 || !notifier.notifications[1].Removed {
+		t.Errorf("expected a removal notification, got %+v", notifier.notifications)
+	}
+}
+
+func TestCheckStaleSources_Recovered(t *testing.T) {
+	source := &staleTestSource{id: "quiet"}
+	uid := source.UID().String()
+	scheduler, sourceStore, stateStore, notifier := newStaleTestScheduler(&Config{
+		StaleSourceThreshold: 24 * time.Hour,
+	}, source)
+
+	now := time.Now()
+	scheduler.updatePollStats(source, func(stats *SourceStats) {
+		stats.LastNewActivityAt = now.Add(-48 * time.Hour)
+	})
+	scheduler.checkStaleSources(context.Background(), ownerUsage{}, now)
+
+	if notifier.notifications[0].RemoveAt != nil {
+		t.Error("expected no removal time without the auto-removal")
+	}
+
+	scheduler.updatePollStats(source, func(stats *SourceStats) {
+		stats.LastNewActivityAt = now
+	})
+	scheduler.checkStaleSources(context.Background(), ownerUsage{}, now.Add(time.Hour))
+
+	if state := stateStore.states[uid]; !state.StaleSince.IsZero() {
+		t.Errorf("expected the recovered source not to be stale, got %+v", state)
+	}
+	if sourceStore.sources[uid] == nil {
+		t.Error("expected the source to be kept")
+	}
+}
+
+func TestLoadSourceStates(t *testing.T) {
+	source := &staleTestSource{id: "dead"}
+	uid := source.UID().String()
+	scheduler, sourceStore, stateStore, _ := newStaleTestScheduler(&Config{
+		StaleSourceThreshold:    24 * time.Hour,
+		StaleSourceAutoRemove:   true,
+		StaleSourceRemovalGrace: time.Hour,
+	}, source)
+
+	// The source was flagged before the restart, so the grace period isn't restarted.
+	now := time.Now()
+	stateStore.states[uid] = SourceState{
+		LastNewActivityAt: now.Add(-48 * time.Hour),
+		StaleSince:        now.Add(-2 * time.Hour),
+	}
+	if err := scheduler.loadSourceStates(context.Background()); err != nil {
+		t.Fatalf("load source states: %v", err)
+	}
+
+	scheduler.checkStaleSources(context.Background(), ownerUsage{}, now)
+
+	if sourceStore.sources[uid] != nil {
+		t.Error("expected the source flagged before the restart to be removed")
+	}
+}
@@ -1,13 +1,150 @@
 package types
 
+import (
+	"strings"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
 type ProviderConfig struct {
+	// MaxLookBack caps how far back sources fetch activities from their last seen activity.
+	// If the last seen activity is older than MaxLookBack (e.g. after downtime), it's discarded
+	// and the source is fetched as on its first fetch (see InitialBackfillPeriod), but at most MaxLookBack back.
+	// Set to 0 to always fetch from the last seen activity.
+	MaxLookBack time.Duration `env:"SOURCE_MAX_LOOK_BACK,default=168h" validate:"gte=0"`
+	// InitialBackfillPeriod is how far back the sources fetch activities on their first fetch (e.g. "everything from the last 7 days"),
+	// so that the newly added sources have predictable onboarding content regardless of their type.
+	// The providers with paginated APIs also cap the number of pages (and posts) fetched, so that active sources don't flood the feed.
+	// Set to 0 to fetch everything the provider APIs return on the first page.
+	InitialBackfillPeriod time.Duration `env:"SOURCE_INITIAL_BACKFILL_PERIOD,default=168h" validate:"gte=0"`
+
 	GithubAPIKey string `env:"GITHUB_API_KEY,default="`
+	// GithubSearchMaxWait is how long the GitHub topic sources wait for the exhausted search quota to reset
+	// (see the X-RateLimit-Reset and Retry-After headers), before stopping the poll with the repositories fetched so far.
+	GithubSearchMaxWait time.Duration `env:"GITHUB_SEARCH_MAX_WAIT,default=10s" validate:"gte=0"`
+	// WebhookSecretKey derives the webhook secrets of each source and owner (see WebhookSecret),
+	// which validate the signature of the provider webhooks (e.g. GitHub release and issue events). Webhooks are rejected when empty.
+	WebhookSecretKey string `env:"SOURCE_WEBHOOK_SECRET_KEY,default="`
 
 	RedditClientID     string `env:"REDDIT_CLIENT_ID,default="`
 	RedditClientSecret string `env:"REDDIT_CLIENT_SECRET,default="`
 
 	MastodonClientID     string `env:"MASTODON_CLIENT_ID,default="`
 	MastodonClientSecret string `env:"MASTODON_CLIENT_SECRET,default="`
+	// MastodonAccessTokens is a comma-separated list of instance host=token pairs
+	// Example: mastodon.social=token1,fosstodon.org=token2
+	MastodonAccessTokens string `env:"MASTODON_ACCESS_TOKENS,default="`
 
 	ProductHuntAPIToken string `env:"PRODUCTHUNT_API_TOKEN,default="`
+
+	// Rate limits (API requests per minute) shared by all sources of the same provider.
+	// Mastodon instances are limited separately. Set to 0 to disable.
+	GithubRateLimit      float64 `env:"GITHUB_RATE_LIMIT,default=30" validate:"gte=0"`
+	RedditRateLimit      float64 `env:"REDDIT_RATE_LIMIT,default=60" validate:"gte=0"`
+	MastodonRateLimit    float64 `env:"MASTODON_RATE_LIMIT,default=60" validate:"gte=0"`
+	ProductHuntRateLimit float64 `env:"PRODUCTHUNT_RATE_LIMIT,default=30" validate:"gte=0"`
+	HackerNewsRateLimit  float64 `env:"HACKERNEWS_RATE_LIMIT,default=0" validate:"gte=0"`
+	LobstersRateLimit    float64 `env:"LOBSTERS_RATE_LIMIT,default=30" validate:"gte=0"`
+
+	// ExternalFetchConcurrency is the max number of concurrent external content fetches (e.g. linked articles, thumbnails)
+	// shared by all sources. Each fetch may do a few requests (e.g. article text fallbacks). Set to 0 to disable.
+	ExternalFetchConcurrency int `env:"EXTERNAL_FETCH_CONCURRENCY,default=50" validate:"gte=0"`
+	// HackerNewsFetchConcurrency is the max number of stories fetched concurrently per Hacker News source.
+	HackerNewsFetchConcurrency int `env:"HACKERNEWS_FETCH_CONCURRENCY,default=20" validate:"gte=1"`
+
+	// RSSPresetOPML is a comma-separated list of OPML file paths or URLs with additional RSS source presets.
+	RSSPresetOPML string `env:"RSS_PRESET_OPML,default="`
+	// JSONAPIEndpoints is the path of a JSON file with the generic JSON API endpoints polled as sources (see jsonapi.Endpoint),
+	// e.g. for the internal or niche APIs without a dedicated provider. The endpoints are visible to all users.
+	JSONAPIEndpoints string `env:"JSONAPI_ENDPOINTS,default="`
+	// RSSPresetIncludeEmbedded controls whether the built-in OPML presets are loaded.
+	// Disable to only use the RSSPresetOPML presets (e.g. a curated subset).
+	RSSPresetIncludeEmbedded bool `env:"RSS_PRESET_INCLUDE_EMBEDDED,default=true"`
+
+	// RSSFetchThumbnails controls whether the thumbnail is fetched from the item's page when the feed doesn't provide an image.
+	// This adds an HTTP request per item, so disable it to speed up polling of large feeds.
+	RSSFetchThumbnails bool `env:"RSS_FETCH_THUMBNAILS,default=true"`
+
+	// ImageValidation checks the activity images with a HEAD request (cached for ImageValidationCacheTTL),
+	// and drops the images that aren't raster images (e.g. SVGs, data URLs, error pages) or exceed ImageValidationMaxBytes.
+	// Disabled by default, since it adds requests to the thumbnail resolution and the uncached feed responses.
+	ImageValidation         bool          `env:"IMAGE_VALIDATION,default=false"`
+	ImageValidationMaxBytes int64         `env:"IMAGE_VALIDATION_MAX_BYTES,default=5242880" validate:"gte=1"`
+	ImageValidationCacheTTL time.Duration `env:"IMAGE_VALIDATION_CACHE_TTL,default=24h" validate:"gte=0"`
+	// ImageValidationContentTypes is a comma-separated allowlist of the image content types (e.g. "image/jpeg,image/png,image/webp").
+	// Empty allows all raster images. SVGs are never allowed, since they can contain scripts.
+	ImageValidationContentTypes string `env:"IMAGE_VALIDATION_CONTENT_TYPES,default="`
+
+	// RSSMinContentLength is the min number of characters in the sanitized RSS item body.
+	// Items with shorter bodies (e.g. teasers) are skipped. Set to 0 to disable.
+	RSSMinContentLength int `env:"RSS_MIN_CONTENT_LENGTH,default=0" validate:"gte=0"`
+
+	// SourceSearchConcurrency is the max number of fetchers searched concurrently when discovering sources.
+	SourceSearchConcurrency int `env:"SOURCE_SEARCH_CONCURRENCY,default=5" validate:"gte=1"`
+	// SourceSearchFetcherTimeout bounds the search of a single fetcher (e.g. a slow RSS instance or 3rd party API).
+	SourceSearchFetcherTimeout time.Duration `env:"SOURCE_SEARCH_FETCHER_TIMEOUT,default=5s" validate:"gt=0"`
+	// SourceSearchTimeout bounds the whole source search.
+	// Fetchers that don't respond in time are skipped, and the partial results are returned.
+	SourceSearchTimeout time.Duration `env:"SOURCE_SEARCH_TIMEOUT,default=10s" validate:"gt=0"`
+
+	// Fallback strategies used to fetch the full article text when the original page looks truncated (e.g. paywalled).
+	ArticleFallbackAMP   bool `env:"ARTICLE_FALLBACK_AMP,default=true"`
+	ArticleFallbackPrint bool `env:"ARTICLE_FALLBACK_PRINT,default=true"`
+	// ArticleFallbackGooglebot impersonates the Googlebot user agent, so it's disabled by default for compliance.
+	ArticleFallbackGooglebot bool `env:"ARTICLE_FALLBACK_GOOGLEBOT,default=false"`
+
+	// ArticleTextCacheMaxSize caps the total size (in bytes) of the cached article texts. Set to 0 to disable the cache.
+	ArticleTextCacheMaxSize int `env:"ARTICLE_TEXT_CACHE_MAX_SIZE,default=52428800" validate:"gte=0"`
+	// ArticleTextCacheTTL is how long the cached texts are served without revalidating them with the origin server.
+	ArticleTextCacheTTL time.Duration `env:"ARTICLE_TEXT_CACHE_TTL,default=24h" validate:"gte=0"`
+	// ArticleTextCachePersistentMaxSize caps the total size (in bytes) of the article texts persisted in the database,
+	// so that they survive restarts. Set to 0 to keep the texts only in memory.
+	ArticleTextCachePersistentMaxSize int `env:"ARTICLE_TEXT_CACHE_PERSISTENT_MAX_SIZE,default=524288000" validate:"gte=0"`
+
+	// IncludeNSFWContent allows activities flagged as NSFW by the provider (e.g. Reddit, Mastodon).
+	// These are excluded by default to avoid misuse or legal issues.
+	IncludeNSFWContent bool `env:"INCLUDE_NSFW_CONTENT,default=false"`
+	// IncludeSpoilerContent allows activities flagged as spoilers or hidden behind a content warning.
+	IncludeSpoilerContent bool `env:"INCLUDE_SPOILER_CONTENT,default=false"`
+}
+
+// ExcludedContentFlags returns the content flags of the activities that aren't processed.
+// Providers can check them before fetching the linked content of the excluded activities.
+func (c *ProviderConfig) ExcludedContentFlags() map[activitytypes.ContentFlag]bool {
+	return map[activitytypes.ContentFlag]bool{
+		activitytypes.ContentFlagNSFW:    !c.IncludeNSFWContent,
+		activitytypes.ContentFlagSpoiler: !c.IncludeSpoilerContent,
+	}
+}
+
+// ArticleTextCache returns the cache of the extracted article texts, or nil if it's disabled.
+// The texts are persisted in the store, unless the persistent cache is disabled.
+func (c *ProviderConfig) ArticleTextCache(store lib.TextCacheStore) *lib.TextCache {
+	if c.ArticleTextCacheMaxSize == 0 {
+		return nil
+	}
+	cache := lib.NewTextCache(c.ArticleTextCacheTTL, c.ArticleTextCacheMaxSize)
+	if c.ArticleTextCachePersistentMaxSize > 0 {
+		cache = cache.WithStore(store, c.ArticleTextCachePersistentMaxSize)
+	}
+	return cache
+}
+
+// ImageValidator returns the validator of the activity images, or nil if it's disabled.
+func (c *ProviderConfig) ImageValidator() *lib.ImageValidator {
+	if !c.ImageValidation {
+		return nil
+	}
+	return lib.NewImageValidator(c.ImageValidationCacheTTL, c.ImageValidationMaxBytes).
+		WithContentTypes(strings.Split(c.ImageValidationContentTypes, ","))
+}
+
+func (c *ProviderConfig) ArticleTextFallbacks() lib.TextFallbacks {
+	return lib.TextFallbacks{
+		AMP:       c.ArticleFallbackAMP,
+		Print:     c.ArticleFallbackPrint,
+		Googlebot: c.ArticleFallbackGooglebot,
+	}
 }
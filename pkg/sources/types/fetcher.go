@@ -16,5 +16,7 @@ type Fetcher interface {
 	// Search can either:
 	// - return a full list of available sources when query is empty or when the set of all available sources is small (e.g. Lobsters Feeds)
 	// - return a filtered list of sources when query is non-empty or the set of all available sources is large (e.g. GitHub Issues)
-	Search(ctx context.Context, query string, config *ProviderConfig) ([]Source, error)
+	// topicsHint is an optional list of requested topics, that fetchers can use to cheaply pre-filter the sources.
+	// Fetchers may ignore it, since the results are filtered by topics afterward.
+	Search(ctx context.Context, query string, topicsHint []TopicTag, config *ProviderConfig) ([]Source, error)
 }
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+type lookBackLimitKey struct{}
+
+// WithLookBackLimit bounds how far back the source fetches when it falls back to the initial backfill
+// because its last seen activity became stale (see IsStale), so that the recovered source doesn't backfill an unbounded gap.
+func WithLookBackLimit(ctx context.Context, limit time.Time) context.Context {
+	return context.WithValue(ctx, lookBackLimitKey{}, limit)
+}
+
+// SinceTime returns the time from which the source should fetch new activities.
+// The last seen activity is used if present, otherwise the initial backfill period applies (see InitialSince).
+func SinceTime(ctx context.Context, since activitytypes.Activity, initialBackfill time.Duration) time.Time {
+	if since != nil {
+		return since.CreatedAt()
+	}
+	return InitialSince(ctx, initialBackfill)
+}
+
+// InitialSince returns the start of the initial backfill period,
+// from which the sources fetch on their first fetch (or after their last seen activity became stale).
+// Each provider translates it into the pagination of its API.
+// Returns the zero time if the period is disabled and no look-back limit is set (see WithLookBackLimit).
+func InitialSince(ctx context.Context, initialBackfill time.Duration) time.Time {
+	var initialSince time.Time
+	if initialBackfill > 0 {
+		initialSince = time.Now().Add(-initialBackfill)
+	}
+	if limit, ok := ctx.Value(lookBackLimitKey{}).(time.Time); ok && limit.After(initialSince) {
+		return limit
+	}
+	return initialSince
+}
+
+// IsStale returns true if the last seen activity is older than the max look-back window.
+// Stale activities shouldn't be used as the starting point for fetching,
+// otherwise the recovered source would backfill an unbounded gap.
+func IsStale(since activitytypes.Activity, maxLookBack time.Duration) bool {
+	if since == nil || maxLookBack <= 0 {
+		return false
+	}
+	return since.CreatedAt().Before(time.Now().Add(-maxLookBack))
+}
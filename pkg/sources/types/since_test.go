@@ -0,0 +1,51 @@
+package types
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+type createdActivity struct {
+	activitytypes.Activity
+	createdAt time.Time
+}
+
+func (a createdActivity) CreatedAt() time.Time { return a.createdAt }
+
+func TestSinceTime(t *testing.T) {
+	last := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := SinceTime(context.Background(), createdActivity{createdAt: last}, 24*time.Hour); !got.Equal(last) {
+		t.Errorf("expected the last seen activity time, got %v", got)
+	}
+
+	if got := SinceTime(context.Background(), nil, 0); !got.IsZero() {
+		t.Errorf("expected zero time with the initial backfill disabled, got %v", got)
+	}
+
+	got := SinceTime(context.Background(), nil, 7*24*time.Hour)
+	want := time.Now().Add(-7 * 24 * time.Hour)
+	if got.Sub(want).Abs() > time.Minute {
+		t.Errorf("expected the start of the initial backfill period %v, got %v", want, got)
+	}
+}
+
+func TestInitialSince_LookBackLimit(t *testing.T) {
+	limit := time.Now().Add(-24 * time.Hour)
+	ctx := WithLookBackLimit(context.Background(), limit)
+
+	if got := InitialSince(ctx, 0); !got.Equal(limit) {
+		t.Errorf("expected the look-back limit with the initial backfill disabled, got %v", got)
+	}
+	if got := InitialSince(ctx, 7*24*time.Hour); !got.Equal(limit) {
+		t.Errorf("expected the initial backfill period to be clamped to the look-back limit, got %v", got)
+	}
+
+	got := InitialSince(ctx, time.Hour)
+	want := time.Now().Add(-time.Hour)
+	if got.Sub(want).Abs() > time.Minute {
+		t.Errorf("expected the shorter initial backfill period %v, got %v", want, got)
+	}
+}
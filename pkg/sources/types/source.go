@@ -3,11 +3,15 @@ package types
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
 	"github.com/rs/zerolog"
 )
 
+// ErrMissingCredentials is returned by Initialize when the provider requires credentials that aren't configured.
+var ErrMissingCredentials = errors.New("missing credentials")
+
 type Source interface {
 	json.Marshaler
 	json.Unmarshaler
@@ -27,6 +31,7 @@ type Source interface {
 	Topics() []TopicTag
 	// Initialize stores the logger and initializes the internal state given config.
 	// The caller should validate the config before usage.
+	// Returns ErrMissingCredentials if the provider credentials aren't configured.
 	Initialize(logger *zerolog.Logger, config *ProviderConfig) error
 	// Stream performs a one-time fetch of new activities from the source.
 	// Since is the last activity emitted by the source.
@@ -87,3 +87,28 @@ func normalize(s string) string {
 	}
 	return string(out)
 }
+
+// FilterByTopics returns the sources that match at least one of the topics.
+// If no topics are provided, all sources are returned.
+func FilterByTopics(input []Source, topics []TopicTag) []Source {
+	if len(topics) == 0 {
+		return input
+	}
+
+	lookup := make(map[TopicTag]bool)
+	for _, topic := range topics {
+		lookup[topic] = true
+	}
+
+	result := make([]Source, 0)
+	for _, source := range input {
+		for _, topic := range source.Topics() {
+			if lookup[topic] {
+				result = append(result, source)
+				break
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+)
+
+// ErrInvalidWebhookSignature is returned by ParseWebhook when the payload isn't signed with any of the source secrets.
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// WebhookParser allows source types to ingest the activities pushed by the provider webhooks, instead of polling.
+type WebhookParser interface {
+	SourceType() string
+	// ParseWebhook validates the payload signature with any of the source secrets (see WebhookSecret),
+	// and returns the activities of the payload.
+	// Events that aren't relevant to the source (e.g. deleted releases, pings) return no activities.
+	// Returns ErrInvalidWebhookSignature if the signature is missing or doesn't match.
+	ParseWebhook(source Source, header http.Header, payload []byte, secrets []string) ([]activitytypes.Activity, error)
+}
+
+// WebhookSecret derives the webhook secret of the source for the given owner (a user with a feed using the source).
+// Sources are shared by the users, so each owner configures their own secret for the provider webhooks,
+// and a secret can't be used to push to the other sources. Returns an empty secret if the key is empty.
+func WebhookSecret(key string, sourceUID activitytypes.TypedUID, ownerID string) string {
+	if key == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(sourceUID.String() + "\n" + ownerID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
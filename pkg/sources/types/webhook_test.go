@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+)
+
+func TestWebhookSecret(t *testing.T) {
+	source := lib.NewTypedUID("githubreleases", "golang", "go")
+	secret := WebhookSecret("key", source, "user")
+
+	if secret == "" {
+		t.Fatal("expected a secret")
+	}
+	if got := WebhookSecret("key", source, "user"); got != secret {
+		t.Errorf("expected a stable secret, got %s and %s", secret, got)
+	}
+	if WebhookSecret("key", source, "other") == secret {
+		t.Error("expected a different secret for another owner")
+	}
+	if WebhookSecret("key", lib.NewTypedUID("githubreleases", "golang", "tools"), "user") == secret {
+		t.Error("expected a different secret for another source")
+	}
+	if WebhookSecret("other", source, "user") == secret {
+		t.Error("expected a different secret for another key")
+	}
+	if got := WebhookSecret("", source, "user"); got != "" {
+		t.Errorf("expected no secret without a key, got %s", got)
+	}
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/feeds"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	entactivityfeedback "github.com/defeedco/defeed/pkg/storage/postgres/ent/activityfeedback"
+)
+
+type ActivityFeedbackRepository struct {
+	db *DB
+}
+
+func NewActivityFeedbackRepository(db *DB) *ActivityFeedbackRepository {
+	return &ActivityFeedbackRepository{db: db}
+}
+
+// SetFeedback stores the feedback of the user on the activity. FeedbackNone removes the existing feedback.
+func (r *ActivityFeedbackRepository) SetFeedback(ctx context.Context, userID string, activityID string, value feeds.FeedbackValue) error {
+	if value == feeds.FeedbackNone {
+		_, err := r.db.Client().ActivityFeedback.Delete().
+			Where(
+				entactivityfeedback.UserID(userID),
+				entactivityfeedback.ActivityID(activityID),
+			).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete feedback: %w", err)
+		}
+		return nil
+	}
+
+	err := r.db.Client().ActivityFeedback.Create().
+		SetUserID(userID).
+		SetActivityID(activityID).
+		SetValue(int(value)).
+		SetUpdatedAt(time.Now()).
+		OnConflictColumns(entactivityfeedback.FieldUserID, entactivityfeedback.FieldActivityID).
+		UpdateValue().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("upsert feedback: %w", err)
+	}
+
+	return nil
+}
+
+// ListFeedback returns the most recent feedback of the user.
+func (r *ActivityFeedbackRepository) ListFeedback(ctx context.Context, userID string, limit int) ([]*feeds.ActivityFeedback, error) {
+	rows, err := r.db.Client().ActivityFeedback.Query().
+		Where(entactivityfeedback.UserID(userID)).
+		Order(ent.Desc(entactivityfeedback.FieldUpdatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query feedback: %w", err)
+	}
+
+	out := make([]*feeds.ActivityFeedback, len(rows))
+	for i, row := range rows {
+		out[i] = &feeds.ActivityFeedback{
+			ActivityID: row.ActivityID,
+			Value:      feeds.FeedbackValue(row.Value),
+		}
+	}
+
+	return out, nil
+}
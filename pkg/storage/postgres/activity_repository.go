@@ -5,15 +5,19 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/lib/tracing"
 	"github.com/defeedco/defeed/pkg/sources/activities"
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
 	"github.com/defeedco/defeed/pkg/sources/providers"
 	"github.com/pgvector/pgvector-go"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
 	entactivity "github.com/defeedco/defeed/pkg/storage/postgres/ent/activity"
@@ -35,6 +39,18 @@ type partialActivity struct {
 	SourceUids  []string `json:"source_uids"`
 }
 
+// mergeSourceUIDs adds the activity source UIDs to the ones of the stored activity,
+// so that the activities emitted by overlapping sources (e.g. hot and new posts of a subreddit) are stored once.
+func mergeSourceUIDs(existing []string, activity types.Activity) []string {
+	sourceUIDs := existing
+	for _, uid := range activity.SourceUIDs() {
+		if !slices.Contains(sourceUIDs, uid.String()) {
+			sourceUIDs = append(sourceUIDs, uid.String())
+		}
+	}
+	return sourceUIDs
+}
+
 func (r *ActivityRepository) Upsert(ctx context.Context, activity *types.DecoratedActivity) error {
 	existingPartialActivities := []partialActivity{}
 	err := r.db.Client().Activity.Query().
@@ -58,12 +74,7 @@ func (r *ActivityRepository) Upsert(ctx context.Context, activity *types.Decorat
 		return fmt.Errorf("marshal activity: %w", err)
 	}
 
-	sourceUIDs := existingPartialActivity.SourceUids
-	for _, uid := range activity.Activity.SourceUIDs() {
-		if !slices.Contains(sourceUIDs, uid.String()) {
-			sourceUIDs = append(sourceUIDs, uid.String())
-		}
-	}
+	sourceUIDs := mergeSourceUIDs(existingPartialActivity.SourceUids, activity.Activity)
 
 	// Assume all sources are of the same type.
 	var sourceType string
@@ -82,27 +93,46 @@ func (r *ActivityRepository) Upsert(ctx context.Context, activity *types.Decorat
 		SetCreatedAt(activity.Activity.CreatedAt()).
 		SetSourceType(sourceType).
 		SetRawJSON(string(rawJson)).
+		SetContentHash(activity.ContentHash).
+		SetEmbeddingModel(activity.EmbeddingModel).
 		SetShortSummary(activity.Summary.ShortSummary).
 		SetFullSummary(activity.Summary.FullSummary).
 		SetSocialScore(activity.Activity.SocialScore()).
+		SetQualityScore(activity.QualityScore).
 		SetUpdateCount(existingPartialActivity.UpdateCount + 1)
 
-	switch len(activity.Embedding) {
+	embedding := activity.Embedding
+	if !r.db.VectorSearch() {
+		// The embedding columns don't exist in the keyword search fallback mode.
+		embedding = nil
+	}
+
+	switch len(embedding) {
 	case 1536:
-		qb = qb.SetEmbedding1536(pgvector.NewVector(activity.Embedding))
+		qb = qb.SetEmbedding1536(pgvector.NewVector(embedding))
 	case 3072:
-		qb = qb.SetEmbedding3072(pgvector.NewVector(activity.Embedding))
+		qb = qb.SetEmbedding3072(pgvector.NewVector(embedding))
 	case 0:
 		// Do nothing
 	default:
-		return fmt.Errorf("invalid embedding length: %d", len(activity.Embedding))
+		return fmt.Errorf("invalid embedding length: %d", len(embedding))
 	}
 
-	err = qb.
+	upsert := qb.
 		// https://github.com/ent/ent/issues/2494#issuecomment-1182015427
 		OnConflictColumns(entactivity.FieldID).
-		UpdateNewValues().
-		Exec(ctx)
+		UpdateNewValues()
+
+	// Clear the embedding of the other dimension (e.g. after an embedding model change),
+	// so that the activity isn't matched by the searches of the old dimension.
+	switch len(embedding) {
+	case 1536:
+		upsert = upsert.ClearEmbedding3072()
+	case 3072:
+		upsert = upsert.ClearEmbedding1536()
+	}
+
+	err = upsert.Exec(ctx)
 
 	if err != nil {
 		r.logger.Error().
@@ -121,9 +151,147 @@ type activityWithSimilarity struct {
 	WeightedScore float64 `sql:"weighted_score"`
 }
 
-func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest) (*types.SearchResult, error) {
+// CountBySource returns the number of stored activities per source UID.
+func (r *ActivityRepository) CountBySource(ctx context.Context) (map[string]int, error) {
+	rows, err := r.db.Client().QueryContext(ctx, `
+		SELECT source_uid, COUNT(*)
+		FROM activities, jsonb_array_elements_text(source_uids) AS source_uid
+		GROUP BY source_uid`)
+	if err != nil {
+		return nil, fmt.Errorf("count activities: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var sourceUID string
+		var count int
+		if err := rows.Scan(&sourceUID, &count); err != nil {
+			return nil, fmt.Errorf("scan count: %w", err)
+		}
+		counts[sourceUID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Stats summarizes the stored activities of the given sources.
+func (r *ActivityRepository) Stats(ctx context.Context, sourceUIDs []string) (types.ActivityStats, error) {
+	if len(sourceUIDs) == 0 {
+		return types.ActivityStats{}, nil
+	}
+
+	rows, err := r.db.Client().QueryContext(ctx, `
+		SELECT COUNT(*), MAX(created_at), COALESCE(SUM(update_count), 0)
+		FROM activities
+		WHERE source_uids ?| $1::text[]`,
+		sourceUIDs,
+	)
+	if err != nil {
+		return types.ActivityStats{}, fmt.Errorf("query activity stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats types.ActivityStats
+	if rows.Next() {
+		var latestCreatedAt *time.Time
+		if err := rows.Scan(&stats.Count, &latestCreatedAt, &stats.UpdateCount); err != nil {
+			return types.ActivityStats{}, fmt.Errorf("scan activity stats: %w", err)
+		}
+		if latestCreatedAt != nil {
+			stats.LatestCreatedAt = *latestCreatedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return types.ActivityStats{}, fmt.Errorf("iterate activity stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// OldestKeptAt returns the creation time of the oldest activity within the newest ones of the source (see TrimSource),
+// or zero if the source has fewer activities.
+func (r *ActivityRepository) OldestKeptAt(ctx context.Context, sourceUID string, keep int) (time.Time, error) {
+	sourceUIDs, err := json.Marshal([]string{sourceUID})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("marshal source uids: %w", err)
+	}
+
+	rows, err := r.db.Client().QueryContext(ctx, `
+		SELECT created_at FROM activities
+		WHERE source_uids @> $1::jsonb
+		ORDER BY created_at DESC, id DESC
+		OFFSET $2
+		LIMIT 1`,
+		string(sourceUIDs), keep-1,
+	)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("query oldest kept activity: %w", err)
+	}
+	defer rows.Close()
+
+	var createdAt time.Time
+	if rows.Next() {
+		if err := rows.Scan(&createdAt); err != nil {
+			return time.Time{}, fmt.Errorf("scan created at: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return time.Time{}, fmt.Errorf("iterate oldest kept activity: %w", err)
+	}
+
+	return createdAt, nil
+}
+
+// TrimSource deletes the oldest activities of the source above the given count.
+// The activities with user feedback, the ones added to collections, and the ones shared with other sources are never deleted.
+func (r *ActivityRepository) TrimSource(ctx context.Context, sourceUID string, keep int) (int, error) {
+	sourceUIDs, err := json.Marshal([]string{sourceUID})
+	if err != nil {
+		return 0, fmt.Errorf("marshal source uids: %w", err)
+	}
+
+	res, err := r.db.Client().ExecContext(ctx, `
+		DELETE FROM activities
+		WHERE source_uids @> $1::jsonb
+			AND jsonb_array_length(source_uids) = 1
+			AND id NOT IN (SELECT activity_id FROM activity_feedbacks)
+			AND id NOT IN (SELECT activity_id FROM collection_activities)
+			AND id NOT IN (
+				SELECT id FROM activities
+				WHERE source_uids @> $1::jsonb
+				ORDER BY created_at DESC, id DESC
+				LIMIT $2
+			)`,
+		string(sourceUIDs), keep,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete activities: %w", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return int(count), nil
+}
+
+func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest) (_ *types.SearchResult, err error) {
+	ctx, span := tracing.Start(ctx, "postgres.ActivityRepository.Search", attribute.String("sort_by", string(req.SortBy)), attribute.String("period", string(req.Period)), attribute.Int("limit", req.Limit))
+	defer tracing.End(span, &err)
+
+	client, release, err := r.db.clientWithStatementTimeout(ctx, r.db.cfg.SearchStatementTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("search client: %w", err)
+	}
+	defer release()
+
 	// Build the base query for both count and data
-	query := r.db.Client().Activity.Query()
+	query := client.Activity.Query()
 
 	if len(req.SourceUIDs) > 0 {
 		sourceUIDs := make([]string, len(req.SourceUIDs))
@@ -151,29 +319,55 @@ func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest
 		query = query.Where(entactivity.IDIn(activityUIDs...))
 	}
 
-	// TODO: Consider moving this logic to the service layer and only "since time" as a param.
+	if len(req.Keywords) > 0 {
+		predicates := make([]predicate.Activity, 0, len(req.Keywords)*2)
+		for _, keyword := range req.Keywords {
+			predicates = append(predicates, entactivity.TitleContainsFold(keyword), entactivity.BodyContainsFold(keyword))
+		}
+		query = query.Where(entactivity.Or(predicates...))
+	}
+
+	for _, uid := range slices.Sorted(maps.Keys(req.SourceFilters)) {
+		p, err := keywordFilterPredicate(uid, req.SourceFilters[uid])
+		if err != nil {
+			return nil, fmt.Errorf("source filter: %w", err)
+		}
+		if p != nil {
+			query = query.Where(p)
+		}
+	}
+
+	if len(req.SourceTypes) > 0 {
+		query = query.Where(entactivity.SourceTypeIn(req.SourceTypes...))
+	}
+
+	if req.MinQualityScore > 0 {
+		// Activities stored before the quality scores were computed aren't filtered.
+		query = query.Where(entactivity.Or(
+			entactivity.QualityScoreLT(0),
+			entactivity.QualityScoreGTE(req.MinQualityScore),
+		))
+	}
+
 	// Add time-based filtering based on period
 	if req.Period != types.PeriodAll {
-		var since time.Time
-		now := time.Now()
-
-		switch req.Period {
-		case types.PeriodMonth:
-			// Start of last month
-			since = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, now.Location())
-		case types.PeriodWeek:
-			// Start of last week (Monday)
-			daysSinceMonday := int(now.Weekday()) - 1
-			if daysSinceMonday < 0 {
-				daysSinceMonday = 6
-			}
-			since = now.AddDate(0, 0, -daysSinceMonday-7).Truncate(24 * time.Hour)
-		case types.PeriodDay:
-			// Start of today
-			since = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		calendar := types.DefaultCalendar()
+		if req.Calendar != nil {
+			calendar = *req.Calendar
 		}
 
-		query = query.Where(entactivity.CreatedAtGTE(since))
+		if since := req.Period.Since(time.Now(), calendar); !since.IsZero() {
+			query = query.Where(entactivity.CreatedAtGTE(since))
+		}
+	}
+
+	if len(req.QueryEmbedding) > 0 && req.EmbeddingDimension > 0 && len(req.QueryEmbedding) != req.EmbeddingDimension {
+		return nil, fmt.Errorf("%w: got %d, expected %d (check the embedding model configuration)",
+			types.ErrEmbeddingDimensionMismatch, len(req.QueryEmbedding), req.EmbeddingDimension)
+	}
+
+	if len(req.QueryEmbedding) > 0 && !r.db.VectorSearch() {
+		return nil, fmt.Errorf("vector search is unavailable without pgvector")
 	}
 
 	var embeddingField string
@@ -192,6 +386,11 @@ func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest
 	// Filter them out, or we'll get invalid similarity scores.
 	if embeddingField != "" {
 		query = query.Where(predicate.Activity(sql.FieldNotNull(embeddingField)))
+
+		// Mixing embedding models silently produces meaningless similarities (e.g. during gradual migrations).
+		if req.EmbeddingModel != "" {
+			query = query.Where(entactivity.EmbeddingModel(req.EmbeddingModel))
+		}
 	}
 
 	query = query.Order(func(s *sql.Selector) {
@@ -207,24 +406,7 @@ func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest
 		}
 		s.AppendSelect(sql.As(simExpr, "similarity"))
 
-		simWeight := req.SimilarityWeight
-		socialWeight := req.SocialScoreWeight
-		recencyWeight := req.RecencyWeight
-
-		// Normalize weights if all are zero
-		if simWeight == 0 && socialWeight == 0 && recencyWeight == 0 {
-			simWeight = 1.0
-			socialWeight = 0.0
-			recencyWeight = 0.0
-		}
-
-		// Normalize weights to sum to 1
-		totalWeight := simWeight + socialWeight + recencyWeight
-		if totalWeight > 0 {
-			simWeight = simWeight / totalWeight
-			socialWeight = socialWeight / totalWeight
-			recencyWeight = recencyWeight / totalWeight
-		}
+		simWeight, socialWeight, recencyWeight := req.NormalizedWeights()
 
 		// Some activities (e.g. rss feed items) don't have a social score,
 		// so we fallback to a low popularity score for now,
@@ -232,16 +414,13 @@ func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest
 		fallbackSocialScore := providers.NormSocialScore(20, 100)
 		normalizedSocialScore := fmt.Sprintf("CASE WHEN social_score < 0 THEN %f ELSE social_score END", fallbackSocialScore)
 
-		// Calculate time decay score (exponential decay over 30 days)
-		// Score = e^(-k * days_old), where k controls decay rate
-		// k = 0.1 means ~0.74 score after 3 days, ~0.37 after 10 days, ~0.05 after 30 days
-		decayRate := 0.1
-		recencyScoreExpr := fmt.Sprintf("EXP(-%f * EXTRACT(EPOCH FROM (NOW() - created_at)) / 86400)", decayRate)
+		// Calculate time decay score (exponential decay over 30 days), see types.RecencyScore.
+		recencyScoreExpr := fmt.Sprintf("EXP(-%f * EXTRACT(EPOCH FROM (NOW() - created_at)) / 86400)", types.RecencyDecayRate)
 
-		weightedExpr := fmt.Sprintf("((%s * %f) + (%s * %f) + (%s * %f))",
+		weightedExpr := fmt.Sprintf("((%s * %f) + (%s * %f) + (%s * %s))",
 			simExpr, simWeight,
 			normalizedSocialScore, socialWeight,
-			recencyScoreExpr, recencyWeight)
+			recencyScoreExpr, recencyWeightExpr(recencyWeight, req.NormalizedSourceTypeRecencyWeights()))
 		s.AppendSelect(sql.As(weightedExpr, "weighted_score"))
 	})
 
@@ -312,13 +491,17 @@ func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest
 		entactivity.FieldShortSummary,
 		entactivity.FieldFullSummary,
 		entactivity.FieldRawJSON,
-		entactivity.FieldEmbedding1536,
-		entactivity.FieldEmbedding3072,
+		entactivity.FieldContentHash,
+		entactivity.FieldEmbeddingModel,
 		entactivity.FieldSocialScore,
+		entactivity.FieldQualityScore,
+	}
+	if r.db.VectorSearch() {
+		fields = append(fields, entactivity.FieldEmbedding1536, entactivity.FieldEmbedding3072)
 	}
 
 	var rows []activityWithSimilarity
-	err := query.Select(fields...).Scan(ctx, &rows)
+	err = query.Select(fields...).Scan(ctx, &rows)
 	if err != nil {
 		return nil, fmt.Errorf("search scan: %w", err)
 	}
@@ -362,6 +545,21 @@ func (r *ActivityRepository) Search(ctx context.Context, req types.SearchRequest
 	}, nil
 }
 
+// recencyWeightExpr returns the recency weight of each activity, depending on its source type.
+func recencyWeightExpr(weight float64, bySourceType map[string]float64) string {
+	if len(bySourceType) == 0 {
+		return fmt.Sprintf("%f", weight)
+	}
+
+	var expr strings.Builder
+	expr.WriteString("(CASE source_type")
+	for _, sourceType := range slices.Sorted(maps.Keys(bySourceType)) {
+		fmt.Fprintf(&expr, " WHEN '%s' THEN %f", strings.ReplaceAll(sourceType, "'", "''"), bySourceType[sourceType])
+	}
+	fmt.Fprintf(&expr, " ELSE %f END)", weight)
+	return expr.String()
+}
+
 type cursorTimestamp time.Time
 
 func (ct cursorTimestamp) MarshalJSON() ([]byte, error) {
@@ -442,15 +640,24 @@ func activityFromEnt(in *ent.Activity, similarity float32, embeddingLength int,
 	case 3072:
 		embedding = in.Embedding3072.Slice()
 	case 0:
-		// Do nothing
+		// Without a query embedding, return the stored embedding of any dimension,
+		// so that the callers can detect missing or outdated embeddings.
+		if in.Embedding3072 != nil {
+			embedding = in.Embedding3072.Slice()
+		} else if in.Embedding1536 != nil {
+			embedding = in.Embedding1536.Slice()
+		}
 	default:
 		return nil, fmt.Errorf("invalid embedding length: %d", embeddingLength)
 	}
 
 	return &types.DecoratedActivity{
-		Activity:   act,
-		Embedding:  embedding,
-		Similarity: similarity,
+		Activity:       act,
+		Embedding:      embedding,
+		EmbeddingModel: in.EmbeddingModel,
+		Similarity:     similarity,
+		ContentHash:    in.ContentHash,
+		QualityScore:   in.QualityScore,
 		Summary: &types.ActivitySummary{
 			ShortSummary: in.ShortSummary,
 			FullSummary:  in.FullSummary,
@@ -475,3 +682,43 @@ func syncJSONSourceUIDs(in *ent.Activity, sourceUIDs []string) ([]byte, error) {
 
 	return modifiedJSON, nil
 }
+
+// keywordFilterPredicate keeps the activities of other sources, and the activities of the source matching the filter.
+// Returns nil if the filter keeps all activities.
+func keywordFilterPredicate(sourceUID string, filter types.KeywordFilter) (predicate.Activity, error) {
+	filter = filter.Normalized()
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	sourceUIDs, err := json.Marshal([]string{sourceUID})
+	if err != nil {
+		return nil, fmt.Errorf("marshal source uids: %w", err)
+	}
+
+	containsKeyword := func(keyword string) predicate.Activity {
+		return entactivity.Or(entactivity.TitleContainsFold(keyword), entactivity.BodyContainsFold(keyword))
+	}
+
+	keep := make([]predicate.Activity, 0, len(filter.ExcludeKeywords)+1)
+	for _, keyword := range filter.ExcludeKeywords {
+		keep = append(keep, entactivity.Not(containsKeyword(keyword)))
+	}
+	if len(filter.IncludeKeywords) > 0 {
+		include := make([]predicate.Activity, len(filter.IncludeKeywords))
+		for i, keyword := range filter.IncludeKeywords {
+			include[i] = containsKeyword(keyword)
+		}
+		keep = append(keep, entactivity.Or(include...))
+	}
+
+	inSource := predicate.Activity(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(entactivity.FieldSourceUids)
+			b.WriteString(" @> ")
+			b.Arg(string(sourceUIDs))
+		}))
+	})
+
+	return entactivity.Or(entactivity.Not(inSource), entactivity.And(keep...)), nil
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/sources/providers/reddit"
+	goreddit "github.com/vartanbeno/go-reddit/v2/reddit"
+)
+
+func TestMergeSourceUIDs(t *testing.T) {
+	hot := lib.NewTypedUID(reddit.TypeRedditSubreddit, "golang", "hot", "day")
+	latest := lib.NewTypedUID(reddit.TypeRedditSubreddit, "golang", "new", "day")
+	post := func(sourceUID activitytypes.TypedUID) *reddit.Post {
+		return &reddit.Post{
+			Post:      &goreddit.Post{ID: "abc123"},
+			SourceTyp: reddit.TypeRedditSubreddit,
+			SourceIDs: []activitytypes.TypedUID{sourceUID},
+		}
+	}
+
+	// The post emitted by the hot and new sort variants is stored once, with both sources.
+	stored := mergeSourceUIDs([]string{}, post(hot))
+	stored = mergeSourceUIDs(stored, post(latest))
+	stored = mergeSourceUIDs(stored, post(hot))
+
+	want := []string{hot.String(), latest.String()}
+	if !slices.Equal(stored, want) {
+		t.Errorf("expected source UIDs %v, got %v", want, stored)
+	}
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defeedco/defeed/pkg/lib"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	entarticletext "github.com/defeedco/defeed/pkg/storage/postgres/ent/articletext"
+)
+
+type ArticleTextRepository struct {
+	db *DB
+}
+
+func NewArticleTextRepository(db *DB) *ArticleTextRepository {
+	return &ArticleTextRepository{db: db}
+}
+
+func (r *ArticleTextRepository) GetText(ctx context.Context, url string) (lib.CachedText, bool, error) {
+	t, err := r.db.Client().ArticleText.Get(ctx, url)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return lib.CachedText{}, false, nil
+		}
+		return lib.CachedText{}, false, err
+	}
+
+	return lib.CachedText{
+		Text:      t.Text,
+		ETag:      t.Etag,
+		FetchedAt: t.FetchedAt,
+	}, true, nil
+}
+
+func (r *ArticleTextRepository) SetText(ctx context.Context, url string, text lib.CachedText) error {
+	return r.db.Client().ArticleText.Create().
+		SetID(url).
+		SetText(text.Text).
+		SetEtag(text.ETag).
+		SetFetchedAt(text.FetchedAt).
+		OnConflictColumns(entarticletext.FieldID).
+		UpdateText().
+		UpdateEtag().
+		UpdateFetchedAt().
+		Exec(ctx)
+}
+
+// TrimTexts removes the least recently fetched texts, until the total size of the kept texts is within maxBytes.
+func (r *ArticleTextRepository) TrimTexts(ctx context.Context, maxBytes int) error {
+	_, err := r.db.Client().ExecContext(ctx, `
+		DELETE FROM article_texts
+		WHERE id IN (
+			SELECT id FROM (
+				SELECT id, SUM(OCTET_LENGTH(text)) OVER (ORDER BY fetched_at DESC, id) AS total
+				FROM article_texts
+			) sized
+			WHERE sized.total > $1
+		)`,
+		maxBytes,
+	)
+	if err != nil {
+		return fmt.Errorf("delete article texts: %w", err)
+	}
+
+	return nil
+}
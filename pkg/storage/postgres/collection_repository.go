@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/feeds"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	entcollectionactivity "github.com/defeedco/defeed/pkg/storage/postgres/ent/collectionactivity"
+)
+
+type CollectionRepository struct {
+	db *DB
+}
+
+func NewCollectionRepository(db *DB) *CollectionRepository {
+	return &CollectionRepository{db: db}
+}
+
+func (r *CollectionRepository) Create(ctx context.Context, c feeds.Collection) error {
+	err := r.db.Client().Collection.Create().
+		SetID(c.ID).
+		SetUserID(c.UserID).
+		SetName(c.Name).
+		SetCreatedAt(c.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create collection: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID returns the collection with its activities in the order they were added.
+func (r *CollectionRepository) GetByID(ctx context.Context, id string) (*feeds.Collection, error) {
+	row, err := r.db.Client().Collection.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, feeds.ErrCollectionNotFound
+		}
+		return nil, fmt.Errorf("query collection: %w", err)
+	}
+
+	activityRows, err := r.db.Client().CollectionActivity.Query().
+		Where(entcollectionactivity.CollectionID(id)).
+		Order(ent.Asc(entcollectionactivity.FieldPosition), ent.Asc(entcollectionactivity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query collection activities: %w", err)
+	}
+
+	activityIDs := make([]string, len(activityRows))
+	for i, activityRow := range activityRows {
+		activityIDs[i] = activityRow.ActivityID
+	}
+
+	return &feeds.Collection{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Name:        row.Name,
+		ActivityIDs: activityIDs,
+		CreatedAt:   row.CreatedAt,
+	}, nil
+}
+
+// AddActivity appends the activity after the last position of the collection.
+// The already collected activities keep their position.
+func (r *CollectionRepository) AddActivity(ctx context.Context, collectionID string, activityID string) error {
+	last, err := r.db.Client().CollectionActivity.Query().
+		Where(entcollectionactivity.CollectionID(collectionID)).
+		Order(ent.Desc(entcollectionactivity.FieldPosition)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("query last position: %w", err)
+	}
+	// Note: the concurrent additions may get the same position, these are ordered by insertion (see GetByID).
+	position := 0
+	if last != nil {
+		position = last.Position + 1
+	}
+
+	err = r.db.Client().CollectionActivity.Create().
+		SetCollectionID(collectionID).
+		SetActivityID(activityID).
+		SetPosition(position).
+		SetAddedAt(time.Now()).
+		Exec(ctx)
+	// The unique index rejects the already collected activities.
+	if err != nil && !ent.IsConstraintError(err) {
+		return fmt.Errorf("create collection activity: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -13,14 +14,40 @@ type Config struct {
 	Name        string `env:"DB_NAME,required"`
 	Port        int    `env:"DB_PORT,required"`
 	AutoMigrate bool   `env:"DB_AUTO_MIGRATE,default=false"`
+	// SecretsKey is the base64-encoded 32 byte key used to encrypt the source secrets (e.g. access tokens, RSS request headers) at rest.
+	// Secrets are stored in plaintext if empty.
+	SecretsKey string `env:"DB_SECRETS_KEY,default="`
+	// PgvectorFallback starts without the vector search if the pgvector extension or the embedding columns are missing,
+	// instead of failing fast. The activities are then searched by keywords only, and their embeddings aren't stored.
+	PgvectorFallback bool `env:"DB_PGVECTOR_FALLBACK,default=false"`
+
+	// Connection pool settings. Vector searches are slow compared to the other queries and hold the connections longer,
+	// so the pool should be sized for the concurrent feed searches (see FEED_MAX_CONCURRENT_SEARCHES) plus the ingestion.
+	// Keep MaxOpenConns below the Postgres max_connections divided by the number of server instances.
+	// Recommended for pgvector workloads: 20-30 open and ~half as many idle connections, with a lifetime of 30m.
+	MaxOpenConns    int           `env:"DB_MAX_OPEN_CONNS,default=25" validate:"gte=0"`
+	MaxIdleConns    int           `env:"DB_MAX_IDLE_CONNS,default=10" validate:"gte=0"`
+	ConnMaxLifetime time.Duration `env:"DB_CONN_MAX_LIFETIME,default=30m"`
+	// StatementTimeout is the Postgres statement_timeout applied to all the queries. Set to 0 to disable.
+	// Should be well above the slowest expected query (e.g. bulk upserts during reprocessing), e.g. 60s.
+	StatementTimeout time.Duration `env:"DB_STATEMENT_TIMEOUT,default=0"`
+	// SearchStatementTimeout is the statement_timeout applied to the activity searches. Set to 0 to disable.
+	// Similarity searches with a missing index or a mismatched embedding dimension can scan the whole table,
+	// so bound them to a few seconds (e.g. 10s) to free the connections for the other requests.
+	SearchStatementTimeout time.Duration `env:"DB_SEARCH_STATEMENT_TIMEOUT,default=10s"`
 }
 
 func (c Config) DSN() string {
-	return fmt.Sprintf(
+	dsn := fmt.Sprintf(
 		"postgres://%s:%s@%s/%s?sslmode=disable",
 		c.User,
 		c.Password,
 		net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
 		c.Name,
 	)
+	if c.StatementTimeout > 0 {
+		// Unknown DSN parameters are sent by pgx as the connection runtime parameters.
+		dsn += fmt.Sprintf("&statement_timeout=%d", c.StatementTimeout.Milliseconds())
+	}
+	return dsn
 }
@@ -3,17 +3,25 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
+
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	entactivity "github.com/defeedco/defeed/pkg/storage/postgres/ent/activity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/migrate"
 
 	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 
 	_ "github.com/jackc/pgx/v5/stdlib" // required for sql.Open to recognize pgx
 )
 
 type DB struct {
-	cfg    *Config
-	client *ent.Client
+	cfg          *Config
+	client       *ent.Client
+	vectorSearch bool
 }
 
 func NewDB(cfg *Config) *DB {
@@ -34,17 +42,114 @@ func (d *DB) Connect(ctx context.Context) error {
 		return fmt.Errorf("pgx connect to database: %w", err)
 	}
 
+	db.SetMaxOpenConns(d.cfg.MaxOpenConns)
+	db.SetMaxIdleConns(d.cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(d.cfg.ConnMaxLifetime)
+
 	driver := entsql.OpenDB("postgres", db)
 	client := ent.NewClient(ent.Driver(driver))
 
+	hasExtension, err := hasPgvectorExtension(ctx, db)
+	if err != nil {
+		return fmt.Errorf("check pgvector extension: %w", err)
+	}
+	if !hasExtension && !d.cfg.PgvectorFallback {
+		return errors.New("pgvector extension is not installed: " +
+			"run CREATE EXTENSION vector, or set DB_PGVECTOR_FALLBACK=true to start with keyword search only")
+	}
+
 	// Optional schema creation for local/dev environments.
 	if d.cfg.AutoMigrate {
-		if err = client.Schema.Create(ctx); err != nil {
+		var opts []schema.MigrateOption
+		if !hasExtension {
+			// The vector columns can't be created without the extension.
+			opts = append(opts, schema.WithHooks(withoutEmbeddingColumns))
+		}
+		if err = client.Schema.Create(ctx, opts...); err != nil {
 			return fmt.Errorf("create schema resources: %w", err)
 		}
 	}
 
+	hasColumns, err := hasEmbeddingColumns(ctx, db)
+	if err != nil {
+		return fmt.Errorf("check embedding columns: %w", err)
+	}
+	if hasExtension && !hasColumns && !d.cfg.PgvectorFallback {
+		return errors.New("activity embedding columns are missing: " +
+			"run the migrations, or set DB_PGVECTOR_FALLBACK=true to start with keyword search only")
+	}
+
 	d.client = client
+	d.vectorSearch = hasExtension && hasColumns
 
 	return nil
 }
+
+// VectorSearch reports whether the activities can be stored and searched by their embeddings.
+// It's false if pgvector is unavailable and the DB is running in the fallback mode (see Config.PgvectorFallback).
+func (d *DB) VectorSearch() bool {
+	return d.vectorSearch
+}
+
+var embeddingColumns = []string{entactivity.FieldEmbedding1536, entactivity.FieldEmbedding3072}
+
+func hasPgvectorExtension(ctx context.Context, db *sql.DB) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'vector')").Scan(&exists)
+	return exists, err
+}
+
+func hasEmbeddingColumns(ctx context.Context, db *sql.DB) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name IN ($2, $3)",
+		migrate.ActivitiesTable.Name, embeddingColumns[0], embeddingColumns[1],
+	).Scan(&count)
+	return count == len(embeddingColumns), err
+}
+
+// withoutEmbeddingColumns is a migration hook that skips the embedding columns of the activities table.
+func withoutEmbeddingColumns(next schema.Creator) schema.Creator {
+	return schema.CreateFunc(func(ctx context.Context, tables ...*schema.Table) error {
+		filtered := make([]*schema.Table, 0, len(tables))
+		for _, table := range tables {
+			if table.Name != migrate.ActivitiesTable.Name {
+				filtered = append(filtered, table)
+				continue
+			}
+			withoutEmbeddings := *table
+			withoutEmbeddings.Columns = nil
+			for _, column := range table.Columns {
+				if !slices.Contains(embeddingColumns, column.Name) {
+					withoutEmbeddings.Columns = append(withoutEmbeddings.Columns, column)
+				}
+			}
+			filtered = append(filtered, &withoutEmbeddings)
+		}
+		return next.Create(ctx, filtered...)
+	})
+}
+
+// clientWithStatementTimeout returns a client that runs the queries in a transaction with the statement timeout,
+// so that slow queries are canceled by Postgres instead of holding the connections.
+// The timeout is local to the transaction, so it doesn't leak to the other queries on the pooled connection.
+// The returned release func must be called once the queries are done. Zero timeout returns the regular client.
+func (d *DB) clientWithStatementTimeout(ctx context.Context, timeout time.Duration) (*ent.Client, func(), error) {
+	if timeout <= 0 {
+		return d.Client(), func() {}, nil
+	}
+
+	tx, err := d.Client().Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds()))
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("set statement timeout: %w", err)
+	}
+
+	// The queries are read-only, so the transaction is always rolled back.
+	return tx.Client(), func() { _ = tx.Rollback() }, nil
+}
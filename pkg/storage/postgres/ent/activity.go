@@ -41,12 +41,18 @@ type Activity struct {
 	FullSummary string `json:"full_summary,omitempty"`
 	// RawJSON holds the value of the "raw_json" field.
 	RawJSON string `json:"raw_json,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
+	// EmbeddingModel holds the value of the "embedding_model" field.
+	EmbeddingModel string `json:"embedding_model,omitempty"`
 	// Embedding1536 holds the value of the "embedding_1536" field.
 	Embedding1536 *pgvector.Vector `json:"embedding_1536,omitempty"`
 	// Embedding3072 holds the value of the "embedding_3072" field.
 	Embedding3072 *pgvector.Vector `json:"embedding_3072,omitempty"`
 	// SocialScore holds the value of the "social_score" field.
 	SocialScore float64 `json:"social_score,omitempty"`
+	// QualityScore holds the value of the "quality_score" field.
+	QualityScore float64 `json:"quality_score,omitempty"`
 	// UpdateCount holds the value of the "update_count" field.
 	UpdateCount  int `json:"update_count,omitempty"`
 	selectValues sql.SelectValues
@@ -61,11 +67,11 @@ func (*Activity) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
 		case activity.FieldSourceUids:
 			values[i] = new([]byte)
-		case activity.FieldSocialScore:
+		case activity.FieldSocialScore, activity.FieldQualityScore:
 			values[i] = new(sql.NullFloat64)
 		case activity.FieldUpdateCount:
 			values[i] = new(sql.NullInt64)
-		case activity.FieldID, activity.FieldUID, activity.FieldSourceType, activity.FieldTitle, activity.FieldBody, activity.FieldURL, activity.FieldImageURL, activity.FieldShortSummary, activity.FieldFullSummary, activity.FieldRawJSON:
+		case activity.FieldID, activity.FieldUID, activity.FieldSourceType, activity.FieldTitle, activity.FieldBody, activity.FieldURL, activity.FieldImageURL, activity.FieldShortSummary, activity.FieldFullSummary, activity.FieldRawJSON, activity.FieldContentHash, activity.FieldEmbeddingModel:
 			values[i] = new(sql.NullString)
 		case activity.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -158,6 +164,18 @@ func (a *Activity) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.RawJSON = value.String
 			}
+		case activity.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				a.ContentHash = value.String
+			}
+		case activity.FieldEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
+			} else if value.Valid {
+				a.EmbeddingModel = value.String
+			}
 		case activity.FieldEmbedding1536:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field embedding_1536", values[i])
@@ -178,6 +196,12 @@ func (a *Activity) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.SocialScore = value.Float64
 			}
+		case activity.FieldQualityScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quality_score", values[i])
+			} else if value.Valid {
+				a.QualityScore = value.Float64
+			}
 		case activity.FieldUpdateCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field update_count", values[i])
@@ -253,6 +277,12 @@ func (a *Activity) String() string {
 	builder.WriteString("raw_json=")
 	builder.WriteString(a.RawJSON)
 	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(a.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("embedding_model=")
+	builder.WriteString(a.EmbeddingModel)
+	builder.WriteString(", ")
 	if v := a.Embedding1536; v != nil {
 		builder.WriteString("embedding_1536=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -266,6 +296,9 @@ func (a *Activity) String() string {
 	builder.WriteString("social_score=")
 	builder.WriteString(fmt.Sprintf("%v", a.SocialScore))
 	builder.WriteString(", ")
+	builder.WriteString("quality_score=")
+	builder.WriteString(fmt.Sprintf("%v", a.QualityScore))
+	builder.WriteString(", ")
 	builder.WriteString("update_count=")
 	builder.WriteString(fmt.Sprintf("%v", a.UpdateCount))
 	builder.WriteByte(')')
@@ -33,12 +33,18 @@ const (
 	FieldFullSummary = "full_summary"
 	// FieldRawJSON holds the string denoting the raw_json field in the database.
 	FieldRawJSON = "raw_json"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldEmbeddingModel holds the string denoting the embedding_model field in the database.
+	FieldEmbeddingModel = "embedding_model"
 	// FieldEmbedding1536 holds the string denoting the embedding_1536 field in the database.
 	FieldEmbedding1536 = "embedding_1536"
 	// FieldEmbedding3072 holds the string denoting the embedding_3072 field in the database.
 	FieldEmbedding3072 = "embedding_3072"
 	// FieldSocialScore holds the string denoting the social_score field in the database.
 	FieldSocialScore = "social_score"
+	// FieldQualityScore holds the string denoting the quality_score field in the database.
+	FieldQualityScore = "quality_score"
 	// FieldUpdateCount holds the string denoting the update_count field in the database.
 	FieldUpdateCount = "update_count"
 	// Table holds the table name of the activity in the database.
@@ -59,9 +65,12 @@ var Columns = []string{
 	FieldShortSummary,
 	FieldFullSummary,
 	FieldRawJSON,
+	FieldContentHash,
+	FieldEmbeddingModel,
 	FieldEmbedding1536,
 	FieldEmbedding3072,
 	FieldSocialScore,
+	FieldQualityScore,
 	FieldUpdateCount,
 }
 
@@ -76,8 +85,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultContentHash holds the default value on creation for the "content_hash" field.
+	DefaultContentHash string
+	// DefaultEmbeddingModel holds the default value on creation for the "embedding_model" field.
+	DefaultEmbeddingModel string
 	// DefaultSocialScore holds the default value on creation for the "social_score" field.
 	DefaultSocialScore float64
+	// DefaultQualityScore holds the default value on creation for the "quality_score" field.
+	DefaultQualityScore float64
 	// DefaultUpdateCount holds the default value on creation for the "update_count" field.
 	DefaultUpdateCount int
 )
@@ -140,6 +155,16 @@ func ByRawJSON(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRawJSON, opts...).ToFunc()
 }
 
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByEmbeddingModel orders the results by the embedding_model field.
+func ByEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingModel, opts...).ToFunc()
+}
+
 // ByEmbedding1536 orders the results by the embedding_1536 field.
 func ByEmbedding1536(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmbedding1536, opts...).ToFunc()
@@ -155,6 +180,11 @@ func BySocialScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSocialScore, opts...).ToFunc()
 }
 
+// ByQualityScore orders the results by the quality_score field.
+func ByQualityScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQualityScore, opts...).ToFunc()
+}
+
 // ByUpdateCount orders the results by the update_count field.
 func ByUpdateCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateCount, opts...).ToFunc()
@@ -115,6 +115,16 @@ func RawJSON(v string) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldRawJSON, v))
 }
 
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldContentHash, v))
+}
+
+// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
+func EmbeddingModel(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
 // Embedding1536 applies equality check predicate on the "embedding_1536" field. It's identical to Embedding1536EQ.
 func Embedding1536(v pgvector.Vector) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldEmbedding1536, v))
@@ -130,6 +140,11 @@ func SocialScore(v float64) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldSocialScore, v))
 }
 
+// QualityScore applies equality check predicate on the "quality_score" field. It's identical to QualityScoreEQ.
+func QualityScore(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldQualityScore, v))
+}
+
 // UpdateCount applies equality check predicate on the "update_count" field. It's identical to UpdateCountEQ.
 func UpdateCount(v int) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldUpdateCount, v))
@@ -760,6 +775,136 @@ func RawJSONContainsFold(v string) predicate.Activity {
 	return predicate.Activity(sql.FieldContainsFold(FieldRawJSON, v))
 }
 
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// EmbeddingModelEQ applies the EQ predicate on the "embedding_model" field.
+func EmbeddingModelEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelNEQ applies the NEQ predicate on the "embedding_model" field.
+func EmbeddingModelNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIn applies the In predicate on the "embedding_model" field.
+func EmbeddingModelIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelNotIn applies the NotIn predicate on the "embedding_model" field.
+func EmbeddingModelNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelGT applies the GT predicate on the "embedding_model" field.
+func EmbeddingModelGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelGTE applies the GTE predicate on the "embedding_model" field.
+func EmbeddingModelGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLT applies the LT predicate on the "embedding_model" field.
+func EmbeddingModelLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLTE applies the LTE predicate on the "embedding_model" field.
+func EmbeddingModelLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContains applies the Contains predicate on the "embedding_model" field.
+func EmbeddingModelContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasPrefix applies the HasPrefix predicate on the "embedding_model" field.
+func EmbeddingModelHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasSuffix applies the HasSuffix predicate on the "embedding_model" field.
+func EmbeddingModelHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelEqualFold applies the EqualFold predicate on the "embedding_model" field.
+func EmbeddingModelEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContainsFold applies the ContainsFold predicate on the "embedding_model" field.
+func EmbeddingModelContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldEmbeddingModel, v))
+}
+
 // Embedding1536EQ applies the EQ predicate on the "embedding_1536" field.
 func Embedding1536EQ(v pgvector.Vector) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldEmbedding1536, v))
@@ -900,6 +1045,46 @@ func SocialScoreLTE(v float64) predicate.Activity {
 	return predicate.Activity(sql.FieldLTE(FieldSocialScore, v))
 }
 
+// QualityScoreEQ applies the EQ predicate on the "quality_score" field.
+func QualityScoreEQ(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldQualityScore, v))
+}
+
+// QualityScoreNEQ applies the NEQ predicate on the "quality_score" field.
+func QualityScoreNEQ(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldQualityScore, v))
+}
+
+// QualityScoreIn applies the In predicate on the "quality_score" field.
+func QualityScoreIn(vs ...float64) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldQualityScore, vs...))
+}
+
+// QualityScoreNotIn applies the NotIn predicate on the "quality_score" field.
+func QualityScoreNotIn(vs ...float64) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldQualityScore, vs...))
+}
+
+// QualityScoreGT applies the GT predicate on the "quality_score" field.
+func QualityScoreGT(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldQualityScore, v))
+}
+
+// QualityScoreGTE applies the GTE predicate on the "quality_score" field.
+func QualityScoreGTE(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldQualityScore, v))
+}
+
+// QualityScoreLT applies the LT predicate on the "quality_score" field.
+func QualityScoreLT(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldQualityScore, v))
+}
+
+// QualityScoreLTE applies the LTE predicate on the "quality_score" field.
+func QualityScoreLTE(v float64) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldQualityScore, v))
+}
+
 // UpdateCountEQ applies the EQ predicate on the "update_count" field.
 func UpdateCountEQ(v int) predicate.Activity {
 	return predicate.Activity(sql.FieldEQ(FieldUpdateCount, v))
@@ -90,6 +90,34 @@ func (ac *ActivityCreate) SetRawJSON(s string) *ActivityCreate {
 	return ac
 }
 
+// SetContentHash sets the "content_hash" field.
+func (ac *ActivityCreate) SetContentHash(s string) *ActivityCreate {
+	ac.mutation.SetContentHash(s)
+	return ac
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableContentHash(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetContentHash(*s)
+	}
+	return ac
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (ac *ActivityCreate) SetEmbeddingModel(s string) *ActivityCreate {
+	ac.mutation.SetEmbeddingModel(s)
+	return ac
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableEmbeddingModel(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetEmbeddingModel(*s)
+	}
+	return ac
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (ac *ActivityCreate) SetEmbedding1536(pg pgvector.Vector) *ActivityCreate {
 	ac.mutation.SetEmbedding1536(pg)
@@ -132,6 +160,20 @@ func (ac *ActivityCreate) SetNillableSocialScore(f *float64) *ActivityCreate {
 	return ac
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (ac *ActivityCreate) SetQualityScore(f float64) *ActivityCreate {
+	ac.mutation.SetQualityScore(f)
+	return ac
+}
+
+// SetNillableQualityScore sets the "quality_score" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableQualityScore(f *float64) *ActivityCreate {
+	if f != nil {
+		ac.SetQualityScore(*f)
+	}
+	return ac
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (ac *ActivityCreate) SetUpdateCount(i int) *ActivityCreate {
 	ac.mutation.SetUpdateCount(i)
@@ -187,10 +229,22 @@ func (ac *ActivityCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ac *ActivityCreate) defaults() {
+	if _, ok := ac.mutation.ContentHash(); !ok {
+		v := activity.DefaultContentHash
+		ac.mutation.SetContentHash(v)
+	}
+	if _, ok := ac.mutation.EmbeddingModel(); !ok {
+		v := activity.DefaultEmbeddingModel
+		ac.mutation.SetEmbeddingModel(v)
+	}
 	if _, ok := ac.mutation.SocialScore(); !ok {
 		v := activity.DefaultSocialScore
 		ac.mutation.SetSocialScore(v)
 	}
+	if _, ok := ac.mutation.QualityScore(); !ok {
+		v := activity.DefaultQualityScore
+		ac.mutation.SetQualityScore(v)
+	}
 	if _, ok := ac.mutation.UpdateCount(); !ok {
 		v := activity.DefaultUpdateCount
 		ac.mutation.SetUpdateCount(v)
@@ -232,9 +286,18 @@ func (ac *ActivityCreate) check() error {
 	if _, ok := ac.mutation.RawJSON(); !ok {
 		return &ValidationError{Name: "raw_json", err: errors.New(`ent: missing required field "Activity.raw_json"`)}
 	}
+	if _, ok := ac.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "Activity.content_hash"`)}
+	}
+	if _, ok := ac.mutation.EmbeddingModel(); !ok {
+		return &ValidationError{Name: "embedding_model", err: errors.New(`ent: missing required field "Activity.embedding_model"`)}
+	}
 	if _, ok := ac.mutation.SocialScore(); !ok {
 		return &ValidationError{Name: "social_score", err: errors.New(`ent: missing required field "Activity.social_score"`)}
 	}
+	if _, ok := ac.mutation.QualityScore(); !ok {
+		return &ValidationError{Name: "quality_score", err: errors.New(`ent: missing required field "Activity.quality_score"`)}
+	}
 	if _, ok := ac.mutation.UpdateCount(); !ok {
 		return &ValidationError{Name: "update_count", err: errors.New(`ent: missing required field "Activity.update_count"`)}
 	}
@@ -318,6 +381,14 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_spec.SetField(activity.FieldRawJSON, field.TypeString, value)
 		_node.RawJSON = value
 	}
+	if value, ok := ac.mutation.ContentHash(); ok {
+		_spec.SetField(activity.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := ac.mutation.EmbeddingModel(); ok {
+		_spec.SetField(activity.FieldEmbeddingModel, field.TypeString, value)
+		_node.EmbeddingModel = value
+	}
 	if value, ok := ac.mutation.Embedding1536(); ok {
 		_spec.SetField(activity.FieldEmbedding1536, field.TypeOther, value)
 		_node.Embedding1536 = &value
@@ -330,6 +401,10 @@ func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
 		_spec.SetField(activity.FieldSocialScore, field.TypeFloat64, value)
 		_node.SocialScore = value
 	}
+	if value, ok := ac.mutation.QualityScore(); ok {
+		_spec.SetField(activity.FieldQualityScore, field.TypeFloat64, value)
+		_node.QualityScore = value
+	}
 	if value, ok := ac.mutation.UpdateCount(); ok {
 		_spec.SetField(activity.FieldUpdateCount, field.TypeInt, value)
 		_node.UpdateCount = value
@@ -518,6 +593,30 @@ func (u *ActivityUpsert) UpdateRawJSON() *ActivityUpsert {
 	return u
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *ActivityUpsert) SetContentHash(v string) *ActivityUpsert {
+	u.Set(activity.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateContentHash() *ActivityUpsert {
+	u.SetExcluded(activity.FieldContentHash)
+	return u
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *ActivityUpsert) SetEmbeddingModel(v string) *ActivityUpsert {
+	u.Set(activity.FieldEmbeddingModel, v)
+	return u
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateEmbeddingModel() *ActivityUpsert {
+	u.SetExcluded(activity.FieldEmbeddingModel)
+	return u
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (u *ActivityUpsert) SetEmbedding1536(v pgvector.Vector) *ActivityUpsert {
 	u.Set(activity.FieldEmbedding1536, v)
@@ -572,6 +671,24 @@ func (u *ActivityUpsert) AddSocialScore(v float64) *ActivityUpsert {
 	return u
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (u *ActivityUpsert) SetQualityScore(v float64) *ActivityUpsert {
+	u.Set(activity.FieldQualityScore, v)
+	return u
+}
+
+// UpdateQualityScore sets the "quality_score" field to the value that was provided on create.
+func (u *ActivityUpsert) UpdateQualityScore() *ActivityUpsert {
+	u.SetExcluded(activity.FieldQualityScore)
+	return u
+}
+
+// AddQualityScore adds v to the "quality_score" field.
+func (u *ActivityUpsert) AddQualityScore(v float64) *ActivityUpsert {
+	u.Add(activity.FieldQualityScore, v)
+	return u
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (u *ActivityUpsert) SetUpdateCount(v int) *ActivityUpsert {
 	u.Set(activity.FieldUpdateCount, v)
@@ -792,6 +909,34 @@ func (u *ActivityUpsertOne) UpdateRawJSON() *ActivityUpsertOne {
 	})
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *ActivityUpsertOne) SetContentHash(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateContentHash() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *ActivityUpsertOne) SetEmbeddingModel(v string) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetEmbeddingModel(v)
+	})
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateEmbeddingModel() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateEmbeddingModel()
+	})
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (u *ActivityUpsertOne) SetEmbedding1536(v pgvector.Vector) *ActivityUpsertOne {
 	return u.Update(func(s *ActivityUpsert) {
@@ -855,6 +1000,27 @@ func (u *ActivityUpsertOne) UpdateSocialScore() *ActivityUpsertOne {
 	})
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (u *ActivityUpsertOne) SetQualityScore(v float64) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetQualityScore(v)
+	})
+}
+
+// AddQualityScore adds v to the "quality_score" field.
+func (u *ActivityUpsertOne) AddQualityScore(v float64) *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddQualityScore(v)
+	})
+}
+
+// UpdateQualityScore sets the "quality_score" field to the value that was provided on create.
+func (u *ActivityUpsertOne) UpdateQualityScore() *ActivityUpsertOne {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateQualityScore()
+	})
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (u *ActivityUpsertOne) SetUpdateCount(v int) *ActivityUpsertOne {
 	return u.Update(func(s *ActivityUpsert) {
@@ -1245,6 +1411,34 @@ func (u *ActivityUpsertBulk) UpdateRawJSON() *ActivityUpsertBulk {
 	})
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *ActivityUpsertBulk) SetContentHash(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateContentHash() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *ActivityUpsertBulk) SetEmbeddingModel(v string) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetEmbeddingModel(v)
+	})
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateEmbeddingModel() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateEmbeddingModel()
+	})
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (u *ActivityUpsertBulk) SetEmbedding1536(v pgvector.Vector) *ActivityUpsertBulk {
 	return u.Update(func(s *ActivityUpsert) {
@@ -1308,6 +1502,27 @@ func (u *ActivityUpsertBulk) UpdateSocialScore() *ActivityUpsertBulk {
 	})
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (u *ActivityUpsertBulk) SetQualityScore(v float64) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.SetQualityScore(v)
+	})
+}
+
+// AddQualityScore adds v to the "quality_score" field.
+func (u *ActivityUpsertBulk) AddQualityScore(v float64) *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.AddQualityScore(v)
+	})
+}
+
+// UpdateQualityScore sets the "quality_score" field to the value that was provided on create.
+func (u *ActivityUpsertBulk) UpdateQualityScore() *ActivityUpsertBulk {
+	return u.Update(func(s *ActivityUpsert) {
+		s.UpdateQualityScore()
+	})
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (u *ActivityUpsertBulk) SetUpdateCount(v int) *ActivityUpsertBulk {
 	return u.Update(func(s *ActivityUpsert) {
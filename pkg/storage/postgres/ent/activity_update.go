@@ -182,6 +182,34 @@ func (au *ActivityUpdate) SetNillableRawJSON(s *string) *ActivityUpdate {
 	return au
 }
 
+// SetContentHash sets the "content_hash" field.
+func (au *ActivityUpdate) SetContentHash(s string) *ActivityUpdate {
+	au.mutation.SetContentHash(s)
+	return au
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableContentHash(s *string) *ActivityUpdate {
+	if s != nil {
+		au.SetContentHash(*s)
+	}
+	return au
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (au *ActivityUpdate) SetEmbeddingModel(s string) *ActivityUpdate {
+	au.mutation.SetEmbeddingModel(s)
+	return au
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableEmbeddingModel(s *string) *ActivityUpdate {
+	if s != nil {
+		au.SetEmbeddingModel(*s)
+	}
+	return au
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (au *ActivityUpdate) SetEmbedding1536(pg pgvector.Vector) *ActivityUpdate {
 	au.mutation.SetEmbedding1536(pg)
@@ -243,6 +271,27 @@ func (au *ActivityUpdate) AddSocialScore(f float64) *ActivityUpdate {
 	return au
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (au *ActivityUpdate) SetQualityScore(f float64) *ActivityUpdate {
+	au.mutation.ResetQualityScore()
+	au.mutation.SetQualityScore(f)
+	return au
+}
+
+// SetNillableQualityScore sets the "quality_score" field if the given value is not nil.
+func (au *ActivityUpdate) SetNillableQualityScore(f *float64) *ActivityUpdate {
+	if f != nil {
+		au.SetQualityScore(*f)
+	}
+	return au
+}
+
+// AddQualityScore adds f to the "quality_score" field.
+func (au *ActivityUpdate) AddQualityScore(f float64) *ActivityUpdate {
+	au.mutation.AddQualityScore(f)
+	return au
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (au *ActivityUpdate) SetUpdateCount(i int) *ActivityUpdate {
 	au.mutation.ResetUpdateCount()
@@ -343,6 +392,12 @@ func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.RawJSON(); ok {
 		_spec.SetField(activity.FieldRawJSON, field.TypeString, value)
 	}
+	if value, ok := au.mutation.ContentHash(); ok {
+		_spec.SetField(activity.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := au.mutation.EmbeddingModel(); ok {
+		_spec.SetField(activity.FieldEmbeddingModel, field.TypeString, value)
+	}
 	if value, ok := au.mutation.Embedding1536(); ok {
 		_spec.SetField(activity.FieldEmbedding1536, field.TypeOther, value)
 	}
@@ -361,6 +416,12 @@ func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.AddedSocialScore(); ok {
 		_spec.AddField(activity.FieldSocialScore, field.TypeFloat64, value)
 	}
+	if value, ok := au.mutation.QualityScore(); ok {
+		_spec.SetField(activity.FieldQualityScore, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedQualityScore(); ok {
+		_spec.AddField(activity.FieldQualityScore, field.TypeFloat64, value)
+	}
 	if value, ok := au.mutation.UpdateCount(); ok {
 		_spec.SetField(activity.FieldUpdateCount, field.TypeInt, value)
 	}
@@ -539,6 +600,34 @@ func (auo *ActivityUpdateOne) SetNillableRawJSON(s *string) *ActivityUpdateOne {
 	return auo
 }
 
+// SetContentHash sets the "content_hash" field.
+func (auo *ActivityUpdateOne) SetContentHash(s string) *ActivityUpdateOne {
+	auo.mutation.SetContentHash(s)
+	return auo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableContentHash(s *string) *ActivityUpdateOne {
+	if s != nil {
+		auo.SetContentHash(*s)
+	}
+	return auo
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (auo *ActivityUpdateOne) SetEmbeddingModel(s string) *ActivityUpdateOne {
+	auo.mutation.SetEmbeddingModel(s)
+	return auo
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableEmbeddingModel(s *string) *ActivityUpdateOne {
+	if s != nil {
+		auo.SetEmbeddingModel(*s)
+	}
+	return auo
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (auo *ActivityUpdateOne) SetEmbedding1536(pg pgvector.Vector) *ActivityUpdateOne {
 	auo.mutation.SetEmbedding1536(pg)
@@ -600,6 +689,27 @@ func (auo *ActivityUpdateOne) AddSocialScore(f float64) *ActivityUpdateOne {
 	return auo
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (auo *ActivityUpdateOne) SetQualityScore(f float64) *ActivityUpdateOne {
+	auo.mutation.ResetQualityScore()
+	auo.mutation.SetQualityScore(f)
+	return auo
+}
+
+// SetNillableQualityScore sets the "quality_score" field if the given value is not nil.
+func (auo *ActivityUpdateOne) SetNillableQualityScore(f *float64) *ActivityUpdateOne {
+	if f != nil {
+		auo.SetQualityScore(*f)
+	}
+	return auo
+}
+
+// AddQualityScore adds f to the "quality_score" field.
+func (auo *ActivityUpdateOne) AddQualityScore(f float64) *ActivityUpdateOne {
+	auo.mutation.AddQualityScore(f)
+	return auo
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (auo *ActivityUpdateOne) SetUpdateCount(i int) *ActivityUpdateOne {
 	auo.mutation.ResetUpdateCount()
@@ -730,6 +840,12 @@ func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err
 	if value, ok := auo.mutation.RawJSON(); ok {
 		_spec.SetField(activity.FieldRawJSON, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.ContentHash(); ok {
+		_spec.SetField(activity.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.EmbeddingModel(); ok {
+		_spec.SetField(activity.FieldEmbeddingModel, field.TypeString, value)
+	}
 	if value, ok := auo.mutation.Embedding1536(); ok {
 		_spec.SetField(activity.FieldEmbedding1536, field.TypeOther, value)
 	}
@@ -748,6 +864,12 @@ func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err
 	if value, ok := auo.mutation.AddedSocialScore(); ok {
 		_spec.AddField(activity.FieldSocialScore, field.TypeFloat64, value)
 	}
+	if value, ok := auo.mutation.QualityScore(); ok {
+		_spec.SetField(activity.FieldQualityScore, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedQualityScore(); ok {
+		_spec.AddField(activity.FieldQualityScore, field.TypeFloat64, value)
+	}
 	if value, ok := auo.mutation.UpdateCount(); ok {
 		_spec.SetField(activity.FieldUpdateCount, field.TypeInt, value)
 	}
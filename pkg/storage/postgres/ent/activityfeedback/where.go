@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activityfeedback
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// ActivityID applies equality check predicate on the "activity_id" field. It's identical to ActivityIDEQ.
+func ActivityID(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldActivityID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldValue, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ActivityIDEQ applies the EQ predicate on the "activity_id" field.
+func ActivityIDEQ(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldActivityID, v))
+}
+
+// ActivityIDNEQ applies the NEQ predicate on the "activity_id" field.
+func ActivityIDNEQ(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNEQ(FieldActivityID, v))
+}
+
+// ActivityIDIn applies the In predicate on the "activity_id" field.
+func ActivityIDIn(vs ...string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldIn(FieldActivityID, vs...))
+}
+
+// ActivityIDNotIn applies the NotIn predicate on the "activity_id" field.
+func ActivityIDNotIn(vs ...string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNotIn(FieldActivityID, vs...))
+}
+
+// ActivityIDGT applies the GT predicate on the "activity_id" field.
+func ActivityIDGT(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGT(FieldActivityID, v))
+}
+
+// ActivityIDGTE applies the GTE predicate on the "activity_id" field.
+func ActivityIDGTE(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGTE(FieldActivityID, v))
+}
+
+// ActivityIDLT applies the LT predicate on the "activity_id" field.
+func ActivityIDLT(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLT(FieldActivityID, v))
+}
+
+// ActivityIDLTE applies the LTE predicate on the "activity_id" field.
+func ActivityIDLTE(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLTE(FieldActivityID, v))
+}
+
+// ActivityIDContains applies the Contains predicate on the "activity_id" field.
+func ActivityIDContains(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldContains(FieldActivityID, v))
+}
+
+// ActivityIDHasPrefix applies the HasPrefix predicate on the "activity_id" field.
+func ActivityIDHasPrefix(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldHasPrefix(FieldActivityID, v))
+}
+
+// ActivityIDHasSuffix applies the HasSuffix predicate on the "activity_id" field.
+func ActivityIDHasSuffix(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldHasSuffix(FieldActivityID, v))
+}
+
+// ActivityIDEqualFold applies the EqualFold predicate on the "activity_id" field.
+func ActivityIDEqualFold(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEqualFold(FieldActivityID, v))
+}
+
+// ActivityIDContainsFold applies the ContainsFold predicate on the "activity_id" field.
+func ActivityIDContainsFold(v string) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldContainsFold(FieldActivityID, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLTE(FieldValue, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ActivityFeedback) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ActivityFeedback) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ActivityFeedback) predicate.ActivityFeedback {
+	return predicate.ActivityFeedback(sql.NotPredicates(p))
+}
@@ -15,8 +15,20 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activityfeedback"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/articletext"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/collection"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/collectionactivity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/failedactivity"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feedposition"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/idempotencykey"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/readactivity"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/source"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/sourcestate"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/userprovision"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,10 +38,30 @@ type Client struct {
 	Schema *migrate.Schema
 	// Activity is the client for interacting with the Activity builders.
 	Activity *ActivityClient
+	// ActivityFeedback is the client for interacting with the ActivityFeedback builders.
+	ActivityFeedback *ActivityFeedbackClient
+	// ArticleText is the client for interacting with the ArticleText builders.
+	ArticleText *ArticleTextClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// CollectionActivity is the client for interacting with the CollectionActivity builders.
+	CollectionActivity *CollectionActivityClient
+	// FailedActivity is the client for interacting with the FailedActivity builders.
+	FailedActivity *FailedActivityClient
 	// Feed is the client for interacting with the Feed builders.
 	Feed *FeedClient
+	// FeedPosition is the client for interacting with the FeedPosition builders.
+	FeedPosition *FeedPositionClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// ReadActivity is the client for interacting with the ReadActivity builders.
+	ReadActivity *ReadActivityClient
 	// Source is the client for interacting with the Source builders.
 	Source *SourceClient
+	// SourceState is the client for interacting with the SourceState builders.
+	SourceState *SourceStateClient
+	// UserProvision is the client for interacting with the UserProvision builders.
+	UserProvision *UserProvisionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,8 +74,18 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Activity = NewActivityClient(c.config)
+	c.ActivityFeedback = NewActivityFeedbackClient(c.config)
+	c.ArticleText = NewArticleTextClient(c.config)
+	c.Collection = NewCollectionClient(c.config)
+	c.CollectionActivity = NewCollectionActivityClient(c.config)
+	c.FailedActivity = NewFailedActivityClient(c.config)
 	c.Feed = NewFeedClient(c.config)
+	c.FeedPosition = NewFeedPositionClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.ReadActivity = NewReadActivityClient(c.config)
 	c.Source = NewSourceClient(c.config)
+	c.SourceState = NewSourceStateClient(c.config)
+	c.UserProvision = NewUserProvisionClient(c.config)
 }
 
 type (
@@ -134,11 +176,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Activity: NewActivityClient(cfg),
-		Feed:     NewFeedClient(cfg),
-		Source:   NewSourceClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Activity:           NewActivityClient(cfg),
+		ActivityFeedback:   NewActivityFeedbackClient(cfg),
+		ArticleText:        NewArticleTextClient(cfg),
+		Collection:         NewCollectionClient(cfg),
+		CollectionActivity: NewCollectionActivityClient(cfg),
+		FailedActivity:     NewFailedActivityClient(cfg),
+		Feed:               NewFeedClient(cfg),
+		FeedPosition:       NewFeedPositionClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		ReadActivity:       NewReadActivityClient(cfg),
+		Source:             NewSourceClient(cfg),
+		SourceState:        NewSourceStateClient(cfg),
+		UserProvision:      NewUserProvisionClient(cfg),
 	}, nil
 }
 
@@ -156,11 +208,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Activity: NewActivityClient(cfg),
-		Feed:     NewFeedClient(cfg),
-		Source:   NewSourceClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Activity:           NewActivityClient(cfg),
+		ActivityFeedback:   NewActivityFeedbackClient(cfg),
+		ArticleText:        NewArticleTextClient(cfg),
+		Collection:         NewCollectionClient(cfg),
+		CollectionActivity: NewCollectionActivityClient(cfg),
+		FailedActivity:     NewFailedActivityClient(cfg),
+		Feed:               NewFeedClient(cfg),
+		FeedPosition:       NewFeedPositionClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		ReadActivity:       NewReadActivityClient(cfg),
+		Source:             NewSourceClient(cfg),
+		SourceState:        NewSourceStateClient(cfg),
+		UserProvision:      NewUserProvisionClient(cfg),
 	}, nil
 }
 
@@ -189,17 +251,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Activity.Use(hooks...)
-	c.Feed.Use(hooks...)
-	c.Source.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Activity, c.ActivityFeedback, c.ArticleText, c.Collection,
+		c.CollectionActivity, c.FailedActivity, c.Feed, c.FeedPosition,
+		c.IdempotencyKey, c.ReadActivity, c.Source, c.SourceState, c.UserProvision,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Activity.Intercept(interceptors...)
-	c.Feed.Intercept(interceptors...)
-	c.Source.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Activity, c.ActivityFeedback, c.ArticleText, c.Collection,
+		c.CollectionActivity, c.FailedActivity, c.Feed, c.FeedPosition,
+		c.IdempotencyKey, c.ReadActivity, c.Source, c.SourceState, c.UserProvision,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -207,10 +277,30 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ActivityMutation:
 		return c.Activity.mutate(ctx, m)
+	case *ActivityFeedbackMutation:
+		return c.ActivityFeedback.mutate(ctx, m)
+	case *ArticleTextMutation:
+		return c.ArticleText.mutate(ctx, m)
+	case *CollectionMutation:
+		return c.Collection.mutate(ctx, m)
+	case *CollectionActivityMutation:
+		return c.CollectionActivity.mutate(ctx, m)
+	case *FailedActivityMutation:
+		return c.FailedActivity.mutate(ctx, m)
 	case *FeedMutation:
 		return c.Feed.mutate(ctx, m)
+	case *FeedPositionMutation:
+		return c.FeedPosition.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *ReadActivityMutation:
+		return c.ReadActivity.mutate(ctx, m)
 	case *SourceMutation:
 		return c.Source.mutate(ctx, m)
+	case *SourceStateMutation:
+		return c.SourceState.mutate(ctx, m)
+	case *UserProvisionMutation:
+		return c.UserProvision.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -349,107 +439,107 @@ func (c *ActivityClient) mutate(ctx context.Context, m *ActivityMutation) (Value
 	}
 }
 
-// FeedClient is a client for the Feed schema.
-type FeedClient struct {
+// ActivityFeedbackClient is a client for the ActivityFeedback schema.
+type ActivityFeedbackClient struct {
 	config
 }
 
-// NewFeedClient returns a client for the Feed from the given config.
-func NewFeedClient(c config) *FeedClient {
-	return &FeedClient{config: c}
+// NewActivityFeedbackClient returns a client for the ActivityFeedback from the given config.
+func NewActivityFeedbackClient(c config) *ActivityFeedbackClient {
+	return &ActivityFeedbackClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `feed.Hooks(f(g(h())))`.
-func (c *FeedClient) Use(hooks ...Hook) {
-	c.hooks.Feed = append(c.hooks.Feed, hooks...)
+// A call to `Use(f, g, h)` equals to `activityfeedback.Hooks(f(g(h())))`.
+func (c *ActivityFeedbackClient) Use(hooks ...Hook) {
+	c.hooks.ActivityFeedback = append(c.hooks.ActivityFeedback, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `feed.Intercept(f(g(h())))`.
-func (c *FeedClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Feed = append(c.inters.Feed, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `activityfeedback.Intercept(f(g(h())))`.
+func (c *ActivityFeedbackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivityFeedback = append(c.inters.ActivityFeedback, interceptors...)
 }
 
-// Create returns a builder for creating a Feed entity.
-func (c *FeedClient) Create() *FeedCreate {
-	mutation := newFeedMutation(c.config, OpCreate)
-	return &FeedCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ActivityFeedback entity.
+func (c *ActivityFeedbackClient) Create() *ActivityFeedbackCreate {
+	mutation := newActivityFeedbackMutation(c.config, OpCreate)
+	return &ActivityFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Feed entities.
-func (c *FeedClient) CreateBulk(builders ...*FeedCreate) *FeedCreateBulk {
-	return &FeedCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ActivityFeedback entities.
+func (c *ActivityFeedbackClient) CreateBulk(builders ...*ActivityFeedbackCreate) *ActivityFeedbackCreateBulk {
+	return &ActivityFeedbackCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FeedClient) MapCreateBulk(slice any, setFunc func(*FeedCreate, int)) *FeedCreateBulk {
+func (c *ActivityFeedbackClient) MapCreateBulk(slice any, setFunc func(*ActivityFeedbackCreate, int)) *ActivityFeedbackCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FeedCreateBulk{err: fmt.Errorf("calling to FeedClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ActivityFeedbackCreateBulk{err: fmt.Errorf("calling to ActivityFeedbackClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FeedCreate, rv.Len())
+	builders := make([]*ActivityFeedbackCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FeedCreateBulk{config: c.config, builders: builders}
+	return &ActivityFeedbackCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Feed.
-func (c *FeedClient) Update() *FeedUpdate {
-	mutation := newFeedMutation(c.config, OpUpdate)
-	return &FeedUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ActivityFeedback.
+func (c *ActivityFeedbackClient) Update() *ActivityFeedbackUpdate {
+	mutation := newActivityFeedbackMutation(c.config, OpUpdate)
+	return &ActivityFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FeedClient) UpdateOne(f *Feed) *FeedUpdateOne {
-	mutation := newFeedMutation(c.config, OpUpdateOne, withFeed(f))
-	return &FeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivityFeedbackClient) UpdateOne(af *ActivityFeedback) *ActivityFeedbackUpdateOne {
+	mutation := newActivityFeedbackMutation(c.config, OpUpdateOne, withActivityFeedback(af))
+	return &ActivityFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FeedClient) UpdateOneID(id string) *FeedUpdateOne {
-	mutation := newFeedMutation(c.config, OpUpdateOne, withFeedID(id))
-	return &FeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActivityFeedbackClient) UpdateOneID(id int) *ActivityFeedbackUpdateOne {
+	mutation := newActivityFeedbackMutation(c.config, OpUpdateOne, withActivityFeedbackID(id))
+	return &ActivityFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Feed.
-func (c *FeedClient) Delete() *FeedDelete {
-	mutation := newFeedMutation(c.config, OpDelete)
-	return &FeedDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ActivityFeedback.
+func (c *ActivityFeedbackClient) Delete() *ActivityFeedbackDelete {
+	mutation := newActivityFeedbackMutation(c.config, OpDelete)
+	return &ActivityFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FeedClient) DeleteOne(f *Feed) *FeedDeleteOne {
-	return c.DeleteOneID(f.ID)
+func (c *ActivityFeedbackClient) DeleteOne(af *ActivityFeedback) *ActivityFeedbackDeleteOne {
+	return c.DeleteOneID(af.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FeedClient) DeleteOneID(id string) *FeedDeleteOne {
-	builder := c.Delete().Where(feed.ID(id))
+func (c *ActivityFeedbackClient) DeleteOneID(id int) *ActivityFeedbackDeleteOne {
+	builder := c.Delete().Where(activityfeedback.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FeedDeleteOne{builder}
+	return &ActivityFeedbackDeleteOne{builder}
 }
 
-// Query returns a query builder for Feed.
-func (c *FeedClient) Query() *FeedQuery {
-	return &FeedQuery{
+// Query returns a query builder for ActivityFeedback.
+func (c *ActivityFeedbackClient) Query() *ActivityFeedbackQuery {
+	return &ActivityFeedbackQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFeed},
+		ctx:    &QueryContext{Type: TypeActivityFeedback},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Feed entity by its id.
-func (c *FeedClient) Get(ctx context.Context, id string) (*Feed, error) {
-	return c.Query().Where(feed.ID(id)).Only(ctx)
+// Get returns a ActivityFeedback entity by its id.
+func (c *ActivityFeedbackClient) Get(ctx context.Context, id int) (*ActivityFeedback, error) {
+	return c.Query().Where(activityfeedback.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FeedClient) GetX(ctx context.Context, id string) *Feed {
+func (c *ActivityFeedbackClient) GetX(ctx context.Context, id int) *ActivityFeedback {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -458,131 +548,131 @@ func (c *FeedClient) GetX(ctx context.Context, id string) *Feed {
 }
 
 // Hooks returns the client hooks.
-func (c *FeedClient) Hooks() []Hook {
-	return c.hooks.Feed
+func (c *ActivityFeedbackClient) Hooks() []Hook {
+	return c.hooks.ActivityFeedback
 }
 
 // Interceptors returns the client interceptors.
-func (c *FeedClient) Interceptors() []Interceptor {
-	return c.inters.Feed
+func (c *ActivityFeedbackClient) Interceptors() []Interceptor {
+	return c.inters.ActivityFeedback
 }
 
-func (c *FeedClient) mutate(ctx context.Context, m *FeedMutation) (Value, error) {
+func (c *ActivityFeedbackClient) mutate(ctx context.Context, m *ActivityFeedbackMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FeedCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FeedUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActivityFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FeedDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ActivityFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Feed mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ActivityFeedback mutation op: %q", m.Op())
 	}
 }
 
-// SourceClient is a client for the Source schema.
-type SourceClient struct {
+// ArticleTextClient is a client for the ArticleText schema.
+type ArticleTextClient struct {
 	config
 }
 
-// NewSourceClient returns a client for the Source from the given config.
-func NewSourceClient(c config) *SourceClient {
-	return &SourceClient{config: c}
+// NewArticleTextClient returns a client for the ArticleText from the given config.
+func NewArticleTextClient(c config) *ArticleTextClient {
+	return &ArticleTextClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `source.Hooks(f(g(h())))`.
-func (c *SourceClient) Use(hooks ...Hook) {
-	c.hooks.Source = append(c.hooks.Source, hooks...)
+// A call to `Use(f, g, h)` equals to `articletext.Hooks(f(g(h())))`.
+func (c *ArticleTextClient) Use(hooks ...Hook) {
+	c.hooks.ArticleText = append(c.hooks.ArticleText, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `source.Intercept(f(g(h())))`.
-func (c *SourceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Source = append(c.inters.Source, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `articletext.Intercept(f(g(h())))`.
+func (c *ArticleTextClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArticleText = append(c.inters.ArticleText, interceptors...)
 }
 
-// Create returns a builder for creating a Source entity.
-func (c *SourceClient) Create() *SourceCreate {
-	mutation := newSourceMutation(c.config, OpCreate)
-	return &SourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ArticleText entity.
+func (c *ArticleTextClient) Create() *ArticleTextCreate {
+	mutation := newArticleTextMutation(c.config, OpCreate)
+	return &ArticleTextCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Source entities.
-func (c *SourceClient) CreateBulk(builders ...*SourceCreate) *SourceCreateBulk {
-	return &SourceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ArticleText entities.
+func (c *ArticleTextClient) CreateBulk(builders ...*ArticleTextCreate) *ArticleTextCreateBulk {
+	return &ArticleTextCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SourceClient) MapCreateBulk(slice any, setFunc func(*SourceCreate, int)) *SourceCreateBulk {
+func (c *ArticleTextClient) MapCreateBulk(slice any, setFunc func(*ArticleTextCreate, int)) *ArticleTextCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SourceCreateBulk{err: fmt.Errorf("calling to SourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArticleTextCreateBulk{err: fmt.Errorf("calling to ArticleTextClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SourceCreate, rv.Len())
+	builders := make([]*ArticleTextCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SourceCreateBulk{config: c.config, builders: builders}
+	return &ArticleTextCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Source.
-func (c *SourceClient) Update() *SourceUpdate {
-	mutation := newSourceMutation(c.config, OpUpdate)
-	return &SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ArticleText.
+func (c *ArticleTextClient) Update() *ArticleTextUpdate {
+	mutation := newArticleTextMutation(c.config, OpUpdate)
+	return &ArticleTextUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SourceClient) UpdateOne(s *Source) *SourceUpdateOne {
-	mutation := newSourceMutation(c.config, OpUpdateOne, withSource(s))
-	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleTextClient) UpdateOne(at *ArticleText) *ArticleTextUpdateOne {
+	mutation := newArticleTextMutation(c.config, OpUpdateOne, withArticleText(at))
+	return &ArticleTextUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SourceClient) UpdateOneID(id string) *SourceUpdateOne {
-	mutation := newSourceMutation(c.config, OpUpdateOne, withSourceID(id))
-	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArticleTextClient) UpdateOneID(id string) *ArticleTextUpdateOne {
+	mutation := newArticleTextMutation(c.config, OpUpdateOne, withArticleTextID(id))
+	return &ArticleTextUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Source.
-func (c *SourceClient) Delete() *SourceDelete {
-	mutation := newSourceMutation(c.config, OpDelete)
-	return &SourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ArticleText.
+func (c *ArticleTextClient) Delete() *ArticleTextDelete {
+	mutation := newArticleTextMutation(c.config, OpDelete)
+	return &ArticleTextDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SourceClient) DeleteOne(s *Source) *SourceDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *ArticleTextClient) DeleteOne(at *ArticleText) *ArticleTextDeleteOne {
+	return c.DeleteOneID(at.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SourceClient) DeleteOneID(id string) *SourceDeleteOne {
-	builder := c.Delete().Where(source.ID(id))
+func (c *ArticleTextClient) DeleteOneID(id string) *ArticleTextDeleteOne {
+	builder := c.Delete().Where(articletext.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SourceDeleteOne{builder}
+	return &ArticleTextDeleteOne{builder}
 }
 
-// Query returns a query builder for Source.
-func (c *SourceClient) Query() *SourceQuery {
-	return &SourceQuery{
+// Query returns a query builder for ArticleText.
+func (c *ArticleTextClient) Query() *ArticleTextQuery {
+	return &ArticleTextQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSource},
+		ctx:    &QueryContext{Type: TypeArticleText},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Source entity by its id.
-func (c *SourceClient) Get(ctx context.Context, id string) (*Source, error) {
-	return c.Query().Where(source.ID(id)).Only(ctx)
+// Get returns a ArticleText entity by its id.
+func (c *ArticleTextClient) Get(ctx context.Context, id string) (*ArticleText, error) {
+	return c.Query().Where(articletext.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SourceClient) GetX(ctx context.Context, id string) *Source {
+func (c *ArticleTextClient) GetX(ctx context.Context, id string) *ArticleText {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -591,36 +681,1394 @@ func (c *SourceClient) GetX(ctx context.Context, id string) *Source {
 }
 
 // Hooks returns the client hooks.
-func (c *SourceClient) Hooks() []Hook {
-	return c.hooks.Source
+func (c *ArticleTextClient) Hooks() []Hook {
+	return c.hooks.ArticleText
 }
 
 // Interceptors returns the client interceptors.
-func (c *SourceClient) Interceptors() []Interceptor {
-	return c.inters.Source
+func (c *ArticleTextClient) Interceptors() []Interceptor {
+	return c.inters.ArticleText
 }
 
-func (c *SourceClient) mutate(ctx context.Context, m *SourceMutation) (Value, error) {
+func (c *ArticleTextClient) mutate(ctx context.Context, m *ArticleTextMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleTextCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleTextUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArticleTextUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ArticleTextDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Source mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ArticleText mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Activity, Feed, Source []ent.Hook
+// CollectionClient is a client for the Collection schema.
+type CollectionClient struct {
+	config
+}
+
+// NewCollectionClient returns a client for the Collection from the given config.
+func NewCollectionClient(c config) *CollectionClient {
+	return &CollectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collection.Hooks(f(g(h())))`.
+func (c *CollectionClient) Use(hooks ...Hook) {
+	c.hooks.Collection = append(c.hooks.Collection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collection.Intercept(f(g(h())))`.
+func (c *CollectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Collection = append(c.inters.Collection, interceptors...)
+}
+
+// Create returns a builder for creating a Collection entity.
+func (c *CollectionClient) Create() *CollectionCreate {
+	mutation := newCollectionMutation(c.config, OpCreate)
+	return &CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Collection entities.
+func (c *CollectionClient) CreateBulk(builders ...*CollectionCreate) *CollectionCreateBulk {
+	return &CollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionClient) MapCreateBulk(slice any, setFunc func(*CollectionCreate, int)) *CollectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionCreateBulk{err: fmt.Errorf("calling to CollectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		Activity, Feed, Source []ent.Interceptor
+	builders := make([]*CollectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &CollectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Collection.
+func (c *CollectionClient) Update() *CollectionUpdate {
+	mutation := newCollectionMutation(c.config, OpUpdate)
+	return &CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionClient) UpdateOne(co *Collection) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollection(co))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionClient) UpdateOneID(id string) *CollectionUpdateOne {
+	mutation := newCollectionMutation(c.config, OpUpdateOne, withCollectionID(id))
+	return &CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Collection.
+func (c *CollectionClient) Delete() *CollectionDelete {
+	mutation := newCollectionMutation(c.config, OpDelete)
+	return &CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionClient) DeleteOne(co *Collection) *CollectionDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionClient) DeleteOneID(id string) *CollectionDeleteOne {
+	builder := c.Delete().Where(collection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionDeleteOne{builder}
+}
+
+// Query returns a query builder for Collection.
+func (c *CollectionClient) Query() *CollectionQuery {
+	return &CollectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Collection entity by its id.
+func (c *CollectionClient) Get(ctx context.Context, id string) (*Collection, error) {
+	return c.Query().Where(collection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionClient) GetX(ctx context.Context, id string) *Collection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionClient) Hooks() []Hook {
+	return c.hooks.Collection
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionClient) Interceptors() []Interceptor {
+	return c.inters.Collection
+}
+
+func (c *CollectionClient) mutate(ctx context.Context, m *CollectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Collection mutation op: %q", m.Op())
+	}
+}
+
+// CollectionActivityClient is a client for the CollectionActivity schema.
+type CollectionActivityClient struct {
+	config
+}
+
+// NewCollectionActivityClient returns a client for the CollectionActivity from the given config.
+func NewCollectionActivityClient(c config) *CollectionActivityClient {
+	return &CollectionActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `collectionactivity.Hooks(f(g(h())))`.
+func (c *CollectionActivityClient) Use(hooks ...Hook) {
+	c.hooks.CollectionActivity = append(c.hooks.CollectionActivity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `collectionactivity.Intercept(f(g(h())))`.
+func (c *CollectionActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollectionActivity = append(c.inters.CollectionActivity, interceptors...)
+}
+
+// Create returns a builder for creating a CollectionActivity entity.
+func (c *CollectionActivityClient) Create() *CollectionActivityCreate {
+	mutation := newCollectionActivityMutation(c.config, OpCreate)
+	return &CollectionActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CollectionActivity entities.
+func (c *CollectionActivityClient) CreateBulk(builders ...*CollectionActivityCreate) *CollectionActivityCreateBulk {
+	return &CollectionActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CollectionActivityClient) MapCreateBulk(slice any, setFunc func(*CollectionActivityCreate, int)) *CollectionActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CollectionActivityCreateBulk{err: fmt.Errorf("calling to CollectionActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CollectionActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CollectionActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CollectionActivity.
+func (c *CollectionActivityClient) Update() *CollectionActivityUpdate {
+	mutation := newCollectionActivityMutation(c.config, OpUpdate)
+	return &CollectionActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CollectionActivityClient) UpdateOne(ca *CollectionActivity) *CollectionActivityUpdateOne {
+	mutation := newCollectionActivityMutation(c.config, OpUpdateOne, withCollectionActivity(ca))
+	return &CollectionActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CollectionActivityClient) UpdateOneID(id int) *CollectionActivityUpdateOne {
+	mutation := newCollectionActivityMutation(c.config, OpUpdateOne, withCollectionActivityID(id))
+	return &CollectionActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CollectionActivity.
+func (c *CollectionActivityClient) Delete() *CollectionActivityDelete {
+	mutation := newCollectionActivityMutation(c.config, OpDelete)
+	return &CollectionActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CollectionActivityClient) DeleteOne(ca *CollectionActivity) *CollectionActivityDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CollectionActivityClient) DeleteOneID(id int) *CollectionActivityDeleteOne {
+	builder := c.Delete().Where(collectionactivity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CollectionActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for CollectionActivity.
+func (c *CollectionActivityClient) Query() *CollectionActivityQuery {
+	return &CollectionActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCollectionActivity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CollectionActivity entity by its id.
+func (c *CollectionActivityClient) Get(ctx context.Context, id int) (*CollectionActivity, error) {
+	return c.Query().Where(collectionactivity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CollectionActivityClient) GetX(ctx context.Context, id int) *CollectionActivity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CollectionActivityClient) Hooks() []Hook {
+	return c.hooks.CollectionActivity
+}
+
+// Interceptors returns the client interceptors.
+func (c *CollectionActivityClient) Interceptors() []Interceptor {
+	return c.inters.CollectionActivity
+}
+
+func (c *CollectionActivityClient) mutate(ctx context.Context, m *CollectionActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CollectionActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CollectionActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CollectionActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CollectionActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CollectionActivity mutation op: %q", m.Op())
+	}
+}
+
+// FailedActivityClient is a client for the FailedActivity schema.
+type FailedActivityClient struct {
+	config
+}
+
+// NewFailedActivityClient returns a client for the FailedActivity from the given config.
+func NewFailedActivityClient(c config) *FailedActivityClient {
+	return &FailedActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `failedactivity.Hooks(f(g(h())))`.
+func (c *FailedActivityClient) Use(hooks ...Hook) {
+	c.hooks.FailedActivity = append(c.hooks.FailedActivity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `failedactivity.Intercept(f(g(h())))`.
+func (c *FailedActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FailedActivity = append(c.inters.FailedActivity, interceptors...)
+}
+
+// Create returns a builder for creating a FailedActivity entity.
+func (c *FailedActivityClient) Create() *FailedActivityCreate {
+	mutation := newFailedActivityMutation(c.config, OpCreate)
+	return &FailedActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FailedActivity entities.
+func (c *FailedActivityClient) CreateBulk(builders ...*FailedActivityCreate) *FailedActivityCreateBulk {
+	return &FailedActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FailedActivityClient) MapCreateBulk(slice any, setFunc func(*FailedActivityCreate, int)) *FailedActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FailedActivityCreateBulk{err: fmt.Errorf("calling to FailedActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FailedActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FailedActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FailedActivity.
+func (c *FailedActivityClient) Update() *FailedActivityUpdate {
+	mutation := newFailedActivityMutation(c.config, OpUpdate)
+	return &FailedActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FailedActivityClient) UpdateOne(fa *FailedActivity) *FailedActivityUpdateOne {
+	mutation := newFailedActivityMutation(c.config, OpUpdateOne, withFailedActivity(fa))
+	return &FailedActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FailedActivityClient) UpdateOneID(id string) *FailedActivityUpdateOne {
+	mutation := newFailedActivityMutation(c.config, OpUpdateOne, withFailedActivityID(id))
+	return &FailedActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FailedActivity.
+func (c *FailedActivityClient) Delete() *FailedActivityDelete {
+	mutation := newFailedActivityMutation(c.config, OpDelete)
+	return &FailedActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FailedActivityClient) DeleteOne(fa *FailedActivity) *FailedActivityDeleteOne {
+	return c.DeleteOneID(fa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FailedActivityClient) DeleteOneID(id string) *FailedActivityDeleteOne {
+	builder := c.Delete().Where(failedactivity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FailedActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for FailedActivity.
+func (c *FailedActivityClient) Query() *FailedActivityQuery {
+	return &FailedActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFailedActivity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FailedActivity entity by its id.
+func (c *FailedActivityClient) Get(ctx context.Context, id string) (*FailedActivity, error) {
+	return c.Query().Where(failedactivity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FailedActivityClient) GetX(ctx context.Context, id string) *FailedActivity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FailedActivityClient) Hooks() []Hook {
+	return c.hooks.FailedActivity
+}
+
+// Interceptors returns the client interceptors.
+func (c *FailedActivityClient) Interceptors() []Interceptor {
+	return c.inters.FailedActivity
+}
+
+func (c *FailedActivityClient) mutate(ctx context.Context, m *FailedActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FailedActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FailedActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FailedActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FailedActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FailedActivity mutation op: %q", m.Op())
+	}
+}
+
+// FeedClient is a client for the Feed schema.
+type FeedClient struct {
+	config
+}
+
+// NewFeedClient returns a client for the Feed from the given config.
+func NewFeedClient(c config) *FeedClient {
+	return &FeedClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `feed.Hooks(f(g(h())))`.
+func (c *FeedClient) Use(hooks ...Hook) {
+	c.hooks.Feed = append(c.hooks.Feed, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `feed.Intercept(f(g(h())))`.
+func (c *FeedClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Feed = append(c.inters.Feed, interceptors...)
+}
+
+// Create returns a builder for creating a Feed entity.
+func (c *FeedClient) Create() *FeedCreate {
+	mutation := newFeedMutation(c.config, OpCreate)
+	return &FeedCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Feed entities.
+func (c *FeedClient) CreateBulk(builders ...*FeedCreate) *FeedCreateBulk {
+	return &FeedCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeedClient) MapCreateBulk(slice any, setFunc func(*FeedCreate, int)) *FeedCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeedCreateBulk{err: fmt.Errorf("calling to FeedClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeedCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeedCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Feed.
+func (c *FeedClient) Update() *FeedUpdate {
+	mutation := newFeedMutation(c.config, OpUpdate)
+	return &FeedUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeedClient) UpdateOne(f *Feed) *FeedUpdateOne {
+	mutation := newFeedMutation(c.config, OpUpdateOne, withFeed(f))
+	return &FeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeedClient) UpdateOneID(id string) *FeedUpdateOne {
+	mutation := newFeedMutation(c.config, OpUpdateOne, withFeedID(id))
+	return &FeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Feed.
+func (c *FeedClient) Delete() *FeedDelete {
+	mutation := newFeedMutation(c.config, OpDelete)
+	return &FeedDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeedClient) DeleteOne(f *Feed) *FeedDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeedClient) DeleteOneID(id string) *FeedDeleteOne {
+	builder := c.Delete().Where(feed.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeedDeleteOne{builder}
+}
+
+// Query returns a query builder for Feed.
+func (c *FeedClient) Query() *FeedQuery {
+	return &FeedQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeed},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Feed entity by its id.
+func (c *FeedClient) Get(ctx context.Context, id string) (*Feed, error) {
+	return c.Query().Where(feed.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeedClient) GetX(ctx context.Context, id string) *Feed {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FeedClient) Hooks() []Hook {
+	return c.hooks.Feed
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeedClient) Interceptors() []Interceptor {
+	return c.inters.Feed
+}
+
+func (c *FeedClient) mutate(ctx context.Context, m *FeedMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeedCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeedUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeedDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Feed mutation op: %q", m.Op())
+	}
+}
+
+// FeedPositionClient is a client for the FeedPosition schema.
+type FeedPositionClient struct {
+	config
+}
+
+// NewFeedPositionClient returns a client for the FeedPosition from the given config.
+func NewFeedPositionClient(c config) *FeedPositionClient {
+	return &FeedPositionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `feedposition.Hooks(f(g(h())))`.
+func (c *FeedPositionClient) Use(hooks ...Hook) {
+	c.hooks.FeedPosition = append(c.hooks.FeedPosition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `feedposition.Intercept(f(g(h())))`.
+func (c *FeedPositionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeedPosition = append(c.inters.FeedPosition, interceptors...)
+}
+
+// Create returns a builder for creating a FeedPosition entity.
+func (c *FeedPositionClient) Create() *FeedPositionCreate {
+	mutation := newFeedPositionMutation(c.config, OpCreate)
+	return &FeedPositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeedPosition entities.
+func (c *FeedPositionClient) CreateBulk(builders ...*FeedPositionCreate) *FeedPositionCreateBulk {
+	return &FeedPositionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeedPositionClient) MapCreateBulk(slice any, setFunc func(*FeedPositionCreate, int)) *FeedPositionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeedPositionCreateBulk{err: fmt.Errorf("calling to FeedPositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeedPositionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeedPositionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeedPosition.
+func (c *FeedPositionClient) Update() *FeedPositionUpdate {
+	mutation := newFeedPositionMutation(c.config, OpUpdate)
+	return &FeedPositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeedPositionClient) UpdateOne(fp *FeedPosition) *FeedPositionUpdateOne {
+	mutation := newFeedPositionMutation(c.config, OpUpdateOne, withFeedPosition(fp))
+	return &FeedPositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeedPositionClient) UpdateOneID(id int) *FeedPositionUpdateOne {
+	mutation := newFeedPositionMutation(c.config, OpUpdateOne, withFeedPositionID(id))
+	return &FeedPositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeedPosition.
+func (c *FeedPositionClient) Delete() *FeedPositionDelete {
+	mutation := newFeedPositionMutation(c.config, OpDelete)
+	return &FeedPositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeedPositionClient) DeleteOne(fp *FeedPosition) *FeedPositionDeleteOne {
+	return c.DeleteOneID(fp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeedPositionClient) DeleteOneID(id int) *FeedPositionDeleteOne {
+	builder := c.Delete().Where(feedposition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeedPositionDeleteOne{builder}
+}
+
+// Query returns a query builder for FeedPosition.
+func (c *FeedPositionClient) Query() *FeedPositionQuery {
+	return &FeedPositionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeedPosition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeedPosition entity by its id.
+func (c *FeedPositionClient) Get(ctx context.Context, id int) (*FeedPosition, error) {
+	return c.Query().Where(feedposition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeedPositionClient) GetX(ctx context.Context, id int) *FeedPosition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FeedPositionClient) Hooks() []Hook {
+	return c.hooks.FeedPosition
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeedPositionClient) Interceptors() []Interceptor {
+	return c.inters.FeedPosition
+}
+
+func (c *FeedPositionClient) mutate(ctx context.Context, m *FeedPositionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeedPositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeedPositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeedPositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeedPositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FeedPosition mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id int) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id int) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id int) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id int) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// ReadActivityClient is a client for the ReadActivity schema.
+type ReadActivityClient struct {
+	config
+}
+
+// NewReadActivityClient returns a client for the ReadActivity from the given config.
+func NewReadActivityClient(c config) *ReadActivityClient {
+	return &ReadActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `readactivity.Hooks(f(g(h())))`.
+func (c *ReadActivityClient) Use(hooks ...Hook) {
+	c.hooks.ReadActivity = append(c.hooks.ReadActivity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `readactivity.Intercept(f(g(h())))`.
+func (c *ReadActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReadActivity = append(c.inters.ReadActivity, interceptors...)
+}
+
+// Create returns a builder for creating a ReadActivity entity.
+func (c *ReadActivityClient) Create() *ReadActivityCreate {
+	mutation := newReadActivityMutation(c.config, OpCreate)
+	return &ReadActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReadActivity entities.
+func (c *ReadActivityClient) CreateBulk(builders ...*ReadActivityCreate) *ReadActivityCreateBulk {
+	return &ReadActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReadActivityClient) MapCreateBulk(slice any, setFunc func(*ReadActivityCreate, int)) *ReadActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReadActivityCreateBulk{err: fmt.Errorf("calling to ReadActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReadActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReadActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReadActivity.
+func (c *ReadActivityClient) Update() *ReadActivityUpdate {
+	mutation := newReadActivityMutation(c.config, OpUpdate)
+	return &ReadActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReadActivityClient) UpdateOne(ra *ReadActivity) *ReadActivityUpdateOne {
+	mutation := newReadActivityMutation(c.config, OpUpdateOne, withReadActivity(ra))
+	return &ReadActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReadActivityClient) UpdateOneID(id int) *ReadActivityUpdateOne {
+	mutation := newReadActivityMutation(c.config, OpUpdateOne, withReadActivityID(id))
+	return &ReadActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReadActivity.
+func (c *ReadActivityClient) Delete() *ReadActivityDelete {
+	mutation := newReadActivityMutation(c.config, OpDelete)
+	return &ReadActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReadActivityClient) DeleteOne(ra *ReadActivity) *ReadActivityDeleteOne {
+	return c.DeleteOneID(ra.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReadActivityClient) DeleteOneID(id int) *ReadActivityDeleteOne {
+	builder := c.Delete().Where(readactivity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReadActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for ReadActivity.
+func (c *ReadActivityClient) Query() *ReadActivityQuery {
+	return &ReadActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReadActivity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReadActivity entity by its id.
+func (c *ReadActivityClient) Get(ctx context.Context, id int) (*ReadActivity, error) {
+	return c.Query().Where(readactivity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReadActivityClient) GetX(ctx context.Context, id int) *ReadActivity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReadActivityClient) Hooks() []Hook {
+	return c.hooks.ReadActivity
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReadActivityClient) Interceptors() []Interceptor {
+	return c.inters.ReadActivity
+}
+
+func (c *ReadActivityClient) mutate(ctx context.Context, m *ReadActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReadActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReadActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReadActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReadActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReadActivity mutation op: %q", m.Op())
+	}
+}
+
+// SourceClient is a client for the Source schema.
+type SourceClient struct {
+	config
+}
+
+// NewSourceClient returns a client for the Source from the given config.
+func NewSourceClient(c config) *SourceClient {
+	return &SourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `source.Hooks(f(g(h())))`.
+func (c *SourceClient) Use(hooks ...Hook) {
+	c.hooks.Source = append(c.hooks.Source, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `source.Intercept(f(g(h())))`.
+func (c *SourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Source = append(c.inters.Source, interceptors...)
+}
+
+// Create returns a builder for creating a Source entity.
+func (c *SourceClient) Create() *SourceCreate {
+	mutation := newSourceMutation(c.config, OpCreate)
+	return &SourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Source entities.
+func (c *SourceClient) CreateBulk(builders ...*SourceCreate) *SourceCreateBulk {
+	return &SourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SourceClient) MapCreateBulk(slice any, setFunc func(*SourceCreate, int)) *SourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SourceCreateBulk{err: fmt.Errorf("calling to SourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Source.
+func (c *SourceClient) Update() *SourceUpdate {
+	mutation := newSourceMutation(c.config, OpUpdate)
+	return &SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceClient) UpdateOne(s *Source) *SourceUpdateOne {
+	mutation := newSourceMutation(c.config, OpUpdateOne, withSource(s))
+	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceClient) UpdateOneID(id string) *SourceUpdateOne {
+	mutation := newSourceMutation(c.config, OpUpdateOne, withSourceID(id))
+	return &SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Source.
+func (c *SourceClient) Delete() *SourceDelete {
+	mutation := newSourceMutation(c.config, OpDelete)
+	return &SourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceClient) DeleteOne(s *Source) *SourceDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceClient) DeleteOneID(id string) *SourceDeleteOne {
+	builder := c.Delete().Where(source.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceDeleteOne{builder}
+}
+
+// Query returns a query builder for Source.
+func (c *SourceClient) Query() *SourceQuery {
+	return &SourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Source entity by its id.
+func (c *SourceClient) Get(ctx context.Context, id string) (*Source, error) {
+	return c.Query().Where(source.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceClient) GetX(ctx context.Context, id string) *Source {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SourceClient) Hooks() []Hook {
+	return c.hooks.Source
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceClient) Interceptors() []Interceptor {
+	return c.inters.Source
+}
+
+func (c *SourceClient) mutate(ctx context.Context, m *SourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Source mutation op: %q", m.Op())
+	}
+}
+
+// SourceStateClient is a client for the SourceState schema.
+type SourceStateClient struct {
+	config
+}
+
+// NewSourceStateClient returns a client for the SourceState from the given config.
+func NewSourceStateClient(c config) *SourceStateClient {
+	return &SourceStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcestate.Hooks(f(g(h())))`.
+func (c *SourceStateClient) Use(hooks ...Hook) {
+	c.hooks.SourceState = append(c.hooks.SourceState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sourcestate.Intercept(f(g(h())))`.
+func (c *SourceStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SourceState = append(c.inters.SourceState, interceptors...)
+}
+
+// Create returns a builder for creating a SourceState entity.
+func (c *SourceStateClient) Create() *SourceStateCreate {
+	mutation := newSourceStateMutation(c.config, OpCreate)
+	return &SourceStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceState entities.
+func (c *SourceStateClient) CreateBulk(builders ...*SourceStateCreate) *SourceStateCreateBulk {
+	return &SourceStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SourceStateClient) MapCreateBulk(slice any, setFunc func(*SourceStateCreate, int)) *SourceStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SourceStateCreateBulk{err: fmt.Errorf("calling to SourceStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SourceStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SourceStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceState.
+func (c *SourceStateClient) Update() *SourceStateUpdate {
+	mutation := newSourceStateMutation(c.config, OpUpdate)
+	return &SourceStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceStateClient) UpdateOne(ss *SourceState) *SourceStateUpdateOne {
+	mutation := newSourceStateMutation(c.config, OpUpdateOne, withSourceState(ss))
+	return &SourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceStateClient) UpdateOneID(id string) *SourceStateUpdateOne {
+	mutation := newSourceStateMutation(c.config, OpUpdateOne, withSourceStateID(id))
+	return &SourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceState.
+func (c *SourceStateClient) Delete() *SourceStateDelete {
+	mutation := newSourceStateMutation(c.config, OpDelete)
+	return &SourceStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceStateClient) DeleteOne(ss *SourceState) *SourceStateDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceStateClient) DeleteOneID(id string) *SourceStateDeleteOne {
+	builder := c.Delete().Where(sourcestate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceStateDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceState.
+func (c *SourceStateClient) Query() *SourceStateQuery {
+	return &SourceStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSourceState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SourceState entity by its id.
+func (c *SourceStateClient) Get(ctx context.Context, id string) (*SourceState, error) {
+	return c.Query().Where(sourcestate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceStateClient) GetX(ctx context.Context, id string) *SourceState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SourceStateClient) Hooks() []Hook {
+	return c.hooks.SourceState
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceStateClient) Interceptors() []Interceptor {
+	return c.inters.SourceState
+}
+
+func (c *SourceStateClient) mutate(ctx context.Context, m *SourceStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SourceState mutation op: %q", m.Op())
+	}
+}
+
+// UserProvisionClient is a client for the UserProvision schema.
+type UserProvisionClient struct {
+	config
+}
+
+// NewUserProvisionClient returns a client for the UserProvision from the given config.
+func NewUserProvisionClient(c config) *UserProvisionClient {
+	return &UserProvisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userprovision.Hooks(f(g(h())))`.
+func (c *UserProvisionClient) Use(hooks ...Hook) {
+	c.hooks.UserProvision = append(c.hooks.UserProvision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userprovision.Intercept(f(g(h())))`.
+func (c *UserProvisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserProvision = append(c.inters.UserProvision, interceptors...)
+}
+
+// Create returns a builder for creating a UserProvision entity.
+func (c *UserProvisionClient) Create() *UserProvisionCreate {
+	mutation := newUserProvisionMutation(c.config, OpCreate)
+	return &UserProvisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserProvision entities.
+func (c *UserProvisionClient) CreateBulk(builders ...*UserProvisionCreate) *UserProvisionCreateBulk {
+	return &UserProvisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserProvisionClient) MapCreateBulk(slice any, setFunc func(*UserProvisionCreate, int)) *UserProvisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserProvisionCreateBulk{err: fmt.Errorf("calling to UserProvisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserProvisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserProvisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserProvision.
+func (c *UserProvisionClient) Update() *UserProvisionUpdate {
+	mutation := newUserProvisionMutation(c.config, OpUpdate)
+	return &UserProvisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserProvisionClient) UpdateOne(up *UserProvision) *UserProvisionUpdateOne {
+	mutation := newUserProvisionMutation(c.config, OpUpdateOne, withUserProvision(up))
+	return &UserProvisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserProvisionClient) UpdateOneID(id int) *UserProvisionUpdateOne {
+	mutation := newUserProvisionMutation(c.config, OpUpdateOne, withUserProvisionID(id))
+	return &UserProvisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserProvision.
+func (c *UserProvisionClient) Delete() *UserProvisionDelete {
+	mutation := newUserProvisionMutation(c.config, OpDelete)
+	return &UserProvisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserProvisionClient) DeleteOne(up *UserProvision) *UserProvisionDeleteOne {
+	return c.DeleteOneID(up.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserProvisionClient) DeleteOneID(id int) *UserProvisionDeleteOne {
+	builder := c.Delete().Where(userprovision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserProvisionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserProvision.
+func (c *UserProvisionClient) Query() *UserProvisionQuery {
+	return &UserProvisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserProvision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserProvision entity by its id.
+func (c *UserProvisionClient) Get(ctx context.Context, id int) (*UserProvision, error) {
+	return c.Query().Where(userprovision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserProvisionClient) GetX(ctx context.Context, id int) *UserProvision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserProvisionClient) Hooks() []Hook {
+	return c.hooks.UserProvision
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserProvisionClient) Interceptors() []Interceptor {
+	return c.inters.UserProvision
+}
+
+func (c *UserProvisionClient) mutate(ctx context.Context, m *UserProvisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserProvisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserProvisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserProvisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserProvisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserProvision mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Activity, ActivityFeedback, ArticleText, Collection, CollectionActivity,
+		FailedActivity, Feed, FeedPosition, IdempotencyKey, ReadActivity, Source,
+		SourceState, UserProvision []ent.Hook
+	}
+	inters struct {
+		Activity, ActivityFeedback, ArticleText, Collection, CollectionActivity,
+		FailedActivity, Feed, FeedPosition, IdempotencyKey, ReadActivity, Source,
+		SourceState, UserProvision []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
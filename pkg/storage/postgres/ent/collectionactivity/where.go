@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collectionactivity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLTE(FieldID, id))
+}
+
+// CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
+func CollectionID(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// ActivityID applies equality check predicate on the "activity_id" field. It's identical to ActivityIDEQ.
+func ActivityID(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldActivityID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldPosition, v))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDGT applies the GT predicate on the "collection_id" field.
+func CollectionIDGT(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGT(FieldCollectionID, v))
+}
+
+// CollectionIDGTE applies the GTE predicate on the "collection_id" field.
+func CollectionIDGTE(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGTE(FieldCollectionID, v))
+}
+
+// CollectionIDLT applies the LT predicate on the "collection_id" field.
+func CollectionIDLT(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLT(FieldCollectionID, v))
+}
+
+// CollectionIDLTE applies the LTE predicate on the "collection_id" field.
+func CollectionIDLTE(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLTE(FieldCollectionID, v))
+}
+
+// CollectionIDContains applies the Contains predicate on the "collection_id" field.
+func CollectionIDContains(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldContains(FieldCollectionID, v))
+}
+
+// CollectionIDHasPrefix applies the HasPrefix predicate on the "collection_id" field.
+func CollectionIDHasPrefix(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldHasPrefix(FieldCollectionID, v))
+}
+
+// CollectionIDHasSuffix applies the HasSuffix predicate on the "collection_id" field.
+func CollectionIDHasSuffix(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldHasSuffix(FieldCollectionID, v))
+}
+
+// CollectionIDEqualFold applies the EqualFold predicate on the "collection_id" field.
+func CollectionIDEqualFold(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEqualFold(FieldCollectionID, v))
+}
+
+// CollectionIDContainsFold applies the ContainsFold predicate on the "collection_id" field.
+func CollectionIDContainsFold(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldContainsFold(FieldCollectionID, v))
+}
+
+// ActivityIDEQ applies the EQ predicate on the "activity_id" field.
+func ActivityIDEQ(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldActivityID, v))
+}
+
+// ActivityIDNEQ applies the NEQ predicate on the "activity_id" field.
+func ActivityIDNEQ(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNEQ(FieldActivityID, v))
+}
+
+// ActivityIDIn applies the In predicate on the "activity_id" field.
+func ActivityIDIn(vs ...string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldIn(FieldActivityID, vs...))
+}
+
+// ActivityIDNotIn applies the NotIn predicate on the "activity_id" field.
+func ActivityIDNotIn(vs ...string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNotIn(FieldActivityID, vs...))
+}
+
+// ActivityIDGT applies the GT predicate on the "activity_id" field.
+func ActivityIDGT(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGT(FieldActivityID, v))
+}
+
+// ActivityIDGTE applies the GTE predicate on the "activity_id" field.
+func ActivityIDGTE(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGTE(FieldActivityID, v))
+}
+
+// ActivityIDLT applies the LT predicate on the "activity_id" field.
+func ActivityIDLT(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLT(FieldActivityID, v))
+}
+
+// ActivityIDLTE applies the LTE predicate on the "activity_id" field.
+func ActivityIDLTE(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLTE(FieldActivityID, v))
+}
+
+// ActivityIDContains applies the Contains predicate on the "activity_id" field.
+func ActivityIDContains(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldContains(FieldActivityID, v))
+}
+
+// ActivityIDHasPrefix applies the HasPrefix predicate on the "activity_id" field.
+func ActivityIDHasPrefix(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldHasPrefix(FieldActivityID, v))
+}
+
+// ActivityIDHasSuffix applies the HasSuffix predicate on the "activity_id" field.
+func ActivityIDHasSuffix(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldHasSuffix(FieldActivityID, v))
+}
+
+// ActivityIDEqualFold applies the EqualFold predicate on the "activity_id" field.
+func ActivityIDEqualFold(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEqualFold(FieldActivityID, v))
+}
+
+// ActivityIDContainsFold applies the ContainsFold predicate on the "activity_id" field.
+func ActivityIDContainsFold(v string) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldContainsFold(FieldActivityID, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLTE(FieldPosition, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CollectionActivity) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CollectionActivity) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CollectionActivity) predicate.CollectionActivity {
+	return predicate.CollectionActivity(sql.NotPredicates(p))
+}
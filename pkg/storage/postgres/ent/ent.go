@@ -13,8 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activityfeedback"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/articletext"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/collection"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/collectionactivity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/failedactivity"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feedposition"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/idempotencykey"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/readactivity"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/source"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/sourcestate"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/userprovision"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			activity.Table: activity.ValidColumn,
-			feed.Table:     feed.ValidColumn,
-			source.Table:   source.ValidColumn,
+			activity.Table:           activity.ValidColumn,
+			activityfeedback.Table:   activityfeedback.ValidColumn,
+			articletext.Table:        articletext.ValidColumn,
+			collection.Table:         collection.ValidColumn,
+			collectionactivity.Table: collectionactivity.ValidColumn,
+			failedactivity.Table:     failedactivity.ValidColumn,
+			feed.Table:               feed.ValidColumn,
+			feedposition.Table:       feedposition.ValidColumn,
+			idempotencykey.Table:     idempotencykey.ValidColumn,
+			readactivity.Table:       readactivity.ValidColumn,
+			source.Table:             source.ValidColumn,
+			sourcestate.Table:        sourcestate.ValidColumn,
+			userprovision.Table:      userprovision.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/schema"
 )
 
 // Feed is the model entity for the Feed schema.
@@ -28,12 +29,28 @@ type Feed struct {
 	Query string `json:"query,omitempty"`
 	// Public holds the value of the "public" field.
 	Public bool `json:"public,omitempty"`
+	// Paused holds the value of the "paused" field.
+	Paused bool `json:"paused,omitempty"`
 	// SourceUids holds the value of the "source_uids" field.
 	SourceUids []string `json:"source_uids,omitempty"`
+	// SourceWeights holds the value of the "source_weights" field.
+	SourceWeights map[string]float64 `json:"source_weights,omitempty"`
+	// SourceOverrides holds the value of the "source_overrides" field.
+	SourceOverrides map[string]schema.SourceOverride `json:"source_overrides,omitempty"`
+	// SourceFilters holds the value of the "source_filters" field.
+	SourceFilters map[string]schema.SourceFilter `json:"source_filters,omitempty"`
+	// MinQualityScore holds the value of the "min_quality_score" field.
+	MinQualityScore float64 `json:"min_quality_score,omitempty"`
+	// Sections holds the value of the "sections" field.
+	Sections []schema.FeedSection `json:"sections,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -42,13 +59,15 @@ func (*Feed) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case feed.FieldSourceUids:
+		case feed.FieldSourceUids, feed.FieldSourceWeights, feed.FieldSourceOverrides, feed.FieldSourceFilters, feed.FieldSections:
 			values[i] = new([]byte)
-		case feed.FieldPublic:
+		case feed.FieldPublic, feed.FieldPaused:
 			values[i] = new(sql.NullBool)
-		case feed.FieldID, feed.FieldUserID, feed.FieldName, feed.FieldIcon, feed.FieldQuery:
+		case feed.FieldMinQualityScore:
+			values[i] = new(sql.NullFloat64)
+		case feed.FieldID, feed.FieldUserID, feed.FieldName, feed.FieldIcon, feed.FieldQuery, feed.FieldLanguage:
 			values[i] = new(sql.NullString)
-		case feed.FieldCreatedAt, feed.FieldUpdatedAt:
+		case feed.FieldCreatedAt, feed.FieldUpdatedAt, feed.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -101,6 +120,12 @@ func (f *Feed) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.Public = value.Bool
 			}
+		case feed.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				f.Paused = value.Bool
+			}
 		case feed.FieldSourceUids:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field source_uids", values[i])
@@ -109,6 +134,50 @@ func (f *Feed) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field source_uids: %w", err)
 				}
 			}
+		case feed.FieldSourceWeights:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source_weights", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.SourceWeights); err != nil {
+					return fmt.Errorf("unmarshal field source_weights: %w", err)
+				}
+			}
+		case feed.FieldSourceOverrides:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source_overrides", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.SourceOverrides); err != nil {
+					return fmt.Errorf("unmarshal field source_overrides: %w", err)
+				}
+			}
+		case feed.FieldSourceFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source_filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.SourceFilters); err != nil {
+					return fmt.Errorf("unmarshal field source_filters: %w", err)
+				}
+			}
+		case feed.FieldMinQualityScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_quality_score", values[i])
+			} else if value.Valid {
+				f.MinQualityScore = value.Float64
+			}
+		case feed.FieldSections:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sections", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Sections); err != nil {
+					return fmt.Errorf("unmarshal field sections: %w", err)
+				}
+			}
+		case feed.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				f.Language = value.String
+			}
 		case feed.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -121,6 +190,13 @@ func (f *Feed) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.UpdatedAt = value.Time
 			}
+		case feed.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				f.DeletedAt = new(time.Time)
+				*f.DeletedAt = value.Time
+			}
 		default:
 			f.selectValues.Set(columns[i], values[i])
 		}
@@ -172,14 +248,40 @@ func (f *Feed) String() string {
 	builder.WriteString("public=")
 	builder.WriteString(fmt.Sprintf("%v", f.Public))
 	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", f.Paused))
+	builder.WriteString(", ")
 	builder.WriteString("source_uids=")
 	builder.WriteString(fmt.Sprintf("%v", f.SourceUids))
 	builder.WriteString(", ")
+	builder.WriteString("source_weights=")
+	builder.WriteString(fmt.Sprintf("%v", f.SourceWeights))
+	builder.WriteString(", ")
+	builder.WriteString("source_overrides=")
+	builder.WriteString(fmt.Sprintf("%v", f.SourceOverrides))
+	builder.WriteString(", ")
+	builder.WriteString("source_filters=")
+	builder.WriteString(fmt.Sprintf("%v", f.SourceFilters))
+	builder.WriteString(", ")
+	builder.WriteString("min_quality_score=")
+	builder.WriteString(fmt.Sprintf("%v", f.MinQualityScore))
+	builder.WriteString(", ")
+	builder.WriteString("sections=")
+	builder.WriteString(fmt.Sprintf("%v", f.Sections))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(f.Language)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(f.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := f.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
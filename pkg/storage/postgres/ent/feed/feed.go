@@ -21,12 +21,28 @@ const (
 	FieldQuery = "query"
 	// FieldPublic holds the string denoting the public field in the database.
 	FieldPublic = "public"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
 	// FieldSourceUids holds the string denoting the source_uids field in the database.
 	FieldSourceUids = "source_uids"
+	// FieldSourceWeights holds the string denoting the source_weights field in the database.
+	FieldSourceWeights = "source_weights"
+	// FieldSourceOverrides holds the string denoting the source_overrides field in the database.
+	FieldSourceOverrides = "source_overrides"
+	// FieldSourceFilters holds the string denoting the source_filters field in the database.
+	FieldSourceFilters = "source_filters"
+	// FieldMinQualityScore holds the string denoting the min_quality_score field in the database.
+	FieldMinQualityScore = "min_quality_score"
+	// FieldSections holds the string denoting the sections field in the database.
+	FieldSections = "sections"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// Table holds the table name of the feed in the database.
 	Table = "feeds"
 )
@@ -39,9 +55,17 @@ var Columns = []string{
 	FieldIcon,
 	FieldQuery,
 	FieldPublic,
+	FieldPaused,
 	FieldSourceUids,
+	FieldSourceWeights,
+	FieldSourceOverrides,
+	FieldSourceFilters,
+	FieldMinQualityScore,
+	FieldSections,
+	FieldLanguage,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -54,6 +78,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
+	// DefaultMinQualityScore holds the default value on creation for the "min_quality_score" field.
+	DefaultMinQualityScore float64
+	// DefaultLanguage holds the default value on creation for the "language" field.
+	DefaultLanguage string
+)
+
 // OrderOption defines the ordering options for the Feed queries.
 type OrderOption func(*sql.Selector)
 
@@ -87,6 +120,21 @@ func ByPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublic, opts...).ToFunc()
 }
 
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
+// ByMinQualityScore orders the results by the min_quality_score field.
+func ByMinQualityScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinQualityScore, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -96,3 +144,8 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
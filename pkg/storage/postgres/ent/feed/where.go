@@ -89,6 +89,21 @@ func Public(v bool) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldPublic, v))
 }
 
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldPaused, v))
+}
+
+// MinQualityScore applies equality check predicate on the "min_quality_score" field. It's identical to MinQualityScoreEQ.
+func MinQualityScore(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldMinQualityScore, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLanguage, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldCreatedAt, v))
@@ -99,6 +114,11 @@ func UpdatedAt(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v string) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldUserID, v))
@@ -369,6 +389,161 @@ func PublicNEQ(v bool) predicate.Feed {
 	return predicate.Feed(sql.FieldNEQ(FieldPublic, v))
 }
 
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldPaused, v))
+}
+
+// SourceWeightsIsNil applies the IsNil predicate on the "source_weights" field.
+func SourceWeightsIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldSourceWeights))
+}
+
+// SourceWeightsNotNil applies the NotNil predicate on the "source_weights" field.
+func SourceWeightsNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldSourceWeights))
+}
+
+// SourceOverridesIsNil applies the IsNil predicate on the "source_overrides" field.
+func SourceOverridesIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldSourceOverrides))
+}
+
+// SourceOverridesNotNil applies the NotNil predicate on the "source_overrides" field.
+func SourceOverridesNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldSourceOverrides))
+}
+
+// SourceFiltersIsNil applies the IsNil predicate on the "source_filters" field.
+func SourceFiltersIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldSourceFilters))
+}
+
+// SourceFiltersNotNil applies the NotNil predicate on the "source_filters" field.
+func SourceFiltersNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldSourceFilters))
+}
+
+// MinQualityScoreEQ applies the EQ predicate on the "min_quality_score" field.
+func MinQualityScoreEQ(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldMinQualityScore, v))
+}
+
+// MinQualityScoreNEQ applies the NEQ predicate on the "min_quality_score" field.
+func MinQualityScoreNEQ(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldMinQualityScore, v))
+}
+
+// MinQualityScoreIn applies the In predicate on the "min_quality_score" field.
+func MinQualityScoreIn(vs ...float64) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldMinQualityScore, vs...))
+}
+
+// MinQualityScoreNotIn applies the NotIn predicate on the "min_quality_score" field.
+func MinQualityScoreNotIn(vs ...float64) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldMinQualityScore, vs...))
+}
+
+// MinQualityScoreGT applies the GT predicate on the "min_quality_score" field.
+func MinQualityScoreGT(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldMinQualityScore, v))
+}
+
+// MinQualityScoreGTE applies the GTE predicate on the "min_quality_score" field.
+func MinQualityScoreGTE(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldMinQualityScore, v))
+}
+
+// MinQualityScoreLT applies the LT predicate on the "min_quality_score" field.
+func MinQualityScoreLT(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldMinQualityScore, v))
+}
+
+// MinQualityScoreLTE applies the LTE predicate on the "min_quality_score" field.
+func MinQualityScoreLTE(v float64) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldMinQualityScore, v))
+}
+
+// SectionsIsNil applies the IsNil predicate on the "sections" field.
+func SectionsIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldSections))
+}
+
+// SectionsNotNil applies the NotNil predicate on the "sections" field.
+func SectionsNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldSections))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldLanguage, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldCreatedAt, v))
@@ -449,6 +624,56 @@ func UpdatedAtLTE(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Feed) predicate.Feed {
 	return predicate.Feed(sql.AndPredicates(predicates...))
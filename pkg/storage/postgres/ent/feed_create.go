@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/schema"
 )
 
 // FeedCreate is the builder for creating a Feed entity.
@@ -53,12 +54,78 @@ func (fc *FeedCreate) SetPublic(b bool) *FeedCreate {
 	return fc
 }
 
+// SetPaused sets the "paused" field.
+func (fc *FeedCreate) SetPaused(b bool) *FeedCreate {
+	fc.mutation.SetPaused(b)
+	return fc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (fc *FeedCreate) SetNillablePaused(b *bool) *FeedCreate {
+	if b != nil {
+		fc.SetPaused(*b)
+	}
+	return fc
+}
+
 // SetSourceUids sets the "source_uids" field.
 func (fc *FeedCreate) SetSourceUids(s []string) *FeedCreate {
 	fc.mutation.SetSourceUids(s)
 	return fc
 }
 
+// SetSourceWeights sets the "source_weights" field.
+func (fc *FeedCreate) SetSourceWeights(m map[string]float64) *FeedCreate {
+	fc.mutation.SetSourceWeights(m)
+	return fc
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (fc *FeedCreate) SetSourceOverrides(mo map[string]schema.SourceOverride) *FeedCreate {
+	fc.mutation.SetSourceOverrides(mo)
+	return fc
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (fc *FeedCreate) SetSourceFilters(mf map[string]schema.SourceFilter) *FeedCreate {
+	fc.mutation.SetSourceFilters(mf)
+	return fc
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (fc *FeedCreate) SetMinQualityScore(f float64) *FeedCreate {
+	fc.mutation.SetMinQualityScore(f)
+	return fc
+}
+
+// SetNillableMinQualityScore sets the "min_quality_score" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableMinQualityScore(f *float64) *FeedCreate {
+	if f != nil {
+		fc.SetMinQualityScore(*f)
+	}
+	return fc
+}
+
+// SetSections sets the "sections" field.
+func (fc *FeedCreate) SetSections(ss []schema.FeedSection) *FeedCreate {
+	fc.mutation.SetSections(ss)
+	return fc
+}
+
+// SetLanguage sets the "language" field.
+func (fc *FeedCreate) SetLanguage(s string) *FeedCreate {
+	fc.mutation.SetLanguage(s)
+	return fc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLanguage(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetLanguage(*s)
+	}
+	return fc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fc *FeedCreate) SetCreatedAt(t time.Time) *FeedCreate {
 	fc.mutation.SetCreatedAt(t)
@@ -71,6 +138,20 @@ func (fc *FeedCreate) SetUpdatedAt(t time.Time) *FeedCreate {
 	return fc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (fc *FeedCreate) SetDeletedAt(t time.Time) *FeedCreate {
+	fc.mutation.SetDeletedAt(t)
+	return fc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableDeletedAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetDeletedAt(*t)
+	}
+	return fc
+}
+
 // SetID sets the "id" field.
 func (fc *FeedCreate) SetID(s string) *FeedCreate {
 	fc.mutation.SetID(s)
@@ -84,6 +165,7 @@ func (fc *FeedCreate) Mutation() *FeedMutation {
 
 // Save creates the Feed in the database.
 func (fc *FeedCreate) Save(ctx context.Context) (*Feed, error) {
+	fc.defaults()
 	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
 }
 
@@ -109,6 +191,22 @@ func (fc *FeedCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (fc *FeedCreate) defaults() {
+	if _, ok := fc.mutation.Paused(); !ok {
+		v := feed.DefaultPaused
+		fc.mutation.SetPaused(v)
+	}
+	if _, ok := fc.mutation.MinQualityScore(); !ok {
+		v := feed.DefaultMinQualityScore
+		fc.mutation.SetMinQualityScore(v)
+	}
+	if _, ok := fc.mutation.Language(); !ok {
+		v := feed.DefaultLanguage
+		fc.mutation.SetLanguage(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (fc *FeedCreate) check() error {
 	if _, ok := fc.mutation.UserID(); !ok {
@@ -126,9 +224,18 @@ func (fc *FeedCreate) check() error {
 	if _, ok := fc.mutation.Public(); !ok {
 		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "Feed.public"`)}
 	}
+	if _, ok := fc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "Feed.paused"`)}
+	}
 	if _, ok := fc.mutation.SourceUids(); !ok {
 		return &ValidationError{Name: "source_uids", err: errors.New(`ent: missing required field "Feed.source_uids"`)}
 	}
+	if _, ok := fc.mutation.MinQualityScore(); !ok {
+		return &ValidationError{Name: "min_quality_score", err: errors.New(`ent: missing required field "Feed.min_quality_score"`)}
+	}
+	if _, ok := fc.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "Feed.language"`)}
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Feed.created_at"`)}
 	}
@@ -191,10 +298,38 @@ func (fc *FeedCreate) createSpec() (*Feed, *sqlgraph.CreateSpec) {
 		_spec.SetField(feed.FieldPublic, field.TypeBool, value)
 		_node.Public = value
 	}
+	if value, ok := fc.mutation.Paused(); ok {
+		_spec.SetField(feed.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
 	if value, ok := fc.mutation.SourceUids(); ok {
 		_spec.SetField(feed.FieldSourceUids, field.TypeJSON, value)
 		_node.SourceUids = value
 	}
+	if value, ok := fc.mutation.SourceWeights(); ok {
+		_spec.SetField(feed.FieldSourceWeights, field.TypeJSON, value)
+		_node.SourceWeights = value
+	}
+	if value, ok := fc.mutation.SourceOverrides(); ok {
+		_spec.SetField(feed.FieldSourceOverrides, field.TypeJSON, value)
+		_node.SourceOverrides = value
+	}
+	if value, ok := fc.mutation.SourceFilters(); ok {
+		_spec.SetField(feed.FieldSourceFilters, field.TypeJSON, value)
+		_node.SourceFilters = value
+	}
+	if value, ok := fc.mutation.MinQualityScore(); ok {
+		_spec.SetField(feed.FieldMinQualityScore, field.TypeFloat64, value)
+		_node.MinQualityScore = value
+	}
+	if value, ok := fc.mutation.Sections(); ok {
+		_spec.SetField(feed.FieldSections, field.TypeJSON, value)
+		_node.Sections = value
+	}
+	if value, ok := fc.mutation.Language(); ok {
+		_spec.SetField(feed.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
 	if value, ok := fc.mutation.CreatedAt(); ok {
 		_spec.SetField(feed.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -203,6 +338,10 @@ func (fc *FeedCreate) createSpec() (*Feed, *sqlgraph.CreateSpec) {
 		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := fc.mutation.DeletedAt(); ok {
+		_spec.SetField(feed.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -315,6 +454,18 @@ func (u *FeedUpsert) UpdatePublic() *FeedUpsert {
 	return u
 }
 
+// SetPaused sets the "paused" field.
+func (u *FeedUpsert) SetPaused(v bool) *FeedUpsert {
+	u.Set(feed.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *FeedUpsert) UpdatePaused() *FeedUpsert {
+	u.SetExcluded(feed.FieldPaused)
+	return u
+}
+
 // SetSourceUids sets the "source_uids" field.
 func (u *FeedUpsert) SetSourceUids(v []string) *FeedUpsert {
 	u.Set(feed.FieldSourceUids, v)
@@ -327,6 +478,108 @@ func (u *FeedUpsert) UpdateSourceUids() *FeedUpsert {
 	return u
 }
 
+// SetSourceWeights sets the "source_weights" field.
+func (u *FeedUpsert) SetSourceWeights(v map[string]float64) *FeedUpsert {
+	u.Set(feed.FieldSourceWeights, v)
+	return u
+}
+
+// UpdateSourceWeights sets the "source_weights" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateSourceWeights() *FeedUpsert {
+	u.SetExcluded(feed.FieldSourceWeights)
+	return u
+}
+
+// ClearSourceWeights clears the value of the "source_weights" field.
+func (u *FeedUpsert) ClearSourceWeights() *FeedUpsert {
+	u.SetNull(feed.FieldSourceWeights)
+	return u
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (u *FeedUpsert) SetSourceOverrides(v map[string]schema.SourceOverride) *FeedUpsert {
+	u.Set(feed.FieldSourceOverrides, v)
+	return u
+}
+
+// UpdateSourceOverrides sets the "source_overrides" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateSourceOverrides() *FeedUpsert {
+	u.SetExcluded(feed.FieldSourceOverrides)
+	return u
+}
+
+// ClearSourceOverrides clears the value of the "source_overrides" field.
+func (u *FeedUpsert) ClearSourceOverrides() *FeedUpsert {
+	u.SetNull(feed.FieldSourceOverrides)
+	return u
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (u *FeedUpsert) SetSourceFilters(v map[string]schema.SourceFilter) *FeedUpsert {
+	u.Set(feed.FieldSourceFilters, v)
+	return u
+}
+
+// UpdateSourceFilters sets the "source_filters" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateSourceFilters() *FeedUpsert {
+	u.SetExcluded(feed.FieldSourceFilters)
+	return u
+}
+
+// ClearSourceFilters clears the value of the "source_filters" field.
+func (u *FeedUpsert) ClearSourceFilters() *FeedUpsert {
+	u.SetNull(feed.FieldSourceFilters)
+	return u
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (u *FeedUpsert) SetMinQualityScore(v float64) *FeedUpsert {
+	u.Set(feed.FieldMinQualityScore, v)
+	return u
+}
+
+// UpdateMinQualityScore sets the "min_quality_score" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateMinQualityScore() *FeedUpsert {
+	u.SetExcluded(feed.FieldMinQualityScore)
+	return u
+}
+
+// AddMinQualityScore adds v to the "min_quality_score" field.
+func (u *FeedUpsert) AddMinQualityScore(v float64) *FeedUpsert {
+	u.Add(feed.FieldMinQualityScore, v)
+	return u
+}
+
+// SetSections sets the "sections" field.
+func (u *FeedUpsert) SetSections(v []schema.FeedSection) *FeedUpsert {
+	u.Set(feed.FieldSections, v)
+	return u
+}
+
+// UpdateSections sets the "sections" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateSections() *FeedUpsert {
+	u.SetExcluded(feed.FieldSections)
+	return u
+}
+
+// ClearSections clears the value of the "sections" field.
+func (u *FeedUpsert) ClearSections() *FeedUpsert {
+	u.SetNull(feed.FieldSections)
+	return u
+}
+
+// SetLanguage sets the "language" field.
+func (u *FeedUpsert) SetLanguage(v string) *FeedUpsert {
+	u.Set(feed.FieldLanguage, v)
+	return u
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateLanguage() *FeedUpsert {
+	u.SetExcluded(feed.FieldLanguage)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FeedUpsert) SetCreatedAt(v time.Time) *FeedUpsert {
 	u.Set(feed.FieldCreatedAt, v)
@@ -351,6 +604,24 @@ func (u *FeedUpsert) UpdateUpdatedAt() *FeedUpsert {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FeedUpsert) SetDeletedAt(v time.Time) *FeedUpsert {
+	u.Set(feed.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FeedUpsert) UpdateDeletedAt() *FeedUpsert {
+	u.SetExcluded(feed.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FeedUpsert) ClearDeletedAt() *FeedUpsert {
+	u.SetNull(feed.FieldDeletedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -469,6 +740,20 @@ func (u *FeedUpsertOne) UpdatePublic() *FeedUpsertOne {
 	})
 }
 
+// SetPaused sets the "paused" field.
+func (u *FeedUpsertOne) SetPaused(v bool) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdatePaused() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdatePaused()
+	})
+}
+
 // SetSourceUids sets the "source_uids" field.
 func (u *FeedUpsertOne) SetSourceUids(v []string) *FeedUpsertOne {
 	return u.Update(func(s *FeedUpsert) {
@@ -483,6 +768,125 @@ func (u *FeedUpsertOne) UpdateSourceUids() *FeedUpsertOne {
 	})
 }
 
+// SetSourceWeights sets the "source_weights" field.
+func (u *FeedUpsertOne) SetSourceWeights(v map[string]float64) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSourceWeights(v)
+	})
+}
+
+// UpdateSourceWeights sets the "source_weights" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateSourceWeights() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSourceWeights()
+	})
+}
+
+// ClearSourceWeights clears the value of the "source_weights" field.
+func (u *FeedUpsertOne) ClearSourceWeights() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSourceWeights()
+	})
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (u *FeedUpsertOne) SetSourceOverrides(v map[string]schema.SourceOverride) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSourceOverrides(v)
+	})
+}
+
+// UpdateSourceOverrides sets the "source_overrides" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateSourceOverrides() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSourceOverrides()
+	})
+}
+
+// ClearSourceOverrides clears the value of the "source_overrides" field.
+func (u *FeedUpsertOne) ClearSourceOverrides() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSourceOverrides()
+	})
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (u *FeedUpsertOne) SetSourceFilters(v map[string]schema.SourceFilter) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSourceFilters(v)
+	})
+}
+
+// UpdateSourceFilters sets the "source_filters" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateSourceFilters() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSourceFilters()
+	})
+}
+
+// ClearSourceFilters clears the value of the "source_filters" field.
+func (u *FeedUpsertOne) ClearSourceFilters() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSourceFilters()
+	})
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (u *FeedUpsertOne) SetMinQualityScore(v float64) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetMinQualityScore(v)
+	})
+}
+
+// AddMinQualityScore adds v to the "min_quality_score" field.
+func (u *FeedUpsertOne) AddMinQualityScore(v float64) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.AddMinQualityScore(v)
+	})
+}
+
+// UpdateMinQualityScore sets the "min_quality_score" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateMinQualityScore() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateMinQualityScore()
+	})
+}
+
+// SetSections sets the "sections" field.
+func (u *FeedUpsertOne) SetSections(v []schema.FeedSection) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSections(v)
+	})
+}
+
+// UpdateSections sets the "sections" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateSections() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSections()
+	})
+}
+
+// ClearSections clears the value of the "sections" field.
+func (u *FeedUpsertOne) ClearSections() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSections()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *FeedUpsertOne) SetLanguage(v string) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateLanguage() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FeedUpsertOne) SetCreatedAt(v time.Time) *FeedUpsertOne {
 	return u.Update(func(s *FeedUpsert) {
@@ -511,6 +915,27 @@ func (u *FeedUpsertOne) UpdateUpdatedAt() *FeedUpsertOne {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FeedUpsertOne) SetDeletedAt(v time.Time) *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FeedUpsertOne) UpdateDeletedAt() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FeedUpsertOne) ClearDeletedAt() *FeedUpsertOne {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *FeedUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -568,6 +993,7 @@ func (fcb *FeedCreateBulk) Save(ctx context.Context) ([]*Feed, error) {
 	for i := range fcb.builders {
 		func(i int, root context.Context) {
 			builder := fcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*FeedMutation)
 				if !ok {
@@ -795,6 +1221,20 @@ func (u *FeedUpsertBulk) UpdatePublic() *FeedUpsertBulk {
 	})
 }
 
+// SetPaused sets the "paused" field.
+func (u *FeedUpsertBulk) SetPaused(v bool) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdatePaused() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdatePaused()
+	})
+}
+
 // SetSourceUids sets the "source_uids" field.
 func (u *FeedUpsertBulk) SetSourceUids(v []string) *FeedUpsertBulk {
 	return u.Update(func(s *FeedUpsert) {
@@ -809,6 +1249,125 @@ func (u *FeedUpsertBulk) UpdateSourceUids() *FeedUpsertBulk {
 	})
 }
 
+// SetSourceWeights sets the "source_weights" field.
+func (u *FeedUpsertBulk) SetSourceWeights(v map[string]float64) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSourceWeights(v)
+	})
+}
+
+// UpdateSourceWeights sets the "source_weights" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateSourceWeights() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSourceWeights()
+	})
+}
+
+// ClearSourceWeights clears the value of the "source_weights" field.
+func (u *FeedUpsertBulk) ClearSourceWeights() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSourceWeights()
+	})
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (u *FeedUpsertBulk) SetSourceOverrides(v map[string]schema.SourceOverride) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSourceOverrides(v)
+	})
+}
+
+// UpdateSourceOverrides sets the "source_overrides" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateSourceOverrides() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSourceOverrides()
+	})
+}
+
+// ClearSourceOverrides clears the value of the "source_overrides" field.
+func (u *FeedUpsertBulk) ClearSourceOverrides() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSourceOverrides()
+	})
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (u *FeedUpsertBulk) SetSourceFilters(v map[string]schema.SourceFilter) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSourceFilters(v)
+	})
+}
+
+// UpdateSourceFilters sets the "source_filters" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateSourceFilters() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSourceFilters()
+	})
+}
+
+// ClearSourceFilters clears the value of the "source_filters" field.
+func (u *FeedUpsertBulk) ClearSourceFilters() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSourceFilters()
+	})
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (u *FeedUpsertBulk) SetMinQualityScore(v float64) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetMinQualityScore(v)
+	})
+}
+
+// AddMinQualityScore adds v to the "min_quality_score" field.
+func (u *FeedUpsertBulk) AddMinQualityScore(v float64) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.AddMinQualityScore(v)
+	})
+}
+
+// UpdateMinQualityScore sets the "min_quality_score" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateMinQualityScore() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateMinQualityScore()
+	})
+}
+
+// SetSections sets the "sections" field.
+func (u *FeedUpsertBulk) SetSections(v []schema.FeedSection) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetSections(v)
+	})
+}
+
+// UpdateSections sets the "sections" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateSections() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateSections()
+	})
+}
+
+// ClearSections clears the value of the "sections" field.
+func (u *FeedUpsertBulk) ClearSections() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearSections()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *FeedUpsertBulk) SetLanguage(v string) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateLanguage() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FeedUpsertBulk) SetCreatedAt(v time.Time) *FeedUpsertBulk {
 	return u.Update(func(s *FeedUpsert) {
@@ -837,6 +1396,27 @@ func (u *FeedUpsertBulk) UpdateUpdatedAt() *FeedUpsertBulk {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FeedUpsertBulk) SetDeletedAt(v time.Time) *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FeedUpsertBulk) UpdateDeletedAt() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FeedUpsertBulk) ClearDeletedAt() *FeedUpsertBulk {
+	return u.Update(func(s *FeedUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *FeedUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
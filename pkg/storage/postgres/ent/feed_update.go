@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/schema"
 )
 
 // FeedUpdate is the builder for updating Feed entities.
@@ -99,6 +100,20 @@ func (fu *FeedUpdate) SetNillablePublic(b *bool) *FeedUpdate {
 	return fu
 }
 
+// SetPaused sets the "paused" field.
+func (fu *FeedUpdate) SetPaused(b bool) *FeedUpdate {
+	fu.mutation.SetPaused(b)
+	return fu
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillablePaused(b *bool) *FeedUpdate {
+	if b != nil {
+		fu.SetPaused(*b)
+	}
+	return fu
+}
+
 // SetSourceUids sets the "source_uids" field.
 func (fu *FeedUpdate) SetSourceUids(s []string) *FeedUpdate {
 	fu.mutation.SetSourceUids(s)
@@ -111,6 +126,95 @@ func (fu *FeedUpdate) AppendSourceUids(s []string) *FeedUpdate {
 	return fu
 }
 
+// SetSourceWeights sets the "source_weights" field.
+func (fu *FeedUpdate) SetSourceWeights(m map[string]float64) *FeedUpdate {
+	fu.mutation.SetSourceWeights(m)
+	return fu
+}
+
+// ClearSourceWeights clears the value of the "source_weights" field.
+func (fu *FeedUpdate) ClearSourceWeights() *FeedUpdate {
+	fu.mutation.ClearSourceWeights()
+	return fu
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (fu *FeedUpdate) SetSourceOverrides(mo map[string]schema.SourceOverride) *FeedUpdate {
+	fu.mutation.SetSourceOverrides(mo)
+	return fu
+}
+
+// ClearSourceOverrides clears the value of the "source_overrides" field.
+func (fu *FeedUpdate) ClearSourceOverrides() *FeedUpdate {
+	fu.mutation.ClearSourceOverrides()
+	return fu
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (fu *FeedUpdate) SetSourceFilters(mf map[string]schema.SourceFilter) *FeedUpdate {
+	fu.mutation.SetSourceFilters(mf)
+	return fu
+}
+
+// ClearSourceFilters clears the value of the "source_filters" field.
+func (fu *FeedUpdate) ClearSourceFilters() *FeedUpdate {
+	fu.mutation.ClearSourceFilters()
+	return fu
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (fu *FeedUpdate) SetMinQualityScore(f float64) *FeedUpdate {
+	fu.mutation.ResetMinQualityScore()
+	fu.mutation.SetMinQualityScore(f)
+	return fu
+}
+
+// SetNillableMinQualityScore sets the "min_quality_score" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableMinQualityScore(f *float64) *FeedUpdate {
+	if f != nil {
+		fu.SetMinQualityScore(*f)
+	}
+	return fu
+}
+
+// AddMinQualityScore adds f to the "min_quality_score" field.
+func (fu *FeedUpdate) AddMinQualityScore(f float64) *FeedUpdate {
+	fu.mutation.AddMinQualityScore(f)
+	return fu
+}
+
+// SetSections sets the "sections" field.
+func (fu *FeedUpdate) SetSections(ss []schema.FeedSection) *FeedUpdate {
+	fu.mutation.SetSections(ss)
+	return fu
+}
+
+// AppendSections appends ss to the "sections" field.
+func (fu *FeedUpdate) AppendSections(ss []schema.FeedSection) *FeedUpdate {
+	fu.mutation.AppendSections(ss)
+	return fu
+}
+
+// ClearSections clears the value of the "sections" field.
+func (fu *FeedUpdate) ClearSections() *FeedUpdate {
+	fu.mutation.ClearSections()
+	return fu
+}
+
+// SetLanguage sets the "language" field.
+func (fu *FeedUpdate) SetLanguage(s string) *FeedUpdate {
+	fu.mutation.SetLanguage(s)
+	return fu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLanguage(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetLanguage(*s)
+	}
+	return fu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fu *FeedUpdate) SetCreatedAt(t time.Time) *FeedUpdate {
 	fu.mutation.SetCreatedAt(t)
@@ -139,6 +243,26 @@ func (fu *FeedUpdate) SetNillableUpdatedAt(t *time.Time) *FeedUpdate {
 	return fu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (fu *FeedUpdate) SetDeletedAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetDeletedAt(t)
+	return fu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableDeletedAt(t *time.Time) *FeedUpdate {
+	if t != nil {
+		fu.SetDeletedAt(*t)
+	}
+	return fu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fu *FeedUpdate) ClearDeletedAt() *FeedUpdate {
+	fu.mutation.ClearDeletedAt()
+	return fu
+}
+
 // Mutation returns the FeedMutation object of the builder.
 func (fu *FeedUpdate) Mutation() *FeedMutation {
 	return fu.mutation
@@ -195,6 +319,9 @@ func (fu *FeedUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.Public(); ok {
 		_spec.SetField(feed.FieldPublic, field.TypeBool, value)
 	}
+	if value, ok := fu.mutation.Paused(); ok {
+		_spec.SetField(feed.FieldPaused, field.TypeBool, value)
+	}
 	if value, ok := fu.mutation.SourceUids(); ok {
 		_spec.SetField(feed.FieldSourceUids, field.TypeJSON, value)
 	}
@@ -203,12 +330,56 @@ func (fu *FeedUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, feed.FieldSourceUids, value)
 		})
 	}
+	if value, ok := fu.mutation.SourceWeights(); ok {
+		_spec.SetField(feed.FieldSourceWeights, field.TypeJSON, value)
+	}
+	if fu.mutation.SourceWeightsCleared() {
+		_spec.ClearField(feed.FieldSourceWeights, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.SourceOverrides(); ok {
+		_spec.SetField(feed.FieldSourceOverrides, field.TypeJSON, value)
+	}
+	if fu.mutation.SourceOverridesCleared() {
+		_spec.ClearField(feed.FieldSourceOverrides, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.SourceFilters(); ok {
+		_spec.SetField(feed.FieldSourceFilters, field.TypeJSON, value)
+	}
+	if fu.mutation.SourceFiltersCleared() {
+		_spec.ClearField(feed.FieldSourceFilters, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.MinQualityScore(); ok {
+		_spec.SetField(feed.FieldMinQualityScore, field.TypeFloat64, value)
+	}
+	if value, ok := fu.mutation.AddedMinQualityScore(); ok {
+		_spec.AddField(feed.FieldMinQualityScore, field.TypeFloat64, value)
+	}
+	if value, ok := fu.mutation.Sections(); ok {
+		_spec.SetField(feed.FieldSections, field.TypeJSON, value)
+	}
+	if value, ok := fu.mutation.AppendedSections(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feed.FieldSections, value)
+		})
+	}
+	if fu.mutation.SectionsCleared() {
+		_spec.ClearField(feed.FieldSections, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.Language(); ok {
+		_spec.SetField(feed.FieldLanguage, field.TypeString, value)
+	}
 	if value, ok := fu.mutation.CreatedAt(); ok {
 		_spec.SetField(feed.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := fu.mutation.UpdatedAt(); ok {
 		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := fu.mutation.DeletedAt(); ok {
+		_spec.SetField(feed.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fu.mutation.DeletedAtCleared() {
+		_spec.ClearField(feed.FieldDeletedAt, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{feed.Label}
@@ -299,6 +470,20 @@ func (fuo *FeedUpdateOne) SetNillablePublic(b *bool) *FeedUpdateOne {
 	return fuo
 }
 
+// SetPaused sets the "paused" field.
+func (fuo *FeedUpdateOne) SetPaused(b bool) *FeedUpdateOne {
+	fuo.mutation.SetPaused(b)
+	return fuo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillablePaused(b *bool) *FeedUpdateOne {
+	if b != nil {
+		fuo.SetPaused(*b)
+	}
+	return fuo
+}
+
 // SetSourceUids sets the "source_uids" field.
 func (fuo *FeedUpdateOne) SetSourceUids(s []string) *FeedUpdateOne {
 	fuo.mutation.SetSourceUids(s)
@@ -311,6 +496,95 @@ func (fuo *FeedUpdateOne) AppendSourceUids(s []string) *FeedUpdateOne {
 	return fuo
 }
 
+// SetSourceWeights sets the "source_weights" field.
+func (fuo *FeedUpdateOne) SetSourceWeights(m map[string]float64) *FeedUpdateOne {
+	fuo.mutation.SetSourceWeights(m)
+	return fuo
+}
+
+// ClearSourceWeights clears the value of the "source_weights" field.
+func (fuo *FeedUpdateOne) ClearSourceWeights() *FeedUpdateOne {
+	fuo.mutation.ClearSourceWeights()
+	return fuo
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (fuo *FeedUpdateOne) SetSourceOverrides(mo map[string]schema.SourceOverride) *FeedUpdateOne {
+	fuo.mutation.SetSourceOverrides(mo)
+	return fuo
+}
+
+// ClearSourceOverrides clears the value of the "source_overrides" field.
+func (fuo *FeedUpdateOne) ClearSourceOverrides() *FeedUpdateOne {
+	fuo.mutation.ClearSourceOverrides()
+	return fuo
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (fuo *FeedUpdateOne) SetSourceFilters(mf map[string]schema.SourceFilter) *FeedUpdateOne {
+	fuo.mutation.SetSourceFilters(mf)
+	return fuo
+}
+
+// ClearSourceFilters clears the value of the "source_filters" field.
+func (fuo *FeedUpdateOne) ClearSourceFilters() *FeedUpdateOne {
+	fuo.mutation.ClearSourceFilters()
+	return fuo
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (fuo *FeedUpdateOne) SetMinQualityScore(f float64) *FeedUpdateOne {
+	fuo.mutation.ResetMinQualityScore()
+	fuo.mutation.SetMinQualityScore(f)
+	return fuo
+}
+
+// SetNillableMinQualityScore sets the "min_quality_score" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableMinQualityScore(f *float64) *FeedUpdateOne {
+	if f != nil {
+		fuo.SetMinQualityScore(*f)
+	}
+	return fuo
+}
+
+// AddMinQualityScore adds f to the "min_quality_score" field.
+func (fuo *FeedUpdateOne) AddMinQualityScore(f float64) *FeedUpdateOne {
+	fuo.mutation.AddMinQualityScore(f)
+	return fuo
+}
+
+// SetSections sets the "sections" field.
+func (fuo *FeedUpdateOne) SetSections(ss []schema.FeedSection) *FeedUpdateOne {
+	fuo.mutation.SetSections(ss)
+	return fuo
+}
+
+// AppendSections appends ss to the "sections" field.
+func (fuo *FeedUpdateOne) AppendSections(ss []schema.FeedSection) *FeedUpdateOne {
+	fuo.mutation.AppendSections(ss)
+	return fuo
+}
+
+// ClearSections clears the value of the "sections" field.
+func (fuo *FeedUpdateOne) ClearSections() *FeedUpdateOne {
+	fuo.mutation.ClearSections()
+	return fuo
+}
+
+// SetLanguage sets the "language" field.
+func (fuo *FeedUpdateOne) SetLanguage(s string) *FeedUpdateOne {
+	fuo.mutation.SetLanguage(s)
+	return fuo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLanguage(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetLanguage(*s)
+	}
+	return fuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fuo *FeedUpdateOne) SetCreatedAt(t time.Time) *FeedUpdateOne {
 	fuo.mutation.SetCreatedAt(t)
@@ -339,6 +613,26 @@ func (fuo *FeedUpdateOne) SetNillableUpdatedAt(t *time.Time) *FeedUpdateOne {
 	return fuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (fuo *FeedUpdateOne) SetDeletedAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetDeletedAt(t)
+	return fuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableDeletedAt(t *time.Time) *FeedUpdateOne {
+	if t != nil {
+		fuo.SetDeletedAt(*t)
+	}
+	return fuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fuo *FeedUpdateOne) ClearDeletedAt() *FeedUpdateOne {
+	fuo.mutation.ClearDeletedAt()
+	return fuo
+}
+
 // Mutation returns the FeedMutation object of the builder.
 func (fuo *FeedUpdateOne) Mutation() *FeedMutation {
 	return fuo.mutation
@@ -425,6 +719,9 @@ func (fuo *FeedUpdateOne) sqlSave(ctx context.Context) (_node *Feed, err error)
 	if value, ok := fuo.mutation.Public(); ok {
 		_spec.SetField(feed.FieldPublic, field.TypeBool, value)
 	}
+	if value, ok := fuo.mutation.Paused(); ok {
+		_spec.SetField(feed.FieldPaused, field.TypeBool, value)
+	}
 	if value, ok := fuo.mutation.SourceUids(); ok {
 		_spec.SetField(feed.FieldSourceUids, field.TypeJSON, value)
 	}
@@ -433,12 +730,56 @@ func (fuo *FeedUpdateOne) sqlSave(ctx context.Context) (_node *Feed, err error)
 			sqljson.Append(u, feed.FieldSourceUids, value)
 		})
 	}
+	if value, ok := fuo.mutation.SourceWeights(); ok {
+		_spec.SetField(feed.FieldSourceWeights, field.TypeJSON, value)
+	}
+	if fuo.mutation.SourceWeightsCleared() {
+		_spec.ClearField(feed.FieldSourceWeights, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.SourceOverrides(); ok {
+		_spec.SetField(feed.FieldSourceOverrides, field.TypeJSON, value)
+	}
+	if fuo.mutation.SourceOverridesCleared() {
+		_spec.ClearField(feed.FieldSourceOverrides, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.SourceFilters(); ok {
+		_spec.SetField(feed.FieldSourceFilters, field.TypeJSON, value)
+	}
+	if fuo.mutation.SourceFiltersCleared() {
+		_spec.ClearField(feed.FieldSourceFilters, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.MinQualityScore(); ok {
+		_spec.SetField(feed.FieldMinQualityScore, field.TypeFloat64, value)
+	}
+	if value, ok := fuo.mutation.AddedMinQualityScore(); ok {
+		_spec.AddField(feed.FieldMinQualityScore, field.TypeFloat64, value)
+	}
+	if value, ok := fuo.mutation.Sections(); ok {
+		_spec.SetField(feed.FieldSections, field.TypeJSON, value)
+	}
+	if value, ok := fuo.mutation.AppendedSections(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feed.FieldSections, value)
+		})
+	}
+	if fuo.mutation.SectionsCleared() {
+		_spec.ClearField(feed.FieldSections, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.Language(); ok {
+		_spec.SetField(feed.FieldLanguage, field.TypeString, value)
+	}
 	if value, ok := fuo.mutation.CreatedAt(); ok {
 		_spec.SetField(feed.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := fuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := fuo.mutation.DeletedAt(); ok {
+		_spec.SetField(feed.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(feed.FieldDeletedAt, field.TypeTime)
+	}
 	_node = &Feed{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feedposition
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldUserID, v))
+}
+
+// FeedID applies equality check predicate on the "feed_id" field. It's identical to FeedIDEQ.
+func FeedID(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldFeedID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldPosition, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// FeedIDEQ applies the EQ predicate on the "feed_id" field.
+func FeedIDEQ(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldFeedID, v))
+}
+
+// FeedIDNEQ applies the NEQ predicate on the "feed_id" field.
+func FeedIDNEQ(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNEQ(FieldFeedID, v))
+}
+
+// FeedIDIn applies the In predicate on the "feed_id" field.
+func FeedIDIn(vs ...string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldIn(FieldFeedID, vs...))
+}
+
+// FeedIDNotIn applies the NotIn predicate on the "feed_id" field.
+func FeedIDNotIn(vs ...string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNotIn(FieldFeedID, vs...))
+}
+
+// FeedIDGT applies the GT predicate on the "feed_id" field.
+func FeedIDGT(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGT(FieldFeedID, v))
+}
+
+// FeedIDGTE applies the GTE predicate on the "feed_id" field.
+func FeedIDGTE(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGTE(FieldFeedID, v))
+}
+
+// FeedIDLT applies the LT predicate on the "feed_id" field.
+func FeedIDLT(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLT(FieldFeedID, v))
+}
+
+// FeedIDLTE applies the LTE predicate on the "feed_id" field.
+func FeedIDLTE(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLTE(FieldFeedID, v))
+}
+
+// FeedIDContains applies the Contains predicate on the "feed_id" field.
+func FeedIDContains(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldContains(FieldFeedID, v))
+}
+
+// FeedIDHasPrefix applies the HasPrefix predicate on the "feed_id" field.
+func FeedIDHasPrefix(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldHasPrefix(FieldFeedID, v))
+}
+
+// FeedIDHasSuffix applies the HasSuffix predicate on the "feed_id" field.
+func FeedIDHasSuffix(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldHasSuffix(FieldFeedID, v))
+}
+
+// FeedIDEqualFold applies the EqualFold predicate on the "feed_id" field.
+func FeedIDEqualFold(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEqualFold(FieldFeedID, v))
+}
+
+// FeedIDContainsFold applies the ContainsFold predicate on the "feed_id" field.
+func FeedIDContainsFold(v string) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldContainsFold(FieldFeedID, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.FieldLTE(FieldPosition, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeedPosition) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeedPosition) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeedPosition) predicate.FeedPosition {
+	return predicate.FeedPosition(sql.NotPredicates(p))
+}
@@ -21,6 +21,66 @@ func (f ActivityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActivityMutation", m)
 }
 
+// The ActivityFeedbackFunc type is an adapter to allow the use of ordinary
+// function as ActivityFeedback mutator.
+type ActivityFeedbackFunc func(context.Context, *ent.ActivityFeedbackMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ActivityFeedbackFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ActivityFeedbackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActivityFeedbackMutation", m)
+}
+
+// The ArticleTextFunc type is an adapter to allow the use of ordinary
+// function as ArticleText mutator.
+type ArticleTextFunc func(context.Context, *ent.ArticleTextMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArticleTextFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArticleTextMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleTextMutation", m)
+}
+
+// The CollectionFunc type is an adapter to allow the use of ordinary
+// function as Collection mutator.
+type CollectionFunc func(context.Context, *ent.CollectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionMutation", m)
+}
+
+// The CollectionActivityFunc type is an adapter to allow the use of ordinary
+// function as CollectionActivity mutator.
+type CollectionActivityFunc func(context.Context, *ent.CollectionActivityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollectionActivityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollectionActivityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionActivityMutation", m)
+}
+
+// The FailedActivityFunc type is an adapter to allow the use of ordinary
+// function as FailedActivity mutator.
+type FailedActivityFunc func(context.Context, *ent.FailedActivityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FailedActivityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FailedActivityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FailedActivityMutation", m)
+}
+
 // The FeedFunc type is an adapter to allow the use of ordinary
 // function as Feed mutator.
 type FeedFunc func(context.Context, *ent.FeedMutation) (ent.Value, error)
@@ -33,6 +93,42 @@ func (f FeedFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeedMutation", m)
 }
 
+// The FeedPositionFunc type is an adapter to allow the use of ordinary
+// function as FeedPosition mutator.
+type FeedPositionFunc func(context.Context, *ent.FeedPositionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeedPositionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeedPositionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeedPositionMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+}
+
+// The ReadActivityFunc type is an adapter to allow the use of ordinary
+// function as ReadActivity mutator.
+type ReadActivityFunc func(context.Context, *ent.ReadActivityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReadActivityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReadActivityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReadActivityMutation", m)
+}
+
 // The SourceFunc type is an adapter to allow the use of ordinary
 // function as Source mutator.
 type SourceFunc func(context.Context, *ent.SourceMutation) (ent.Value, error)
@@ -45,6 +141,30 @@ func (f SourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SourceMutation", m)
 }
 
+// The SourceStateFunc type is an adapter to allow the use of ordinary
+// function as SourceState mutator.
+type SourceStateFunc func(context.Context, *ent.SourceStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SourceStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SourceStateMutation", m)
+}
+
+// The UserProvisionFunc type is an adapter to allow the use of ordinary
+// function as UserProvision mutator.
+type UserProvisionFunc func(context.Context, *ent.UserProvisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserProvisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserProvisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserProvisionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -22,9 +22,12 @@ var (
 		{Name: "short_summary", Type: field.TypeString},
 		{Name: "full_summary", Type: field.TypeString},
 		{Name: "raw_json", Type: field.TypeString},
+		{Name: "content_hash", Type: field.TypeString, Default: ""},
+		{Name: "embedding_model", Type: field.TypeString, Default: ""},
 		{Name: "embedding_1536", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1536)"}},
 		{Name: "embedding_3072", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(3072)"}},
 		{Name: "social_score", Type: field.TypeFloat64, Default: -1},
+		{Name: "quality_score", Type: field.TypeFloat64, Default: -1},
 		{Name: "update_count", Type: field.TypeInt, Default: 0},
 	}
 	// ActivitiesTable holds the schema information for the "activities" table.
@@ -33,6 +36,116 @@ var (
 		Columns:    ActivitiesColumns,
 		PrimaryKey: []*schema.Column{ActivitiesColumns[0]},
 	}
+	// ActivityFeedbacksColumns holds the columns for the "activity_feedbacks" table.
+	ActivityFeedbacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "activity_id", Type: field.TypeString},
+		{Name: "value", Type: field.TypeInt},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ActivityFeedbacksTable holds the schema information for the "activity_feedbacks" table.
+	ActivityFeedbacksTable = &schema.Table{
+		Name:       "activity_feedbacks",
+		Columns:    ActivityFeedbacksColumns,
+		PrimaryKey: []*schema.Column{ActivityFeedbacksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activityfeedback_user_id_activity_id",
+				Unique:  true,
+				Columns: []*schema.Column{ActivityFeedbacksColumns[1], ActivityFeedbacksColumns[2]},
+			},
+			{
+				Name:    "activityfeedback_user_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityFeedbacksColumns[1], ActivityFeedbacksColumns[4]},
+			},
+		},
+	}
+	// ArticleTextsColumns holds the columns for the "article_texts" table.
+	ArticleTextsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "text", Type: field.TypeString},
+		{Name: "etag", Type: field.TypeString},
+		{Name: "fetched_at", Type: field.TypeTime},
+	}
+	// ArticleTextsTable holds the schema information for the "article_texts" table.
+	ArticleTextsTable = &schema.Table{
+		Name:       "article_texts",
+		Columns:    ArticleTextsColumns,
+		PrimaryKey: []*schema.Column{ArticleTextsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articletext_fetched_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArticleTextsColumns[3]},
+			},
+		},
+	}
+	// CollectionsColumns holds the columns for the "collections" table.
+	CollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CollectionsTable holds the schema information for the "collections" table.
+	CollectionsTable = &schema.Table{
+		Name:       "collections",
+		Columns:    CollectionsColumns,
+		PrimaryKey: []*schema.Column{CollectionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collection_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionsColumns[1]},
+			},
+		},
+	}
+	// CollectionActivitiesColumns holds the columns for the "collection_activities" table.
+	CollectionActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "collection_id", Type: field.TypeString},
+		{Name: "activity_id", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "added_at", Type: field.TypeTime},
+	}
+	// CollectionActivitiesTable holds the schema information for the "collection_activities" table.
+	CollectionActivitiesTable = &schema.Table{
+		Name:       "collection_activities",
+		Columns:    CollectionActivitiesColumns,
+		PrimaryKey: []*schema.Column{CollectionActivitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collectionactivity_collection_id_activity_id",
+				Unique:  true,
+				Columns: []*schema.Column{CollectionActivitiesColumns[1], CollectionActivitiesColumns[2]},
+			},
+			{
+				Name:    "collectionactivity_collection_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionActivitiesColumns[1], CollectionActivitiesColumns[3]},
+			},
+		},
+	}
+	// FailedActivitiesColumns holds the columns for the "failed_activities" table.
+	FailedActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "source_uids", Type: field.TypeJSON},
+		{Name: "source_type", Type: field.TypeString},
+		{Name: "raw_json", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_retry_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// FailedActivitiesTable holds the schema information for the "failed_activities" table.
+	FailedActivitiesTable = &schema.Table{
+		Name:       "failed_activities",
+		Columns:    FailedActivitiesColumns,
+		PrimaryKey: []*schema.Column{FailedActivitiesColumns[0]},
+	}
 	// FeedsColumns holds the columns for the "feeds" table.
 	FeedsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -41,9 +154,17 @@ var (
 		{Name: "icon", Type: field.TypeString},
 		{Name: "query", Type: field.TypeString},
 		{Name: "public", Type: field.TypeBool},
+		{Name: "paused", Type: field.TypeBool, Default: false},
 		{Name: "source_uids", Type: field.TypeJSON},
+		{Name: "source_weights", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_overrides", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "min_quality_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "sections", Type: field.TypeJSON, Nullable: true},
+		{Name: "language", Type: field.TypeString, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// FeedsTable holds the schema information for the "feeds" table.
 	FeedsTable = &schema.Table{
@@ -51,6 +172,72 @@ var (
 		Columns:    FeedsColumns,
 		PrimaryKey: []*schema.Column{FeedsColumns[0]},
 	}
+	// FeedPositionsColumns holds the columns for the "feed_positions" table.
+	FeedPositionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "feed_id", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt},
+	}
+	// FeedPositionsTable holds the schema information for the "feed_positions" table.
+	FeedPositionsTable = &schema.Table{
+		Name:       "feed_positions",
+		Columns:    FeedPositionsColumns,
+		PrimaryKey: []*schema.Column{FeedPositionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feedposition_user_id_feed_id",
+				Unique:  true,
+				Columns: []*schema.Column{FeedPositionsColumns[1], FeedPositionsColumns[2]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_user_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+		},
+	}
+	// ReadActivitiesColumns holds the columns for the "read_activities" table.
+	ReadActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "activity_id", Type: field.TypeString},
+		{Name: "read_at", Type: field.TypeTime},
+	}
+	// ReadActivitiesTable holds the schema information for the "read_activities" table.
+	ReadActivitiesTable = &schema.Table{
+		Name:       "read_activities",
+		Columns:    ReadActivitiesColumns,
+		PrimaryKey: []*schema.Column{ReadActivitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "readactivity_user_id_activity_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReadActivitiesColumns[1], ReadActivitiesColumns[2]},
+			},
+			{
+				Name:    "readactivity_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReadActivitiesColumns[1], ReadActivitiesColumns[3]},
+			},
+		},
+	}
 	// SourcesColumns holds the columns for the "sources" table.
 	SourcesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -65,11 +252,47 @@ var (
 		Columns:    SourcesColumns,
 		PrimaryKey: []*schema.Column{SourcesColumns[0]},
 	}
+	// SourceStatesColumns holds the columns for the "source_states" table.
+	SourceStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "last_new_activity_at", Type: field.TypeTime},
+		{Name: "stale_since", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_reason", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SourceStatesTable holds the schema information for the "source_states" table.
+	SourceStatesTable = &schema.Table{
+		Name:       "source_states",
+		Columns:    SourceStatesColumns,
+		PrimaryKey: []*schema.Column{SourceStatesColumns[0]},
+	}
+	// UserProvisionsColumns holds the columns for the "user_provisions" table.
+	UserProvisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "provisioned_at", Type: field.TypeTime},
+	}
+	// UserProvisionsTable holds the schema information for the "user_provisions" table.
+	UserProvisionsTable = &schema.Table{
+		Name:       "user_provisions",
+		Columns:    UserProvisionsColumns,
+		PrimaryKey: []*schema.Column{UserProvisionsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ActivitiesTable,
+		ActivityFeedbacksTable,
+		ArticleTextsTable,
+		CollectionsTable,
+		CollectionActivitiesTable,
+		FailedActivitiesTable,
 		FeedsTable,
+		FeedPositionsTable,
+		IdempotencyKeysTable,
+		ReadActivitiesTable,
 		SourcesTable,
+		SourceStatesTable,
+		UserProvisionsTable,
 	}
 )
 
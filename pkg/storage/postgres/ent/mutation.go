@@ -12,9 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activityfeedback"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/articletext"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/collection"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/collectionactivity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/failedactivity"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feedposition"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/idempotencykey"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/readactivity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/schema"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/source"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/sourcestate"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/userprovision"
 	pgvector "github.com/pgvector/pgvector-go"
 )
 
@@ -27,9 +38,19 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeActivity = "Activity"
-	TypeFeed     = "Feed"
-	TypeSource   = "Source"
+	TypeActivity           = "Activity"
+	TypeActivityFeedback   = "ActivityFeedback"
+	TypeArticleText        = "ArticleText"
+	TypeCollection         = "Collection"
+	TypeCollectionActivity = "CollectionActivity"
+	TypeFailedActivity     = "FailedActivity"
+	TypeFeed               = "Feed"
+	TypeFeedPosition       = "FeedPosition"
+	TypeIdempotencyKey     = "IdempotencyKey"
+	TypeReadActivity       = "ReadActivity"
+	TypeSource             = "Source"
+	TypeSourceState        = "SourceState"
+	TypeUserProvision      = "UserProvision"
 )
 
 // ActivityMutation represents an operation that mutates the Activity nodes in the graph.
@@ -50,10 +71,14 @@ type ActivityMutation struct {
 	short_summary     *string
 	full_summary      *string
 	raw_json          *string
+	content_hash      *string
+	embedding_model   *string
 	embedding_1536    *pgvector.Vector
 	embedding_3072    *pgvector.Vector
 	social_score      *float64
 	addsocial_score   *float64
+	quality_score     *float64
+	addquality_score  *float64
 	update_count      *int
 	addupdate_count   *int
 	clearedFields     map[string]struct{}
@@ -577,6 +602,78 @@ func (m *ActivityMutation) ResetRawJSON() {
 	m.raw_json = nil
 }
 
+// SetContentHash sets the "content_hash" field.
+func (m *ActivityMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *ActivityMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *ActivityMutation) ResetContentHash() {
+	m.content_hash = nil
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *ActivityMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *ActivityMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldEmbeddingModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *ActivityMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+}
+
 // SetEmbedding1536 sets the "embedding_1536" field.
 func (m *ActivityMutation) SetEmbedding1536(pg pgvector.Vector) {
 	m.embedding_1536 = &pg
@@ -731,6 +828,62 @@ func (m *ActivityMutation) ResetSocialScore() {
 	m.addsocial_score = nil
 }
 
+// SetQualityScore sets the "quality_score" field.
+func (m *ActivityMutation) SetQualityScore(f float64) {
+	m.quality_score = &f
+	m.addquality_score = nil
+}
+
+// QualityScore returns the value of the "quality_score" field in the mutation.
+func (m *ActivityMutation) QualityScore() (r float64, exists bool) {
+	v := m.quality_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQualityScore returns the old "quality_score" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldQualityScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQualityScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQualityScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQualityScore: %w", err)
+	}
+	return oldValue.QualityScore, nil
+}
+
+// AddQualityScore adds f to the "quality_score" field.
+func (m *ActivityMutation) AddQualityScore(f float64) {
+	if m.addquality_score != nil {
+		*m.addquality_score += f
+	} else {
+		m.addquality_score = &f
+	}
+}
+
+// AddedQualityScore returns the value that was added to the "quality_score" field in this mutation.
+func (m *ActivityMutation) AddedQualityScore() (r float64, exists bool) {
+	v := m.addquality_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQualityScore resets all changes to the "quality_score" field.
+func (m *ActivityMutation) ResetQualityScore() {
+	m.quality_score = nil
+	m.addquality_score = nil
+}
+
 // SetUpdateCount sets the "update_count" field.
 func (m *ActivityMutation) SetUpdateCount(i int) {
 	m.update_count = &i
@@ -821,7 +974,7 @@ func (m *ActivityMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ActivityMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+	fields := make([]string, 0, 18)
 	if m.uid != nil {
 		fields = append(fields, activity.FieldUID)
 	}
@@ -855,6 +1008,12 @@ func (m *ActivityMutation) Fields() []string {
 	if m.raw_json != nil {
 		fields = append(fields, activity.FieldRawJSON)
 	}
+	if m.content_hash != nil {
+		fields = append(fields, activity.FieldContentHash)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, activity.FieldEmbeddingModel)
+	}
 	if m.embedding_1536 != nil {
 		fields = append(fields, activity.FieldEmbedding1536)
 	}
@@ -864,6 +1023,9 @@ func (m *ActivityMutation) Fields() []string {
 	if m.social_score != nil {
 		fields = append(fields, activity.FieldSocialScore)
 	}
+	if m.quality_score != nil {
+		fields = append(fields, activity.FieldQualityScore)
+	}
 	if m.update_count != nil {
 		fields = append(fields, activity.FieldUpdateCount)
 	}
@@ -897,12 +1059,18 @@ func (m *ActivityMutation) Field(name string) (ent.Value, bool) {
 		return m.FullSummary()
 	case activity.FieldRawJSON:
 		return m.RawJSON()
+	case activity.FieldContentHash:
+		return m.ContentHash()
+	case activity.FieldEmbeddingModel:
+		return m.EmbeddingModel()
 	case activity.FieldEmbedding1536:
 		return m.Embedding1536()
 	case activity.FieldEmbedding3072:
 		return m.Embedding3072()
 	case activity.FieldSocialScore:
 		return m.SocialScore()
+	case activity.FieldQualityScore:
+		return m.QualityScore()
 	case activity.FieldUpdateCount:
 		return m.UpdateCount()
 	}
@@ -936,12 +1104,18 @@ func (m *ActivityMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldFullSummary(ctx)
 	case activity.FieldRawJSON:
 		return m.OldRawJSON(ctx)
+	case activity.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case activity.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
 	case activity.FieldEmbedding1536:
 		return m.OldEmbedding1536(ctx)
 	case activity.FieldEmbedding3072:
 		return m.OldEmbedding3072(ctx)
 	case activity.FieldSocialScore:
 		return m.OldSocialScore(ctx)
+	case activity.FieldQualityScore:
+		return m.OldQualityScore(ctx)
 	case activity.FieldUpdateCount:
 		return m.OldUpdateCount(ctx)
 	}
@@ -1030,6 +1204,20 @@ func (m *ActivityMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRawJSON(v)
 		return nil
+	case activity.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case activity.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
 	case activity.FieldEmbedding1536:
 		v, ok := value.(pgvector.Vector)
 		if !ok {
@@ -1051,6 +1239,13 @@ func (m *ActivityMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSocialScore(v)
 		return nil
+	case activity.FieldQualityScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQualityScore(v)
+		return nil
 	case activity.FieldUpdateCount:
 		v, ok := value.(int)
 		if !ok {
@@ -1069,6 +1264,9 @@ func (m *ActivityMutation) AddedFields() []string {
 	if m.addsocial_score != nil {
 		fields = append(fields, activity.FieldSocialScore)
 	}
+	if m.addquality_score != nil {
+		fields = append(fields, activity.FieldQualityScore)
+	}
 	if m.addupdate_count != nil {
 		fields = append(fields, activity.FieldUpdateCount)
 	}
@@ -1082,6 +1280,8 @@ func (m *ActivityMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case activity.FieldSocialScore:
 		return m.AddedSocialScore()
+	case activity.FieldQualityScore:
+		return m.AddedQualityScore()
 	case activity.FieldUpdateCount:
 		return m.AddedUpdateCount()
 	}
@@ -1100,6 +1300,13 @@ func (m *ActivityMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddSocialScore(v)
 		return nil
+	case activity.FieldQualityScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQualityScore(v)
+		return nil
 	case activity.FieldUpdateCount:
 		v, ok := value.(int)
 		if !ok {
@@ -1182,6 +1389,12 @@ func (m *ActivityMutation) ResetField(name string) error {
 	case activity.FieldRawJSON:
 		m.ResetRawJSON()
 		return nil
+	case activity.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case activity.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
 	case activity.FieldEmbedding1536:
 		m.ResetEmbedding1536()
 		return nil
@@ -1191,6 +1404,9 @@ func (m *ActivityMutation) ResetField(name string) error {
 	case activity.FieldSocialScore:
 		m.ResetSocialScore()
 		return nil
+	case activity.FieldQualityScore:
+		m.ResetQualityScore()
+		return nil
 	case activity.FieldUpdateCount:
 		m.ResetUpdateCount()
 		return nil
@@ -1246,38 +1462,34 @@ func (m *ActivityMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Activity edge %s", name)
 }
 
-// FeedMutation represents an operation that mutates the Feed nodes in the graph.
-type FeedMutation struct {
+// ActivityFeedbackMutation represents an operation that mutates the ActivityFeedback nodes in the graph.
+type ActivityFeedbackMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	user_id           *string
-	name              *string
-	icon              *string
-	query             *string
-	public            *bool
-	source_uids       *[]string
-	appendsource_uids []string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*Feed, error)
-	predicates        []predicate.Feed
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	activity_id   *string
+	value         *int
+	addvalue      *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ActivityFeedback, error)
+	predicates    []predicate.ActivityFeedback
 }
 
-var _ ent.Mutation = (*FeedMutation)(nil)
+var _ ent.Mutation = (*ActivityFeedbackMutation)(nil)
 
-// feedOption allows management of the mutation configuration using functional options.
-type feedOption func(*FeedMutation)
+// activityfeedbackOption allows management of the mutation configuration using functional options.
+type activityfeedbackOption func(*ActivityFeedbackMutation)
 
-// newFeedMutation creates new mutation for the Feed entity.
-func newFeedMutation(c config, op Op, opts ...feedOption) *FeedMutation {
-	m := &FeedMutation{
+// newActivityFeedbackMutation creates new mutation for the ActivityFeedback entity.
+func newActivityFeedbackMutation(c config, op Op, opts ...activityfeedbackOption) *ActivityFeedbackMutation {
+	m := &ActivityFeedbackMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFeed,
+		typ:           TypeActivityFeedback,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1286,20 +1498,20 @@ func newFeedMutation(c config, op Op, opts ...feedOption) *FeedMutation {
 	return m
 }
 
-// withFeedID sets the ID field of the mutation.
-func withFeedID(id string) feedOption {
-	return func(m *FeedMutation) {
+// withActivityFeedbackID sets the ID field of the mutation.
+func withActivityFeedbackID(id int) activityfeedbackOption {
+	return func(m *ActivityFeedbackMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Feed
+			value *ActivityFeedback
 		)
-		m.oldValue = func(ctx context.Context) (*Feed, error) {
+		m.oldValue = func(ctx context.Context) (*ActivityFeedback, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Feed.Get(ctx, id)
+					value, err = m.Client().ActivityFeedback.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1308,10 +1520,10 @@ func withFeedID(id string) feedOption {
 	}
 }
 
-// withFeed sets the old Feed of the mutation.
-func withFeed(node *Feed) feedOption {
-	return func(m *FeedMutation) {
-		m.oldValue = func(context.Context) (*Feed, error) {
+// withActivityFeedback sets the old ActivityFeedback of the mutation.
+func withActivityFeedback(node *ActivityFeedback) activityfeedbackOption {
+	return func(m *ActivityFeedbackMutation) {
+		m.oldValue = func(context.Context) (*ActivityFeedback, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1320,7 +1532,7 @@ func withFeed(node *Feed) feedOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FeedMutation) Client() *Client {
+func (m ActivityFeedbackMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1328,7 +1540,7 @@ func (m FeedMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FeedMutation) Tx() (*Tx, error) {
+func (m ActivityFeedbackMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1337,15 +1549,9 @@ func (m FeedMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Feed entities.
-func (m *FeedMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FeedMutation) ID() (id string, exists bool) {
+func (m *ActivityFeedbackMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1356,28 +1562,28 @@ func (m *FeedMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FeedMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ActivityFeedbackMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Feed.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActivityFeedback.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *FeedMutation) SetUserID(s string) {
+func (m *ActivityFeedbackMutation) SetUserID(s string) {
 	m.user_id = &s
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *FeedMutation) UserID() (r string, exists bool) {
+func (m *ActivityFeedbackMutation) UserID() (r string, exists bool) {
 	v := m.user_id
 	if v == nil {
 		return
@@ -1385,10 +1591,10 @@ func (m *FeedMutation) UserID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ActivityFeedback entity.
+// If the ActivityFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ActivityFeedbackMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -1403,248 +1609,5992 @@ func (m *FeedMutation) OldUserID(ctx context.Context) (v string, err error) {
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *FeedMutation) ResetUserID() {
+func (m *ActivityFeedbackMutation) ResetUserID() {
 	m.user_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *FeedMutation) SetName(s string) {
-	m.name = &s
+// SetActivityID sets the "activity_id" field.
+func (m *ActivityFeedbackMutation) SetActivityID(s string) {
+	m.activity_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FeedMutation) Name() (r string, exists bool) {
-	v := m.name
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *ActivityFeedbackMutation) ActivityID() (r string, exists bool) {
+	v := m.activity_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// OldActivityID returns the old "activity_id" field's value of the ActivityFeedback entity.
+// If the ActivityFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ActivityFeedbackMutation) OldActivityID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ActivityID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FeedMutation) ResetName() {
-	m.name = nil
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *ActivityFeedbackMutation) ResetActivityID() {
+	m.activity_id = nil
 }
 
-// SetIcon sets the "icon" field.
-func (m *FeedMutation) SetIcon(s string) {
-	m.icon = &s
+// SetValue sets the "value" field.
+func (m *ActivityFeedbackMutation) SetValue(i int) {
+	m.value = &i
+	m.addvalue = nil
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *FeedMutation) Icon() (r string, exists bool) {
-	v := m.icon
+// Value returns the value of the "value" field in the mutation.
+func (m *ActivityFeedbackMutation) Value() (r int, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIcon returns the old "icon" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the ActivityFeedback entity.
+// If the ActivityFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldIcon(ctx context.Context) (v string, err error) {
+func (m *ActivityFeedbackMutation) OldValue(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Icon, nil
-}
-
-// ResetIcon resets all changes to the "icon" field.
-func (m *FeedMutation) ResetIcon() {
-	m.icon = nil
+	return oldValue.Value, nil
 }
 
-// SetQuery sets the "query" field.
-func (m *FeedMutation) SetQuery(s string) {
-	m.query = &s
+// AddValue adds i to the "value" field.
+func (m *ActivityFeedbackMutation) AddValue(i int) {
+	if m.addvalue != nil {
+		*m.addvalue += i
+	} else {
+		m.addvalue = &i
+	}
 }
 
-// Query returns the value of the "query" field in the mutation.
-func (m *FeedMutation) Query() (r string, exists bool) {
-	v := m.query
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *ActivityFeedbackMutation) AddedValue() (r int, exists bool) {
+	v := m.addvalue
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuery returns the old "query" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldQuery(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuery requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
-	}
-	return oldValue.Query, nil
-}
-
-// ResetQuery resets all changes to the "query" field.
-func (m *FeedMutation) ResetQuery() {
-	m.query = nil
+// ResetValue resets all changes to the "value" field.
+func (m *ActivityFeedbackMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
 }
 
-// SetPublic sets the "public" field.
-func (m *FeedMutation) SetPublic(b bool) {
-	m.public = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ActivityFeedbackMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Public returns the value of the "public" field in the mutation.
-func (m *FeedMutation) Public() (r bool, exists bool) {
-	v := m.public
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ActivityFeedbackMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublic returns the old "public" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ActivityFeedback entity.
+// If the ActivityFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldPublic(ctx context.Context) (v bool, err error) {
+func (m *ActivityFeedbackMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublic requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Public, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetPublic resets all changes to the "public" field.
-func (m *FeedMutation) ResetPublic() {
-	m.public = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ActivityFeedbackMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetSourceUids sets the "source_uids" field.
-func (m *FeedMutation) SetSourceUids(s []string) {
-	m.source_uids = &s
-	m.appendsource_uids = nil
+// Where appends a list predicates to the ActivityFeedbackMutation builder.
+func (m *ActivityFeedbackMutation) Where(ps ...predicate.ActivityFeedback) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// SourceUids returns the value of the "source_uids" field in the mutation.
-func (m *FeedMutation) SourceUids() (r []string, exists bool) {
-	v := m.source_uids
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ActivityFeedbackMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ActivityFeedbackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActivityFeedback, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldSourceUids returns the old "source_uids" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+// Op returns the operation name.
+func (m *ActivityFeedbackMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ActivityFeedbackMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ActivityFeedback).
+func (m *ActivityFeedbackMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ActivityFeedbackMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, activityfeedback.FieldUserID)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, activityfeedback.FieldActivityID)
+	}
+	if m.value != nil {
+		fields = append(fields, activityfeedback.FieldValue)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, activityfeedback.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ActivityFeedbackMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case activityfeedback.FieldUserID:
+		return m.UserID()
+	case activityfeedback.FieldActivityID:
+		return m.ActivityID()
+	case activityfeedback.FieldValue:
+		return m.Value()
+	case activityfeedback.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ActivityFeedbackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case activityfeedback.FieldUserID:
+		return m.OldUserID(ctx)
+	case activityfeedback.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case activityfeedback.FieldValue:
+		return m.OldValue(ctx)
+	case activityfeedback.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ActivityFeedback field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ActivityFeedbackMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case activityfeedback.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case activityfeedback.FieldActivityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case activityfeedback.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case activityfeedback.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ActivityFeedback field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ActivityFeedbackMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue != nil {
+		fields = append(fields, activityfeedback.FieldValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ActivityFeedbackMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case activityfeedback.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ActivityFeedbackMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case activityfeedback.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ActivityFeedback numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ActivityFeedbackMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ActivityFeedbackMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ActivityFeedbackMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ActivityFeedback nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ActivityFeedbackMutation) ResetField(name string) error {
+	switch name {
+	case activityfeedback.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case activityfeedback.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case activityfeedback.FieldValue:
+		m.ResetValue()
+		return nil
+	case activityfeedback.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ActivityFeedback field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ActivityFeedbackMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ActivityFeedbackMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ActivityFeedbackMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ActivityFeedbackMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ActivityFeedbackMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ActivityFeedbackMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ActivityFeedbackMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ActivityFeedback unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ActivityFeedbackMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ActivityFeedback edge %s", name)
+}
+
+// ArticleTextMutation represents an operation that mutates the ArticleText nodes in the graph.
+type ArticleTextMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	text          *string
+	etag          *string
+	fetched_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ArticleText, error)
+	predicates    []predicate.ArticleText
+}
+
+var _ ent.Mutation = (*ArticleTextMutation)(nil)
+
+// articletextOption allows management of the mutation configuration using functional options.
+type articletextOption func(*ArticleTextMutation)
+
+// newArticleTextMutation creates new mutation for the ArticleText entity.
+func newArticleTextMutation(c config, op Op, opts ...articletextOption) *ArticleTextMutation {
+	m := &ArticleTextMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeArticleText,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withArticleTextID sets the ID field of the mutation.
+func withArticleTextID(id string) articletextOption {
+	return func(m *ArticleTextMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ArticleText
+		)
+		m.oldValue = func(ctx context.Context) (*ArticleText, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ArticleText.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withArticleText sets the old ArticleText of the mutation.
+func withArticleText(node *ArticleText) articletextOption {
+	return func(m *ArticleTextMutation) {
+		m.oldValue = func(context.Context) (*ArticleText, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ArticleTextMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ArticleTextMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ArticleText entities.
+func (m *ArticleTextMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ArticleTextMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ArticleTextMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ArticleText.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetText sets the "text" field.
+func (m *ArticleTextMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *ArticleTextMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the ArticleText entity.
+// If the ArticleText object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleTextMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *ArticleTextMutation) ResetText() {
+	m.text = nil
+}
+
+// SetEtag sets the "etag" field.
+func (m *ArticleTextMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *ArticleTextMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the ArticleText entity.
+// If the ArticleText object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleTextMutation) OldEtag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *ArticleTextMutation) ResetEtag() {
+	m.etag = nil
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (m *ArticleTextMutation) SetFetchedAt(t time.Time) {
+	m.fetched_at = &t
+}
+
+// FetchedAt returns the value of the "fetched_at" field in the mutation.
+func (m *ArticleTextMutation) FetchedAt() (r time.Time, exists bool) {
+	v := m.fetched_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchedAt returns the old "fetched_at" field's value of the ArticleText entity.
+// If the ArticleText object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleTextMutation) OldFetchedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchedAt: %w", err)
+	}
+	return oldValue.FetchedAt, nil
+}
+
+// ResetFetchedAt resets all changes to the "fetched_at" field.
+func (m *ArticleTextMutation) ResetFetchedAt() {
+	m.fetched_at = nil
+}
+
+// Where appends a list predicates to the ArticleTextMutation builder.
+func (m *ArticleTextMutation) Where(ps ...predicate.ArticleText) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ArticleTextMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArticleTextMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArticleText, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ArticleTextMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ArticleTextMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ArticleText).
+func (m *ArticleTextMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ArticleTextMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.text != nil {
+		fields = append(fields, articletext.FieldText)
+	}
+	if m.etag != nil {
+		fields = append(fields, articletext.FieldEtag)
+	}
+	if m.fetched_at != nil {
+		fields = append(fields, articletext.FieldFetchedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ArticleTextMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case articletext.FieldText:
+		return m.Text()
+	case articletext.FieldEtag:
+		return m.Etag()
+	case articletext.FieldFetchedAt:
+		return m.FetchedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ArticleTextMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case articletext.FieldText:
+		return m.OldText(ctx)
+	case articletext.FieldEtag:
+		return m.OldEtag(ctx)
+	case articletext.FieldFetchedAt:
+		return m.OldFetchedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ArticleText field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArticleTextMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case articletext.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case articletext.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case articletext.FieldFetchedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleText field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ArticleTextMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ArticleTextMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArticleTextMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ArticleText numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ArticleTextMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ArticleTextMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ArticleTextMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ArticleText nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ArticleTextMutation) ResetField(name string) error {
+	switch name {
+	case articletext.FieldText:
+		m.ResetText()
+		return nil
+	case articletext.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case articletext.FieldFetchedAt:
+		m.ResetFetchedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ArticleText field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ArticleTextMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ArticleTextMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ArticleTextMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ArticleTextMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ArticleTextMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ArticleTextMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ArticleTextMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ArticleText unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ArticleTextMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ArticleText edge %s", name)
+}
+
+// CollectionMutation represents an operation that mutates the Collection nodes in the graph.
+type CollectionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	user_id       *string
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Collection, error)
+	predicates    []predicate.Collection
+}
+
+var _ ent.Mutation = (*CollectionMutation)(nil)
+
+// collectionOption allows management of the mutation configuration using functional options.
+type collectionOption func(*CollectionMutation)
+
+// newCollectionMutation creates new mutation for the Collection entity.
+func newCollectionMutation(c config, op Op, opts ...collectionOption) *CollectionMutation {
+	m := &CollectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCollection,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCollectionID sets the ID field of the mutation.
+func withCollectionID(id string) collectionOption {
+	return func(m *CollectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Collection
+		)
+		m.oldValue = func(ctx context.Context) (*Collection, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Collection.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCollection sets the old Collection of the mutation.
+func withCollection(node *Collection) collectionOption {
+	return func(m *CollectionMutation) {
+		m.oldValue = func(context.Context) (*Collection, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CollectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CollectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Collection entities.
+func (m *CollectionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CollectionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CollectionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Collection.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *CollectionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CollectionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CollectionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *CollectionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CollectionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CollectionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CollectionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CollectionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CollectionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the CollectionMutation builder.
+func (m *CollectionMutation) Where(ps ...predicate.Collection) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CollectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CollectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Collection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CollectionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CollectionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Collection).
+func (m *CollectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CollectionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, collection.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, collection.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, collection.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case collection.FieldUserID:
+		return m.UserID()
+	case collection.FieldName:
+		return m.Name()
+	case collection.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case collection.FieldUserID:
+		return m.OldUserID(ctx)
+	case collection.FieldName:
+		return m.OldName(ctx)
+	case collection.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Collection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case collection.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case collection.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case collection.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Collection field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CollectionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CollectionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Collection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CollectionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CollectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CollectionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Collection nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CollectionMutation) ResetField(name string) error {
+	switch name {
+	case collection.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case collection.FieldName:
+		m.ResetName()
+		return nil
+	case collection.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Collection field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CollectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CollectionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CollectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CollectionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CollectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CollectionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CollectionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Collection unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CollectionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Collection edge %s", name)
+}
+
+// CollectionActivityMutation represents an operation that mutates the CollectionActivity nodes in the graph.
+type CollectionActivityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	collection_id *string
+	activity_id   *string
+	position      *int
+	addposition   *int
+	added_at      *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CollectionActivity, error)
+	predicates    []predicate.CollectionActivity
+}
+
+var _ ent.Mutation = (*CollectionActivityMutation)(nil)
+
+// collectionactivityOption allows management of the mutation configuration using functional options.
+type collectionactivityOption func(*CollectionActivityMutation)
+
+// newCollectionActivityMutation creates new mutation for the CollectionActivity entity.
+func newCollectionActivityMutation(c config, op Op, opts ...collectionactivityOption) *CollectionActivityMutation {
+	m := &CollectionActivityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCollectionActivity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCollectionActivityID sets the ID field of the mutation.
+func withCollectionActivityID(id int) collectionactivityOption {
+	return func(m *CollectionActivityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CollectionActivity
+		)
+		m.oldValue = func(ctx context.Context) (*CollectionActivity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CollectionActivity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCollectionActivity sets the old CollectionActivity of the mutation.
+func withCollectionActivity(node *CollectionActivity) collectionactivityOption {
+	return func(m *CollectionActivityMutation) {
+		m.oldValue = func(context.Context) (*CollectionActivity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CollectionActivityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CollectionActivityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CollectionActivityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CollectionActivityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CollectionActivity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (m *CollectionActivityMutation) SetCollectionID(s string) {
+	m.collection_id = &s
+}
+
+// CollectionID returns the value of the "collection_id" field in the mutation.
+func (m *CollectionActivityMutation) CollectionID() (r string, exists bool) {
+	v := m.collection_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectionID returns the old "collection_id" field's value of the CollectionActivity entity.
+// If the CollectionActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionActivityMutation) OldCollectionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
+	}
+	return oldValue.CollectionID, nil
+}
+
+// ResetCollectionID resets all changes to the "collection_id" field.
+func (m *CollectionActivityMutation) ResetCollectionID() {
+	m.collection_id = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *CollectionActivityMutation) SetActivityID(s string) {
+	m.activity_id = &s
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *CollectionActivityMutation) ActivityID() (r string, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the CollectionActivity entity.
+// If the CollectionActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionActivityMutation) OldActivityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *CollectionActivityMutation) ResetActivityID() {
+	m.activity_id = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *CollectionActivityMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *CollectionActivityMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the CollectionActivity entity.
+// If the CollectionActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionActivityMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *CollectionActivityMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *CollectionActivityMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *CollectionActivityMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *CollectionActivityMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *CollectionActivityMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the CollectionActivity entity.
+// If the CollectionActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionActivityMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *CollectionActivityMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// Where appends a list predicates to the CollectionActivityMutation builder.
+func (m *CollectionActivityMutation) Where(ps ...predicate.CollectionActivity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CollectionActivityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CollectionActivityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CollectionActivity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CollectionActivityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CollectionActivityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CollectionActivity).
+func (m *CollectionActivityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CollectionActivityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.collection_id != nil {
+		fields = append(fields, collectionactivity.FieldCollectionID)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, collectionactivity.FieldActivityID)
+	}
+	if m.position != nil {
+		fields = append(fields, collectionactivity.FieldPosition)
+	}
+	if m.added_at != nil {
+		fields = append(fields, collectionactivity.FieldAddedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CollectionActivityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case collectionactivity.FieldCollectionID:
+		return m.CollectionID()
+	case collectionactivity.FieldActivityID:
+		return m.ActivityID()
+	case collectionactivity.FieldPosition:
+		return m.Position()
+	case collectionactivity.FieldAddedAt:
+		return m.AddedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CollectionActivityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case collectionactivity.FieldCollectionID:
+		return m.OldCollectionID(ctx)
+	case collectionactivity.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case collectionactivity.FieldPosition:
+		return m.OldPosition(ctx)
+	case collectionactivity.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CollectionActivity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionActivityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case collectionactivity.FieldCollectionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionID(v)
+		return nil
+	case collectionactivity.FieldActivityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case collectionactivity.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case collectionactivity.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CollectionActivity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CollectionActivityMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, collectionactivity.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CollectionActivityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case collectionactivity.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionActivityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case collectionactivity.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CollectionActivity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CollectionActivityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CollectionActivityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CollectionActivityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CollectionActivity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CollectionActivityMutation) ResetField(name string) error {
+	switch name {
+	case collectionactivity.FieldCollectionID:
+		m.ResetCollectionID()
+		return nil
+	case collectionactivity.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case collectionactivity.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case collectionactivity.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CollectionActivity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CollectionActivityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CollectionActivityMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CollectionActivityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CollectionActivityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CollectionActivityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CollectionActivityMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CollectionActivityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CollectionActivity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CollectionActivityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CollectionActivity edge %s", name)
+}
+
+// FailedActivityMutation represents an operation that mutates the FailedActivity nodes in the graph.
+type FailedActivityMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	source_uids       *[]string
+	appendsource_uids []string
+	source_type       *string
+	raw_json          *string
+	error             *string
+	attempt_count     *int
+	addattempt_count  *int
+	next_retry_at     *time.Time
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*FailedActivity, error)
+	predicates        []predicate.FailedActivity
+}
+
+var _ ent.Mutation = (*FailedActivityMutation)(nil)
+
+// failedactivityOption allows management of the mutation configuration using functional options.
+type failedactivityOption func(*FailedActivityMutation)
+
+// newFailedActivityMutation creates new mutation for the FailedActivity entity.
+func newFailedActivityMutation(c config, op Op, opts ...failedactivityOption) *FailedActivityMutation {
+	m := &FailedActivityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFailedActivity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFailedActivityID sets the ID field of the mutation.
+func withFailedActivityID(id string) failedactivityOption {
+	return func(m *FailedActivityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FailedActivity
+		)
+		m.oldValue = func(ctx context.Context) (*FailedActivity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FailedActivity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFailedActivity sets the old FailedActivity of the mutation.
+func withFailedActivity(node *FailedActivity) failedactivityOption {
+	return func(m *FailedActivityMutation) {
+		m.oldValue = func(context.Context) (*FailedActivity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FailedActivityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FailedActivityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FailedActivity entities.
+func (m *FailedActivityMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FailedActivityMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FailedActivityMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FailedActivity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceUids sets the "source_uids" field.
+func (m *FailedActivityMutation) SetSourceUids(s []string) {
+	m.source_uids = &s
+	m.appendsource_uids = nil
+}
+
+// SourceUids returns the value of the "source_uids" field in the mutation.
+func (m *FailedActivityMutation) SourceUids() (r []string, exists bool) {
+	v := m.source_uids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceUids returns the old "source_uids" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldSourceUids(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceUids is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceUids requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceUids: %w", err)
+	}
+	return oldValue.SourceUids, nil
+}
+
+// AppendSourceUids adds s to the "source_uids" field.
+func (m *FailedActivityMutation) AppendSourceUids(s []string) {
+	m.appendsource_uids = append(m.appendsource_uids, s...)
+}
+
+// AppendedSourceUids returns the list of values that were appended to the "source_uids" field in this mutation.
+func (m *FailedActivityMutation) AppendedSourceUids() ([]string, bool) {
+	if len(m.appendsource_uids) == 0 {
+		return nil, false
+	}
+	return m.appendsource_uids, true
+}
+
+// ResetSourceUids resets all changes to the "source_uids" field.
+func (m *FailedActivityMutation) ResetSourceUids() {
+	m.source_uids = nil
+	m.appendsource_uids = nil
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *FailedActivityMutation) SetSourceType(s string) {
+	m.source_type = &s
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *FailedActivityMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldSourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *FailedActivityMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (m *FailedActivityMutation) SetRawJSON(s string) {
+	m.raw_json = &s
+}
+
+// RawJSON returns the value of the "raw_json" field in the mutation.
+func (m *FailedActivityMutation) RawJSON() (r string, exists bool) {
+	v := m.raw_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawJSON returns the old "raw_json" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+	}
+	return oldValue.RawJSON, nil
+}
+
+// ResetRawJSON resets all changes to the "raw_json" field.
+func (m *FailedActivityMutation) ResetRawJSON() {
+	m.raw_json = nil
+}
+
+// SetError sets the "error" field.
+func (m *FailedActivityMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *FailedActivityMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *FailedActivityMutation) ResetError() {
+	m.error = nil
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (m *FailedActivityMutation) SetAttemptCount(i int) {
+	m.attempt_count = &i
+	m.addattempt_count = nil
+}
+
+// AttemptCount returns the value of the "attempt_count" field in the mutation.
+func (m *FailedActivityMutation) AttemptCount() (r int, exists bool) {
+	v := m.attempt_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttemptCount returns the old "attempt_count" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldAttemptCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttemptCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttemptCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttemptCount: %w", err)
+	}
+	return oldValue.AttemptCount, nil
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (m *FailedActivityMutation) AddAttemptCount(i int) {
+	if m.addattempt_count != nil {
+		*m.addattempt_count += i
+	} else {
+		m.addattempt_count = &i
+	}
+}
+
+// AddedAttemptCount returns the value that was added to the "attempt_count" field in this mutation.
+func (m *FailedActivityMutation) AddedAttemptCount() (r int, exists bool) {
+	v := m.addattempt_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttemptCount resets all changes to the "attempt_count" field.
+func (m *FailedActivityMutation) ResetAttemptCount() {
+	m.attempt_count = nil
+	m.addattempt_count = nil
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (m *FailedActivityMutation) SetNextRetryAt(t time.Time) {
+	m.next_retry_at = &t
+}
+
+// NextRetryAt returns the value of the "next_retry_at" field in the mutation.
+func (m *FailedActivityMutation) NextRetryAt() (r time.Time, exists bool) {
+	v := m.next_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRetryAt returns the old "next_retry_at" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldNextRetryAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRetryAt: %w", err)
+	}
+	return oldValue.NextRetryAt, nil
+}
+
+// ResetNextRetryAt resets all changes to the "next_retry_at" field.
+func (m *FailedActivityMutation) ResetNextRetryAt() {
+	m.next_retry_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FailedActivityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FailedActivityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FailedActivityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FailedActivityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FailedActivityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FailedActivity entity.
+// If the FailedActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedActivityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FailedActivityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the FailedActivityMutation builder.
+func (m *FailedActivityMutation) Where(ps ...predicate.FailedActivity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FailedActivityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FailedActivityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FailedActivity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FailedActivityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FailedActivityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FailedActivity).
+func (m *FailedActivityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FailedActivityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.source_uids != nil {
+		fields = append(fields, failedactivity.FieldSourceUids)
+	}
+	if m.source_type != nil {
+		fields = append(fields, failedactivity.FieldSourceType)
+	}
+	if m.raw_json != nil {
+		fields = append(fields, failedactivity.FieldRawJSON)
+	}
+	if m.error != nil {
+		fields = append(fields, failedactivity.FieldError)
+	}
+	if m.attempt_count != nil {
+		fields = append(fields, failedactivity.FieldAttemptCount)
+	}
+	if m.next_retry_at != nil {
+		fields = append(fields, failedactivity.FieldNextRetryAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, failedactivity.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, failedactivity.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FailedActivityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case failedactivity.FieldSourceUids:
+		return m.SourceUids()
+	case failedactivity.FieldSourceType:
+		return m.SourceType()
+	case failedactivity.FieldRawJSON:
+		return m.RawJSON()
+	case failedactivity.FieldError:
+		return m.Error()
+	case failedactivity.FieldAttemptCount:
+		return m.AttemptCount()
+	case failedactivity.FieldNextRetryAt:
+		return m.NextRetryAt()
+	case failedactivity.FieldCreatedAt:
+		return m.CreatedAt()
+	case failedactivity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FailedActivityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case failedactivity.FieldSourceUids:
+		return m.OldSourceUids(ctx)
+	case failedactivity.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case failedactivity.FieldRawJSON:
+		return m.OldRawJSON(ctx)
+	case failedactivity.FieldError:
+		return m.OldError(ctx)
+	case failedactivity.FieldAttemptCount:
+		return m.OldAttemptCount(ctx)
+	case failedactivity.FieldNextRetryAt:
+		return m.OldNextRetryAt(ctx)
+	case failedactivity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case failedactivity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FailedActivity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FailedActivityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case failedactivity.FieldSourceUids:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceUids(v)
+		return nil
+	case failedactivity.FieldSourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case failedactivity.FieldRawJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawJSON(v)
+		return nil
+	case failedactivity.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case failedactivity.FieldAttemptCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttemptCount(v)
+		return nil
+	case failedactivity.FieldNextRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRetryAt(v)
+		return nil
+	case failedactivity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case failedactivity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FailedActivity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FailedActivityMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempt_count != nil {
+		fields = append(fields, failedactivity.FieldAttemptCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FailedActivityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case failedactivity.FieldAttemptCount:
+		return m.AddedAttemptCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FailedActivityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case failedactivity.FieldAttemptCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttemptCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FailedActivity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FailedActivityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FailedActivityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FailedActivityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FailedActivity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FailedActivityMutation) ResetField(name string) error {
+	switch name {
+	case failedactivity.FieldSourceUids:
+		m.ResetSourceUids()
+		return nil
+	case failedactivity.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case failedactivity.FieldRawJSON:
+		m.ResetRawJSON()
+		return nil
+	case failedactivity.FieldError:
+		m.ResetError()
+		return nil
+	case failedactivity.FieldAttemptCount:
+		m.ResetAttemptCount()
+		return nil
+	case failedactivity.FieldNextRetryAt:
+		m.ResetNextRetryAt()
+		return nil
+	case failedactivity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case failedactivity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FailedActivity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FailedActivityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FailedActivityMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FailedActivityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FailedActivityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FailedActivityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FailedActivityMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FailedActivityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FailedActivity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FailedActivityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FailedActivity edge %s", name)
+}
+
+// FeedMutation represents an operation that mutates the Feed nodes in the graph.
+type FeedMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	user_id              *string
+	name                 *string
+	icon                 *string
+	query                *string
+	public               *bool
+	paused               *bool
+	source_uids          *[]string
+	appendsource_uids    []string
+	source_weights       *map[string]float64
+	source_overrides     *map[string]schema.SourceOverride
+	source_filters       *map[string]schema.SourceFilter
+	min_quality_score    *float64
+	addmin_quality_score *float64
+	sections             *[]schema.FeedSection
+	appendsections       []schema.FeedSection
+	language             *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	deleted_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*Feed, error)
+	predicates           []predicate.Feed
+}
+
+var _ ent.Mutation = (*FeedMutation)(nil)
+
+// feedOption allows management of the mutation configuration using functional options.
+type feedOption func(*FeedMutation)
+
+// newFeedMutation creates new mutation for the Feed entity.
+func newFeedMutation(c config, op Op, opts ...feedOption) *FeedMutation {
+	m := &FeedMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFeed,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFeedID sets the ID field of the mutation.
+func withFeedID(id string) feedOption {
+	return func(m *FeedMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Feed
+		)
+		m.oldValue = func(ctx context.Context) (*Feed, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Feed.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFeed sets the old Feed of the mutation.
+func withFeed(node *Feed) feedOption {
+	return func(m *FeedMutation) {
+		m.oldValue = func(context.Context) (*Feed, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FeedMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FeedMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Feed entities.
+func (m *FeedMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FeedMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FeedMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Feed.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FeedMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FeedMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FeedMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *FeedMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FeedMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FeedMutation) ResetName() {
+	m.name = nil
+}
+
+// SetIcon sets the "icon" field.
+func (m *FeedMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *FeedMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *FeedMutation) ResetIcon() {
+	m.icon = nil
+}
+
+// SetQuery sets the "query" field.
+func (m *FeedMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *FeedMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *FeedMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetPublic sets the "public" field.
+func (m *FeedMutation) SetPublic(b bool) {
+	m.public = &b
+}
+
+// Public returns the value of the "public" field in the mutation.
+func (m *FeedMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublic returns the old "public" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+	}
+	return oldValue.Public, nil
+}
+
+// ResetPublic resets all changes to the "public" field.
+func (m *FeedMutation) ResetPublic() {
+	m.public = nil
+}
+
+// SetPaused sets the "paused" field.
+func (m *FeedMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *FeedMutation) Paused() (r bool, exists bool) {
+	v := m.paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaused returns the old "paused" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
+	}
+	return oldValue.Paused, nil
+}
+
+// ResetPaused resets all changes to the "paused" field.
+func (m *FeedMutation) ResetPaused() {
+	m.paused = nil
+}
+
+// SetSourceUids sets the "source_uids" field.
+func (m *FeedMutation) SetSourceUids(s []string) {
+	m.source_uids = &s
+	m.appendsource_uids = nil
+}
+
+// SourceUids returns the value of the "source_uids" field in the mutation.
+func (m *FeedMutation) SourceUids() (r []string, exists bool) {
+	v := m.source_uids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceUids returns the old "source_uids" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *FeedMutation) OldSourceUids(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceUids is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceUids is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceUids requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceUids: %w", err)
+	}
+	return oldValue.SourceUids, nil
+}
+
+// AppendSourceUids adds s to the "source_uids" field.
+func (m *FeedMutation) AppendSourceUids(s []string) {
+	m.appendsource_uids = append(m.appendsource_uids, s...)
+}
+
+// AppendedSourceUids returns the list of values that were appended to the "source_uids" field in this mutation.
+func (m *FeedMutation) AppendedSourceUids() ([]string, bool) {
+	if len(m.appendsource_uids) == 0 {
+		return nil, false
+	}
+	return m.appendsource_uids, true
+}
+
+// ResetSourceUids resets all changes to the "source_uids" field.
+func (m *FeedMutation) ResetSourceUids() {
+	m.source_uids = nil
+	m.appendsource_uids = nil
+}
+
+// SetSourceWeights sets the "source_weights" field.
+func (m *FeedMutation) SetSourceWeights(value map[string]float64) {
+	m.source_weights = &value
+}
+
+// SourceWeights returns the value of the "source_weights" field in the mutation.
+func (m *FeedMutation) SourceWeights() (r map[string]float64, exists bool) {
+	v := m.source_weights
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceWeights returns the old "source_weights" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldSourceWeights(ctx context.Context) (v map[string]float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceWeights is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceWeights requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceWeights: %w", err)
+	}
+	return oldValue.SourceWeights, nil
+}
+
+// ClearSourceWeights clears the value of the "source_weights" field.
+func (m *FeedMutation) ClearSourceWeights() {
+	m.source_weights = nil
+	m.clearedFields[feed.FieldSourceWeights] = struct{}{}
+}
+
+// SourceWeightsCleared returns if the "source_weights" field was cleared in this mutation.
+func (m *FeedMutation) SourceWeightsCleared() bool {
+	_, ok := m.clearedFields[feed.FieldSourceWeights]
+	return ok
+}
+
+// ResetSourceWeights resets all changes to the "source_weights" field.
+func (m *FeedMutation) ResetSourceWeights() {
+	m.source_weights = nil
+	delete(m.clearedFields, feed.FieldSourceWeights)
+}
+
+// SetSourceOverrides sets the "source_overrides" field.
+func (m *FeedMutation) SetSourceOverrides(mo map[string]schema.SourceOverride) {
+	m.source_overrides = &mo
+}
+
+// SourceOverrides returns the value of the "source_overrides" field in the mutation.
+func (m *FeedMutation) SourceOverrides() (r map[string]schema.SourceOverride, exists bool) {
+	v := m.source_overrides
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceOverrides returns the old "source_overrides" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldSourceOverrides(ctx context.Context) (v map[string]schema.SourceOverride, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceOverrides is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceOverrides requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceOverrides: %w", err)
+	}
+	return oldValue.SourceOverrides, nil
+}
+
+// ClearSourceOverrides clears the value of the "source_overrides" field.
+func (m *FeedMutation) ClearSourceOverrides() {
+	m.source_overrides = nil
+	m.clearedFields[feed.FieldSourceOverrides] = struct{}{}
+}
+
+// SourceOverridesCleared returns if the "source_overrides" field was cleared in this mutation.
+func (m *FeedMutation) SourceOverridesCleared() bool {
+	_, ok := m.clearedFields[feed.FieldSourceOverrides]
+	return ok
+}
+
+// ResetSourceOverrides resets all changes to the "source_overrides" field.
+func (m *FeedMutation) ResetSourceOverrides() {
+	m.source_overrides = nil
+	delete(m.clearedFields, feed.FieldSourceOverrides)
+}
+
+// SetSourceFilters sets the "source_filters" field.
+func (m *FeedMutation) SetSourceFilters(mf map[string]schema.SourceFilter) {
+	m.source_filters = &mf
+}
+
+// SourceFilters returns the value of the "source_filters" field in the mutation.
+func (m *FeedMutation) SourceFilters() (r map[string]schema.SourceFilter, exists bool) {
+	v := m.source_filters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceFilters returns the old "source_filters" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldSourceFilters(ctx context.Context) (v map[string]schema.SourceFilter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceFilters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceFilters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceFilters: %w", err)
+	}
+	return oldValue.SourceFilters, nil
+}
+
+// ClearSourceFilters clears the value of the "source_filters" field.
+func (m *FeedMutation) ClearSourceFilters() {
+	m.source_filters = nil
+	m.clearedFields[feed.FieldSourceFilters] = struct{}{}
+}
+
+// SourceFiltersCleared returns if the "source_filters" field was cleared in this mutation.
+func (m *FeedMutation) SourceFiltersCleared() bool {
+	_, ok := m.clearedFields[feed.FieldSourceFilters]
+	return ok
+}
+
+// ResetSourceFilters resets all changes to the "source_filters" field.
+func (m *FeedMutation) ResetSourceFilters() {
+	m.source_filters = nil
+	delete(m.clearedFields, feed.FieldSourceFilters)
+}
+
+// SetMinQualityScore sets the "min_quality_score" field.
+func (m *FeedMutation) SetMinQualityScore(f float64) {
+	m.min_quality_score = &f
+	m.addmin_quality_score = nil
+}
+
+// MinQualityScore returns the value of the "min_quality_score" field in the mutation.
+func (m *FeedMutation) MinQualityScore() (r float64, exists bool) {
+	v := m.min_quality_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinQualityScore returns the old "min_quality_score" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldMinQualityScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinQualityScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinQualityScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinQualityScore: %w", err)
+	}
+	return oldValue.MinQualityScore, nil
+}
+
+// AddMinQualityScore adds f to the "min_quality_score" field.
+func (m *FeedMutation) AddMinQualityScore(f float64) {
+	if m.addmin_quality_score != nil {
+		*m.addmin_quality_score += f
+	} else {
+		m.addmin_quality_score = &f
+	}
+}
+
+// AddedMinQualityScore returns the value that was added to the "min_quality_score" field in this mutation.
+func (m *FeedMutation) AddedMinQualityScore() (r float64, exists bool) {
+	v := m.addmin_quality_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinQualityScore resets all changes to the "min_quality_score" field.
+func (m *FeedMutation) ResetMinQualityScore() {
+	m.min_quality_score = nil
+	m.addmin_quality_score = nil
+}
+
+// SetSections sets the "sections" field.
+func (m *FeedMutation) SetSections(ss []schema.FeedSection) {
+	m.sections = &ss
+	m.appendsections = nil
+}
+
+// Sections returns the value of the "sections" field in the mutation.
+func (m *FeedMutation) Sections() (r []schema.FeedSection, exists bool) {
+	v := m.sections
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSections returns the old "sections" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldSections(ctx context.Context) (v []schema.FeedSection, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSections is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSections requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSections: %w", err)
+	}
+	return oldValue.Sections, nil
+}
+
+// AppendSections adds ss to the "sections" field.
+func (m *FeedMutation) AppendSections(ss []schema.FeedSection) {
+	m.appendsections = append(m.appendsections, ss...)
+}
+
+// AppendedSections returns the list of values that were appended to the "sections" field in this mutation.
+func (m *FeedMutation) AppendedSections() ([]schema.FeedSection, bool) {
+	if len(m.appendsections) == 0 {
+		return nil, false
+	}
+	return m.appendsections, true
+}
+
+// ClearSections clears the value of the "sections" field.
+func (m *FeedMutation) ClearSections() {
+	m.sections = nil
+	m.appendsections = nil
+	m.clearedFields[feed.FieldSections] = struct{}{}
+}
+
+// SectionsCleared returns if the "sections" field was cleared in this mutation.
+func (m *FeedMutation) SectionsCleared() bool {
+	_, ok := m.clearedFields[feed.FieldSections]
+	return ok
+}
+
+// ResetSections resets all changes to the "sections" field.
+func (m *FeedMutation) ResetSections() {
+	m.sections = nil
+	m.appendsections = nil
+	delete(m.clearedFields, feed.FieldSections)
+}
+
+// SetLanguage sets the "language" field.
+func (m *FeedMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *FeedMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *FeedMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FeedMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FeedMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FeedMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FeedMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FeedMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FeedMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FeedMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FeedMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FeedMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[feed.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FeedMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[feed.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FeedMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, feed.FieldDeletedAt)
+}
+
+// Where appends a list predicates to the FeedMutation builder.
+func (m *FeedMutation) Where(ps ...predicate.Feed) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeedMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeedMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Feed, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeedMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeedMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Feed).
+func (m *FeedMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeedMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.user_id != nil {
+		fields = append(fields, feed.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, feed.FieldName)
+	}
+	if m.icon != nil {
+		fields = append(fields, feed.FieldIcon)
+	}
+	if m.query != nil {
+		fields = append(fields, feed.FieldQuery)
+	}
+	if m.public != nil {
+		fields = append(fields, feed.FieldPublic)
+	}
+	if m.paused != nil {
+		fields = append(fields, feed.FieldPaused)
+	}
+	if m.source_uids != nil {
+		fields = append(fields, feed.FieldSourceUids)
+	}
+	if m.source_weights != nil {
+		fields = append(fields, feed.FieldSourceWeights)
+	}
+	if m.source_overrides != nil {
+		fields = append(fields, feed.FieldSourceOverrides)
+	}
+	if m.source_filters != nil {
+		fields = append(fields, feed.FieldSourceFilters)
+	}
+	if m.min_quality_score != nil {
+		fields = append(fields, feed.FieldMinQualityScore)
+	}
+	if m.sections != nil {
+		fields = append(fields, feed.FieldSections)
+	}
+	if m.language != nil {
+		fields = append(fields, feed.FieldLanguage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, feed.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, feed.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, feed.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeedMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case feed.FieldUserID:
+		return m.UserID()
+	case feed.FieldName:
+		return m.Name()
+	case feed.FieldIcon:
+		return m.Icon()
+	case feed.FieldQuery:
+		return m.Query()
+	case feed.FieldPublic:
+		return m.Public()
+	case feed.FieldPaused:
+		return m.Paused()
+	case feed.FieldSourceUids:
+		return m.SourceUids()
+	case feed.FieldSourceWeights:
+		return m.SourceWeights()
+	case feed.FieldSourceOverrides:
+		return m.SourceOverrides()
+	case feed.FieldSourceFilters:
+		return m.SourceFilters()
+	case feed.FieldMinQualityScore:
+		return m.MinQualityScore()
+	case feed.FieldSections:
+		return m.Sections()
+	case feed.FieldLanguage:
+		return m.Language()
+	case feed.FieldCreatedAt:
+		return m.CreatedAt()
+	case feed.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case feed.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case feed.FieldUserID:
+		return m.OldUserID(ctx)
+	case feed.FieldName:
+		return m.OldName(ctx)
+	case feed.FieldIcon:
+		return m.OldIcon(ctx)
+	case feed.FieldQuery:
+		return m.OldQuery(ctx)
+	case feed.FieldPublic:
+		return m.OldPublic(ctx)
+	case feed.FieldPaused:
+		return m.OldPaused(ctx)
+	case feed.FieldSourceUids:
+		return m.OldSourceUids(ctx)
+	case feed.FieldSourceWeights:
+		return m.OldSourceWeights(ctx)
+	case feed.FieldSourceOverrides:
+		return m.OldSourceOverrides(ctx)
+	case feed.FieldSourceFilters:
+		return m.OldSourceFilters(ctx)
+	case feed.FieldMinQualityScore:
+		return m.OldMinQualityScore(ctx)
+	case feed.FieldSections:
+		return m.OldSections(ctx)
+	case feed.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case feed.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case feed.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case feed.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Feed field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case feed.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case feed.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case feed.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case feed.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case feed.FieldPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublic(v)
+		return nil
+	case feed.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case feed.FieldSourceUids:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceUids(v)
+		return nil
+	case feed.FieldSourceWeights:
+		v, ok := value.(map[string]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceWeights(v)
+		return nil
+	case feed.FieldSourceOverrides:
+		v, ok := value.(map[string]schema.SourceOverride)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceOverrides(v)
+		return nil
+	case feed.FieldSourceFilters:
+		v, ok := value.(map[string]schema.SourceFilter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceFilters(v)
+		return nil
+	case feed.FieldMinQualityScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinQualityScore(v)
+		return nil
+	case feed.FieldSections:
+		v, ok := value.([]schema.FeedSection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSections(v)
+		return nil
+	case feed.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case feed.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case feed.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case feed.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Feed field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeedMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_quality_score != nil {
+		fields = append(fields, feed.FieldMinQualityScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeedMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case feed.FieldMinQualityScore:
+		return m.AddedMinQualityScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case feed.FieldMinQualityScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinQualityScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Feed numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeedMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(feed.FieldSourceWeights) {
+		fields = append(fields, feed.FieldSourceWeights)
+	}
+	if m.FieldCleared(feed.FieldSourceOverrides) {
+		fields = append(fields, feed.FieldSourceOverrides)
+	}
+	if m.FieldCleared(feed.FieldSourceFilters) {
+		fields = append(fields, feed.FieldSourceFilters)
+	}
+	if m.FieldCleared(feed.FieldSections) {
+		fields = append(fields, feed.FieldSections)
+	}
+	if m.FieldCleared(feed.FieldDeletedAt) {
+		fields = append(fields, feed.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeedMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeedMutation) ClearField(name string) error {
+	switch name {
+	case feed.FieldSourceWeights:
+		m.ClearSourceWeights()
+		return nil
+	case feed.FieldSourceOverrides:
+		m.ClearSourceOverrides()
+		return nil
+	case feed.FieldSourceFilters:
+		m.ClearSourceFilters()
+		return nil
+	case feed.FieldSections:
+		m.ClearSections()
+		return nil
+	case feed.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Feed nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeedMutation) ResetField(name string) error {
+	switch name {
+	case feed.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case feed.FieldName:
+		m.ResetName()
+		return nil
+	case feed.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case feed.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case feed.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case feed.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case feed.FieldSourceUids:
+		m.ResetSourceUids()
+		return nil
+	case feed.FieldSourceWeights:
+		m.ResetSourceWeights()
+		return nil
+	case feed.FieldSourceOverrides:
+		m.ResetSourceOverrides()
+		return nil
+	case feed.FieldSourceFilters:
+		m.ResetSourceFilters()
+		return nil
+	case feed.FieldMinQualityScore:
+		m.ResetMinQualityScore()
+		return nil
+	case feed.FieldSections:
+		m.ResetSections()
+		return nil
+	case feed.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case feed.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case feed.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case feed.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Feed field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeedMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeedMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeedMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeedMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeedMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeedMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeedMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Feed unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeedMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Feed edge %s", name)
+}
+
+// FeedPositionMutation represents an operation that mutates the FeedPosition nodes in the graph.
+type FeedPositionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	feed_id       *string
+	position      *int
+	addposition   *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FeedPosition, error)
+	predicates    []predicate.FeedPosition
+}
+
+var _ ent.Mutation = (*FeedPositionMutation)(nil)
+
+// feedpositionOption allows management of the mutation configuration using functional options.
+type feedpositionOption func(*FeedPositionMutation)
+
+// newFeedPositionMutation creates new mutation for the FeedPosition entity.
+func newFeedPositionMutation(c config, op Op, opts ...feedpositionOption) *FeedPositionMutation {
+	m := &FeedPositionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFeedPosition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFeedPositionID sets the ID field of the mutation.
+func withFeedPositionID(id int) feedpositionOption {
+	return func(m *FeedPositionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FeedPosition
+		)
+		m.oldValue = func(ctx context.Context) (*FeedPosition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FeedPosition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFeedPosition sets the old FeedPosition of the mutation.
+func withFeedPosition(node *FeedPosition) feedpositionOption {
+	return func(m *FeedPositionMutation) {
+		m.oldValue = func(context.Context) (*FeedPosition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FeedPositionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FeedPositionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FeedPositionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FeedPositionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FeedPosition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FeedPositionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FeedPositionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the FeedPosition entity.
+// If the FeedPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedPositionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FeedPositionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetFeedID sets the "feed_id" field.
+func (m *FeedPositionMutation) SetFeedID(s string) {
+	m.feed_id = &s
+}
+
+// FeedID returns the value of the "feed_id" field in the mutation.
+func (m *FeedPositionMutation) FeedID() (r string, exists bool) {
+	v := m.feed_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeedID returns the old "feed_id" field's value of the FeedPosition entity.
+// If the FeedPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedPositionMutation) OldFeedID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeedID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeedID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeedID: %w", err)
+	}
+	return oldValue.FeedID, nil
+}
+
+// ResetFeedID resets all changes to the "feed_id" field.
+func (m *FeedPositionMutation) ResetFeedID() {
+	m.feed_id = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *FeedPositionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *FeedPositionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the FeedPosition entity.
+// If the FeedPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedPositionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *FeedPositionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *FeedPositionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *FeedPositionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// Where appends a list predicates to the FeedPositionMutation builder.
+func (m *FeedPositionMutation) Where(ps ...predicate.FeedPosition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeedPositionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeedPositionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FeedPosition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeedPositionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeedPositionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FeedPosition).
+func (m *FeedPositionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeedPositionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, feedposition.FieldUserID)
+	}
+	if m.feed_id != nil {
+		fields = append(fields, feedposition.FieldFeedID)
+	}
+	if m.position != nil {
+		fields = append(fields, feedposition.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeedPositionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case feedposition.FieldUserID:
+		return m.UserID()
+	case feedposition.FieldFeedID:
+		return m.FeedID()
+	case feedposition.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeedPositionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case feedposition.FieldUserID:
+		return m.OldUserID(ctx)
+	case feedposition.FieldFeedID:
+		return m.OldFeedID(ctx)
+	case feedposition.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown FeedPosition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedPositionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case feedposition.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case feedposition.FieldFeedID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeedID(v)
+		return nil
+	case feedposition.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeedPosition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeedPositionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, feedposition.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeedPositionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case feedposition.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedPositionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case feedposition.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FeedPosition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeedPositionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeedPositionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeedPositionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FeedPosition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeedPositionMutation) ResetField(name string) error {
+	switch name {
+	case feedposition.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case feedposition.FieldFeedID:
+		m.ResetFeedID()
+		return nil
+	case feedposition.FieldPosition:
+		m.ResetPosition()
+		return nil
+	}
+	return fmt.Errorf("unknown FeedPosition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeedPositionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeedPositionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeedPositionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeedPositionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeedPositionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeedPositionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeedPositionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FeedPosition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeedPositionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FeedPosition edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	key           *string
+	resource_id   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyKey, error)
+	predicates    []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id int) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *IdempotencyKeyMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *IdempotencyKeyMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *IdempotencyKeyMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *IdempotencyKeyMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *IdempotencyKeyMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *IdempotencyKeyMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, idempotencykey.FieldUserID)
+	}
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, idempotencykey.FieldResourceID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldUserID:
+		return m.UserID()
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldResourceID:
+		return m.ResourceID()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldUserID:
+		return m.OldUserID(ctx)
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// ReadActivityMutation represents an operation that mutates the ReadActivity nodes in the graph.
+type ReadActivityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	activity_id   *string
+	read_at       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ReadActivity, error)
+	predicates    []predicate.ReadActivity
+}
+
+var _ ent.Mutation = (*ReadActivityMutation)(nil)
+
+// readactivityOption allows management of the mutation configuration using functional options.
+type readactivityOption func(*ReadActivityMutation)
+
+// newReadActivityMutation creates new mutation for the ReadActivity entity.
+func newReadActivityMutation(c config, op Op, opts ...readactivityOption) *ReadActivityMutation {
+	m := &ReadActivityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReadActivity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReadActivityID sets the ID field of the mutation.
+func withReadActivityID(id int) readactivityOption {
+	return func(m *ReadActivityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReadActivity
+		)
+		m.oldValue = func(ctx context.Context) (*ReadActivity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReadActivity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReadActivity sets the old ReadActivity of the mutation.
+func withReadActivity(node *ReadActivity) readactivityOption {
+	return func(m *ReadActivityMutation) {
+		m.oldValue = func(context.Context) (*ReadActivity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReadActivityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReadActivityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReadActivityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReadActivityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReadActivity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReadActivityMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReadActivityMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReadActivity entity.
+// If the ReadActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadActivityMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReadActivityMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *ReadActivityMutation) SetActivityID(s string) {
+	m.activity_id = &s
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *ReadActivityMutation) ActivityID() (r string, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the ReadActivity entity.
+// If the ReadActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadActivityMutation) OldActivityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+	}
+	return oldValue.ActivityID, nil
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *ReadActivityMutation) ResetActivityID() {
+	m.activity_id = nil
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *ReadActivityMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *ReadActivityMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the ReadActivity entity.
+// If the ReadActivity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadActivityMutation) OldReadAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *ReadActivityMutation) ResetReadAt() {
+	m.read_at = nil
+}
+
+// Where appends a list predicates to the ReadActivityMutation builder.
+func (m *ReadActivityMutation) Where(ps ...predicate.ReadActivity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReadActivityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReadActivityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReadActivity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReadActivityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReadActivityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReadActivity).
+func (m *ReadActivityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReadActivityMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, readactivity.FieldUserID)
+	}
+	if m.activity_id != nil {
+		fields = append(fields, readactivity.FieldActivityID)
+	}
+	if m.read_at != nil {
+		fields = append(fields, readactivity.FieldReadAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReadActivityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case readactivity.FieldUserID:
+		return m.UserID()
+	case readactivity.FieldActivityID:
+		return m.ActivityID()
+	case readactivity.FieldReadAt:
+		return m.ReadAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReadActivityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case readactivity.FieldUserID:
+		return m.OldUserID(ctx)
+	case readactivity.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case readactivity.FieldReadAt:
+		return m.OldReadAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReadActivity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadActivityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case readactivity.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case readactivity.FieldActivityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case readactivity.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadActivity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReadActivityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReadActivityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadActivityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ReadActivity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReadActivityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReadActivityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReadActivityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReadActivity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReadActivityMutation) ResetField(name string) error {
+	switch name {
+	case readactivity.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case readactivity.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case readactivity.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadActivity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReadActivityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReadActivityMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReadActivityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReadActivityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReadActivityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReadActivityMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReadActivityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ReadActivity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReadActivityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ReadActivity edge %s", name)
+}
+
+// SourceMutation represents an operation that mutates the Source nodes in the graph.
+type SourceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	url           *string
+	_type         *string
+	raw_json      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Source, error)
+	predicates    []predicate.Source
+}
+
+var _ ent.Mutation = (*SourceMutation)(nil)
+
+// sourceOption allows management of the mutation configuration using functional options.
+type sourceOption func(*SourceMutation)
+
+// newSourceMutation creates new mutation for the Source entity.
+func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
+	m := &SourceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSource,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceID sets the ID field of the mutation.
+func withSourceID(id string) sourceOption {
+	return func(m *SourceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Source
+		)
+		m.oldValue = func(ctx context.Context) (*Source, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Source.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSource sets the old Source of the mutation.
+func withSource(node *Source) sourceOption {
+	return func(m *SourceMutation) {
+		m.oldValue = func(context.Context) (*Source, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Source entities.
+func (m *SourceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Source.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SourceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SourceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SourceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *SourceMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *SourceMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *SourceMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetType sets the "type" field.
+func (m *SourceMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SourceMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SourceMutation) ResetType() {
+	m._type = nil
+}
+
+// SetRawJSON sets the "raw_json" field.
+func (m *SourceMutation) SetRawJSON(s string) {
+	m.raw_json = &s
+}
+
+// RawJSON returns the value of the "raw_json" field in the mutation.
+func (m *SourceMutation) RawJSON() (r string, exists bool) {
+	v := m.raw_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawJSON returns the old "raw_json" field's value of the Source entity.
+// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+	}
+	return oldValue.RawJSON, nil
+}
+
+// ResetRawJSON resets all changes to the "raw_json" field.
+func (m *SourceMutation) ResetRawJSON() {
+	m.raw_json = nil
+}
+
+// Where appends a list predicates to the SourceMutation builder.
+func (m *SourceMutation) Where(ps ...predicate.Source) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SourceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Source, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SourceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SourceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Source).
+func (m *SourceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, source.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, source.FieldURL)
+	}
+	if m._type != nil {
+		fields = append(fields, source.FieldType)
+	}
+	if m.raw_json != nil {
+		fields = append(fields, source.FieldRawJSON)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case source.FieldName:
+		return m.Name()
+	case source.FieldURL:
+		return m.URL()
+	case source.FieldType:
+		return m.GetType()
+	case source.FieldRawJSON:
+		return m.RawJSON()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case source.FieldName:
+		return m.OldName(ctx)
+	case source.FieldURL:
+		return m.OldURL(ctx)
+	case source.FieldType:
+		return m.OldType(ctx)
+	case source.FieldRawJSON:
+		return m.OldRawJSON(ctx)
+	}
+	return nil, fmt.Errorf("unknown Source field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case source.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case source.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case source.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case source.FieldRawJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawJSON(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Source field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Source numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Source nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceMutation) ResetField(name string) error {
+	switch name {
+	case source.FieldName:
+		m.ResetName()
+		return nil
+	case source.FieldURL:
+		m.ResetURL()
+		return nil
+	case source.FieldType:
+		m.ResetType()
+		return nil
+	case source.FieldRawJSON:
+		m.ResetRawJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown Source field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Source unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Source edge %s", name)
+}
+
+// SourceStateMutation represents an operation that mutates the SourceState nodes in the graph.
+type SourceStateMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	last_new_activity_at *time.Time
+	stale_since          *time.Time
+	disabled_reason      *string
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*SourceState, error)
+	predicates           []predicate.SourceState
+}
+
+var _ ent.Mutation = (*SourceStateMutation)(nil)
+
+// sourcestateOption allows management of the mutation configuration using functional options.
+type sourcestateOption func(*SourceStateMutation)
+
+// newSourceStateMutation creates new mutation for the SourceState entity.
+func newSourceStateMutation(c config, op Op, opts ...sourcestateOption) *SourceStateMutation {
+	m := &SourceStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceStateID sets the ID field of the mutation.
+func withSourceStateID(id string) sourcestateOption {
+	return func(m *SourceStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceState
+		)
+		m.oldValue = func(ctx context.Context) (*SourceState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceState sets the old SourceState of the mutation.
+func withSourceState(node *SourceState) sourcestateOption {
+	return func(m *SourceStateMutation) {
+		m.oldValue = func(context.Context) (*SourceState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SourceState entities.
+func (m *SourceStateMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceStateMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceStateMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLastNewActivityAt sets the "last_new_activity_at" field.
+func (m *SourceStateMutation) SetLastNewActivityAt(t time.Time) {
+	m.last_new_activity_at = &t
+}
+
+// LastNewActivityAt returns the value of the "last_new_activity_at" field in the mutation.
+func (m *SourceStateMutation) LastNewActivityAt() (r time.Time, exists bool) {
+	v := m.last_new_activity_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastNewActivityAt returns the old "last_new_activity_at" field's value of the SourceState entity.
+// If the SourceState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceStateMutation) OldLastNewActivityAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastNewActivityAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceUids requires an ID field in the mutation")
+		return v, errors.New("OldLastNewActivityAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceUids: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastNewActivityAt: %w", err)
 	}
-	return oldValue.SourceUids, nil
+	return oldValue.LastNewActivityAt, nil
 }
 
-// AppendSourceUids adds s to the "source_uids" field.
-func (m *FeedMutation) AppendSourceUids(s []string) {
-	m.appendsource_uids = append(m.appendsource_uids, s...)
+// ResetLastNewActivityAt resets all changes to the "last_new_activity_at" field.
+func (m *SourceStateMutation) ResetLastNewActivityAt() {
+	m.last_new_activity_at = nil
 }
 
-// AppendedSourceUids returns the list of values that were appended to the "source_uids" field in this mutation.
-func (m *FeedMutation) AppendedSourceUids() ([]string, bool) {
-	if len(m.appendsource_uids) == 0 {
-		return nil, false
+// SetStaleSince sets the "stale_since" field.
+func (m *SourceStateMutation) SetStaleSince(t time.Time) {
+	m.stale_since = &t
+}
+
+// StaleSince returns the value of the "stale_since" field in the mutation.
+func (m *SourceStateMutation) StaleSince() (r time.Time, exists bool) {
+	v := m.stale_since
+	if v == nil {
+		return
 	}
-	return m.appendsource_uids, true
+	return *v, true
 }
 
-// ResetSourceUids resets all changes to the "source_uids" field.
-func (m *FeedMutation) ResetSourceUids() {
-	m.source_uids = nil
-	m.appendsource_uids = nil
+// OldStaleSince returns the old "stale_since" field's value of the SourceState entity.
+// If the SourceState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceStateMutation) OldStaleSince(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStaleSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStaleSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStaleSince: %w", err)
+	}
+	return oldValue.StaleSince, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FeedMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearStaleSince clears the value of the "stale_since" field.
+func (m *SourceStateMutation) ClearStaleSince() {
+	m.stale_since = nil
+	m.clearedFields[sourcestate.FieldStaleSince] = struct{}{}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FeedMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// StaleSinceCleared returns if the "stale_since" field was cleared in this mutation.
+func (m *SourceStateMutation) StaleSinceCleared() bool {
+	_, ok := m.clearedFields[sourcestate.FieldStaleSince]
+	return ok
+}
+
+// ResetStaleSince resets all changes to the "stale_since" field.
+func (m *SourceStateMutation) ResetStaleSince() {
+	m.stale_since = nil
+	delete(m.clearedFields, sourcestate.FieldStaleSince)
+}
+
+// SetDisabledReason sets the "disabled_reason" field.
+func (m *SourceStateMutation) SetDisabledReason(s string) {
+	m.disabled_reason = &s
+}
+
+// DisabledReason returns the value of the "disabled_reason" field in the mutation.
+func (m *SourceStateMutation) DisabledReason() (r string, exists bool) {
+	v := m.disabled_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// OldDisabledReason returns the old "disabled_reason" field's value of the SourceState entity.
+// If the SourceState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SourceStateMutation) OldDisabledReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisabledReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDisabledReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisabledReason: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.DisabledReason, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FeedMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDisabledReason resets all changes to the "disabled_reason" field.
+func (m *SourceStateMutation) ResetDisabledReason() {
+	m.disabled_reason = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FeedMutation) SetUpdatedAt(t time.Time) {
+func (m *SourceStateMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FeedMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SourceStateMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1652,10 +7602,10 @@ func (m *FeedMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Feed entity.
-// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SourceState entity.
+// If the SourceState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SourceStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1670,19 +7620,19 @@ func (m *FeedMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FeedMutation) ResetUpdatedAt() {
+func (m *SourceStateMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the FeedMutation builder.
-func (m *FeedMutation) Where(ps ...predicate.Feed) {
+// Where appends a list predicates to the SourceStateMutation builder.
+func (m *SourceStateMutation) Where(ps ...predicate.SourceState) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FeedMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SourceStateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FeedMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Feed, len(ps))
+func (m *SourceStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceState, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1690,48 +7640,36 @@ func (m *FeedMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FeedMutation) Op() Op {
+func (m *SourceStateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FeedMutation) SetOp(op Op) {
+func (m *SourceStateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Feed).
-func (m *FeedMutation) Type() string {
+// Type returns the node type of this mutation (SourceState).
+func (m *SourceStateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FeedMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.user_id != nil {
-		fields = append(fields, feed.FieldUserID)
-	}
-	if m.name != nil {
-		fields = append(fields, feed.FieldName)
-	}
-	if m.icon != nil {
-		fields = append(fields, feed.FieldIcon)
-	}
-	if m.query != nil {
-		fields = append(fields, feed.FieldQuery)
-	}
-	if m.public != nil {
-		fields = append(fields, feed.FieldPublic)
+func (m *SourceStateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.last_new_activity_at != nil {
+		fields = append(fields, sourcestate.FieldLastNewActivityAt)
 	}
-	if m.source_uids != nil {
-		fields = append(fields, feed.FieldSourceUids)
+	if m.stale_since != nil {
+		fields = append(fields, sourcestate.FieldStaleSince)
 	}
-	if m.created_at != nil {
-		fields = append(fields, feed.FieldCreatedAt)
+	if m.disabled_reason != nil {
+		fields = append(fields, sourcestate.FieldDisabledReason)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, feed.FieldUpdatedAt)
+		fields = append(fields, sourcestate.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1739,23 +7677,15 @@ func (m *FeedMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FeedMutation) Field(name string) (ent.Value, bool) {
+func (m *SourceStateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case feed.FieldUserID:
-		return m.UserID()
-	case feed.FieldName:
-		return m.Name()
-	case feed.FieldIcon:
-		return m.Icon()
-	case feed.FieldQuery:
-		return m.Query()
-	case feed.FieldPublic:
-		return m.Public()
-	case feed.FieldSourceUids:
-		return m.SourceUids()
-	case feed.FieldCreatedAt:
-		return m.CreatedAt()
-	case feed.FieldUpdatedAt:
+	case sourcestate.FieldLastNewActivityAt:
+		return m.LastNewActivityAt()
+	case sourcestate.FieldStaleSince:
+		return m.StaleSince()
+	case sourcestate.FieldDisabledReason:
+		return m.DisabledReason()
+	case sourcestate.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1764,83 +7694,47 @@ func (m *FeedMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FeedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SourceStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case feed.FieldUserID:
-		return m.OldUserID(ctx)
-	case feed.FieldName:
-		return m.OldName(ctx)
-	case feed.FieldIcon:
-		return m.OldIcon(ctx)
-	case feed.FieldQuery:
-		return m.OldQuery(ctx)
-	case feed.FieldPublic:
-		return m.OldPublic(ctx)
-	case feed.FieldSourceUids:
-		return m.OldSourceUids(ctx)
-	case feed.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case feed.FieldUpdatedAt:
+	case sourcestate.FieldLastNewActivityAt:
+		return m.OldLastNewActivityAt(ctx)
+	case sourcestate.FieldStaleSince:
+		return m.OldStaleSince(ctx)
+	case sourcestate.FieldDisabledReason:
+		return m.OldDisabledReason(ctx)
+	case sourcestate.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Feed field %s", name)
+	return nil, fmt.Errorf("unknown SourceState field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FeedMutation) SetField(name string, value ent.Value) error {
+func (m *SourceStateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case feed.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case feed.FieldName:
-		v, ok := value.(string)
+	case sourcestate.FieldLastNewActivityAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetLastNewActivityAt(v)
 		return nil
-	case feed.FieldIcon:
-		v, ok := value.(string)
+	case sourcestate.FieldStaleSince:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIcon(v)
+		m.SetStaleSince(v)
 		return nil
-	case feed.FieldQuery:
+	case sourcestate.FieldDisabledReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuery(v)
-		return nil
-	case feed.FieldPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublic(v)
-		return nil
-	case feed.FieldSourceUids:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceUids(v)
-		return nil
-	case feed.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+		m.SetDisabledReason(v)
 		return nil
-	case feed.FieldUpdatedAt:
+	case sourcestate.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1848,157 +7742,152 @@ func (m *FeedMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Feed field %s", name)
+	return fmt.Errorf("unknown SourceState field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FeedMutation) AddedFields() []string {
+func (m *SourceStateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FeedMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SourceStateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FeedMutation) AddField(name string, value ent.Value) error {
+func (m *SourceStateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Feed numeric field %s", name)
+	return fmt.Errorf("unknown SourceState numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FeedMutation) ClearedFields() []string {
-	return nil
+func (m *SourceStateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sourcestate.FieldStaleSince) {
+		fields = append(fields, sourcestate.FieldStaleSince)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FeedMutation) FieldCleared(name string) bool {
+func (m *SourceStateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FeedMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Feed nullable field %s", name)
+func (m *SourceStateMutation) ClearField(name string) error {
+	switch name {
+	case sourcestate.FieldStaleSince:
+		m.ClearStaleSince()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceState nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FeedMutation) ResetField(name string) error {
+func (m *SourceStateMutation) ResetField(name string) error {
 	switch name {
-	case feed.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case feed.FieldName:
-		m.ResetName()
-		return nil
-	case feed.FieldIcon:
-		m.ResetIcon()
-		return nil
-	case feed.FieldQuery:
-		m.ResetQuery()
-		return nil
-	case feed.FieldPublic:
-		m.ResetPublic()
+	case sourcestate.FieldLastNewActivityAt:
+		m.ResetLastNewActivityAt()
 		return nil
-	case feed.FieldSourceUids:
-		m.ResetSourceUids()
+	case sourcestate.FieldStaleSince:
+		m.ResetStaleSince()
 		return nil
-	case feed.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case sourcestate.FieldDisabledReason:
+		m.ResetDisabledReason()
 		return nil
-	case feed.FieldUpdatedAt:
+	case sourcestate.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Feed field %s", name)
+	return fmt.Errorf("unknown SourceState field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FeedMutation) AddedEdges() []string {
+func (m *SourceStateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FeedMutation) AddedIDs(name string) []ent.Value {
+func (m *SourceStateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FeedMutation) RemovedEdges() []string {
+func (m *SourceStateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FeedMutation) RemovedIDs(name string) []ent.Value {
+func (m *SourceStateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FeedMutation) ClearedEdges() []string {
+func (m *SourceStateMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FeedMutation) EdgeCleared(name string) bool {
+func (m *SourceStateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *FeedMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Feed unique edge %s", name)
+// if that edge is not defined in the schema.
+func (m *SourceStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SourceState unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FeedMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Feed edge %s", name)
+func (m *SourceStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SourceState edge %s", name)
 }
 
-// SourceMutation represents an operation that mutates the Source nodes in the graph.
-type SourceMutation struct {
+// UserProvisionMutation represents an operation that mutates the UserProvision nodes in the graph.
+type UserProvisionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	name          *string
-	url           *string
-	_type         *string
-	raw_json      *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Source, error)
-	predicates    []predicate.Source
+	op             Op
+	typ            string
+	id             *int
+	user_id        *string
+	provisioned_at *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*UserProvision, error)
+	predicates     []predicate.UserProvision
 }
 
-var _ ent.Mutation = (*SourceMutation)(nil)
+var _ ent.Mutation = (*UserProvisionMutation)(nil)
 
-// sourceOption allows management of the mutation configuration using functional options.
-type sourceOption func(*SourceMutation)
+// userprovisionOption allows management of the mutation configuration using functional options.
+type userprovisionOption func(*UserProvisionMutation)
 
-// newSourceMutation creates new mutation for the Source entity.
-func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
-	m := &SourceMutation{
+// newUserProvisionMutation creates new mutation for the UserProvision entity.
+func newUserProvisionMutation(c config, op Op, opts ...userprovisionOption) *UserProvisionMutation {
+	m := &UserProvisionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSource,
+		typ:           TypeUserProvision,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2007,20 +7896,20 @@ func newSourceMutation(c config, op Op, opts ...sourceOption) *SourceMutation {
 	return m
 }
 
-// withSourceID sets the ID field of the mutation.
-func withSourceID(id string) sourceOption {
-	return func(m *SourceMutation) {
+// withUserProvisionID sets the ID field of the mutation.
+func withUserProvisionID(id int) userprovisionOption {
+	return func(m *UserProvisionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Source
+			value *UserProvision
 		)
-		m.oldValue = func(ctx context.Context) (*Source, error) {
+		m.oldValue = func(ctx context.Context) (*UserProvision, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Source.Get(ctx, id)
+					value, err = m.Client().UserProvision.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2029,10 +7918,10 @@ func withSourceID(id string) sourceOption {
 	}
 }
 
-// withSource sets the old Source of the mutation.
-func withSource(node *Source) sourceOption {
-	return func(m *SourceMutation) {
-		m.oldValue = func(context.Context) (*Source, error) {
+// withUserProvision sets the old UserProvision of the mutation.
+func withUserProvision(node *UserProvision) userprovisionOption {
+	return func(m *UserProvisionMutation) {
+		m.oldValue = func(context.Context) (*UserProvision, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2041,7 +7930,7 @@ func withSource(node *Source) sourceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SourceMutation) Client() *Client {
+func (m UserProvisionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2049,7 +7938,7 @@ func (m SourceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SourceMutation) Tx() (*Tx, error) {
+func (m UserProvisionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2058,15 +7947,9 @@ func (m SourceMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Source entities.
-func (m *SourceMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SourceMutation) ID() (id string, exists bool) {
+func (m *UserProvisionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2077,174 +7960,102 @@ func (m *SourceMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SourceMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserProvisionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Source.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserProvision.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *SourceMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *SourceMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *SourceMutation) ResetName() {
-	m.name = nil
-}
-
-// SetURL sets the "url" field.
-func (m *SourceMutation) SetURL(s string) {
-	m.url = &s
-}
-
-// URL returns the value of the "url" field in the mutation.
-func (m *SourceMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldURL returns the old "url" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
-	}
-	return oldValue.URL, nil
-}
-
-// ResetURL resets all changes to the "url" field.
-func (m *SourceMutation) ResetURL() {
-	m.url = nil
-}
-
-// SetType sets the "type" field.
-func (m *SourceMutation) SetType(s string) {
-	m._type = &s
+// SetUserID sets the "user_id" field.
+func (m *UserProvisionMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *SourceMutation) GetType() (r string, exists bool) {
-	v := m._type
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserProvisionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserProvision entity.
+// If the UserProvision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *UserProvisionMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.UserID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *SourceMutation) ResetType() {
-	m._type = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserProvisionMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetRawJSON sets the "raw_json" field.
-func (m *SourceMutation) SetRawJSON(s string) {
-	m.raw_json = &s
+// SetProvisionedAt sets the "provisioned_at" field.
+func (m *UserProvisionMutation) SetProvisionedAt(t time.Time) {
+	m.provisioned_at = &t
 }
 
-// RawJSON returns the value of the "raw_json" field in the mutation.
-func (m *SourceMutation) RawJSON() (r string, exists bool) {
-	v := m.raw_json
+// ProvisionedAt returns the value of the "provisioned_at" field in the mutation.
+func (m *UserProvisionMutation) ProvisionedAt() (r time.Time, exists bool) {
+	v := m.provisioned_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawJSON returns the old "raw_json" field's value of the Source entity.
-// If the Source object wasn't provided to the builder, the object is fetched from the database.
+// OldProvisionedAt returns the old "provisioned_at" field's value of the UserProvision entity.
+// If the UserProvision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceMutation) OldRawJSON(ctx context.Context) (v string, err error) {
+func (m *UserProvisionMutation) OldProvisionedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawJSON is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvisionedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawJSON requires an ID field in the mutation")
+		return v, errors.New("OldProvisionedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvisionedAt: %w", err)
 	}
-	return oldValue.RawJSON, nil
+	return oldValue.ProvisionedAt, nil
 }
 
-// ResetRawJSON resets all changes to the "raw_json" field.
-func (m *SourceMutation) ResetRawJSON() {
-	m.raw_json = nil
+// ResetProvisionedAt resets all changes to the "provisioned_at" field.
+func (m *UserProvisionMutation) ResetProvisionedAt() {
+	m.provisioned_at = nil
 }
 
-// Where appends a list predicates to the SourceMutation builder.
-func (m *SourceMutation) Where(ps ...predicate.Source) {
+// Where appends a list predicates to the UserProvisionMutation builder.
+func (m *UserProvisionMutation) Where(ps ...predicate.UserProvision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SourceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserProvisionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Source, len(ps))
+func (m *UserProvisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserProvision, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2252,36 +8063,30 @@ func (m *SourceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SourceMutation) Op() Op {
+func (m *UserProvisionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SourceMutation) SetOp(op Op) {
+func (m *UserProvisionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Source).
-func (m *SourceMutation) Type() string {
+// Type returns the node type of this mutation (UserProvision).
+func (m *UserProvisionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SourceMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, source.FieldName)
-	}
-	if m.url != nil {
-		fields = append(fields, source.FieldURL)
-	}
-	if m._type != nil {
-		fields = append(fields, source.FieldType)
+func (m *UserProvisionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.user_id != nil {
+		fields = append(fields, userprovision.FieldUserID)
 	}
-	if m.raw_json != nil {
-		fields = append(fields, source.FieldRawJSON)
+	if m.provisioned_at != nil {
+		fields = append(fields, userprovision.FieldProvisionedAt)
 	}
 	return fields
 }
@@ -2289,16 +8094,12 @@ func (m *SourceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SourceMutation) Field(name string) (ent.Value, bool) {
+func (m *UserProvisionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case source.FieldName:
-		return m.Name()
-	case source.FieldURL:
-		return m.URL()
-	case source.FieldType:
-		return m.GetType()
-	case source.FieldRawJSON:
-		return m.RawJSON()
+	case userprovision.FieldUserID:
+		return m.UserID()
+	case userprovision.FieldProvisionedAt:
+		return m.ProvisionedAt()
 	}
 	return nil, false
 }
@@ -2306,162 +8107,138 @@ func (m *SourceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserProvisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case source.FieldName:
-		return m.OldName(ctx)
-	case source.FieldURL:
-		return m.OldURL(ctx)
-	case source.FieldType:
-		return m.OldType(ctx)
-	case source.FieldRawJSON:
-		return m.OldRawJSON(ctx)
+	case userprovision.FieldUserID:
+		return m.OldUserID(ctx)
+	case userprovision.FieldProvisionedAt:
+		return m.OldProvisionedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Source field %s", name)
+	return nil, fmt.Errorf("unknown UserProvision field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SourceMutation) SetField(name string, value ent.Value) error {
+func (m *UserProvisionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case source.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case source.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case source.FieldType:
+	case userprovision.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case source.FieldRawJSON:
-		v, ok := value.(string)
+	case userprovision.FieldProvisionedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRawJSON(v)
+		m.SetProvisionedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Source field %s", name)
+	return fmt.Errorf("unknown UserProvision field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SourceMutation) AddedFields() []string {
+func (m *UserProvisionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SourceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserProvisionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SourceMutation) AddField(name string, value ent.Value) error {
+func (m *UserProvisionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Source numeric field %s", name)
+	return fmt.Errorf("unknown UserProvision numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SourceMutation) ClearedFields() []string {
+func (m *UserProvisionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SourceMutation) FieldCleared(name string) bool {
+func (m *UserProvisionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SourceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Source nullable field %s", name)
+func (m *UserProvisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserProvision nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SourceMutation) ResetField(name string) error {
+func (m *UserProvisionMutation) ResetField(name string) error {
 	switch name {
-	case source.FieldName:
-		m.ResetName()
-		return nil
-	case source.FieldURL:
-		m.ResetURL()
-		return nil
-	case source.FieldType:
-		m.ResetType()
+	case userprovision.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case source.FieldRawJSON:
-		m.ResetRawJSON()
+	case userprovision.FieldProvisionedAt:
+		m.ResetProvisionedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Source field %s", name)
+	return fmt.Errorf("unknown UserProvision field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SourceMutation) AddedEdges() []string {
+func (m *UserProvisionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SourceMutation) AddedIDs(name string) []ent.Value {
+func (m *UserProvisionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SourceMutation) RemovedEdges() []string {
+func (m *UserProvisionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SourceMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserProvisionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SourceMutation) ClearedEdges() []string {
+func (m *UserProvisionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SourceMutation) EdgeCleared(name string) bool {
+func (m *UserProvisionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SourceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Source unique edge %s", name)
+func (m *UserProvisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserProvision unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SourceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Source edge %s", name)
+func (m *UserProvisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserProvision edge %s", name)
 }
@@ -9,8 +9,38 @@ import (
 // Activity is the predicate function for activity builders.
 type Activity func(*sql.Selector)
 
+// ActivityFeedback is the predicate function for activityfeedback builders.
+type ActivityFeedback func(*sql.Selector)
+
+// ArticleText is the predicate function for articletext builders.
+type ArticleText func(*sql.Selector)
+
+// Collection is the predicate function for collection builders.
+type Collection func(*sql.Selector)
+
+// CollectionActivity is the predicate function for collectionactivity builders.
+type CollectionActivity func(*sql.Selector)
+
+// FailedActivity is the predicate function for failedactivity builders.
+type FailedActivity func(*sql.Selector)
+
 // Feed is the predicate function for feed builders.
 type Feed func(*sql.Selector)
 
+// FeedPosition is the predicate function for feedposition builders.
+type FeedPosition func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// ReadActivity is the predicate function for readactivity builders.
+type ReadActivity func(*sql.Selector)
+
 // Source is the predicate function for source builders.
 type Source func(*sql.Selector)
+
+// SourceState is the predicate function for sourcestate builders.
+type SourceState func(*sql.Selector)
+
+// UserProvision is the predicate function for userprovision builders.
+type UserProvision func(*sql.Selector)
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readactivity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldUserID, v))
+}
+
+// ActivityID applies equality check predicate on the "activity_id" field. It's identical to ActivityIDEQ.
+func ActivityID(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldActivityID, v))
+}
+
+// ReadAt applies equality check predicate on the "read_at" field. It's identical to ReadAtEQ.
+func ReadAt(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldReadAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ActivityIDEQ applies the EQ predicate on the "activity_id" field.
+func ActivityIDEQ(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldActivityID, v))
+}
+
+// ActivityIDNEQ applies the NEQ predicate on the "activity_id" field.
+func ActivityIDNEQ(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNEQ(FieldActivityID, v))
+}
+
+// ActivityIDIn applies the In predicate on the "activity_id" field.
+func ActivityIDIn(vs ...string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldIn(FieldActivityID, vs...))
+}
+
+// ActivityIDNotIn applies the NotIn predicate on the "activity_id" field.
+func ActivityIDNotIn(vs ...string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNotIn(FieldActivityID, vs...))
+}
+
+// ActivityIDGT applies the GT predicate on the "activity_id" field.
+func ActivityIDGT(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGT(FieldActivityID, v))
+}
+
+// ActivityIDGTE applies the GTE predicate on the "activity_id" field.
+func ActivityIDGTE(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGTE(FieldActivityID, v))
+}
+
+// ActivityIDLT applies the LT predicate on the "activity_id" field.
+func ActivityIDLT(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLT(FieldActivityID, v))
+}
+
+// ActivityIDLTE applies the LTE predicate on the "activity_id" field.
+func ActivityIDLTE(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLTE(FieldActivityID, v))
+}
+
+// ActivityIDContains applies the Contains predicate on the "activity_id" field.
+func ActivityIDContains(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldContains(FieldActivityID, v))
+}
+
+// ActivityIDHasPrefix applies the HasPrefix predicate on the "activity_id" field.
+func ActivityIDHasPrefix(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldHasPrefix(FieldActivityID, v))
+}
+
+// ActivityIDHasSuffix applies the HasSuffix predicate on the "activity_id" field.
+func ActivityIDHasSuffix(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldHasSuffix(FieldActivityID, v))
+}
+
+// ActivityIDEqualFold applies the EqualFold predicate on the "activity_id" field.
+func ActivityIDEqualFold(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEqualFold(FieldActivityID, v))
+}
+
+// ActivityIDContainsFold applies the ContainsFold predicate on the "activity_id" field.
+func ActivityIDContainsFold(v string) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldContainsFold(FieldActivityID, v))
+}
+
+// ReadAtEQ applies the EQ predicate on the "read_at" field.
+func ReadAtEQ(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ReadAtNEQ applies the NEQ predicate on the "read_at" field.
+func ReadAtNEQ(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNEQ(FieldReadAt, v))
+}
+
+// ReadAtIn applies the In predicate on the "read_at" field.
+func ReadAtIn(vs ...time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldIn(FieldReadAt, vs...))
+}
+
+// ReadAtNotIn applies the NotIn predicate on the "read_at" field.
+func ReadAtNotIn(vs ...time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldNotIn(FieldReadAt, vs...))
+}
+
+// ReadAtGT applies the GT predicate on the "read_at" field.
+func ReadAtGT(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGT(FieldReadAt, v))
+}
+
+// ReadAtGTE applies the GTE predicate on the "read_at" field.
+func ReadAtGTE(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldGTE(FieldReadAt, v))
+}
+
+// ReadAtLT applies the LT predicate on the "read_at" field.
+func ReadAtLT(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLT(FieldReadAt, v))
+}
+
+// ReadAtLTE applies the LTE predicate on the "read_at" field.
+func ReadAtLTE(v time.Time) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.FieldLTE(FieldReadAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadActivity) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadActivity) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadActivity) predicate.ReadActivity {
+	return predicate.ReadActivity(sql.NotPredicates(p))
+}
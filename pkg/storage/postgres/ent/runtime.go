@@ -4,6 +4,8 @@ package ent
 
 import (
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/activity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/failedactivity"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/schema"
 )
 
@@ -13,12 +15,44 @@ import (
 func init() {
 	activityFields := schema.Activity{}.Fields()
 	_ = activityFields
+	// activityDescContentHash is the schema descriptor for content_hash field.
+	activityDescContentHash := activityFields[12].Descriptor()
+	// activity.DefaultContentHash holds the default value on creation for the content_hash field.
+	activity.DefaultContentHash = activityDescContentHash.Default.(string)
+	// activityDescEmbeddingModel is the schema descriptor for embedding_model field.
+	activityDescEmbeddingModel := activityFields[13].Descriptor()
+	// activity.DefaultEmbeddingModel holds the default value on creation for the embedding_model field.
+	activity.DefaultEmbeddingModel = activityDescEmbeddingModel.Default.(string)
 	// activityDescSocialScore is the schema descriptor for social_score field.
-	activityDescSocialScore := activityFields[14].Descriptor()
+	activityDescSocialScore := activityFields[16].Descriptor()
 	// activity.DefaultSocialScore holds the default value on creation for the social_score field.
 	activity.DefaultSocialScore = activityDescSocialScore.Default.(float64)
+	// activityDescQualityScore is the schema descriptor for quality_score field.
+	activityDescQualityScore := activityFields[17].Descriptor()
+	// activity.DefaultQualityScore holds the default value on creation for the quality_score field.
+	activity.DefaultQualityScore = activityDescQualityScore.Default.(float64)
 	// activityDescUpdateCount is the schema descriptor for update_count field.
-	activityDescUpdateCount := activityFields[15].Descriptor()
+	activityDescUpdateCount := activityFields[18].Descriptor()
 	// activity.DefaultUpdateCount holds the default value on creation for the update_count field.
 	activity.DefaultUpdateCount = activityDescUpdateCount.Default.(int)
+	failedactivityFields := schema.FailedActivity{}.Fields()
+	_ = failedactivityFields
+	// failedactivityDescAttemptCount is the schema descriptor for attempt_count field.
+	failedactivityDescAttemptCount := failedactivityFields[5].Descriptor()
+	// failedactivity.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	failedactivity.DefaultAttemptCount = failedactivityDescAttemptCount.Default.(int)
+	feedFields := schema.Feed{}.Fields()
+	_ = feedFields
+	// feedDescPaused is the schema descriptor for paused field.
+	feedDescPaused := feedFields[6].Descriptor()
+	// feed.DefaultPaused holds the default value on creation for the paused field.
+	feed.DefaultPaused = feedDescPaused.Default.(bool)
+	// feedDescMinQualityScore is the schema descriptor for min_quality_score field.
+	feedDescMinQualityScore := feedFields[11].Descriptor()
+	// feed.DefaultMinQualityScore holds the default value on creation for the min_quality_score field.
+	feed.DefaultMinQualityScore = feedDescMinQualityScore.Default.(float64)
+	// feedDescLanguage is the schema descriptor for language field.
+	feedDescLanguage := feedFields[13].Descriptor()
+	// feed.DefaultLanguage holds the default value on creation for the language field.
+	feed.DefaultLanguage = feedDescLanguage.Default.(string)
 }
@@ -25,6 +25,12 @@ func (Activity) Fields() []ent.Field {
 		field.String("short_summary"),
 		field.String("full_summary"),
 		field.String("raw_json"),
+		// Hash of the content the summary and embedding were computed from, used to detect edited activities.
+		field.String("content_hash").
+			Default(""),
+		// Name of the model the embedding was computed with, since vectors of different models can't be compared.
+		field.String("embedding_model").
+			Default(""),
 		field.Other("embedding_1536", pgvector.Vector{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "vector(1536)",
@@ -39,6 +45,9 @@ func (Activity) Fields() []ent.Field {
 			Optional(),
 		field.Float("social_score").
 			Default(-1.0),
+		// Content substance heuristic (0-1), independent of the popularity. -1 if not computed yet.
+		field.Float("quality_score").
+			Default(-1.0),
 		// Internal field for monitoring purposes
 		field.Int("update_count").
 			Default(0),
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ActivityFeedback is the relevance feedback of the user on an activity ("more/less like this").
+type ActivityFeedback struct {
+	ent.Schema
+}
+
+func (ActivityFeedback) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("activity_id"),
+		// Value is 1 for "more like this" and -1 for "less like this".
+		field.Int("value"),
+		field.Time("updated_at"),
+	}
+}
+
+func (ActivityFeedback) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "activity_id").Unique(),
+		index.Fields("user_id", "updated_at"),
+	}
+}
+
+func (ActivityFeedback) Edges() []ent.Edge {
+	return nil
+}
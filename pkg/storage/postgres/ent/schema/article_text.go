@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleText persists the article text extracted from a page, so that it isn't re-fetched and re-parsed.
+type ArticleText struct {
+	ent.Schema
+}
+
+func (ArticleText) Fields() []ent.Field {
+	return []ent.Field{
+		// Canonical page URL
+		field.String("id").Unique(),
+		field.String("text"),
+		field.String("etag"),
+		field.Time("fetched_at"),
+	}
+}
+
+func (ArticleText) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("fetched_at"),
+	}
+}
+
+func (ArticleText) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Collection is a hand-picked set of activities of the user, which can span multiple feeds.
+type Collection struct {
+	ent.Schema
+}
+
+func (Collection) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique(),
+		field.String("user_id"),
+		field.String("name"),
+		field.Time("created_at"),
+	}
+}
+
+func (Collection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (Collection) Edges() []ent.Edge {
+	return nil
+}
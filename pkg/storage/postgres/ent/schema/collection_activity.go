@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CollectionActivity is an activity added to a collection, at the given position.
+type CollectionActivity struct {
+	ent.Schema
+}
+
+func (CollectionActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("collection_id"),
+		field.String("activity_id"),
+		field.Int("position"),
+		field.Time("added_at"),
+	}
+}
+
+func (CollectionActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("collection_id", "activity_id").Unique(),
+		index.Fields("collection_id", "position"),
+	}
+}
+
+func (CollectionActivity) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// FailedActivity tracks activities that failed processing, so they can be retried later.
+type FailedActivity struct {
+	ent.Schema
+}
+
+func (FailedActivity) Fields() []ent.Field {
+	return []ent.Field{
+		// Activity UID
+		field.String("id").Unique(),
+		field.JSON("source_uids", []string{}),
+		field.String("source_type"),
+		field.String("raw_json"),
+		field.String("error"),
+		field.Int("attempt_count").
+			Default(0),
+		field.Time("next_retry_at"),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+func (FailedActivity) Edges() []ent.Edge {
+	return nil
+}
@@ -9,6 +9,25 @@ type Feed struct {
 	ent.Schema
 }
 
+// FeedSection is a named subset of the feed sources.
+type FeedSection struct {
+	Name       string   `json:"name"`
+	Icon       string   `json:"icon"`
+	SourceUIDs []string `json:"sourceUids"`
+}
+
+// SourceOverride is the feed-specific display metadata of a source.
+type SourceOverride struct {
+	DisplayName  string `json:"displayName,omitempty"`
+	IconOverride string `json:"iconOverride,omitempty"`
+}
+
+// SourceFilter is the feed-specific keyword filter of a source.
+type SourceFilter struct {
+	IncludeKeywords []string `json:"includeKeywords,omitempty"`
+	ExcludeKeywords []string `json:"excludeKeywords,omitempty"`
+}
+
 func (Feed) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Unique(),
@@ -17,9 +36,18 @@ func (Feed) Fields() []ent.Field {
 		field.String("icon"),
 		field.String("query"),
 		field.Bool("public"),
+		field.Bool("paused").Default(false),
 		field.JSON("source_uids", []string{}),
+		field.JSON("source_weights", map[string]float64{}).Optional(),
+		field.JSON("source_overrides", map[string]SourceOverride{}).Optional(),
+		field.JSON("source_filters", map[string]SourceFilter{}).Optional(),
+		field.Float("min_quality_score").Default(0),
+		field.JSON("sections", []FeedSection{}).Optional(),
+		field.String("language").Default(""),
 		field.Time("created_at"),
 		field.Time("updated_at"),
+		// deleted_at is set for the soft-deleted feeds, until they're purged.
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FeedPosition is the position of a feed in the user's preferred feed order.
+// The feeds of other users (e.g. public feeds) can be positioned too.
+type FeedPosition struct {
+	ent.Schema
+}
+
+func (FeedPosition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("feed_id"),
+		field.Int("position"),
+	}
+}
+
+func (FeedPosition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "feed_id").Unique(),
+	}
+}
+
+func (FeedPosition) Edges() []ent.Edge {
+	return nil
+}
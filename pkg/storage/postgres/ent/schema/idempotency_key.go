@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey maps a client provided idempotency key to the resource created with it.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("key"),
+		field.String("resource_id"),
+		field.Time("created_at"),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "key").Unique(),
+	}
+}
+
+func (IdempotencyKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReadActivity marks an activity as read by the user.
+type ReadActivity struct {
+	ent.Schema
+}
+
+func (ReadActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("activity_id"),
+		field.Time("read_at"),
+	}
+}
+
+func (ReadActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "activity_id").Unique(),
+		// Finds the last read time of the user (see ReadActivityRepository.LastReadAt).
+		index.Fields("user_id", "read_at"),
+	}
+}
+
+func (ReadActivity) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SourceState persists the staleness of the sources, so that it survives restarts
+// and the feed owners can see why the removed sources aren't polled anymore.
+type SourceState struct {
+	ent.Schema
+}
+
+func (SourceState) Fields() []ent.Field {
+	return []ent.Field{
+		// Source UID
+		field.String("id").Unique(),
+		field.Time("last_new_activity_at"),
+		field.Time("stale_since").
+			Optional().
+			Nillable(),
+		field.String("disabled_reason"),
+		field.Time("updated_at"),
+	}
+}
+
+func (SourceState) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// UserProvision tracks the users who were provisioned with the starter feeds,
+// so that they're provisioned only once (e.g. not again after deleting all feeds).
+type UserProvision struct {
+	ent.Schema
+}
+
+func (UserProvision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").Unique(),
+		field.Time("provisioned_at"),
+	}
+}
+
+func (UserProvision) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcestate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sourcestate type in the database.
+	Label = "source_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLastNewActivityAt holds the string denoting the last_new_activity_at field in the database.
+	FieldLastNewActivityAt = "last_new_activity_at"
+	// FieldStaleSince holds the string denoting the stale_since field in the database.
+	FieldStaleSince = "stale_since"
+	// FieldDisabledReason holds the string denoting the disabled_reason field in the database.
+	FieldDisabledReason = "disabled_reason"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the sourcestate in the database.
+	Table = "source_states"
+)
+
+// Columns holds all SQL columns for sourcestate fields.
+var Columns = []string{
+	FieldID,
+	FieldLastNewActivityAt,
+	FieldStaleSince,
+	FieldDisabledReason,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the SourceState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLastNewActivityAt orders the results by the last_new_activity_at field.
+func ByLastNewActivityAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastNewActivityAt, opts...).ToFunc()
+}
+
+// ByStaleSince orders the results by the stale_since field.
+func ByStaleSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStaleSince, opts...).ToFunc()
+}
+
+// ByDisabledReason orders the results by the disabled_reason field.
+func ByDisabledReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledReason, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcestate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldContainsFold(FieldID, id))
+}
+
+// LastNewActivityAt applies equality check predicate on the "last_new_activity_at" field. It's identical to LastNewActivityAtEQ.
+func LastNewActivityAt(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldLastNewActivityAt, v))
+}
+
+// StaleSince applies equality check predicate on the "stale_since" field. It's identical to StaleSinceEQ.
+func StaleSince(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldStaleSince, v))
+}
+
+// DisabledReason applies equality check predicate on the "disabled_reason" field. It's identical to DisabledReasonEQ.
+func DisabledReason(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldDisabledReason, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LastNewActivityAtEQ applies the EQ predicate on the "last_new_activity_at" field.
+func LastNewActivityAtEQ(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldLastNewActivityAt, v))
+}
+
+// LastNewActivityAtNEQ applies the NEQ predicate on the "last_new_activity_at" field.
+func LastNewActivityAtNEQ(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNEQ(FieldLastNewActivityAt, v))
+}
+
+// LastNewActivityAtIn applies the In predicate on the "last_new_activity_at" field.
+func LastNewActivityAtIn(vs ...time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldIn(FieldLastNewActivityAt, vs...))
+}
+
+// LastNewActivityAtNotIn applies the NotIn predicate on the "last_new_activity_at" field.
+func LastNewActivityAtNotIn(vs ...time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNotIn(FieldLastNewActivityAt, vs...))
+}
+
+// LastNewActivityAtGT applies the GT predicate on the "last_new_activity_at" field.
+func LastNewActivityAtGT(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGT(FieldLastNewActivityAt, v))
+}
+
+// LastNewActivityAtGTE applies the GTE predicate on the "last_new_activity_at" field.
+func LastNewActivityAtGTE(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGTE(FieldLastNewActivityAt, v))
+}
+
+// LastNewActivityAtLT applies the LT predicate on the "last_new_activity_at" field.
+func LastNewActivityAtLT(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLT(FieldLastNewActivityAt, v))
+}
+
+// LastNewActivityAtLTE applies the LTE predicate on the "last_new_activity_at" field.
+func LastNewActivityAtLTE(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLTE(FieldLastNewActivityAt, v))
+}
+
+// StaleSinceEQ applies the EQ predicate on the "stale_since" field.
+func StaleSinceEQ(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldStaleSince, v))
+}
+
+// StaleSinceNEQ applies the NEQ predicate on the "stale_since" field.
+func StaleSinceNEQ(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNEQ(FieldStaleSince, v))
+}
+
+// StaleSinceIn applies the In predicate on the "stale_since" field.
+func StaleSinceIn(vs ...time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldIn(FieldStaleSince, vs...))
+}
+
+// StaleSinceNotIn applies the NotIn predicate on the "stale_since" field.
+func StaleSinceNotIn(vs ...time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNotIn(FieldStaleSince, vs...))
+}
+
+// StaleSinceGT applies the GT predicate on the "stale_since" field.
+func StaleSinceGT(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGT(FieldStaleSince, v))
+}
+
+// StaleSinceGTE applies the GTE predicate on the "stale_since" field.
+func StaleSinceGTE(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGTE(FieldStaleSince, v))
+}
+
+// StaleSinceLT applies the LT predicate on the "stale_since" field.
+func StaleSinceLT(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLT(FieldStaleSince, v))
+}
+
+// StaleSinceLTE applies the LTE predicate on the "stale_since" field.
+func StaleSinceLTE(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLTE(FieldStaleSince, v))
+}
+
+// StaleSinceIsNil applies the IsNil predicate on the "stale_since" field.
+func StaleSinceIsNil() predicate.SourceState {
+	return predicate.SourceState(sql.FieldIsNull(FieldStaleSince))
+}
+
+// StaleSinceNotNil applies the NotNil predicate on the "stale_since" field.
+func StaleSinceNotNil() predicate.SourceState {
+	return predicate.SourceState(sql.FieldNotNull(FieldStaleSince))
+}
+
+// DisabledReasonEQ applies the EQ predicate on the "disabled_reason" field.
+func DisabledReasonEQ(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldDisabledReason, v))
+}
+
+// DisabledReasonNEQ applies the NEQ predicate on the "disabled_reason" field.
+func DisabledReasonNEQ(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNEQ(FieldDisabledReason, v))
+}
+
+// DisabledReasonIn applies the In predicate on the "disabled_reason" field.
+func DisabledReasonIn(vs ...string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldIn(FieldDisabledReason, vs...))
+}
+
+// DisabledReasonNotIn applies the NotIn predicate on the "disabled_reason" field.
+func DisabledReasonNotIn(vs ...string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNotIn(FieldDisabledReason, vs...))
+}
+
+// DisabledReasonGT applies the GT predicate on the "disabled_reason" field.
+func DisabledReasonGT(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGT(FieldDisabledReason, v))
+}
+
+// DisabledReasonGTE applies the GTE predicate on the "disabled_reason" field.
+func DisabledReasonGTE(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGTE(FieldDisabledReason, v))
+}
+
+// DisabledReasonLT applies the LT predicate on the "disabled_reason" field.
+func DisabledReasonLT(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLT(FieldDisabledReason, v))
+}
+
+// DisabledReasonLTE applies the LTE predicate on the "disabled_reason" field.
+func DisabledReasonLTE(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLTE(FieldDisabledReason, v))
+}
+
+// DisabledReasonContains applies the Contains predicate on the "disabled_reason" field.
+func DisabledReasonContains(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldContains(FieldDisabledReason, v))
+}
+
+// DisabledReasonHasPrefix applies the HasPrefix predicate on the "disabled_reason" field.
+func DisabledReasonHasPrefix(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldHasPrefix(FieldDisabledReason, v))
+}
+
+// DisabledReasonHasSuffix applies the HasSuffix predicate on the "disabled_reason" field.
+func DisabledReasonHasSuffix(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldHasSuffix(FieldDisabledReason, v))
+}
+
+// DisabledReasonEqualFold applies the EqualFold predicate on the "disabled_reason" field.
+func DisabledReasonEqualFold(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEqualFold(FieldDisabledReason, v))
+}
+
+// DisabledReasonContainsFold applies the ContainsFold predicate on the "disabled_reason" field.
+func DisabledReasonContainsFold(v string) predicate.SourceState {
+	return predicate.SourceState(sql.FieldContainsFold(FieldDisabledReason, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SourceState {
+	return predicate.SourceState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SourceState) predicate.SourceState {
+	return predicate.SourceState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SourceState) predicate.SourceState {
+	return predicate.SourceState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SourceState) predicate.SourceState {
+	return predicate.SourceState(sql.NotPredicates(p))
+}
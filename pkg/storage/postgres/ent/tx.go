@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,10 +16,30 @@ type Tx struct {
 	config
 	// Activity is the client for interacting with the Activity builders.
 	Activity *ActivityClient
+	// ActivityFeedback is the client for interacting with the ActivityFeedback builders.
+	ActivityFeedback *ActivityFeedbackClient
+	// ArticleText is the client for interacting with the ArticleText builders.
+	ArticleText *ArticleTextClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// CollectionActivity is the client for interacting with the CollectionActivity builders.
+	CollectionActivity *CollectionActivityClient
+	// FailedActivity is the client for interacting with the FailedActivity builders.
+	FailedActivity *FailedActivityClient
 	// Feed is the client for interacting with the Feed builders.
 	Feed *FeedClient
+	// FeedPosition is the client for interacting with the FeedPosition builders.
+	FeedPosition *FeedPositionClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// ReadActivity is the client for interacting with the ReadActivity builders.
+	ReadActivity *ReadActivityClient
 	// Source is the client for interacting with the Source builders.
 	Source *SourceClient
+	// SourceState is the client for interacting with the SourceState builders.
+	SourceState *SourceStateClient
+	// UserProvision is the client for interacting with the UserProvision builders.
+	UserProvision *UserProvisionClient
 
 	// lazily loaded.
 	client     *Client
@@ -150,8 +172,18 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Activity = NewActivityClient(tx.config)
+	tx.ActivityFeedback = NewActivityFeedbackClient(tx.config)
+	tx.ArticleText = NewArticleTextClient(tx.config)
+	tx.Collection = NewCollectionClient(tx.config)
+	tx.CollectionActivity = NewCollectionActivityClient(tx.config)
+	tx.FailedActivity = NewFailedActivityClient(tx.config)
 	tx.Feed = NewFeedClient(tx.config)
+	tx.FeedPosition = NewFeedPositionClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.ReadActivity = NewReadActivityClient(tx.config)
 	tx.Source = NewSourceClient(tx.config)
+	tx.SourceState = NewSourceStateClient(tx.config)
+	tx.UserProvision = NewUserProvisionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -214,3 +246,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
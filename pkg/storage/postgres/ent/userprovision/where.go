@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userprovision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEQ(FieldUserID, v))
+}
+
+// ProvisionedAt applies equality check predicate on the "provisioned_at" field. It's identical to ProvisionedAtEQ.
+func ProvisionedAt(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEQ(FieldProvisionedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ProvisionedAtEQ applies the EQ predicate on the "provisioned_at" field.
+func ProvisionedAtEQ(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldEQ(FieldProvisionedAt, v))
+}
+
+// ProvisionedAtNEQ applies the NEQ predicate on the "provisioned_at" field.
+func ProvisionedAtNEQ(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldNEQ(FieldProvisionedAt, v))
+}
+
+// ProvisionedAtIn applies the In predicate on the "provisioned_at" field.
+func ProvisionedAtIn(vs ...time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldIn(FieldProvisionedAt, vs...))
+}
+
+// ProvisionedAtNotIn applies the NotIn predicate on the "provisioned_at" field.
+func ProvisionedAtNotIn(vs ...time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldNotIn(FieldProvisionedAt, vs...))
+}
+
+// ProvisionedAtGT applies the GT predicate on the "provisioned_at" field.
+func ProvisionedAtGT(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldGT(FieldProvisionedAt, v))
+}
+
+// ProvisionedAtGTE applies the GTE predicate on the "provisioned_at" field.
+func ProvisionedAtGTE(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldGTE(FieldProvisionedAt, v))
+}
+
+// ProvisionedAtLT applies the LT predicate on the "provisioned_at" field.
+func ProvisionedAtLT(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldLT(FieldProvisionedAt, v))
+}
+
+// ProvisionedAtLTE applies the LTE predicate on the "provisioned_at" field.
+func ProvisionedAtLTE(v time.Time) predicate.UserProvision {
+	return predicate.UserProvision(sql.FieldLTE(FieldProvisionedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserProvision) predicate.UserProvision {
+	return predicate.UserProvision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserProvision) predicate.UserProvision {
+	return predicate.UserProvision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserProvision) predicate.UserProvision {
+	return predicate.UserProvision(sql.NotPredicates(p))
+}
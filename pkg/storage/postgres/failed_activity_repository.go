@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources/activities"
+	"github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	entfailedactivity "github.com/defeedco/defeed/pkg/storage/postgres/ent/failedactivity"
+)
+
+type FailedActivityRepository struct {
+	db *DB
+}
+
+func NewFailedActivityRepository(db *DB) *FailedActivityRepository {
+	return &FailedActivityRepository{db: db}
+}
+
+func (r *FailedActivityRepository) Upsert(ctx context.Context, f *types.FailedActivity) error {
+	rawJson, err := f.Activity.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal activity: %w", err)
+	}
+
+	sourceUIDs := make([]string, len(f.Activity.SourceUIDs()))
+	for i, uid := range f.Activity.SourceUIDs() {
+		sourceUIDs[i] = uid.String()
+	}
+
+	// Assume all sources are of the same type.
+	var sourceType string
+	if len(sourceUIDs) > 0 {
+		sourceType = f.Activity.SourceUIDs()[0].Type()
+	}
+
+	return r.db.Client().FailedActivity.Create().
+		SetID(f.Activity.UID().String()).
+		SetSourceUids(sourceUIDs).
+		SetSourceType(sourceType).
+		SetRawJSON(string(rawJson)).
+		SetError(f.Error).
+		SetAttemptCount(f.AttemptCount).
+		SetNextRetryAt(f.NextRetryAt).
+		SetCreatedAt(f.CreatedAt).
+		SetUpdatedAt(f.UpdatedAt).
+		OnConflictColumns(entfailedactivity.FieldID).
+		UpdateSourceUids().
+		UpdateSourceType().
+		UpdateRawJSON().
+		UpdateError().
+		UpdateAttemptCount().
+		UpdateNextRetryAt().
+		UpdateUpdatedAt().
+		Exec(ctx)
+}
+
+func (r *FailedActivityRepository) Remove(ctx context.Context, uid string) error {
+	_, err := r.db.Client().FailedActivity.Delete().
+		Where(entfailedactivity.ID(uid)).
+		Exec(ctx)
+	return err
+}
+
+func (r *FailedActivityRepository) GetByID(ctx context.Context, uid string) (*types.FailedActivity, error) {
+	f, err := r.db.Client().FailedActivity.Query().
+		Where(entfailedactivity.ID(uid)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return failedActivityFromEnt(f)
+}
+
+// ListDue returns the failed activities scheduled for a retry before the given time,
+// that have less than maxAttempts processing attempts.
+func (r *FailedActivityRepository) ListDue(ctx context.Context, before time.Time, maxAttempts int, limit int) ([]*types.FailedActivity, error) {
+	failedEnt, err := r.db.Client().FailedActivity.Query().
+		Where(
+			entfailedactivity.NextRetryAtLTE(before),
+			entfailedactivity.AttemptCountLT(maxAttempts),
+		).
+		Order(ent.Asc(entfailedactivity.FieldNextRetryAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return failedActivitiesFromEnt(failedEnt)
+}
+
+func (r *FailedActivityRepository) List(ctx context.Context) ([]*types.FailedActivity, error) {
+	failedEnt, err := r.db.Client().FailedActivity.Query().
+		Order(ent.Desc(entfailedactivity.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return failedActivitiesFromEnt(failedEnt)
+}
+
+func (r *FailedActivityRepository) Count(ctx context.Context) (int, error) {
+	return r.db.Client().FailedActivity.Query().Count(ctx)
+}
+
+// Requeue resets the attempt count of the given failed activities and schedules them for an immediate retry.
+// If no UIDs are provided, all failed activities are requeued.
+func (r *FailedActivityRepository) Requeue(ctx context.Context, uids []string) (int, error) {
+	qb := r.db.Client().FailedActivity.Update()
+	if len(uids) > 0 {
+		qb = qb.Where(entfailedactivity.IDIn(uids...))
+	}
+
+	now := time.Now()
+	return qb.
+		SetAttemptCount(0).
+		SetNextRetryAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+func failedActivitiesFromEnt(in []*ent.FailedActivity) ([]*types.FailedActivity, error) {
+	result := make([]*types.FailedActivity, len(in))
+	for i, f := range in {
+		out, err := failedActivityFromEnt(f)
+		if err != nil {
+			return nil, fmt.Errorf("deserialize failed activity: %w", err)
+		}
+		result[i] = out
+	}
+	return result, nil
+}
+
+func failedActivityFromEnt(in *ent.FailedActivity) (*types.FailedActivity, error) {
+	act, err := activities.NewActivity(in.SourceType)
+	if err != nil {
+		return nil, fmt.Errorf("new activity: %w", err)
+	}
+
+	err = act.UnmarshalJSON([]byte(in.RawJSON))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal activity: %w", err)
+	}
+
+	return &types.FailedActivity{
+		Activity:     act,
+		Error:        in.Error,
+		AttemptCount: in.AttemptCount,
+		NextRetryAt:  in.NextRetryAt,
+		CreatedAt:    in.CreatedAt,
+		UpdatedAt:    in.UpdatedAt,
+	}, nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/defeedco/defeed/pkg/sources/activities/types"
@@ -11,7 +12,9 @@ import (
 	"github.com/defeedco/defeed/pkg/sources"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
 	entfeed "github.com/defeedco/defeed/pkg/storage/postgres/ent/feed"
+	entfeedposition "github.com/defeedco/defeed/pkg/storage/postgres/ent/feedposition"
 	"github.com/defeedco/defeed/pkg/storage/postgres/ent/predicate"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/schema"
 )
 
 type FeedRepository struct {
@@ -29,26 +32,137 @@ func (r *FeedRepository) Upsert(ctx context.Context, f feeds.Feed) error {
 		sourceUIDs[i] = uid.String()
 	}
 
-	err := r.db.Client().Feed.Create().
+	sections := make([]schema.FeedSection, len(f.Sections))
+	for i, section := range f.Sections {
+		sections[i] = schema.FeedSection{
+			Name:       section.Name,
+			Icon:       section.Icon,
+			SourceUIDs: make([]string, len(section.SourceUIDs)),
+		}
+		for j, uid := range section.SourceUIDs {
+			sections[i].SourceUIDs[j] = uid.String()
+		}
+	}
+
+	sourceOverrides := make(map[string]schema.SourceOverride, len(f.SourceOverrides))
+	for uid, override := range f.SourceOverrides {
+		sourceOverrides[uid] = schema.SourceOverride{
+			DisplayName:  override.DisplayName,
+			IconOverride: override.IconOverride,
+		}
+	}
+
+	sourceFilters := make(map[string]schema.SourceFilter, len(f.SourceFilters))
+	for uid, filter := range f.SourceFilters {
+		sourceFilters[uid] = schema.SourceFilter{
+			IncludeKeywords: filter.IncludeKeywords,
+			ExcludeKeywords: filter.ExcludeKeywords,
+		}
+	}
+
+	create := r.db.Client().Feed.Create().
 		SetID(f.ID).
 		SetUserID(f.UserID).
 		SetName(f.Name).
 		SetIcon(f.Icon).
 		SetQuery(f.Query).
 		SetSourceUids(sourceUIDs).
+		SetSourceWeights(f.SourceWeights).
+		SetSourceOverrides(sourceOverrides).
+		SetSourceFilters(sourceFilters).
 		SetPublic(f.Public).
+		SetPaused(f.Paused).
+		SetMinQualityScore(f.MinQualityScore).
+		SetSections(sections).
+		SetLanguage(f.Language).
 		SetUpdatedAt(f.UpdatedAt).
-		SetCreatedAt(f.CreatedAt).
+		SetCreatedAt(f.CreatedAt)
+	if !f.DeletedAt.IsZero() {
+		create.SetDeletedAt(f.DeletedAt)
+	}
+
+	err := create.
 		// https://github.com/ent/ent/issues/2494#issuecomment-1182015427
 		OnConflictColumns(entfeed.FieldID).
 		UpdateNewValues().
+		Update(func(u *ent.FeedUpsert) {
+			// Unset fields aren't updated, so the restored feeds must be cleared explicitly.
+			if f.DeletedAt.IsZero() {
+				u.ClearDeletedAt()
+			}
+		}).
 		Exec(ctx)
 
 	return err
 }
 
 func (r *FeedRepository) Remove(ctx context.Context, uid string) error {
-	return r.db.Client().Feed.DeleteOneID(uid).Exec(ctx)
+	err := r.db.Client().Feed.DeleteOneID(uid).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Client().FeedPosition.Delete().
+		Where(entfeedposition.FeedID(uid)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete feed positions: %w", err)
+	}
+
+	return nil
+}
+
+// ListPositions returns the positions of the feeds in the user's preferred order, by feed ID.
+func (r *FeedRepository) ListPositions(ctx context.Context, userID string) (map[string]int, error) {
+	rows, err := r.db.Client().FeedPosition.Query().
+		Where(entfeedposition.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query feed positions: %w", err)
+	}
+
+	positions := make(map[string]int, len(rows))
+	for _, row := range rows {
+		positions[row.FeedID] = row.Position
+	}
+
+	return positions, nil
+}
+
+// SetPositions replaces the user's preferred feed order in a single transaction.
+func (r *FeedRepository) SetPositions(ctx context.Context, userID string, feedIDs []string) error {
+	tx, err := r.db.Client().Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	_, err = tx.FeedPosition.Delete().
+		Where(entfeedposition.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("delete feed positions: %w", err)
+	}
+
+	builders := make([]*ent.FeedPositionCreate, len(feedIDs))
+	for i, feedID := range feedIDs {
+		builders[i] = tx.FeedPosition.Create().
+			SetUserID(userID).
+			SetFeedID(feedID).
+			SetPosition(i)
+	}
+
+	err = tx.FeedPosition.CreateBulk(builders...).Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("create feed positions: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
 }
 
 func (r *FeedRepository) List(ctx context.Context) ([]*feeds.Feed, error) {
@@ -68,11 +182,31 @@ func (r *FeedRepository) List(ctx context.Context) ([]*feeds.Feed, error) {
 	return result, nil
 }
 
+// ListByOwner returns the (not deleted) feeds owned by the user.
+func (r *FeedRepository) ListByOwner(ctx context.Context, userID string) ([]*feeds.Feed, error) {
+	feedsEnt, err := r.db.Client().Feed.Query().
+		Where(entfeed.UserID(userID), entfeed.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*feeds.Feed, len(feedsEnt))
+	for i, f := range feedsEnt {
+		result[i], err = feedFromEnt(f)
+		if err != nil {
+			return nil, fmt.Errorf("deserialize feed: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
 func (r *FeedRepository) GetByID(ctx context.Context, uid string) (*feeds.Feed, error) {
 	f, err := r.db.Client().Feed.Query().Where(entfeed.ID(uid)).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("feed not found")
+			return nil, feeds.ErrFeedNotFound
 		}
 		return nil, err
 	}
@@ -80,6 +214,26 @@ func (r *FeedRepository) GetByID(ctx context.Context, uid string) (*feeds.Feed,
 	return feedFromEnt(f)
 }
 
+// ListDeletedBefore returns the soft-deleted feeds that were deleted before the given time.
+func (r *FeedRepository) ListDeletedBefore(ctx context.Context, before time.Time) ([]*feeds.Feed, error) {
+	feedsEnt, err := r.db.Client().Feed.Query().
+		Where(entfeed.DeletedAtLT(before)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*feeds.Feed, len(feedsEnt))
+	for i, f := range feedsEnt {
+		result[i], err = feedFromEnt(f)
+		if err != nil {
+			return nil, fmt.Errorf("deserialize feed: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
 func (r *FeedRepository) FindBySourceUIDs(ctx context.Context, sourceUIDs []types.TypedUID) ([]*feeds.Feed, error) {
 	if len(sourceUIDs) == 0 {
 		return []*feeds.Feed{}, nil
@@ -129,15 +283,66 @@ func feedFromEnt(in *ent.Feed) (*feeds.Feed, error) {
 		sourceUIDs[i] = typedUID
 	}
 
+	sections := make([]feeds.FeedSection, len(in.Sections))
+	for i, section := range in.Sections {
+		sections[i] = feeds.FeedSection{
+			Name:       section.Name,
+			Icon:       section.Icon,
+			SourceUIDs: make([]types.TypedUID, len(section.SourceUIDs)),
+		}
+		for j, uid := range section.SourceUIDs {
+			typedUID, err := sources.NewTypedUID(uid)
+			if err != nil {
+				return nil, fmt.Errorf("deserialize section source UID: %w", err)
+			}
+			sections[i].SourceUIDs[j] = typedUID
+		}
+	}
+
+	var sourceOverrides map[string]feeds.SourceOverride
+	if len(in.SourceOverrides) > 0 {
+		sourceOverrides = make(map[string]feeds.SourceOverride, len(in.SourceOverrides))
+		for uid, override := range in.SourceOverrides {
+			sourceOverrides[uid] = feeds.SourceOverride{
+				DisplayName:  override.DisplayName,
+				IconOverride: override.IconOverride,
+			}
+		}
+	}
+
+	var sourceFilters map[string]types.KeywordFilter
+	if len(in.SourceFilters) > 0 {
+		sourceFilters = make(map[string]types.KeywordFilter, len(in.SourceFilters))
+		for uid, filter := range in.SourceFilters {
+			sourceFilters[uid] = types.KeywordFilter{
+				IncludeKeywords: filter.IncludeKeywords,
+				ExcludeKeywords: filter.ExcludeKeywords,
+			}
+		}
+	}
+
+	var deletedAt time.Time
+	if in.DeletedAt != nil {
+		deletedAt = *in.DeletedAt
+	}
+
 	return &feeds.Feed{
-		ID:         in.ID,
-		UserID:     in.UserID,
-		Name:       in.Name,
-		Icon:       in.Icon,
-		Query:      in.Query,
-		SourceUIDs: sourceUIDs,
-		CreatedAt:  in.CreatedAt,
-		UpdatedAt:  in.UpdatedAt,
-		Public:     in.Public,
+		ID:              in.ID,
+		UserID:          in.UserID,
+		Name:            in.Name,
+		Icon:            in.Icon,
+		Query:           in.Query,
+		SourceUIDs:      sourceUIDs,
+		SourceWeights:   in.SourceWeights,
+		SourceOverrides: sourceOverrides,
+		SourceFilters:   sourceFilters,
+		CreatedAt:       in.CreatedAt,
+		UpdatedAt:       in.UpdatedAt,
+		DeletedAt:       deletedAt,
+		Public:          in.Public,
+		Paused:          in.Paused,
+		MinQualityScore: in.MinQualityScore,
+		Sections:        sections,
+		Language:        in.Language,
 	}, nil
 }
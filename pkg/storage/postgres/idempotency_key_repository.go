@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	entidempotencykey "github.com/defeedco/defeed/pkg/storage/postgres/ent/idempotencykey"
+)
+
+type IdempotencyKeyRepository struct {
+	db *DB
+}
+
+func NewIdempotencyKeyRepository(db *DB) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{db: db}
+}
+
+// Reserve claims the user key before the resource is created, so that concurrent retries don't create duplicates.
+// Keys created before expiredBefore, or reserved before abandonedBefore without a resource (e.g. the server crashed), are taken over.
+// Returns false if the key is already used.
+func (r *IdempotencyKeyRepository) Reserve(ctx context.Context, userID string, key string, createdAt time.Time, expiredBefore time.Time, abandonedBefore time.Time) (bool, error) {
+	res, err := r.db.Client().ExecContext(ctx, `
+		INSERT INTO idempotency_keys (user_id, key, resource_id, created_at)
+		VALUES ($1, $2, '', $3)
+		ON CONFLICT (user_id, key) DO UPDATE
+		SET resource_id = EXCLUDED.resource_id, created_at = EXCLUDED.created_at
+		WHERE idempotency_keys.created_at < $4
+			OR (idempotency_keys.resource_id = '' AND idempotency_keys.created_at < $5)`,
+		userID, key, createdAt, expiredBefore, abandonedBefore,
+	)
+	if err != nil {
+		return false, fmt.Errorf("insert idempotency key: %w", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return count == 1, nil
+}
+
+// Find returns the resource ID created with the given user key after the given time.
+// Returns an empty string if the key wasn't used yet, or the resource is still being created.
+func (r *IdempotencyKeyRepository) Find(ctx context.Context, userID string, key string, createdAfter time.Time) (string, error) {
+	k, err := r.db.Client().IdempotencyKey.Query().
+		Where(
+			entidempotencykey.UserID(userID),
+			entidempotencykey.Key(key),
+			entidempotencykey.CreatedAtGT(createdAfter),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return k.ResourceID, nil
+}
+
+// Complete stores the resource created with the reserved user key.
+func (r *IdempotencyKeyRepository) Complete(ctx context.Context, userID string, key string, resourceID string) error {
+	_, err := r.db.Client().IdempotencyKey.Update().
+		Where(
+			entidempotencykey.UserID(userID),
+			entidempotencykey.Key(key),
+		).
+		SetResourceID(resourceID).
+		Save(ctx)
+	return err
+}
+
+// Release removes the reserved user key, so that the request can be retried after the resource failed to be created.
+func (r *IdempotencyKeyRepository) Release(ctx context.Context, userID string, key string) error {
+	_, err := r.db.Client().IdempotencyKey.Delete().
+		Where(
+			entidempotencykey.UserID(userID),
+			entidempotencykey.Key(key),
+			entidempotencykey.ResourceID(""),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *IdempotencyKeyRepository) RemoveCreatedBefore(ctx context.Context, before time.Time) error {
+	_, err := r.db.Client().IdempotencyKey.Delete().
+		Where(entidempotencykey.CreatedAtLT(before)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	activitytypes "github.com/defeedco/defeed/pkg/sources/activities/types"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent"
+	"github.com/defeedco/defeed/pkg/storage/postgres/ent/readactivity"
+)
+
+type ReadActivityRepository struct {
+	db *DB
+}
+
+func NewReadActivityRepository(db *DB) *ReadActivityRepository {
+	return &ReadActivityRepository{db: db}
+}
+
+// MarkAllRead marks all the activities from the given sources created at or before the given time as read by the user.
+// The activities are inserted with a single set-based query, and already read activities are skipped.
+// Returns the number of newly marked activities.
+func (r *ReadActivityRepository) MarkAllRead(ctx context.Context, userID string, sourceUIDs []activitytypes.TypedUID, before time.Time) (int, error) {
+	if len(sourceUIDs) == 0 {
+		return 0, nil
+	}
+
+	uids := make([]string, len(sourceUIDs))
+	for i, uid := range sourceUIDs {
+		uids[i] = uid.String()
+	}
+
+	res, err := r.db.Client().ExecContext(ctx, `
+		INSERT INTO read_activities (user_id, activity_id, read_at)
+		SELECT $1, id, $2 FROM activities
+		WHERE source_uids ?| $3::text[] AND created_at <= $4
+		ON CONFLICT (user_id, activity_id) DO NOTHING`,
+		userID, time.Now(), uids, before,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("insert read activities: %w", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// LastReadAt returns the time the user last marked activities as read, or zero if never.
+func (r *ReadActivityRepository) LastReadAt(ctx context.Context, userID string) (time.Time, error) {
+	last, err := r.db.Client().ReadActivity.Query().
+		Where(readactivity.UserID(userID)).
+		Order(ent.Desc(readactivity.FieldReadAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("query last read activity: %w", err)
+	}
+
+	return last.ReadAt, nil
+}
+
+// ReadActivityIDs returns the subset of the given activities that were read by the user.
+func (r *ReadActivityRepository) ReadActivityIDs(ctx context.Context, userID string, activityIDs []string) (map[string]bool, error) {
+	if len(activityIDs) == 0 {
+		return map[string]bool{}, nil
+	}
+
+	rows, err := r.db.Client().ReadActivity.Query().
+		Where(
+			readactivity.UserID(userID),
+			readactivity.ActivityIDIn(activityIDs...),
+		).
+		Select(readactivity.FieldActivityID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query read activities: %w", err)
+	}
+
+	out := make(map[string]bool, len(rows))
+	for _, id := range rows {
+		out[id] = true
+	}
+	return out, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/defeedco/defeed/pkg/lib"
 	"github.com/defeedco/defeed/pkg/sources/types"
 
 	"github.com/defeedco/defeed/pkg/sources"
@@ -13,16 +15,23 @@ import (
 
 type SourceRepository struct {
 	db *DB
+	// secrets encrypts the source secrets at rest. Nil stores them in plaintext.
+	secrets *lib.SecretCipher
 }
 
-func NewSourceRepository(db *DB) *SourceRepository {
-	return &SourceRepository{db: db}
+func NewSourceRepository(db *DB, secrets *lib.SecretCipher) *SourceRepository {
+	return &SourceRepository{db: db, secrets: secrets}
 }
 
 func (r *SourceRepository) Add(s types.Source) error {
 	ctx := context.Background()
 
-	rawJson, err := json.Marshal(s)
+	sealed, err := r.secrets.SealSecrets(s)
+	if err != nil {
+		return fmt.Errorf("encrypt source secrets: %w", err)
+	}
+
+	rawJson, err := json.Marshal(sealed)
 	if err != nil {
 		return fmt.Errorf("marshal source: %w", err)
 	}
@@ -53,7 +62,7 @@ func (r *SourceRepository) List() ([]types.Source, error) {
 
 	result := make([]types.Source, len(sourcesEnt))
 	for i, s := range sourcesEnt {
-		out, err := sourceFromEnt(s)
+		out, err := r.sourceFromEnt(s)
 		if err != nil {
 			return nil, fmt.Errorf("deserialize source: %w", err)
 		}
@@ -74,10 +83,10 @@ func (r *SourceRepository) GetByID(uid string) (types.Source, error) {
 		return nil, err
 	}
 
-	return sourceFromEnt(s)
+	return r.sourceFromEnt(s)
 }
 
-func sourceFromEnt(in *ent.Source) (types.Source, error) {
+func (r *SourceRepository) sourceFromEnt(in *ent.Source) (types.Source, error) {
 	out, err := sources.NewSource(in.Type)
 	if err != nil {
 		return nil, fmt.Errorf("new source: %w", err)
@@ -86,5 +95,8 @@ func sourceFromEnt(in *ent.Source) (types.Source, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal source: %w", err)
 	}
+	if err := r.secrets.OpenSecrets(out); err != nil {
+		return nil, fmt.Errorf("decrypt source secrets: %w", err)
+	}
 	return out, nil
 }
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/defeedco/defeed/pkg/sources"
+	entsourcestate "github.com/defeedco/defeed/pkg/storage/postgres/ent/sourcestate"
+)
+
+type SourceStateRepository struct {
+	db *DB
+}
+
+func NewSourceStateRepository(db *DB) *SourceStateRepository {
+	return &SourceStateRepository{db: db}
+}
+
+// List returns the persisted states by source UID.
+func (r *SourceStateRepository) List(ctx context.Context) (map[string]sources.SourceState, error) {
+	statesEnt, err := r.db.Client().SourceState.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]sources.SourceState, len(statesEnt))
+	for _, s := range statesEnt {
+		state := sources.SourceState{
+			LastNewActivityAt: s.LastNewActivityAt,
+			DisabledReason:    s.DisabledReason,
+		}
+		if s.StaleSince != nil {
+			state.StaleSince = *s.StaleSince
+		}
+		out[s.ID] = state
+	}
+
+	return out, nil
+}
+
+func (r *SourceStateRepository) Save(ctx context.Context, uid string, state sources.SourceState) error {
+	create := r.db.Client().SourceState.Create().
+		SetID(uid).
+		SetLastNewActivityAt(state.LastNewActivityAt).
+		SetDisabledReason(state.DisabledReason).
+		SetUpdatedAt(time.Now())
+	if !state.StaleSince.IsZero() {
+		create.SetStaleSince(state.StaleSince)
+	}
+
+	upsert := create.
+		OnConflictColumns(entsourcestate.FieldID).
+		UpdateNewValues()
+	if state.StaleSince.IsZero() {
+		upsert.ClearStaleSince()
+	}
+
+	return upsert.Exec(ctx)
+}
+
+func (r *SourceStateRepository) Remove(ctx context.Context, uid string) error {
+	_, err := r.db.Client().SourceState.Delete().
+		Where(entsourcestate.ID(uid)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entuserprovision "github.com/defeedco/defeed/pkg/storage/postgres/ent/userprovision"
+)
+
+type UserProvisionRepository struct {
+	db *DB
+}
+
+func NewUserProvisionRepository(db *DB) *UserProvisionRepository {
+	return &UserProvisionRepository{db: db}
+}
+
+// Claim marks the user as provisioned.
+// Returns false if the user was already provisioned (e.g. by a concurrent request).
+func (r *UserProvisionRepository) Claim(ctx context.Context, userID string, provisionedAt time.Time) (bool, error) {
+	res, err := r.db.Client().ExecContext(ctx, `
+		INSERT INTO user_provisions (user_id, provisioned_at)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO NOTHING`,
+		userID, provisionedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("insert user provision: %w", err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+
+	return count == 1, nil
+}
+
+// Release removes the user provision, so that the user is provisioned again.
+func (r *UserProvisionRepository) Release(ctx context.Context, userID string) error {
+	_, err := r.db.Client().UserProvision.Delete().
+		Where(entuserprovision.UserID(userID)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,9 @@
+-- Migration to add the content hash to activities
+-- Activities whose content changes (e.g. edited posts) are re-summarized and re-embedded.
+-- Existing activities get their hash on the next update, without being reprocessed.
+
+BEGIN;
+
+ALTER TABLE activities ADD COLUMN IF NOT EXISTS content_hash VARCHAR NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- Migration to add the embedding model to activities
+-- Searches only compare the query embedding against the activity embeddings of the same model,
+-- so that the embedding models can be migrated gradually (e.g. with cmd/reprocess) or A/B tested.
+-- Existing embeddings were all computed with the previously hard-coded model.
+
+BEGIN;
+
+ALTER TABLE activities ADD COLUMN IF NOT EXISTS embedding_model VARCHAR NOT NULL DEFAULT '';
+
+UPDATE activities
+SET embedding_model = 'text-embedding-3-large'
+WHERE embedding_model = ''
+  AND (embedding_1536 IS NOT NULL OR embedding_3072 IS NOT NULL);
+
+COMMIT;
@@ -0,0 +1,17 @@
+-- Migration to add the activity_feedbacks table
+-- Stores the "more/less like this" relevance feedback of the users on the activities.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS activity_feedbacks (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR NOT NULL,
+    activity_id VARCHAR NOT NULL,
+    value BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS activityfeedback_user_id_activity_id ON activity_feedbacks (user_id, activity_id);
+CREATE INDEX IF NOT EXISTS activityfeedback_user_id_updated_at ON activity_feedbacks (user_id, updated_at);
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- Migration to add the activity quality score and the per-feed quality threshold
+-- Existing activities get their score on the next update, and aren't filtered until then.
+
+BEGIN;
+
+ALTER TABLE activities ADD COLUMN IF NOT EXISTS quality_score DOUBLE PRECISION NOT NULL DEFAULT -1;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS min_quality_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- Migration to add the article_texts table
+-- Persists the article texts extracted from the pages, keyed by the canonical page URL.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS article_texts (
+    id VARCHAR NOT NULL PRIMARY KEY,
+    text VARCHAR NOT NULL,
+    etag VARCHAR NOT NULL,
+    fetched_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS articletext_fetched_at ON article_texts (fetched_at);
+
+COMMIT;
@@ -0,0 +1,26 @@
+-- Migration to add the collections and collection_activities tables
+-- Collections are hand-picked sets of activities of the user, which can span multiple feeds.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS collections (
+    id VARCHAR NOT NULL PRIMARY KEY,
+    user_id VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS collection_user_id ON collections (user_id);
+
+CREATE TABLE IF NOT EXISTS collection_activities (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    collection_id VARCHAR NOT NULL,
+    activity_id VARCHAR NOT NULL,
+    position BIGINT NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS collectionactivity_collection_id_activity_id ON collection_activities (collection_id, activity_id);
+CREATE INDEX IF NOT EXISTS collectionactivity_collection_id_position ON collection_activities (collection_id, position);
+
+COMMIT;
@@ -0,0 +1,21 @@
+-- Migration to add the failed_activities table
+-- Activities that fail processing (e.g. due to transient LLM or DB errors) are tracked here and retried with backoff.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS failed_activities (
+    id VARCHAR NOT NULL PRIMARY KEY,
+    source_uids JSONB NOT NULL,
+    source_type VARCHAR NOT NULL,
+    raw_json VARCHAR NOT NULL,
+    error VARCHAR NOT NULL,
+    attempt_count BIGINT NOT NULL DEFAULT 0,
+    next_retry_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Index for efficient retry lookups
+CREATE INDEX IF NOT EXISTS idx_failed_activities_next_retry_at ON failed_activities (next_retry_at);
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Migration to add the soft deletion of feeds
+-- deleted_at is set for the deleted feeds, which can be restored until they're purged after the retention.
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Migration to add the language to feeds
+-- BCP 47 tag of the language the feed topics are generated in. Empty defaults to English.
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS language VARCHAR NOT NULL DEFAULT '';
+
+COMMIT;
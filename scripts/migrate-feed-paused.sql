@@ -0,0 +1,8 @@
+-- Migration to add the paused flag to feeds
+-- Sources of paused feeds are not polled (unless used by other active feeds).
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- Migration to add the feed_positions table
+-- Stores the position of each feed in the user's preferred feed order.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS feed_positions (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR NOT NULL,
+    feed_id VARCHAR NOT NULL,
+    position BIGINT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS feedposition_user_id_feed_id ON feed_positions (user_id, feed_id);
+
+COMMIT;
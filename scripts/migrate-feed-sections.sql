@@ -0,0 +1,8 @@
+-- Migration to add the sections to feeds
+-- Sections are named subsets of the feed sources ({"name", "icon", "sourceUids"}).
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS sections JSONB;
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Migration to add the source filters to feeds
+-- Maps source UIDs to the feed-specific include/exclude keyword lists of the sources.
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS source_filters JSONB;
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Migration to add the source overrides to feeds
+-- Maps source UIDs to the feed-specific display names and icons of the sources.
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS source_overrides JSONB;
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- Migration to add per-source weights to feeds
+-- Maps source UIDs to a weight that biases the number of activities picked from each source.
+
+BEGIN;
+
+ALTER TABLE feeds ADD COLUMN IF NOT EXISTS source_weights JSONB;
+
+COMMIT;
@@ -0,0 +1,16 @@
+-- Migration to add the idempotency_keys table
+-- Maps (user_id, key) from the Idempotency-Key header to the feed created with it.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR NOT NULL,
+    key VARCHAR NOT NULL,
+    resource_id VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idempotencykey_user_id_key ON idempotency_keys (user_id, key);
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- Migration to add the read_activities table
+-- Tracks the activities read by each user (e.g. marked as read in bulk per feed).
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS read_activities (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR NOT NULL,
+    activity_id VARCHAR NOT NULL,
+    read_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS readactivity_user_id_activity_id ON read_activities (user_id, activity_id);
+
+COMMIT;
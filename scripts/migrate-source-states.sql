@@ -0,0 +1,14 @@
+-- Migration to add the source_states table
+-- Persists the last new activity time and the staleness of the sources, so that they survive restarts.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS source_states (
+    id VARCHAR NOT NULL PRIMARY KEY,
+    last_new_activity_at TIMESTAMPTZ NOT NULL,
+    stale_since TIMESTAMPTZ NULL,
+    disabled_reason VARCHAR NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,12 @@
+-- Migration to add the user_provisions table
+-- Tracks the users who were provisioned with the starter feeds, so that they're provisioned only once.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_provisions (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR NOT NULL UNIQUE,
+    provisioned_at TIMESTAMPTZ NOT NULL
+);
+
+COMMIT;